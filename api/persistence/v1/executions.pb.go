@@ -82,6 +82,8 @@ type ShardInfo struct {
 	ClusterReplicationLevel      map[string]int64      `protobuf:"bytes,12,rep,name=cluster_replication_level,json=clusterReplicationLevel,proto3" json:"cluster_replication_level,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 	ReplicationDlqAckLevel       map[string]int64      `protobuf:"bytes,13,rep,name=replication_dlq_ack_level,json=replicationDlqAckLevel,proto3" json:"replication_dlq_ack_level,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 	VisibilityAckLevel           int64                 `protobuf:"varint,14,opt,name=visibility_ack_level,json=visibilityAckLevel,proto3" json:"visibility_ack_level,omitempty"`
+	// set while the owner hands the shard off to another host
+	HandoffInfo *ShardHandoffInfo `protobuf:"bytes,15,opt,name=handoff_info,json=handoffInfo,proto3" json:"handoff_info,omitempty"`
 }
 
 func (m *ShardInfo) Reset()      { *m = ShardInfo{} }
@@ -214,6 +216,13 @@ func (m *ShardInfo) GetVisibilityAckLevel() int64 {
 	return 0
 }
 
+func (m *ShardInfo) GetHandoffInfo() *ShardHandoffInfo {
+	if m != nil {
+		return m.HandoffInfo
+	}
+	return nil
+}
+
 // execution column
 type WorkflowExecutionInfo struct {
 	NamespaceId                       string           `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
@@ -1996,6 +2005,77 @@ func (m *Checksum) GetValue() []byte {
 	return nil
 }
 
+// handoff of a shard to another host, the new owner waits for the handoff to complete before it acquires the shard
+type ShardHandoffInfo struct {
+	// identity of the host the shard is handed off to
+	NewOwner string `protobuf:"bytes,1,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+	// range ID of the shard when the handoff began, the handoff is ignored once the range changes
+	RangeId   int64      `protobuf:"varint,2,opt,name=range_id,json=rangeId,proto3" json:"range_id,omitempty"`
+	StartTime *time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty"`
+	// set once the owner drained the shard
+	Completed bool `protobuf:"varint,4,opt,name=completed,proto3" json:"completed,omitempty"`
+}
+
+func (m *ShardHandoffInfo) Reset()      { *m = ShardHandoffInfo{} }
+func (*ShardHandoffInfo) ProtoMessage() {}
+func (*ShardHandoffInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67a714d0e7ba9f37, []int{14}
+}
+func (m *ShardHandoffInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShardHandoffInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShardHandoffInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ShardHandoffInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardHandoffInfo.Merge(m, src)
+}
+func (m *ShardHandoffInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShardHandoffInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardHandoffInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardHandoffInfo proto.InternalMessageInfo
+
+func (m *ShardHandoffInfo) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
+	}
+	return ""
+}
+
+func (m *ShardHandoffInfo) GetRangeId() int64 {
+	if m != nil {
+		return m.RangeId
+	}
+	return 0
+}
+
+func (m *ShardHandoffInfo) GetStartTime() *time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *ShardHandoffInfo) GetCompleted() bool {
+	if m != nil {
+		return m.Completed
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*ShardInfo)(nil), "temporal.server.api.persistence.v1.ShardInfo")
 	proto.RegisterMapType((map[string]int64)(nil), "temporal.server.api.persistence.v1.ShardInfo.ClusterReplicationLevelEntry")
@@ -2017,6 +2097,7 @@ func init() {
 	proto.RegisterType((*RequestCancelInfo)(nil), "temporal.server.api.persistence.v1.RequestCancelInfo")
 	proto.RegisterType((*SignalInfo)(nil), "temporal.server.api.persistence.v1.SignalInfo")
 	proto.RegisterType((*Checksum)(nil), "temporal.server.api.persistence.v1.Checksum")
+	proto.RegisterType((*ShardHandoffInfo)(nil), "temporal.server.api.persistence.v1.ShardHandoffInfo")
 }
 
 func init() {
@@ -2024,208 +2105,212 @@ func init() {
 }
 
 var fileDescriptor_67a714d0e7ba9f37 = []byte{
-	// 3213 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3a, 0xcd, 0x73, 0xdb, 0xc6,
-	0xf5, 0x86, 0x45, 0x49, 0xe4, 0x23, 0x45, 0x51, 0xd0, 0x17, 0x24, 0xcb, 0x94, 0xcc, 0xd8, 0x89,
-	0x9c, 0x38, 0x94, 0x2d, 0x3b, 0xdf, 0x99, 0xf9, 0x8d, 0x2d, 0xdb, 0x09, 0x39, 0x89, 0xe3, 0x40,
-	0x4a, 0x9c, 0xc9, 0x6f, 0x32, 0x1c, 0x08, 0x58, 0x4a, 0xa8, 0x40, 0x80, 0x06, 0x40, 0xda, 0xcc,
-	0xf4, 0x90, 0x43, 0xa7, 0xb9, 0xe6, 0xd8, 0x99, 0x9e, 0x7a, 0xeb, 0xb9, 0x33, 0xf9, 0x03, 0x3a,
-	0xbd, 0xf4, 0x98, 0x63, 0x4e, 0x6d, 0xe3, 0x5c, 0x7a, 0xc9, 0x34, 0x7f, 0x42, 0x67, 0xdf, 0xee,
-	0x02, 0x0b, 0x10, 0x92, 0x21, 0x37, 0x3e, 0xe4, 0x06, 0xec, 0xfb, 0xd8, 0xb7, 0x6f, 0xdf, 0x37,
-	0x00, 0xd7, 0x43, 0xd2, 0xeb, 0x7b, 0xbe, 0xe1, 0x6c, 0x05, 0xc4, 0x1f, 0x12, 0x7f, 0xcb, 0xe8,
-	0xdb, 0x5b, 0x7d, 0xe2, 0x07, 0x76, 0x10, 0x12, 0xd7, 0x24, 0x5b, 0xc3, 0x6b, 0x5b, 0xe4, 0x31,
-	0x31, 0x07, 0xa1, 0xed, 0xb9, 0x41, 0xb3, 0xef, 0x7b, 0xa1, 0xa7, 0x36, 0x04, 0x51, 0x93, 0x11,
-	0x35, 0x8d, 0xbe, 0xdd, 0x94, 0x88, 0x9a, 0xc3, 0x6b, 0xab, 0xf5, 0x03, 0xcf, 0x3b, 0x70, 0xc8,
-	0x16, 0x52, 0xec, 0x0f, 0xba, 0x5b, 0xd6, 0xc0, 0x37, 0x28, 0x13, 0xc6, 0x63, 0x75, 0x3d, 0x0d,
-	0x0f, 0xed, 0x1e, 0x09, 0x42, 0xa3, 0xd7, 0xe7, 0x08, 0x17, 0x2c, 0xd2, 0x27, 0xae, 0x45, 0x5c,
-	0xd3, 0x26, 0xc1, 0xd6, 0x81, 0x77, 0xe0, 0xe1, 0x3a, 0x3e, 0x71, 0x94, 0x8b, 0x91, 0xf0, 0x54,
-	0x6a, 0xd3, 0xeb, 0xf5, 0x3c, 0x97, 0x0a, 0xdc, 0x23, 0x41, 0x60, 0x1c, 0x90, 0x4c, 0x2c, 0xe2,
-	0x0e, 0x7a, 0x01, 0x45, 0x7a, 0xe4, 0xf9, 0x47, 0x5d, 0xc7, 0x7b, 0xc4, 0xb1, 0x2e, 0x25, 0xb0,
-	0xba, 0x86, 0xed, 0x0c, 0x7c, 0x32, 0xce, 0x2c, 0x89, 0x76, 0x68, 0x07, 0xa1, 0xe7, 0x8f, 0xc6,
-	0xd1, 0x5e, 0x4c, 0xa0, 0x89, 0xad, 0xc6, 0xf1, 0x2e, 0x67, 0xa9, 0x3f, 0x12, 0x91, 0x9d, 0x88,
-	0xa3, 0xbe, 0x72, 0x22, 0x6a, 0xea, 0x34, 0x2f, 0x9d, 0x88, 0x1c, 0x1a, 0xc1, 0x11, 0x47, 0xbc,
-	0x92, 0x85, 0x78, 0xdc, 0xb1, 0x1a, 0xff, 0x00, 0x28, 0xed, 0x1e, 0x1a, 0xbe, 0xd5, 0x72, 0xbb,
-	0x9e, 0xba, 0x02, 0xc5, 0x80, 0xbe, 0x74, 0x6c, 0x4b, 0x53, 0x36, 0x94, 0xcd, 0x49, 0x7d, 0x1a,
-	0xdf, 0x5b, 0x16, 0x05, 0xf9, 0x86, 0x7b, 0x40, 0x28, 0xe8, 0xec, 0x86, 0xb2, 0x39, 0xa1, 0x4f,
-	0xe3, 0x7b, 0xcb, 0x52, 0x17, 0x60, 0xd2, 0x7b, 0xe4, 0x12, 0x5f, 0x9b, 0xd8, 0x50, 0x36, 0x4b,
-	0x3a, 0x7b, 0x51, 0xb7, 0x61, 0xd1, 0x27, 0x7d, 0xc7, 0x36, 0xd1, 0x46, 0x3a, 0x86, 0x79, 0xd4,
-	0x71, 0xc8, 0x90, 0x38, 0x5a, 0x01, 0xa9, 0xe7, 0x25, 0xe0, 0x4d, 0xf3, 0xe8, 0x03, 0x0a, 0x52,
-	0xaf, 0x80, 0x1a, 0xfa, 0x86, 0x1b, 0x74, 0x89, 0x2f, 0x11, 0x4c, 0x22, 0x41, 0x4d, 0x40, 0x64,
-	0xec, 0x20, 0xf4, 0x1c, 0xe2, 0x76, 0x02, 0xdb, 0x35, 0x49, 0xc7, 0x27, 0x2e, 0x79, 0xa4, 0x4d,
-	0xa1, 0xdc, 0x35, 0x06, 0xd9, 0xa5, 0x00, 0x9d, 0xae, 0xab, 0x37, 0xa1, 0x3c, 0xe8, 0x5b, 0x46,
-	0x48, 0x3a, 0xd4, 0x2e, 0xb5, 0xe9, 0x0d, 0x65, 0xb3, 0xbc, 0xbd, 0xda, 0x64, 0x46, 0xdb, 0x14,
-	0x46, 0xdb, 0xdc, 0x13, 0x46, 0x7b, 0xab, 0xf0, 0xcd, 0x3f, 0xd7, 0x15, 0x1d, 0x18, 0x11, 0x5d,
-	0x56, 0x3f, 0x86, 0x05, 0x4a, 0x2b, 0xc9, 0xc6, 0x78, 0x15, 0x73, 0xf2, 0x9a, 0x43, 0x6a, 0x21,
-	0x3f, 0xb2, 0xbc, 0x0d, 0x75, 0xd7, 0xe8, 0x91, 0xa0, 0x6f, 0x98, 0xa4, 0xe3, 0x7a, 0xa1, 0xdd,
-	0x15, 0x0a, 0x1b, 0x52, 0xef, 0xf3, 0x5c, 0xad, 0x84, 0xa7, 0x5f, 0x8b, 0xb0, 0xee, 0x49, 0x48,
-	0x9f, 0x32, 0x1c, 0xf5, 0x6b, 0x05, 0x56, 0x4d, 0x67, 0x10, 0x84, 0xc4, 0xef, 0x64, 0x28, 0x10,
-	0x36, 0x26, 0x36, 0xcb, 0xdb, 0xed, 0xe6, 0xd3, 0x9d, 0xbc, 0x19, 0xd9, 0x42, 0x73, 0x87, 0xf1,
-	0xdb, 0x4b, 0x69, 0xfd, 0x8e, 0x1b, 0xfa, 0x23, 0x7d, 0xd9, 0xcc, 0x86, 0xaa, 0xbf, 0x53, 0x60,
-	0x39, 0x92, 0x24, 0xa9, 0x2b, 0xad, 0x8c, 0x62, 0xbc, 0xf7, 0x6c, 0x62, 0xc8, 0x9a, 0x43, 0x19,
-	0xb8, 0x4e, 0x17, 0xcc, 0x0c, 0x04, 0xf5, 0xf7, 0x0a, 0xac, 0x08, 0x31, 0x64, 0x2b, 0x64, 0x82,
-	0x54, 0xfe, 0x07, 0x7d, 0xe8, 0x31, 0xb7, 0x0c, 0x7d, 0xa4, 0xa1, 0x54, 0x1f, 0x2b, 0xb2, 0x00,
-	0x96, 0xf3, 0x50, 0xd2, 0xc8, 0x0c, 0x0a, 0xd2, 0x3a, 0x9d, 0x20, 0xd2, 0x1e, 0xb7, 0x9d, 0x87,
-	0xc9, 0x7b, 0x59, 0xf2, 0x33, 0x81, 0xea, 0x55, 0x58, 0x18, 0xda, 0x81, 0xbd, 0x6f, 0x3b, 0x76,
-	0x38, 0x92, 0x04, 0xa8, 0xa2, 0x71, 0xa9, 0x31, 0x4c, 0x50, 0xac, 0xb6, 0x61, 0xed, 0x24, 0x0b,
-	0x50, 0x6b, 0x30, 0x71, 0x44, 0x46, 0x18, 0x25, 0x4a, 0x3a, 0x7d, 0xa4, 0x61, 0x60, 0x68, 0x38,
-	0x03, 0xc2, 0xc3, 0x03, 0x7b, 0x79, 0xfb, 0xec, 0x9b, 0xca, 0xaa, 0x09, 0x2b, 0xc7, 0x5e, 0x63,
-	0x06, 0xa3, 0xab, 0x32, 0xa3, 0x13, 0xfd, 0x4a, 0xde, 0x24, 0x16, 0x38, 0xf3, 0x8a, 0x4e, 0x25,
-	0x70, 0x0b, 0xce, 0x9d, 0xa0, 0xe5, 0xd3, 0xb0, 0x6a, 0xfc, 0xb4, 0x06, 0x8b, 0x0f, 0x78, 0x28,
-	0xbf, 0x23, 0xd2, 0x2e, 0x06, 0xdb, 0x0b, 0x50, 0x89, 0x5d, 0x9f, 0x07, 0xdc, 0x92, 0x5e, 0x8e,
-	0xd6, 0x5a, 0x96, 0xba, 0x0e, 0x65, 0x91, 0x06, 0x44, 0xdc, 0x2d, 0xe9, 0x20, 0x96, 0x5a, 0x96,
-	0xda, 0x84, 0xf9, 0xbe, 0xe1, 0x13, 0x37, 0xec, 0x24, 0x58, 0xb1, 0x40, 0x3c, 0xc7, 0x40, 0xf7,
-	0x24, 0x86, 0x57, 0x40, 0xe5, 0xf8, 0x32, 0xdf, 0x02, 0xa2, 0xd7, 0x18, 0xe4, 0x41, 0xcc, 0xbd,
-	0x01, 0x33, 0x1c, 0xdb, 0x1f, 0xb8, 0x14, 0x71, 0x92, 0x89, 0xc8, 0x16, 0xf5, 0x81, 0xdb, 0xb2,
-	0xe8, 0x29, 0x6c, 0xd7, 0x0e, 0x6d, 0x23, 0x24, 0x98, 0x36, 0xa6, 0x50, 0x01, 0xe5, 0x68, 0xad,
-	0x65, 0xa9, 0x6f, 0xc1, 0x8a, 0xe9, 0xf5, 0xfa, 0x0e, 0x41, 0x0f, 0x20, 0x43, 0xca, 0x70, 0xdf,
-	0x08, 0xcd, 0x43, 0x8a, 0x3f, 0x8d, 0xf8, 0x4b, 0x31, 0xc2, 0x1d, 0x0a, 0xbf, 0x45, 0xc1, 0x2d,
-	0x4b, 0xbd, 0x0f, 0xb5, 0x34, 0x29, 0x8f, 0xb6, 0x97, 0x62, 0xa7, 0xa1, 0xde, 0xc2, 0x13, 0x1c,
-	0xf5, 0x94, 0xf7, 0xd9, 0x23, 0xf2, 0xd1, 0x67, 0x53, 0x8c, 0xd5, 0xf3, 0x00, 0x34, 0x59, 0x76,
-	0x1e, 0x0e, 0xc8, 0x80, 0x60, 0x70, 0x2d, 0xe9, 0x25, 0xba, 0xf2, 0x31, 0x5d, 0xa0, 0x0a, 0x8a,
-	0x34, 0x13, 0x8e, 0xfa, 0x04, 0xf5, 0xaa, 0x01, 0x53, 0x90, 0x80, 0xec, 0x8d, 0xfa, 0x84, 0x6a,
-	0x55, 0xfd, 0x02, 0x56, 0x23, 0xec, 0xa8, 0xa6, 0xc2, 0xb8, 0xe7, 0x0d, 0x42, 0xad, 0x8c, 0x82,
-	0xae, 0x8c, 0x99, 0xef, 0x6d, 0x5e, 0x37, 0xdd, 0x2a, 0xfc, 0x81, 0x46, 0x30, 0xed, 0x51, 0xda,
-	0x3c, 0xf6, 0x18, 0x03, 0x9a, 0x6f, 0x22, 0xf6, 0xf4, 0x06, 0x04, 0xe3, 0x4a, 0x3e, 0xc6, 0xd1,
-	0x49, 0xf4, 0x41, 0xc4, 0x72, 0x1f, 0xce, 0x5b, 0xa4, 0x6b, 0x0c, 0x1c, 0xc9, 0x02, 0x50, 0x1f,
-	0x82, 0xf7, 0x4c, 0x3e, 0xde, 0xab, 0x9c, 0x8b, 0xb0, 0x96, 0x3d, 0x23, 0x38, 0x12, 0x7b, 0xbc,
-	0x00, 0x33, 0x41, 0x68, 0xf8, 0x61, 0x94, 0xc2, 0x58, 0x94, 0xa9, 0xe0, 0xa2, 0x48, 0x59, 0xaf,
-	0x80, 0xea, 0x18, 0x41, 0xc8, 0xcd, 0x01, 0x45, 0xb0, 0x2d, 0x6d, 0x0e, 0x31, 0x67, 0x29, 0x04,
-	0xaf, 0x8b, 0xb2, 0x6d, 0x59, 0xea, 0xab, 0x30, 0x8f, 0xc8, 0x5d, 0xdb, 0x8f, 0x48, 0x6c, 0x4b,
-	0x53, 0x59, 0x61, 0x40, 0x41, 0x77, 0x29, 0x04, 0x49, 0x5a, 0x96, 0xfa, 0x2e, 0x9c, 0x43, 0xf4,
-	0xe4, 0x09, 0x99, 0x4c, 0xb6, 0xa5, 0xcd, 0x23, 0xd9, 0x32, 0x45, 0x91, 0xc5, 0xdf, 0xa5, 0xf0,
-	0x96, 0xa5, 0xfe, 0x1f, 0x00, 0x43, 0xc5, 0xdc, 0xbe, 0x90, 0x33, 0xb7, 0x97, 0x90, 0x06, 0x73,
-	0x7a, 0x1b, 0x50, 0xa4, 0x8e, 0x5c, 0x6e, 0x2c, 0xe6, 0x64, 0x53, 0xa5, 0x94, 0x9f, 0xc4, 0x25,
-	0xc7, 0x36, 0x2c, 0x26, 0x4f, 0x21, 0x74, 0xba, 0xc4, 0xaa, 0xa8, 0x47, 0xd2, 0x01, 0x84, 0x6a,
-	0xdf, 0x82, 0x95, 0xd4, 0xc9, 0xcd, 0x43, 0x62, 0x0d, 0x1c, 0x0c, 0x0d, 0xcb, 0xcc, 0xdf, 0x64,
-	0xba, 0x5d, 0x0e, 0x6e, 0x59, 0xea, 0x1b, 0xa0, 0x65, 0x28, 0x8d, 0x79, 0xb6, 0x86, 0x94, 0x8b,
-	0x8f, 0xd2, 0x2a, 0x43, 0x1f, 0xdf, 0x4d, 0xcb, 0x29, 0xec, 0x69, 0x25, 0x9f, 0x3d, 0x25, 0x0e,
-	0x22, 0x0c, 0x69, 0xec, 0xf0, 0x46, 0x48, 0x9d, 0x3e, 0xd4, 0x56, 0xb1, 0xc6, 0x4b, 0xd0, 0xdc,
-	0x64, 0xa0, 0x84, 0x4b, 0x26, 0x4e, 0x80, 0xd7, 0x70, 0x2e, 0xe7, 0x35, 0x2c, 0x67, 0x9c, 0x12,
-	0xef, 0xc3, 0x80, 0xb5, 0x6c, 0xdd, 0xf2, 0x0d, 0xd6, 0x72, 0x6e, 0xb0, 0x92, 0x75, 0x01, 0x6c,
-	0x8b, 0xcb, 0x50, 0x33, 0x0d, 0xd7, 0x24, 0x4e, 0xc7, 0x27, 0x0f, 0x07, 0x24, 0x08, 0x89, 0xa5,
-	0x9d, 0xdf, 0x50, 0x36, 0x8b, 0xfa, 0x2c, 0x5b, 0xd7, 0xc5, 0xb2, 0xea, 0xc3, 0xa5, 0xa4, 0x34,
-	0x9e, 0x6f, 0x1f, 0xd8, 0xae, 0xe1, 0xa4, 0xc5, 0xaa, 0xe7, 0x14, 0xeb, 0x82, 0x2c, 0xd6, 0x47,
-	0x9c, 0x59, 0x52, 0xbc, 0x31, 0x13, 0xe1, 0x52, 0x52, 0x13, 0x59, 0xc7, 0x38, 0x99, 0x30, 0x11,
-	0x2e, 0x6c, 0xcb, 0x52, 0x5f, 0x86, 0xb9, 0xe4, 0xb9, 0x28, 0xc5, 0x06, 0x52, 0x24, 0x0f, 0xc6,
-	0x70, 0x83, 0xd0, 0x36, 0x8f, 0x46, 0x1d, 0x29, 0x58, 0x5f, 0x60, 0xb8, 0x0c, 0xb0, 0x17, 0x85,
-	0xec, 0x03, 0xd8, 0xe0, 0xb8, 0x91, 0x9d, 0x87, 0x5e, 0x27, 0x76, 0x61, 0x6a, 0x85, 0x8d, 0x7c,
-	0x56, 0xb8, 0xc6, 0x18, 0x89, 0x03, 0xef, 0x79, 0xbb, 0xc2, 0xa9, 0xa9, 0x39, 0x6a, 0x30, 0x2d,
-	0x0c, 0xf0, 0x05, 0xd6, 0x1c, 0xf1, 0x57, 0xf5, 0x13, 0x58, 0xf2, 0x49, 0xe8, 0x8f, 0x3a, 0x2c,
-	0xed, 0x39, 0x1d, 0xdb, 0x0d, 0x89, 0x3f, 0x34, 0x1c, 0xed, 0x62, 0xbe, 0x8d, 0x17, 0x90, 0xbc,
-	0xc5, 0xa8, 0x5b, 0x9c, 0x38, 0x66, 0xdb, 0x33, 0x1e, 0xdb, 0xbd, 0x41, 0x2f, 0x66, 0x7b, 0xe9,
-	0x34, 0x6c, 0x3f, 0x64, 0xd4, 0x11, 0xdb, 0x1b, 0x69, 0xb6, 0xfc, 0x18, 0x81, 0xf6, 0x22, 0x1e,
-	0x2b, 0x41, 0xc5, 0xfd, 0x2a, 0x50, 0xdf, 0xa6, 0x85, 0x2c, 0xa5, 0xda, 0x37, 0xcc, 0x23, 0xaf,
-	0xdb, 0xed, 0x98, 0x1e, 0xe9, 0x76, 0x6d, 0xd3, 0xa6, 0x39, 0xf9, 0xa5, 0x0d, 0x65, 0x53, 0xd1,
-	0x97, 0x11, 0xe1, 0x16, 0x83, 0xef, 0xc4, 0x60, 0xb5, 0x07, 0x8d, 0x8c, 0x3c, 0x49, 0x1e, 0xf7,
-	0x6d, 0x26, 0x2e, 0x33, 0xd2, 0xcd, 0x9c, 0x46, 0xba, 0x3e, 0x96, 0x30, 0xef, 0x44, 0x9c, 0x78,
-	0x53, 0xb5, 0xce, 0x44, 0x75, 0x3d, 0xb7, 0x83, 0x4f, 0xc6, 0xbe, 0x43, 0x3a, 0xc4, 0xf7, 0x3d,
-	0x1f, 0xb3, 0x7a, 0xa0, 0x5d, 0xde, 0x98, 0xd8, 0x2c, 0xe9, 0xe7, 0x10, 0x78, 0xcf, 0x73, 0x75,
-	0x81, 0x74, 0x87, 0xe2, 0xd0, 0xfc, 0x1e, 0xa8, 0x9b, 0x50, 0x3b, 0x34, 0x02, 0x46, 0xdf, 0xe9,
-	0x7b, 0x8e, 0x6d, 0x8e, 0xb4, 0x97, 0xd1, 0x0f, 0xab, 0x87, 0x46, 0x80, 0x14, 0xf7, 0x71, 0x95,
-	0x26, 0x3c, 0xd3, 0xf7, 0xdc, 0xc8, 0xfe, 0xb4, 0x57, 0xd0, 0x52, 0x2b, 0x74, 0x51, 0xd8, 0x12,
-	0x2d, 0x94, 0x02, 0xfb, 0x80, 0xfa, 0xa6, 0xe9, 0x0d, 0xdc, 0x50, 0x6b, 0xb2, 0x42, 0x89, 0xad,
-	0xed, 0xd0, 0x25, 0xf5, 0x12, 0x54, 0x78, 0x1d, 0xd3, 0x09, 0xec, 0x2f, 0x89, 0xb6, 0x45, 0x51,
-	0x6e, 0x9d, 0xd5, 0x14, 0xbd, 0xcc, 0xd7, 0x77, 0xed, 0x2f, 0x69, 0x1b, 0x3a, 0x67, 0x0c, 0x42,
-	0xaf, 0xe3, 0x93, 0x80, 0x84, 0x9d, 0xbe, 0x67, 0xbb, 0x61, 0xa0, 0x5d, 0xcf, 0xaa, 0x8a, 0xa2,
-	0x19, 0xc2, 0xf0, 0x5a, 0x53, 0xa7, 0xd8, 0xf7, 0x11, 0x59, 0x9f, 0xa5, 0xf4, 0xd2, 0x82, 0xfa,
-	0x5b, 0x98, 0x0b, 0x88, 0xe1, 0x9b, 0x87, 0xd4, 0x16, 0x7c, 0x7b, 0x7f, 0x10, 0x92, 0x40, 0xbb,
-	0x81, 0xdd, 0xc9, 0x47, 0x79, 0xba, 0x93, 0xcc, 0x0a, 0xb7, 0xb9, 0x8b, 0x2c, 0x6f, 0x46, 0x1c,
-	0x59, 0x8f, 0x52, 0x0b, 0x52, 0xcb, 0xea, 0x03, 0x28, 0xf4, 0x48, 0xcf, 0xd3, 0x5e, 0xc3, 0x0d,
-	0x77, 0x9e, 0x7d, 0xc3, 0x0f, 0x49, 0xcf, 0x63, 0x9b, 0x20, 0x43, 0xf5, 0x0b, 0x98, 0xe3, 0xf9,
-	0xb2, 0xc3, 0x14, 0x68, 0x93, 0x40, 0x7b, 0x1d, 0x35, 0x75, 0x35, 0x73, 0x17, 0xa9, 0x8c, 0xe4,
-	0xd9, 0xf4, 0x7d, 0x41, 0xa7, 0xd7, 0x86, 0xa9, 0x15, 0xf5, 0x3a, 0x2c, 0xf1, 0x8a, 0x24, 0xb2,
-	0x69, 0x5e, 0x28, 0xbf, 0x81, 0x06, 0x30, 0x8f, 0xd0, 0x48, 0x44, 0x56, 0x30, 0xff, 0x3f, 0xcc,
-	0xc6, 0xe8, 0x41, 0x68, 0x84, 0x81, 0xf6, 0x26, 0x4a, 0xb4, 0x9d, 0xe7, 0xdc, 0x11, 0xb3, 0x5d,
-	0x4a, 0xa9, 0x57, 0x49, 0xe2, 0x3d, 0x91, 0x9e, 0xa8, 0x28, 0x69, 0x17, 0x7b, 0xeb, 0xb4, 0xe9,
-	0x49, 0x1f, 0xa4, 0x9d, 0xeb, 0x06, 0x2c, 0x8f, 0xd5, 0x62, 0xe1, 0x63, 0x3c, 0xf5, 0xdb, 0xac,
-	0x26, 0x49, 0xd6, 0x63, 0x7b, 0x8f, 0xe9, 0xa9, 0x6f, 0xc0, 0x12, 0x3d, 0x2b, 0x61, 0xe3, 0x09,
-	0x1b, 0x25, 0x62, 0x7e, 0xf0, 0x0e, 0x12, 0x2d, 0x20, 0x74, 0x2f, 0x02, 0x32, 0x87, 0x78, 0x0f,
-	0xaa, 0xc9, 0xb2, 0x5a, 0x7b, 0x37, 0xe7, 0x01, 0x66, 0x88, 0x5c, 0x4c, 0xaf, 0x5a, 0xb0, 0x98,
-	0x69, 0x8c, 0x19, 0xad, 0xdc, 0x6b, 0xc9, 0xee, 0x73, 0x3d, 0xe9, 0x51, 0x7c, 0x80, 0x37, 0xbc,
-	0xd6, 0xbc, 0x6f, 0x8c, 0x1c, 0xcf, 0xb0, 0xe4, 0xb6, 0xf1, 0x33, 0x28, 0x45, 0x16, 0xf8, 0x8b,
-	0x72, 0x6e, 0x17, 0x8a, 0xb3, 0xb5, 0x5a, 0xbb, 0x50, 0xac, 0xd5, 0xe6, 0xda, 0x85, 0xe2, 0x95,
-	0xda, 0xab, 0xed, 0x42, 0xf1, 0xd5, 0x5a, 0xb3, 0x5d, 0x28, 0x5e, 0xad, 0x5d, 0x6b, 0x17, 0x8a,
-	0xd7, 0x6a, 0xdb, 0xed, 0x42, 0x71, 0xbb, 0x76, 0xbd, 0x71, 0x1d, 0xaa, 0x49, 0x1b, 0xa1, 0x81,
-	0x27, 0x11, 0x55, 0x14, 0x16, 0x78, 0xa4, 0x88, 0xd2, 0xf8, 0x8f, 0x02, 0x4b, 0x63, 0x1e, 0x45,
-	0xa9, 0x09, 0x66, 0x6d, 0x9f, 0xd0, 0x9b, 0x93, 0xb2, 0xb6, 0xc2, 0xb3, 0x36, 0x02, 0xe2, 0xac,
-	0xbd, 0x08, 0x53, 0xdc, 0xfe, 0x59, 0xa7, 0x3a, 0xe9, 0xa3, 0xc5, 0xb7, 0x61, 0x12, 0x6f, 0x17,
-	0xdb, 0xd2, 0xea, 0xf6, 0x8d, 0x4c, 0x3b, 0xc7, 0x51, 0x66, 0xa6, 0x67, 0xa3, 0x1c, 0x3a, 0x63,
-	0xa1, 0xde, 0x85, 0x29, 0xfa, 0x30, 0x08, 0xb0, 0x69, 0xad, 0x6e, 0x37, 0x93, 0x4a, 0x3c, 0x99,
-	0xcb, 0x20, 0xd0, 0x39, 0x75, 0xe3, 0xdb, 0x02, 0xd4, 0xc4, 0x60, 0x03, 0x9b, 0x8c, 0x5f, 0xaa,
-	0x23, 0x8f, 0x75, 0x30, 0x21, 0xeb, 0x60, 0x07, 0x4a, 0xac, 0x2c, 0x1e, 0xf5, 0x09, 0x17, 0xfd,
-	0xc5, 0x93, 0xf5, 0x80, 0x85, 0xf0, 0xa8, 0x4f, 0xf4, 0x62, 0xc8, 0x9f, 0x68, 0xb7, 0x1f, 0x1a,
-	0xfe, 0x01, 0x49, 0x75, 0xfb, 0xac, 0x2b, 0x9f, 0x63, 0xa0, 0x54, 0xb7, 0xcf, 0xf1, 0x65, 0x99,
-	0xa7, 0x58, 0x33, 0xcb, 0x20, 0xc9, 0x6e, 0x9f, 0x63, 0xf3, 0x03, 0x4c, 0xb3, 0xe3, 0xb3, 0x45,
-	0x16, 0xbc, 0x92, 0xdd, 0x73, 0x31, 0xdd, 0x3d, 0xbf, 0x03, 0xab, 0x9c, 0x85, 0x79, 0x68, 0x3b,
-	0x56, 0xbc, 0xad, 0xe7, 0x3a, 0x23, 0x6c, 0xb6, 0x8b, 0xfa, 0x32, 0xc3, 0xd8, 0xa1, 0x08, 0x62,
-	0xf7, 0x8f, 0x5c, 0x67, 0x44, 0x55, 0x2b, 0x37, 0x2a, 0x80, 0x66, 0x0a, 0x41, 0xdc, 0x9c, 0x68,
-	0x30, 0x2d, 0xba, 0x9f, 0x32, 0x9b, 0x40, 0xf3, 0x57, 0x75, 0x19, 0xa6, 0x45, 0x07, 0x59, 0x41,
-	0xc8, 0x54, 0xc8, 0x1a, 0xc7, 0x16, 0xcc, 0x4a, 0x73, 0x2f, 0x8c, 0x20, 0x33, 0x79, 0x3b, 0xb1,
-	0x98, 0x90, 0x82, 0xda, 0x85, 0x62, 0xb5, 0x36, 0xdb, 0xf8, 0xeb, 0x04, 0xcc, 0x4b, 0xa3, 0xa1,
-	0x5f, 0x8d, 0xe9, 0x48, 0xba, 0x9b, 0x4c, 0xea, 0xee, 0x22, 0x54, 0x53, 0x6d, 0x35, 0x1b, 0xe1,
-	0x54, 0xba, 0x72, 0x4b, 0xdd, 0x80, 0x19, 0x97, 0x3c, 0x96, 0x90, 0xd8, 0xdc, 0xa6, 0x4c, 0x17,
-	0x05, 0x0e, 0xad, 0x70, 0xa2, 0xb6, 0xc3, 0xb6, 0xd0, 0x3c, 0x68, 0x85, 0x23, 0xd6, 0x18, 0xca,
-	0xbe, 0x6f, 0xb8, 0xe6, 0x61, 0x27, 0xf4, 0x8e, 0x08, 0xbb, 0xc7, 0x8a, 0x5e, 0x66, 0x6b, 0x7b,
-	0x74, 0x49, 0xdd, 0x82, 0x05, 0x97, 0xb0, 0xec, 0x95, 0x40, 0x9d, 0x41, 0xd4, 0x39, 0x97, 0xd0,
-	0x9c, 0x74, 0x4b, 0x22, 0x90, 0x2e, 0x7f, 0x56, 0xbe, 0xfc, 0x76, 0xa1, 0x58, 0xaa, 0x41, 0xbb,
-	0x50, 0x84, 0x5a, 0xb9, 0x5d, 0x28, 0x56, 0x6a, 0x33, 0xfc, 0x0e, 0xff, 0x72, 0x16, 0xd4, 0x4f,
-	0xe3, 0xcb, 0xfd, 0xf5, 0x5f, 0xa1, 0xa4, 0x81, 0xa9, 0xa7, 0x99, 0xff, 0xf4, 0xb3, 0x99, 0x7f,
-	0xe3, 0x4f, 0x05, 0x98, 0xc1, 0xe9, 0xed, 0xaf, 0x46, 0x5f, 0x77, 0xa0, 0xc2, 0xdb, 0x3f, 0xc6,
-	0x67, 0x12, 0xf9, 0x34, 0x8e, 0x49, 0x18, 0xbc, 0xc9, 0x43, 0x1e, 0xe5, 0x30, 0x7e, 0x51, 0x89,
-	0x34, 0x84, 0x10, 0xad, 0x0f, 0xf2, 0x9b, 0x42, 0x7e, 0xd7, 0xf2, 0x65, 0x33, 0xde, 0x14, 0x21,
-	0xfb, 0x68, 0x6e, 0x21, 0x2d, 0xca, 0xb7, 0x3b, 0x9d, 0xbc, 0xdd, 0xcb, 0x50, 0x8b, 0xe2, 0xa2,
-	0xe8, 0x3f, 0x8b, 0xd8, 0xa8, 0xcd, 0x8a, 0x75, 0x31, 0xfc, 0x58, 0x81, 0x62, 0xe4, 0xa0, 0xec,
-	0xbb, 0xd1, 0x34, 0xe1, 0xce, 0x29, 0xd9, 0x08, 0x3c, 0xcd, 0x46, 0xca, 0xcf, 0x68, 0x23, 0x7f,
-	0x9c, 0x85, 0xca, 0x4d, 0x33, 0xb4, 0x87, 0x76, 0x38, 0x42, 0x13, 0x91, 0x0e, 0xa5, 0x24, 0x0f,
-	0xf5, 0x06, 0x68, 0x71, 0xac, 0x48, 0x8d, 0x84, 0xd9, 0x0c, 0x7d, 0x31, 0x82, 0x27, 0x26, 0xc2,
-	0xf7, 0x60, 0x36, 0x45, 0x88, 0xa6, 0x93, 0x7b, 0x20, 0x5c, 0x4d, 0xb2, 0xa5, 0x25, 0x66, 0x6a,
-	0x56, 0x52, 0xc8, 0x5b, 0x62, 0x06, 0x89, 0xb9, 0xc8, 0x79, 0x3e, 0x36, 0x64, 0xb1, 0x8f, 0x79,
-	0x68, 0x29, 0x88, 0x06, 0x64, 0x6d, 0x3e, 0x14, 0x8d, 0xa4, 0x9e, 0x3a, 0x8d, 0xd4, 0x15, 0x4e,
-	0xcb, 0x64, 0xde, 0x81, 0x4a, 0x62, 0xaa, 0x95, 0xd7, 0xa7, 0xcb, 0x81, 0x34, 0xc9, 0x5a, 0x87,
-	0xb2, 0xc1, 0xef, 0x4a, 0x04, 0xeb, 0x92, 0x0e, 0x62, 0x89, 0xe5, 0x7a, 0xa9, 0xe4, 0xe3, 0x93,
-	0x72, 0x3f, 0x2a, 0xf6, 0x3e, 0x87, 0x95, 0xe3, 0xe7, 0x2d, 0x90, 0x6f, 0x3e, 0xb1, 0x14, 0x64,
-	0x4f, 0x5a, 0x52, 0xbc, 0x4d, 0xc7, 0x0b, 0xc8, 0x69, 0xc7, 0xea, 0x12, 0xef, 0x1d, 0x4a, 0x2f,
-	0x78, 0xef, 0x61, 0x27, 0x42, 0x65, 0x4d, 0x33, 0xce, 0x39, 0x56, 0x9f, 0x67, 0x93, 0xde, 0x24,
-	0xd7, 0x0f, 0x60, 0xee, 0x90, 0x18, 0x7e, 0xb8, 0x4f, 0x8c, 0xf0, 0xb4, 0xb3, 0xf4, 0x5a, 0x44,
-	0x29, 0xb8, 0x65, 0x8d, 0x00, 0xab, 0xd9, 0x23, 0xc0, 0xcc, 0xa9, 0x1a, 0xcb, 0x83, 0x59, 0x53,
-	0x35, 0xf6, 0x4d, 0x56, 0x0c, 0x46, 0x69, 0x1d, 0x5d, 0x63, 0xa1, 0x24, 0x14, 0xb1, 0x9d, 0x15,
-	0xca, 0xf2, 0xb0, 0x6b, 0x2e, 0x39, 0xec, 0x4a, 0xd6, 0x80, 0x6a, 0xba, 0x06, 0xa4, 0xe1, 0x2a,
-	0xf2, 0x03, 0xe2, 0x86, 0x76, 0x38, 0xc2, 0x89, 0x3b, 0x4e, 0xee, 0xb8, 0x37, 0xb0, 0xe5, 0xcc,
-	0x09, 0xcb, 0x42, 0xe6, 0x84, 0xe5, 0xf8, 0x01, 0xdb, 0xe2, 0xf3, 0x19, 0xb0, 0x2d, 0x3d, 0x9f,
-	0x01, 0xdb, 0xf2, 0x09, 0x03, 0xb6, 0x3d, 0x58, 0x64, 0x54, 0xe9, 0xa6, 0x5d, 0xcb, 0xe9, 0xde,
-	0xf3, 0x48, 0x9e, 0x6a, 0xd7, 0x4f, 0x1c, 0xdb, 0xad, 0x9c, 0x3c, 0xb6, 0xcb, 0x31, 0x47, 0x5b,
-	0x7d, 0xfa, 0x1c, 0xed, 0x1e, 0xa8, 0x8c, 0x0b, 0x1b, 0x1b, 0xb0, 0xff, 0x70, 0xf8, 0x24, 0x7e,
-	0x23, 0x19, 0xfe, 0x38, 0x90, 0x86, 0xbf, 0xbb, 0xec, 0x51, 0xaf, 0x21, 0xed, 0x07, 0x46, 0x10,
-	0xf2, 0x15, 0xda, 0x64, 0x48, 0xfc, 0x68, 0x2e, 0x25, 0x7e, 0x6c, 0x6a, 0x6b, 0x68, 0x6a, 0xcb,
-	0x11, 0xd5, 0x03, 0x84, 0x47, 0x26, 0x97, 0x2e, 0x5a, 0xce, 0x67, 0x16, 0x2d, 0x72, 0x1f, 0x52,
-	0x1f, 0xeb, 0x43, 0x3e, 0x85, 0x25, 0xdc, 0x3a, 0x76, 0x78, 0x8b, 0x84, 0x86, 0xed, 0x04, 0x38,
-	0xff, 0x1e, 0x3b, 0xd4, 0x58, 0x63, 0x1f, 0xe8, 0x0b, 0x94, 0xfe, 0x7d, 0x41, 0x7e, 0x9b, 0x51,
-	0xab, 0x5f, 0xc0, 0x6a, 0x8a, 0xaf, 0xfc, 0x05, 0x69, 0x23, 0xef, 0xa7, 0x8b, 0x04, 0xef, 0xf8,
-	0x53, 0x52, 0xe3, 0x6f, 0x0a, 0x94, 0xb0, 0x82, 0x7b, 0x4a, 0x6a, 0x4e, 0x26, 0xb2, 0xb3, 0xe9,
-	0x44, 0x76, 0x13, 0xca, 0x68, 0xa0, 0xbc, 0x56, 0x98, 0xc8, 0xfb, 0x1f, 0x0d, 0x23, 0x12, 0xa9,
-	0x47, 0x8e, 0x40, 0xec, 0x87, 0x20, 0x0c, 0x2a, 0x3c, 0xf8, 0xac, 0x40, 0x91, 0x05, 0xaa, 0xa8,
-	0xbb, 0x9d, 0xc6, 0xf7, 0x96, 0xd5, 0xf8, 0xa9, 0x00, 0x2a, 0xf6, 0x8e, 0xc9, 0x8f, 0xe9, 0x27,
-	0x56, 0x1a, 0xf1, 0x07, 0xea, 0xec, 0x4a, 0x23, 0x82, 0x27, 0x2a, 0x8d, 0xa4, 0x1e, 0x26, 0xd2,
-	0x7a, 0xb8, 0x07, 0xb3, 0x29, 0xbe, 0xbc, 0x72, 0xc8, 0x5b, 0x88, 0x24, 0x77, 0xa5, 0xcd, 0xbd,
-	0xd8, 0x4e, 0xae, 0x99, 0x79, 0x73, 0xcf, 0x41, 0x52, 0xbb, 0x7e, 0x11, 0xaa, 0x02, 0x9f, 0x97,
-	0xd0, 0xac, 0xb1, 0x17, 0xa5, 0x81, 0xce, 0x67, 0x2f, 0xa9, 0xb2, 0x63, 0xfa, 0xd9, 0xcb, 0x8e,
-	0xcc, 0x51, 0x50, 0x31, 0x7b, 0x14, 0xb4, 0x06, 0xa5, 0xc8, 0xa7, 0x44, 0xed, 0x10, 0x2d, 0x9c,
-	0xf2, 0x2b, 0xfb, 0x67, 0xd1, 0x4f, 0x0e, 0x2c, 0x5f, 0xf3, 0x4c, 0x51, 0xc6, 0xfa, 0x7b, 0xf3,
-	0x98, 0x7a, 0xfe, 0x3e, 0x52, 0x60, 0x8e, 0x66, 0x39, 0x44, 0xfc, 0x0e, 0x21, 0x2d, 0x8d, 0xfd,
-	0xbc, 0x50, 0x19, 0xfb, 0x79, 0xa1, 0xf1, 0xad, 0x02, 0x73, 0xfc, 0x58, 0x3b, 0x98, 0x4e, 0x9f,
-	0x97, 0xb9, 0x65, 0x26, 0xf2, 0x89, 0xec, 0xcf, 0x63, 0x69, 0xb9, 0x0b, 0xe3, 0x72, 0x7f, 0x7d,
-	0x16, 0x60, 0x17, 0xbf, 0x2d, 0x3c, 0x47, 0xff, 0x18, 0x93, 0x54, 0xaa, 0x0f, 0x55, 0x28, 0xe0,
-	0xad, 0xb2, 0x9f, 0x4b, 0xf0, 0x59, 0x7d, 0x1d, 0x26, 0x6d, 0xb7, 0x3f, 0x08, 0xd1, 0xaa, 0xf3,
-	0x04, 0x4a, 0x86, 0x4e, 0xa5, 0x37, 0x3d, 0x37, 0xf4, 0x3d, 0x87, 0x1b, 0xb9, 0x78, 0x1d, 0xd3,
-	0xc4, 0xf4, 0xb8, 0x26, 0xbe, 0x52, 0xa0, 0xb8, 0x73, 0x48, 0xcc, 0xa3, 0x60, 0xd0, 0x4b, 0xeb,
-	0x61, 0x32, 0xd6, 0xc3, 0x6d, 0x98, 0xea, 0x3a, 0xc6, 0xd0, 0xf3, 0xf1, 0xd4, 0xd5, 0xed, 0x2b,
-	0x27, 0x37, 0x76, 0x82, 0xe3, 0x5d, 0xa4, 0xd1, 0x39, 0x6d, 0xfc, 0x23, 0xd0, 0x04, 0x8e, 0x2b,
-	0xd8, 0xcb, 0xad, 0xdf, 0x7c, 0xf7, 0x43, 0xfd, 0xcc, 0xf7, 0x3f, 0xd4, 0xcf, 0xfc, 0xfc, 0x43,
-	0x5d, 0xf9, 0xea, 0x49, 0x5d, 0xf9, 0xf3, 0x93, 0xba, 0xf2, 0xf7, 0x27, 0x75, 0xe5, 0xbb, 0x27,
-	0x75, 0xe5, 0x5f, 0x4f, 0xea, 0xca, 0xbf, 0x9f, 0xd4, 0xcf, 0xfc, 0xfc, 0xa4, 0xae, 0x7c, 0xf3,
-	0x63, 0xfd, 0xcc, 0x77, 0x3f, 0xd6, 0xcf, 0x7c, 0xff, 0x63, 0xfd, 0xcc, 0xe7, 0x37, 0x0e, 0xbc,
-	0x58, 0x06, 0xdb, 0x3b, 0xfe, 0x7f, 0xde, 0x77, 0xa4, 0xd7, 0xfd, 0x29, 0x0c, 0xc1, 0xd7, 0xff,
-	0x1b, 0x00, 0x00, 0xff, 0xff, 0x26, 0xbc, 0x14, 0x15, 0x08, 0x2c, 0x00, 0x00,
+	// 3279 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3a, 0x4b, 0x73, 0xdb, 0xd6,
+	0xd5, 0x86, 0x45, 0x49, 0xe4, 0x21, 0x45, 0x41, 0xd0, 0x0b, 0x94, 0x65, 0x4a, 0x66, 0xec, 0x44,
+	0x4e, 0x1c, 0xca, 0x96, 0x9d, 0x77, 0x66, 0xbe, 0xb1, 0x65, 0x3b, 0x26, 0x27, 0xb1, 0x1d, 0x48,
+	0x89, 0x33, 0xf9, 0x26, 0xc3, 0x81, 0x80, 0x4b, 0x09, 0x9f, 0x40, 0x80, 0xc6, 0x83, 0x32, 0x33,
+	0xdf, 0x22, 0x8b, 0x4e, 0xb3, 0xcd, 0xb2, 0x33, 0x5d, 0x75, 0xd7, 0x6e, 0x3b, 0x93, 0xe9, 0xba,
+	0xd3, 0x4d, 0x97, 0x59, 0x66, 0xd7, 0xc6, 0xd9, 0x74, 0x93, 0x69, 0x7e, 0x42, 0xe7, 0x9e, 0x7b,
+	0x01, 0x5c, 0x80, 0x90, 0x4c, 0xb9, 0xf1, 0x22, 0x3b, 0xe0, 0xbc, 0xee, 0xb9, 0xe7, 0x9e, 0x7b,
+	0x5e, 0x00, 0x5c, 0x0f, 0x48, 0xaf, 0xef, 0x7a, 0xba, 0xbd, 0xe9, 0x13, 0x6f, 0x40, 0xbc, 0x4d,
+	0xbd, 0x6f, 0x6d, 0xf6, 0x89, 0xe7, 0x5b, 0x7e, 0x40, 0x1c, 0x83, 0x6c, 0x0e, 0xae, 0x6d, 0x92,
+	0x27, 0xc4, 0x08, 0x03, 0xcb, 0x75, 0xfc, 0x66, 0xdf, 0x73, 0x03, 0x57, 0x69, 0x44, 0x4c, 0x4d,
+	0xc6, 0xd4, 0xd4, 0xfb, 0x56, 0x53, 0x60, 0x6a, 0x0e, 0xae, 0xad, 0xd4, 0xf7, 0x5d, 0x77, 0xdf,
+	0x26, 0x9b, 0xc8, 0xb1, 0x17, 0x76, 0x37, 0xcd, 0xd0, 0xd3, 0xa9, 0x10, 0x26, 0x63, 0x65, 0x2d,
+	0x8b, 0x0f, 0xac, 0x1e, 0xf1, 0x03, 0xbd, 0xd7, 0xe7, 0x04, 0x17, 0x4c, 0xd2, 0x27, 0x8e, 0x49,
+	0x1c, 0xc3, 0x22, 0xfe, 0xe6, 0xbe, 0xbb, 0xef, 0x22, 0x1c, 0x9f, 0x38, 0xc9, 0xc5, 0x58, 0x79,
+	0xaa, 0xb5, 0xe1, 0xf6, 0x7a, 0xae, 0x43, 0x15, 0xee, 0x11, 0xdf, 0xd7, 0xf7, 0x49, 0x2e, 0x15,
+	0x71, 0xc2, 0x9e, 0x4f, 0x89, 0x8e, 0x5c, 0xef, 0xb0, 0x6b, 0xbb, 0x47, 0x9c, 0xea, 0x52, 0x8a,
+	0xaa, 0xab, 0x5b, 0x76, 0xe8, 0x91, 0x51, 0x61, 0x69, 0xb2, 0x03, 0xcb, 0x0f, 0x5c, 0x6f, 0x38,
+	0x4a, 0xf6, 0x72, 0x8a, 0x2c, 0x5a, 0x6a, 0x94, 0xee, 0x72, 0x9e, 0xf9, 0x63, 0x15, 0xd9, 0x8e,
+	0x38, 0xe9, 0x6b, 0x27, 0x92, 0x66, 0x76, 0xf3, 0xca, 0x89, 0xc4, 0x81, 0xee, 0x1f, 0x72, 0xc2,
+	0x2b, 0x79, 0x84, 0xc7, 0x6d, 0xab, 0xf1, 0x97, 0x32, 0x94, 0x76, 0x0e, 0x74, 0xcf, 0x6c, 0x39,
+	0x5d, 0x57, 0xa9, 0x41, 0xd1, 0xa7, 0x2f, 0x1d, 0xcb, 0x54, 0xa5, 0x75, 0x69, 0x63, 0x52, 0x9b,
+	0xc6, 0xf7, 0x96, 0x49, 0x51, 0x9e, 0xee, 0xec, 0x13, 0x8a, 0x3a, 0xbb, 0x2e, 0x6d, 0x4c, 0x68,
+	0xd3, 0xf8, 0xde, 0x32, 0x95, 0x05, 0x98, 0x74, 0x8f, 0x1c, 0xe2, 0xa9, 0x13, 0xeb, 0xd2, 0x46,
+	0x49, 0x63, 0x2f, 0xca, 0x16, 0x2c, 0x7a, 0xa4, 0x6f, 0x5b, 0x06, 0xfa, 0x48, 0x47, 0x37, 0x0e,
+	0x3b, 0x36, 0x19, 0x10, 0x5b, 0x2d, 0x20, 0xf7, 0xbc, 0x80, 0xbc, 0x69, 0x1c, 0x7e, 0x48, 0x51,
+	0xca, 0x15, 0x50, 0x02, 0x4f, 0x77, 0xfc, 0x2e, 0xf1, 0x04, 0x86, 0x49, 0x64, 0x90, 0x23, 0x8c,
+	0x48, 0xed, 0x07, 0xae, 0x4d, 0x9c, 0x8e, 0x6f, 0x39, 0x06, 0xe9, 0x78, 0xc4, 0x21, 0x47, 0xea,
+	0x14, 0xea, 0x2d, 0x33, 0xcc, 0x0e, 0x45, 0x68, 0x14, 0xae, 0xdc, 0x84, 0x72, 0xd8, 0x37, 0xf5,
+	0x80, 0x74, 0xa8, 0x5f, 0xaa, 0xd3, 0xeb, 0xd2, 0x46, 0x79, 0x6b, 0xa5, 0xc9, 0x9c, 0xb6, 0x19,
+	0x39, 0x6d, 0x73, 0x37, 0x72, 0xda, 0x5b, 0x85, 0x6f, 0xfe, 0xb1, 0x26, 0x69, 0xc0, 0x98, 0x28,
+	0x58, 0xf9, 0x18, 0x16, 0x28, 0xaf, 0xa0, 0x1b, 0x93, 0x55, 0x1c, 0x53, 0xd6, 0x1c, 0x72, 0x47,
+	0xfa, 0xa3, 0xc8, 0xdb, 0x50, 0x77, 0xf4, 0x1e, 0xf1, 0xfb, 0xba, 0x41, 0x3a, 0x8e, 0x1b, 0x58,
+	0xdd, 0xc8, 0x60, 0x03, 0x7a, 0xfb, 0x5c, 0x47, 0x2d, 0xe1, 0xee, 0x57, 0x63, 0xaa, 0xfb, 0x02,
+	0xd1, 0xa7, 0x8c, 0x46, 0xf9, 0x5a, 0x82, 0x15, 0xc3, 0x0e, 0xfd, 0x80, 0x78, 0x9d, 0x1c, 0x03,
+	0xc2, 0xfa, 0xc4, 0x46, 0x79, 0xab, 0xdd, 0x7c, 0xf6, 0x25, 0x6f, 0xc6, 0xbe, 0xd0, 0xdc, 0x66,
+	0xf2, 0x76, 0x33, 0x56, 0xbf, 0xe3, 0x04, 0xde, 0x50, 0x5b, 0x36, 0xf2, 0xb1, 0xca, 0x6f, 0x24,
+	0x58, 0x8e, 0x35, 0x49, 0xdb, 0x4a, 0x2d, 0xa3, 0x1a, 0x1f, 0x3c, 0x9f, 0x1a, 0x56, 0x2f, 0xa3,
+	0x03, 0xb7, 0xe9, 0x82, 0x91, 0x43, 0xa0, 0xfc, 0x56, 0x82, 0x5a, 0xa4, 0x86, 0xe8, 0x85, 0x4c,
+	0x91, 0xca, 0x7f, 0x61, 0x0f, 0x2d, 0x91, 0x96, 0x63, 0x8f, 0x2c, 0x96, 0xda, 0xa3, 0x26, 0x2a,
+	0x60, 0xda, 0x8f, 0x05, 0x8b, 0xcc, 0xa0, 0x22, 0xad, 0xd3, 0x29, 0x22, 0xac, 0x71, 0xdb, 0x7e,
+	0x9c, 0x3e, 0x97, 0x25, 0x2f, 0x17, 0xa9, 0x5c, 0x85, 0x85, 0x81, 0xe5, 0x5b, 0x7b, 0x96, 0x6d,
+	0x05, 0x43, 0x41, 0x81, 0x2a, 0x3a, 0x97, 0x92, 0xe0, 0x62, 0x8e, 0x47, 0x50, 0x39, 0xd0, 0x1d,
+	0xd3, 0xed, 0x76, 0x3b, 0x96, 0xd3, 0x75, 0xd5, 0x59, 0xf4, 0xf1, 0x1b, 0x63, 0xab, 0x7a, 0x8f,
+	0x31, 0x53, 0x8d, 0xb5, 0xf2, 0x41, 0xf2, 0xb2, 0xd2, 0x86, 0xd5, 0x93, 0x5c, 0x4b, 0x91, 0x61,
+	0xe2, 0x90, 0x0c, 0x31, 0xfc, 0x94, 0x34, 0xfa, 0x48, 0xe3, 0xcb, 0x40, 0xb7, 0x43, 0xc2, 0xe3,
+	0x0e, 0x7b, 0x79, 0xf7, 0xec, 0xdb, 0xd2, 0x8a, 0x01, 0xb5, 0x63, 0xfd, 0x23, 0x47, 0xd0, 0x55,
+	0x51, 0xd0, 0x89, 0x17, 0x56, 0x5c, 0x24, 0x51, 0x38, 0xf7, 0xec, 0x4f, 0xa5, 0x70, 0x0b, 0xce,
+	0x9d, 0x70, 0x7c, 0xa7, 0x11, 0xd5, 0xf8, 0x69, 0x15, 0x16, 0x1f, 0xf1, 0x1c, 0x71, 0x27, 0xca,
+	0xe7, 0x18, 0xc5, 0x2f, 0x40, 0x25, 0x89, 0x29, 0x3c, 0x92, 0x97, 0xb4, 0x72, 0x0c, 0x6b, 0x99,
+	0xca, 0x1a, 0x94, 0xa3, 0xfc, 0x12, 0x05, 0xf4, 0x92, 0x06, 0x11, 0xa8, 0x65, 0x2a, 0x4d, 0x98,
+	0xef, 0xeb, 0x1e, 0x71, 0x82, 0x4e, 0x4a, 0x14, 0x8b, 0xf0, 0x73, 0x0c, 0x75, 0x5f, 0x10, 0x78,
+	0x05, 0x14, 0x4e, 0x2f, 0xca, 0x2d, 0x20, 0xb9, 0xcc, 0x30, 0x8f, 0x12, 0xe9, 0x0d, 0x98, 0xe1,
+	0xd4, 0x5e, 0xe8, 0x50, 0xc2, 0x49, 0xa6, 0x22, 0x03, 0x6a, 0xa1, 0xd3, 0x32, 0xe9, 0x2e, 0x2c,
+	0xc7, 0x0a, 0x2c, 0x3d, 0x20, 0x98, 0x8f, 0xa6, 0xd0, 0x00, 0xe5, 0x18, 0xd6, 0x32, 0x95, 0x77,
+	0xa0, 0x66, 0xb8, 0xbd, 0xbe, 0x4d, 0xf0, 0x6a, 0x91, 0x01, 0x15, 0xb8, 0xa7, 0x07, 0xc6, 0x01,
+	0xa5, 0x9f, 0x46, 0xfa, 0xa5, 0x84, 0xe0, 0x0e, 0xc5, 0xdf, 0xa2, 0xe8, 0x96, 0xa9, 0x3c, 0x04,
+	0x39, 0xcb, 0xca, 0xc3, 0xf8, 0xa5, 0xc4, 0xc5, 0xa9, 0x6f, 0xf3, 0xcc, 0x49, 0xfd, 0xfa, 0x1e,
+	0x7b, 0x44, 0x39, 0xda, 0x6c, 0x46, 0xb0, 0x72, 0x1e, 0x80, 0x66, 0xe1, 0xce, 0xe3, 0x90, 0x84,
+	0x04, 0xa3, 0x76, 0x49, 0x2b, 0x51, 0xc8, 0xc7, 0x14, 0x40, 0x0d, 0x14, 0x5b, 0x26, 0x18, 0xf6,
+	0x09, 0xda, 0x55, 0x05, 0x66, 0xa0, 0x08, 0xb3, 0x3b, 0xec, 0x13, 0x6a, 0x55, 0xe5, 0x0b, 0x58,
+	0x89, 0xa9, 0xe3, 0x62, 0x0d, 0x03, 0xaa, 0x1b, 0x06, 0x6a, 0x19, 0x15, 0xad, 0x8d, 0xb8, 0xef,
+	0x6d, 0x5e, 0x90, 0xdd, 0x2a, 0xfc, 0x8e, 0x86, 0x46, 0xf5, 0x28, 0xeb, 0x1e, 0xbb, 0x4c, 0x00,
+	0x4d, 0x64, 0xb1, 0x78, 0x2f, 0x4c, 0x04, 0x57, 0xc6, 0x13, 0x1c, 0xef, 0x44, 0x0b, 0x63, 0x91,
+	0x7b, 0x70, 0xde, 0x24, 0x5d, 0x3d, 0xb4, 0x05, 0x0f, 0x40, 0x7b, 0x44, 0xb2, 0x67, 0xc6, 0x93,
+	0xbd, 0xc2, 0xa5, 0x44, 0xde, 0xb2, 0xab, 0xfb, 0x87, 0xd1, 0x1a, 0x2f, 0xc1, 0x8c, 0x1f, 0xe8,
+	0x5e, 0x10, 0xe7, 0x46, 0x16, 0xbe, 0x2a, 0x08, 0x8c, 0x72, 0xe1, 0x6b, 0xa0, 0xd8, 0xba, 0x1f,
+	0x70, 0x77, 0x40, 0x15, 0x2c, 0x53, 0x9d, 0x43, 0xca, 0x59, 0x8a, 0xc1, 0xe3, 0xa2, 0x62, 0x5b,
+	0xa6, 0xf2, 0x3a, 0xcc, 0x23, 0x71, 0xd7, 0xf2, 0x62, 0x16, 0xcb, 0x54, 0x15, 0x56, 0x71, 0x50,
+	0xd4, 0x5d, 0xcb, 0xe3, 0x2c, 0x2d, 0x53, 0x79, 0x1f, 0xce, 0x21, 0x79, 0x7a, 0x87, 0x4c, 0x27,
+	0xcb, 0x54, 0xe7, 0x91, 0x6d, 0x99, 0x92, 0x88, 0xea, 0xef, 0x50, 0x7c, 0xcb, 0x54, 0xfe, 0x07,
+	0x80, 0x91, 0x62, 0xd1, 0xb0, 0x30, 0x66, 0xd1, 0x50, 0x42, 0x1e, 0x0a, 0x55, 0xda, 0x80, 0x2a,
+	0x75, 0xc4, 0x3a, 0x66, 0x71, 0x4c, 0x31, 0x55, 0xca, 0xf9, 0x49, 0x52, 0xcb, 0x6c, 0xc1, 0x62,
+	0x7a, 0x17, 0x91, 0x4d, 0x97, 0x58, 0x79, 0x76, 0x24, 0x6c, 0x20, 0x32, 0xed, 0x3b, 0x50, 0xcb,
+	0xec, 0xdc, 0x38, 0x20, 0x66, 0x68, 0x63, 0x68, 0x58, 0x66, 0xf7, 0x4d, 0xe4, 0xdb, 0xe1, 0xe8,
+	0x96, 0xa9, 0xbc, 0x05, 0x6a, 0x8e, 0xd1, 0xd8, 0xcd, 0x56, 0x91, 0x73, 0xf1, 0x28, 0x6b, 0x32,
+	0xbc, 0xe3, 0x3b, 0x59, 0x3d, 0x23, 0x7f, 0xaa, 0x8d, 0xe7, 0x4f, 0xa9, 0x8d, 0x44, 0x8e, 0x34,
+	0xb2, 0x79, 0x3d, 0xa0, 0x97, 0x3e, 0x50, 0x57, 0xb0, 0x78, 0x4c, 0xf1, 0xdc, 0x64, 0xa8, 0xd4,
+	0x95, 0x4c, 0xed, 0x00, 0x8f, 0xe1, 0xdc, 0x98, 0xc7, 0xb0, 0x9c, 0xb3, 0x4b, 0x3c, 0x0f, 0x1d,
+	0x56, 0xf3, 0x6d, 0xcb, 0x17, 0x58, 0x1d, 0x73, 0x81, 0x5a, 0xde, 0x01, 0xb0, 0x25, 0x2e, 0x83,
+	0x6c, 0xe8, 0x8e, 0x41, 0xec, 0x8e, 0x47, 0x1e, 0x87, 0xc4, 0x0f, 0x88, 0xa9, 0x9e, 0x5f, 0x97,
+	0x36, 0x8a, 0xda, 0x2c, 0x83, 0x6b, 0x11, 0x58, 0xf1, 0xe0, 0x52, 0x5a, 0x1b, 0xd7, 0xb3, 0xf6,
+	0x2d, 0x47, 0xb7, 0xb3, 0x6a, 0xd5, 0xc7, 0x54, 0xeb, 0x82, 0xa8, 0xd6, 0x03, 0x2e, 0x2c, 0xad,
+	0xde, 0x88, 0x8b, 0x70, 0x2d, 0xa9, 0x8b, 0xac, 0x61, 0x9c, 0x4c, 0xb9, 0x08, 0x57, 0xb6, 0x65,
+	0x2a, 0xaf, 0xc2, 0x5c, 0x7a, 0x5f, 0x94, 0x63, 0x1d, 0x39, 0xd2, 0x1b, 0x63, 0xb4, 0x7e, 0x60,
+	0x19, 0x87, 0xc3, 0x8e, 0x10, 0xac, 0x2f, 0x30, 0x5a, 0x86, 0xd8, 0x8d, 0x43, 0xf6, 0x3e, 0xac,
+	0x73, 0xda, 0xd8, 0xcf, 0x03, 0xb7, 0x93, 0x5c, 0x61, 0xea, 0x85, 0x8d, 0xf1, 0xbc, 0x70, 0x95,
+	0x09, 0x8a, 0x36, 0xbc, 0xeb, 0xee, 0x44, 0x97, 0x9a, 0xba, 0xa3, 0x0a, 0xd3, 0x91, 0x03, 0xbe,
+	0xc4, 0xba, 0x2e, 0xfe, 0xaa, 0x7c, 0x02, 0x4b, 0x1e, 0x09, 0xbc, 0x61, 0x87, 0xa5, 0x3d, 0xbb,
+	0x63, 0x39, 0x01, 0xf1, 0x06, 0xba, 0xad, 0x5e, 0x1c, 0x6f, 0xe1, 0x05, 0x64, 0x6f, 0x31, 0xee,
+	0x16, 0x67, 0x4e, 0xc4, 0xf6, 0xf4, 0x27, 0x56, 0x2f, 0xec, 0x25, 0x62, 0x2f, 0x9d, 0x46, 0xec,
+	0x47, 0x8c, 0x3b, 0x16, 0x7b, 0x23, 0x2b, 0x96, 0x6f, 0xc3, 0x57, 0x5f, 0xc6, 0x6d, 0xa5, 0xb8,
+	0xf8, 0xbd, 0xf2, 0x95, 0x77, 0xa1, 0xc6, 0xb8, 0xf6, 0x74, 0xe3, 0x90, 0xd6, 0x9b, 0x86, 0x4b,
+	0xba, 0x5d, 0xcb, 0xb0, 0x68, 0x4e, 0x7e, 0x65, 0x5d, 0xda, 0x90, 0xb4, 0x65, 0x24, 0xb8, 0xc5,
+	0xf0, 0xdb, 0x09, 0x5a, 0xe9, 0x41, 0x23, 0x27, 0x4f, 0x92, 0x27, 0x7d, 0x8b, 0xa9, 0xcb, 0x9c,
+	0x74, 0x63, 0x4c, 0x27, 0x5d, 0x1b, 0x49, 0x98, 0x77, 0x62, 0x49, 0xbc, 0x5b, 0x5b, 0x63, 0xaa,
+	0x3a, 0xae, 0xd3, 0xc1, 0x27, 0x7d, 0xcf, 0x26, 0x1d, 0xe2, 0x79, 0xae, 0x87, 0x59, 0xdd, 0x57,
+	0x2f, 0xaf, 0x4f, 0x6c, 0x94, 0xb4, 0x73, 0x88, 0xbc, 0xef, 0x3a, 0x5a, 0x44, 0x74, 0x87, 0xd2,
+	0xd0, 0xfc, 0xee, 0x2b, 0x1b, 0x20, 0x1f, 0xe8, 0x3e, 0xe3, 0xef, 0xf4, 0x5d, 0xdb, 0x32, 0x86,
+	0xea, 0xab, 0x78, 0x0f, 0xab, 0x07, 0xba, 0x8f, 0x1c, 0x0f, 0x11, 0x4a, 0x13, 0x9e, 0xe1, 0xb9,
+	0x4e, 0xec, 0x7f, 0xea, 0x6b, 0xe8, 0xa9, 0x15, 0x0a, 0x8c, 0x7c, 0x89, 0x16, 0x4a, 0xbe, 0xb5,
+	0x4f, 0xef, 0xa6, 0xe1, 0x86, 0x4e, 0xa0, 0x36, 0x59, 0xa1, 0xc4, 0x60, 0xdb, 0x14, 0xa4, 0x5c,
+	0x82, 0x0a, 0xaf, 0x63, 0x3a, 0xbe, 0xf5, 0x25, 0x51, 0x37, 0x29, 0xc9, 0xad, 0xb3, 0xaa, 0xa4,
+	0x95, 0x39, 0x7c, 0xc7, 0xfa, 0x92, 0xf6, 0xb7, 0x73, 0x7a, 0x18, 0xb8, 0x1d, 0x8f, 0xf8, 0x24,
+	0xe8, 0xf4, 0x5d, 0xcb, 0x09, 0x7c, 0xf5, 0x7a, 0x5e, 0x55, 0x14, 0x0f, 0x27, 0x06, 0xd7, 0x9a,
+	0x1a, 0xa5, 0x7e, 0x88, 0xc4, 0xda, 0x2c, 0xe5, 0x17, 0x00, 0xca, 0xff, 0xc3, 0x9c, 0x4f, 0x74,
+	0xcf, 0x38, 0xa0, 0xbe, 0xe0, 0x59, 0x7b, 0x61, 0x40, 0x7c, 0xf5, 0x06, 0xb6, 0x3d, 0x0f, 0xc6,
+	0xe9, 0x25, 0x72, 0x2b, 0xdc, 0xe6, 0x0e, 0x8a, 0xbc, 0x19, 0x4b, 0x64, 0xcd, 0x8f, 0xec, 0x67,
+	0xc0, 0xca, 0x23, 0x28, 0xf4, 0x48, 0xcf, 0x55, 0xdf, 0xc0, 0x05, 0xb7, 0x9f, 0x7f, 0xc1, 0x8f,
+	0x48, 0xcf, 0x65, 0x8b, 0xa0, 0x40, 0xe5, 0x0b, 0x98, 0xe3, 0xf9, 0xb2, 0xc3, 0x0c, 0x68, 0x11,
+	0x5f, 0x7d, 0x13, 0x2d, 0x75, 0x35, 0x77, 0x15, 0xa1, 0x8c, 0xe4, 0xd9, 0xf4, 0x5e, 0xc4, 0xa7,
+	0xc9, 0x83, 0x0c, 0x44, 0xb9, 0x0e, 0x4b, 0xbc, 0x22, 0x89, 0x7d, 0x9a, 0x17, 0xca, 0x6f, 0xa1,
+	0x03, 0xcc, 0x23, 0x36, 0x56, 0x91, 0x15, 0xcc, 0xff, 0x0b, 0xb3, 0x09, 0xb9, 0x1f, 0xe8, 0x81,
+	0xaf, 0xbe, 0x8d, 0x1a, 0x6d, 0x8d, 0xb3, 0xef, 0x58, 0xd8, 0x0e, 0xe5, 0xd4, 0xaa, 0x24, 0xf5,
+	0x9e, 0x4a, 0x4f, 0x5e, 0x38, 0x7a, 0xc5, 0xde, 0x39, 0x6d, 0x7a, 0xd2, 0xc2, 0xec, 0xe5, 0xba,
+	0x01, 0xcb, 0x23, 0xb5, 0x58, 0xf0, 0x04, 0x77, 0xfd, 0x2e, 0xab, 0x49, 0xd2, 0xf5, 0xd8, 0xee,
+	0x13, 0xba, 0xeb, 0x1b, 0xb0, 0x44, 0xf7, 0x4a, 0xd8, 0xdc, 0xc3, 0x42, 0x8d, 0xd8, 0x3d, 0x78,
+	0x0f, 0x99, 0x16, 0x10, 0xbb, 0x1b, 0x23, 0xd9, 0x85, 0xf8, 0x00, 0xaa, 0xe9, 0xb2, 0x5a, 0x7d,
+	0x7f, 0xcc, 0x0d, 0xcc, 0x10, 0xb1, 0x98, 0x5e, 0x31, 0x61, 0x31, 0xd7, 0x19, 0x73, 0x5a, 0xb9,
+	0x37, 0xd2, 0xdd, 0xe7, 0x5a, 0xfa, 0x46, 0xf1, 0xc9, 0xe0, 0xe0, 0x5a, 0xf3, 0xa1, 0x3e, 0xb4,
+	0x5d, 0xdd, 0x14, 0xdb, 0xc6, 0xcf, 0xa0, 0x14, 0x7b, 0xe0, 0x2f, 0x2a, 0xb9, 0x5d, 0x28, 0xce,
+	0xca, 0x72, 0xbb, 0x50, 0x94, 0xe5, 0xb9, 0x76, 0xa1, 0x78, 0x45, 0x7e, 0xbd, 0x5d, 0x28, 0xbe,
+	0x2e, 0x37, 0xdb, 0x85, 0xe2, 0x55, 0xf9, 0x5a, 0xbb, 0x50, 0xbc, 0x26, 0x6f, 0xb5, 0x0b, 0xc5,
+	0x2d, 0xf9, 0x7a, 0xe3, 0x3a, 0x54, 0xd3, 0x3e, 0x42, 0x03, 0x4f, 0x2a, 0xaa, 0x48, 0x2c, 0xf0,
+	0x08, 0x11, 0xa5, 0xf1, 0x6f, 0x09, 0x96, 0x46, 0x6e, 0x14, 0xe5, 0x26, 0x98, 0xb5, 0x3d, 0x42,
+	0x4f, 0x4e, 0xc8, 0xda, 0x12, 0xcf, 0xda, 0x88, 0x48, 0xb2, 0xf6, 0x22, 0x4c, 0x71, 0xff, 0x67,
+	0x9d, 0xea, 0xa4, 0x87, 0x1e, 0xdf, 0x86, 0x49, 0x3c, 0x5d, 0x6c, 0x4b, 0xab, 0xc7, 0x0c, 0x27,
+	0x70, 0x46, 0x9a, 0x7b, 0xb3, 0x51, 0x0f, 0x8d, 0x89, 0x50, 0xee, 0xc2, 0x14, 0x7d, 0x08, 0x7d,
+	0x6c, 0x5a, 0xab, 0x5b, 0xcd, 0xb4, 0x11, 0x4f, 0x96, 0x12, 0xfa, 0x1a, 0xe7, 0x6e, 0x7c, 0x5b,
+	0x00, 0x39, 0x1a, 0x6c, 0x60, 0x93, 0xf1, 0x4b, 0x75, 0xe4, 0x89, 0x0d, 0x26, 0x44, 0x1b, 0x6c,
+	0x43, 0x89, 0x95, 0xc5, 0xc3, 0x3e, 0xe1, 0xaa, 0xbf, 0x7c, 0xb2, 0x1d, 0xb0, 0x10, 0x1e, 0xf6,
+	0x89, 0x56, 0x0c, 0xf8, 0x13, 0xed, 0xf6, 0x03, 0xdd, 0xdb, 0x27, 0x99, 0x6e, 0x9f, 0x75, 0xe5,
+	0x73, 0x0c, 0x95, 0xe9, 0xf6, 0x39, 0xbd, 0xa8, 0xf3, 0x14, 0x6b, 0x66, 0x19, 0x26, 0xdd, 0xed,
+	0x73, 0x6a, 0xbe, 0x81, 0x69, 0xb6, 0x7d, 0x06, 0x64, 0xc1, 0x2b, 0xdd, 0x3d, 0x17, 0xb3, 0xdd,
+	0xf3, 0x7b, 0xb0, 0xc2, 0x45, 0x18, 0x07, 0x96, 0x6d, 0x26, 0xcb, 0xba, 0x8e, 0x3d, 0xc4, 0x66,
+	0xbb, 0xa8, 0x2d, 0x33, 0x8a, 0x6d, 0x4a, 0x10, 0xad, 0xfe, 0xc0, 0xb1, 0x87, 0xd4, 0xb4, 0x62,
+	0xa3, 0x02, 0xe8, 0xa6, 0xe0, 0x27, 0xcd, 0x89, 0x0a, 0xd3, 0x51, 0xf7, 0x53, 0x46, 0x64, 0xf4,
+	0xaa, 0x2c, 0xc3, 0x74, 0xd4, 0x41, 0x56, 0x10, 0x33, 0x15, 0xb0, 0xc6, 0xb1, 0x05, 0xb3, 0xc2,
+	0x40, 0x0d, 0x23, 0xc8, 0xcc, 0xb8, 0x9d, 0x58, 0xc2, 0x48, 0x51, 0xed, 0x42, 0xb1, 0x2a, 0xcf,
+	0x36, 0xfe, 0x3a, 0x01, 0xf3, 0xc2, 0x68, 0xe8, 0x57, 0xe3, 0x3a, 0x82, 0xed, 0x26, 0xd3, 0xb6,
+	0xbb, 0x08, 0xd5, 0x4c, 0x5b, 0xcd, 0x46, 0x38, 0x95, 0xae, 0xd8, 0x52, 0x37, 0x60, 0xc6, 0x21,
+	0x4f, 0x04, 0x22, 0x36, 0xb7, 0x29, 0x53, 0x60, 0x44, 0x43, 0x2b, 0x9c, 0xb8, 0xed, 0xb0, 0x4c,
+	0xb5, 0xc8, 0x2b, 0x9c, 0x08, 0xc6, 0x48, 0xf6, 0x3c, 0xdd, 0x31, 0x0e, 0x3a, 0x81, 0x7b, 0x48,
+	0xd8, 0x39, 0x56, 0xb4, 0x32, 0x83, 0xed, 0x52, 0x90, 0xb2, 0x09, 0x0b, 0x0e, 0x61, 0xd9, 0x2b,
+	0x45, 0x3a, 0x83, 0xa4, 0x73, 0x0e, 0xa1, 0x39, 0xe9, 0x96, 0xc0, 0x20, 0x1c, 0xfe, 0xac, 0x78,
+	0xf8, 0xed, 0x42, 0xb1, 0x24, 0x43, 0xbb, 0x50, 0x04, 0xb9, 0xdc, 0x2e, 0x14, 0x2b, 0xf2, 0x0c,
+	0x3f, 0xc3, 0x3f, 0x9f, 0x05, 0xe5, 0xd3, 0xe4, 0x70, 0x7f, 0xfd, 0x47, 0x28, 0x58, 0x60, 0xea,
+	0x59, 0xee, 0x3f, 0xfd, 0x7c, 0xee, 0xdf, 0xf8, 0x43, 0x01, 0x66, 0xe8, 0xc3, 0xaf, 0x27, 0x5a,
+	0xde, 0x81, 0x0a, 0x6f, 0xff, 0x98, 0x9c, 0x49, 0x94, 0xd3, 0x38, 0x26, 0x61, 0xf0, 0x26, 0x0f,
+	0x65, 0x94, 0x83, 0xe4, 0x45, 0x21, 0xc2, 0x10, 0x22, 0x6a, 0x7d, 0x50, 0xde, 0x14, 0xca, 0xbb,
+	0x36, 0x5e, 0x36, 0xe3, 0x4d, 0x11, 0x8a, 0x9f, 0x3f, 0x1a, 0x05, 0x8a, 0xa7, 0x3b, 0x9d, 0x3e,
+	0xdd, 0xcb, 0x20, 0xc7, 0x71, 0x31, 0xea, 0x3f, 0x8b, 0xd8, 0xa8, 0xcd, 0x46, 0xf0, 0x68, 0xf8,
+	0x51, 0x83, 0x62, 0x7c, 0x41, 0xd9, 0x07, 0xa9, 0x69, 0xc2, 0x2f, 0xa7, 0xe0, 0x23, 0xf0, 0x2c,
+	0x1f, 0x29, 0x3f, 0xa7, 0x8f, 0xfc, 0x7e, 0x16, 0x2a, 0x37, 0x8d, 0xc0, 0x1a, 0x58, 0xc1, 0x10,
+	0x5d, 0x44, 0xd8, 0x94, 0x94, 0xde, 0xd4, 0x5b, 0xa0, 0x26, 0xb1, 0x22, 0x33, 0x12, 0x66, 0x33,
+	0xf4, 0xc5, 0x18, 0x9f, 0x9a, 0x08, 0xdf, 0x87, 0xd9, 0x0c, 0xa3, 0x3a, 0x91, 0xd7, 0xfa, 0x1c,
+	0x37, 0x10, 0xae, 0xa6, 0xc5, 0xd2, 0x12, 0x33, 0x33, 0x2b, 0x29, 0x8c, 0x5b, 0x62, 0xfa, 0xa9,
+	0xb9, 0xc8, 0x79, 0x3e, 0x36, 0x64, 0xb1, 0x8f, 0xdd, 0xd0, 0x92, 0x1f, 0x0f, 0xc8, 0xda, 0x7c,
+	0x28, 0x1a, 0x6b, 0x3d, 0x75, 0x1a, 0xad, 0x2b, 0x9c, 0x97, 0xe9, 0xbc, 0x0d, 0x95, 0xd4, 0x54,
+	0x6b, 0xdc, 0x3b, 0x5d, 0xf6, 0x85, 0x49, 0xd6, 0x1a, 0x94, 0x75, 0x7e, 0x56, 0x51, 0xb0, 0x2e,
+	0x69, 0x10, 0x81, 0x58, 0xae, 0x17, 0x4a, 0x3e, 0x3e, 0x29, 0xf7, 0xe2, 0x62, 0xef, 0x73, 0xa8,
+	0x1d, 0x3f, 0x6f, 0x81, 0xf1, 0xe6, 0x13, 0x4b, 0x7e, 0xfe, 0xa4, 0x25, 0x23, 0xdb, 0xb0, 0x5d,
+	0x9f, 0x9c, 0x76, 0xac, 0x2e, 0xc8, 0xde, 0xa6, 0xfc, 0x91, 0xec, 0x5d, 0x58, 0xe2, 0xba, 0x66,
+	0x05, 0x8f, 0x39, 0x56, 0x9f, 0x47, 0xf6, 0x8c, 0xd4, 0x0f, 0x61, 0xee, 0x80, 0xe8, 0x5e, 0xb0,
+	0x47, 0xf4, 0xe0, 0xb4, 0xb3, 0x74, 0x39, 0xe6, 0x8c, 0xa4, 0xe5, 0x8d, 0x00, 0xab, 0xf9, 0x23,
+	0xc0, 0xdc, 0xa9, 0x1a, 0xcb, 0x83, 0x79, 0x53, 0x35, 0xf6, 0xb1, 0x37, 0x1a, 0x8c, 0xd2, 0x3a,
+	0x5a, 0x66, 0xa1, 0x24, 0x88, 0x62, 0x3b, 0x2b, 0x94, 0xc5, 0x61, 0xd7, 0x5c, 0x7a, 0xd8, 0x95,
+	0xae, 0x01, 0x95, 0x6c, 0x0d, 0x48, 0xc3, 0x55, 0x7c, 0x0f, 0x88, 0x13, 0x58, 0xc1, 0x50, 0x9d,
+	0x8f, 0x26, 0x77, 0xfc, 0x36, 0x30, 0x70, 0xee, 0x84, 0x65, 0x21, 0x77, 0xc2, 0x72, 0xfc, 0x80,
+	0x6d, 0xf1, 0xc5, 0x0c, 0xd8, 0x96, 0x5e, 0xcc, 0x80, 0x6d, 0xf9, 0x84, 0x01, 0xdb, 0x2e, 0x2c,
+	0x32, 0xae, 0x6c, 0xd3, 0xae, 0x8e, 0x79, 0xbd, 0xe7, 0x91, 0x3d, 0xd3, 0xae, 0x9f, 0x38, 0xb6,
+	0xab, 0x9d, 0x3c, 0xb6, 0x1b, 0x63, 0x8e, 0xb6, 0xf2, 0xec, 0x39, 0xda, 0x7d, 0x50, 0x98, 0x14,
+	0x36, 0x36, 0x60, 0x3f, 0xf8, 0xf0, 0x49, 0xfc, 0x7a, 0x3a, 0xfc, 0x71, 0x24, 0x0d, 0x7f, 0x77,
+	0xd9, 0xa3, 0x26, 0x23, 0xef, 0x87, 0x74, 0xa4, 0xc0, 0x20, 0xb4, 0xc9, 0x10, 0xe4, 0xd1, 0x5c,
+	0x4a, 0xbc, 0xc4, 0xd5, 0x56, 0xd1, 0xd5, 0x96, 0x63, 0xae, 0x47, 0x88, 0x8f, 0x5d, 0x2e, 0x5b,
+	0xb4, 0x9c, 0xcf, 0x2d, 0x5a, 0xc4, 0x3e, 0xa4, 0x3e, 0xd2, 0x87, 0x7c, 0x0a, 0x4b, 0xb8, 0x74,
+	0x72, 0xe1, 0x4d, 0x12, 0xe8, 0x96, 0xed, 0xab, 0x6b, 0x79, 0x9b, 0x1a, 0x69, 0xec, 0x7d, 0x6d,
+	0x81, 0xf2, 0xdf, 0x8b, 0xd8, 0x6f, 0x33, 0x6e, 0xfa, 0xe9, 0x22, 0x23, 0x57, 0xfc, 0x82, 0xb4,
+	0x3e, 0xee, 0xa7, 0x8b, 0x94, 0xec, 0xe4, 0x53, 0x52, 0xe3, 0x6f, 0x12, 0x94, 0xe8, 0x83, 0xf7,
+	0x8c, 0xd4, 0x9c, 0x4e, 0x64, 0x67, 0xb3, 0x89, 0xec, 0x26, 0x94, 0xd1, 0x41, 0x79, 0xad, 0x30,
+	0x31, 0xa6, 0x5a, 0xc0, 0x98, 0xa2, 0xd4, 0x23, 0x46, 0x20, 0xf6, 0xa7, 0x11, 0x04, 0x49, 0xf0,
+	0xa9, 0x41, 0x91, 0x05, 0xaa, 0xb8, 0xbb, 0x9d, 0xc6, 0xf7, 0x96, 0xd9, 0xf8, 0xa9, 0x00, 0x0a,
+	0xf6, 0x8e, 0xe9, 0x8f, 0xe9, 0x27, 0x56, 0x1a, 0xc9, 0x07, 0xea, 0xfc, 0x4a, 0x23, 0xc6, 0xa7,
+	0x2a, 0x8d, 0xb4, 0x1d, 0x26, 0xb2, 0x76, 0xb8, 0x0f, 0xb3, 0x19, 0xb9, 0x6a, 0xe1, 0x34, 0x29,
+	0xbd, 0x9a, 0x5e, 0x95, 0x36, 0xf7, 0xd1, 0x72, 0x62, 0xcd, 0xcc, 0x9b, 0x7b, 0x8e, 0x12, 0xda,
+	0xf5, 0x8b, 0x50, 0x8d, 0xe8, 0x79, 0x09, 0xcd, 0x1a, 0xfb, 0xa8, 0x34, 0xd0, 0x42, 0x27, 0xaf,
+	0xec, 0x98, 0x7e, 0xfe, 0xb2, 0x23, 0x77, 0x14, 0x54, 0xcc, 0x1f, 0x05, 0xad, 0x42, 0x29, 0xbe,
+	0x53, 0x51, 0xed, 0x10, 0x03, 0x4e, 0xf9, 0x95, 0xfd, 0xb3, 0xf8, 0x27, 0x07, 0x96, 0xaf, 0x79,
+	0xa6, 0x28, 0x63, 0xfd, 0xbd, 0x71, 0x4c, 0x3d, 0xff, 0x10, 0x39, 0x30, 0x47, 0xb3, 0x1c, 0x12,
+	0xfd, 0x0e, 0x21, 0x80, 0x46, 0x7e, 0x5e, 0xa8, 0x8c, 0xfc, 0xbc, 0xd0, 0xf8, 0x56, 0x82, 0x39,
+	0xbe, 0xad, 0x6d, 0x4c, 0xa7, 0x2f, 0xca, 0xdd, 0x72, 0x13, 0xf9, 0x44, 0xfe, 0xe7, 0xb1, 0xac,
+	0xde, 0x85, 0x51, 0xbd, 0xbf, 0x3e, 0x0b, 0xb0, 0x83, 0xdf, 0x16, 0x5e, 0xe0, 0xfd, 0x18, 0xd1,
+	0x54, 0xa8, 0x0f, 0x15, 0x28, 0xe0, 0xa9, 0xb2, 0x9f, 0x4b, 0xf0, 0x59, 0x79, 0x13, 0x26, 0x2d,
+	0xa7, 0x1f, 0x06, 0xea, 0xe4, 0x98, 0x81, 0x92, 0x91, 0x53, 0xed, 0x0d, 0xd7, 0x09, 0x3c, 0xd7,
+	0xe6, 0x4e, 0x1e, 0xbd, 0x8e, 0x58, 0x62, 0x7a, 0xd4, 0x12, 0x5f, 0x49, 0x50, 0xdc, 0x3e, 0x20,
+	0xc6, 0xa1, 0x1f, 0xf6, 0xb2, 0x76, 0x98, 0x4c, 0xec, 0x70, 0x1b, 0xa6, 0xba, 0xb6, 0x3e, 0x70,
+	0x3d, 0xdc, 0x75, 0x75, 0xeb, 0xca, 0xc9, 0x8d, 0x5d, 0x24, 0xf1, 0x2e, 0xf2, 0x68, 0x9c, 0x37,
+	0xf9, 0x11, 0x68, 0x02, 0xc7, 0x15, 0xec, 0xa5, 0xf1, 0x27, 0x09, 0xe4, 0xec, 0xef, 0x56, 0xca,
+	0x39, 0x28, 0xd1, 0x41, 0x07, 0xfb, 0x27, 0x93, 0x35, 0xcf, 0x45, 0x87, 0x1c, 0x3d, 0xa0, 0xef,
+	0x27, 0xfd, 0xc7, 0x99, 0xfe, 0x3f, 0x61, 0xe2, 0xf4, 0xff, 0x27, 0xac, 0x42, 0x89, 0xff, 0x15,
+	0x43, 0x98, 0xeb, 0x14, 0xb5, 0x04, 0x70, 0xeb, 0xff, 0xbe, 0xfb, 0xa1, 0x7e, 0xe6, 0xfb, 0x1f,
+	0xea, 0x67, 0x7e, 0xfe, 0xa1, 0x2e, 0x7d, 0xf5, 0xb4, 0x2e, 0xfd, 0xf1, 0x69, 0x5d, 0xfa, 0xfb,
+	0xd3, 0xba, 0xf4, 0xdd, 0xd3, 0xba, 0xf4, 0xcf, 0xa7, 0x75, 0xe9, 0x5f, 0x4f, 0xeb, 0x67, 0x7e,
+	0x7e, 0x5a, 0x97, 0xbe, 0xf9, 0xb1, 0x7e, 0xe6, 0xbb, 0x1f, 0xeb, 0x67, 0xbe, 0xff, 0xb1, 0x7e,
+	0xe6, 0xf3, 0x1b, 0xfb, 0x6e, 0x62, 0x2f, 0xcb, 0x3d, 0xfe, 0xa7, 0xe6, 0xf7, 0x84, 0xd7, 0xbd,
+	0x29, 0x54, 0xf7, 0xfa, 0x7f, 0x06, 0x00, 0xe6, 0xfc, 0xc9, 0xf3, 0x0d, 0x2d, 0x00, 0x00,
 }
 
 func (this *ShardInfo) Equal(that interface{}) bool {
@@ -2317,6 +2402,9 @@ func (this *ShardInfo) Equal(that interface{}) bool {
 	if this.VisibilityAckLevel != that1.VisibilityAckLevel {
 		return false
 	}
+	if !this.HandoffInfo.Equal(that1.HandoffInfo) {
+		return false
+	}
 	return true
 }
 func (this *WorkflowExecutionInfo) Equal(that interface{}) bool {
@@ -3243,11 +3331,48 @@ func (this *Checksum) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ShardHandoffInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ShardHandoffInfo)
+	if !ok {
+		that2, ok := that.(ShardHandoffInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NewOwner != that1.NewOwner {
+		return false
+	}
+	if this.RangeId != that1.RangeId {
+		return false
+	}
+	if that1.StartTime == nil {
+		if this.StartTime != nil {
+			return false
+		}
+	} else if !this.StartTime.Equal(*that1.StartTime) {
+		return false
+	}
+	if this.Completed != that1.Completed {
+		return false
+	}
+	return true
+}
 func (this *ShardInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 18)
+	s := make([]string, 0, 19)
 	s = append(s, "&persistence.ShardInfo{")
 	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
 	s = append(s, "RangeId: "+fmt.Sprintf("%#v", this.RangeId)+",\n")
@@ -3311,6 +3436,9 @@ func (this *ShardInfo) GoString() string {
 		s = append(s, "ReplicationDlqAckLevel: "+mapStringForReplicationDlqAckLevel+",\n")
 	}
 	s = append(s, "VisibilityAckLevel: "+fmt.Sprintf("%#v", this.VisibilityAckLevel)+",\n")
+	if this.HandoffInfo != nil {
+		s = append(s, "HandoffInfo: "+fmt.Sprintf("%#v", this.HandoffInfo)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -3640,6 +3768,19 @@ func (this *Checksum) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *ShardHandoffInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&persistence.ShardHandoffInfo{")
+	s = append(s, "NewOwner: "+fmt.Sprintf("%#v", this.NewOwner)+",\n")
+	s = append(s, "RangeId: "+fmt.Sprintf("%#v", this.RangeId)+",\n")
+	s = append(s, "StartTime: "+fmt.Sprintf("%#v", this.StartTime)+",\n")
+	s = append(s, "Completed: "+fmt.Sprintf("%#v", this.Completed)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringExecutions(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -3668,6 +3809,18 @@ func (m *ShardInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HandoffInfo != nil {
+		{
+			size, err := m.HandoffInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExecutions(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
 	if m.VisibilityAckLevel != 0 {
 		i = encodeVarintExecutions(dAtA, i, uint64(m.VisibilityAckLevel))
 		i--
@@ -3712,12 +3865,12 @@ func (m *ShardInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			v := m.ClusterTimerAckLevel[k]
 			baseI := i
 			if v != nil {
-				n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo((*v), dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime((*v)):])
-				if err1 != nil {
-					return 0, err1
+				n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo((*v), dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime((*v)):])
+				if err2 != nil {
+					return 0, err2
 				}
-				i -= n1
-				i = encodeVarintExecutions(dAtA, i, uint64(n1))
+				i -= n2
+				i = encodeVarintExecutions(dAtA, i, uint64(n2))
 				i--
 				dAtA[i] = 0x12
 			}
@@ -3754,22 +3907,22 @@ func (m *ShardInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x48
 	}
 	if m.TimerAckLevelTime != nil {
-		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.TimerAckLevelTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.TimerAckLevelTime):])
-		if err2 != nil {
-			return 0, err2
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.TimerAckLevelTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.TimerAckLevelTime):])
+		if err3 != nil {
+			return 0, err3
 		}
-		i -= n2
-		i = encodeVarintExecutions(dAtA, i, uint64(n2))
+		i -= n3
+		i = encodeVarintExecutions(dAtA, i, uint64(n3))
 		i--
 		dAtA[i] = 0x42
 	}
 	if m.UpdateTime != nil {
-		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdateTime):])
-		if err3 != nil {
-			return 0, err3
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.UpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.UpdateTime):])
+		if err4 != nil {
+			return 0, err4
 		}
-		i -= n3
-		i = encodeVarintExecutions(dAtA, i, uint64(n3))
+		i -= n4
+		i = encodeVarintExecutions(dAtA, i, uint64(n4))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -3829,12 +3982,12 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.ExecutionTime != nil {
-		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExecutionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExecutionTime):])
-		if err4 != nil {
-			return 0, err4
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExecutionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExecutionTime):])
+		if err5 != nil {
+			return 0, err5
 		}
-		i -= n4
-		i = encodeVarintExecutions(dAtA, i, uint64(n4))
+		i -= n5
+		i = encodeVarintExecutions(dAtA, i, uint64(n5))
 		i--
 		dAtA[i] = 0x3
 		i--
@@ -3855,12 +4008,12 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xd0
 	}
 	if m.WorkflowRunExpirationTime != nil {
-		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowRunExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowRunExpirationTime):])
-		if err5 != nil {
-			return 0, err5
+		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowRunExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowRunExpirationTime):])
+		if err6 != nil {
+			return 0, err6
 		}
-		i -= n5
-		i = encodeVarintExecutions(dAtA, i, uint64(n5))
+		i -= n6
+		i = encodeVarintExecutions(dAtA, i, uint64(n6))
 		i--
 		dAtA[i] = 0x3
 		i--
@@ -4020,12 +4173,12 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if m.WorkflowExecutionExpirationTime != nil {
-		n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowExecutionExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowExecutionExpirationTime):])
-		if err11 != nil {
-			return 0, err11
+		n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowExecutionExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowExecutionExpirationTime):])
+		if err12 != nil {
+			return 0, err12
 		}
-		i -= n11
-		i = encodeVarintExecutions(dAtA, i, uint64(n11))
+		i -= n12
+		i = encodeVarintExecutions(dAtA, i, uint64(n12))
 		i--
 		dAtA[i] = 0x2
 		i--
@@ -4047,24 +4200,24 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xb0
 	}
 	if m.RetryMaximumInterval != nil {
-		n12, err12 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryMaximumInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryMaximumInterval):])
-		if err12 != nil {
-			return 0, err12
+		n13, err13 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryMaximumInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryMaximumInterval):])
+		if err13 != nil {
+			return 0, err13
 		}
-		i -= n12
-		i = encodeVarintExecutions(dAtA, i, uint64(n12))
+		i -= n13
+		i = encodeVarintExecutions(dAtA, i, uint64(n13))
 		i--
 		dAtA[i] = 0x2
 		i--
 		dAtA[i] = 0xaa
 	}
 	if m.RetryInitialInterval != nil {
-		n13, err13 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryInitialInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryInitialInterval):])
-		if err13 != nil {
-			return 0, err13
+		n14, err14 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryInitialInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryInitialInterval):])
+		if err14 != nil {
+			return 0, err14
 		}
-		i -= n13
-		i = encodeVarintExecutions(dAtA, i, uint64(n13))
+		i -= n14
+		i = encodeVarintExecutions(dAtA, i, uint64(n14))
 		i--
 		dAtA[i] = 0x2
 		i--
@@ -4078,12 +4231,12 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x98
 	}
 	if m.StickyScheduleToStartTimeout != nil {
-		n14, err14 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StickyScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StickyScheduleToStartTimeout):])
-		if err14 != nil {
-			return 0, err14
+		n15, err15 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StickyScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StickyScheduleToStartTimeout):])
+		if err15 != nil {
+			return 0, err15
 		}
-		i -= n14
-		i = encodeVarintExecutions(dAtA, i, uint64(n14))
+		i -= n15
+		i = encodeVarintExecutions(dAtA, i, uint64(n15))
 		i--
 		dAtA[i] = 0x2
 		i--
@@ -4117,12 +4270,12 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xfa
 	}
 	if m.WorkflowTaskOriginalScheduledTime != nil {
-		n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskOriginalScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskOriginalScheduledTime):])
-		if err15 != nil {
-			return 0, err15
+		n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskOriginalScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskOriginalScheduledTime):])
+		if err16 != nil {
+			return 0, err16
 		}
-		i -= n15
-		i = encodeVarintExecutions(dAtA, i, uint64(n15))
+		i -= n16
+		i = encodeVarintExecutions(dAtA, i, uint64(n16))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -4141,24 +4294,24 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xe8
 	}
 	if m.WorkflowTaskScheduledTime != nil {
-		n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskScheduledTime):])
-		if err16 != nil {
-			return 0, err16
+		n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskScheduledTime):])
+		if err17 != nil {
+			return 0, err17
 		}
-		i -= n16
-		i = encodeVarintExecutions(dAtA, i, uint64(n16))
+		i -= n17
+		i = encodeVarintExecutions(dAtA, i, uint64(n17))
 		i--
 		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0xe2
 	}
 	if m.WorkflowTaskStartedTime != nil {
-		n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskStartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskStartedTime):])
-		if err17 != nil {
-			return 0, err17
+		n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowTaskStartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowTaskStartedTime):])
+		if err18 != nil {
+			return 0, err18
 		}
-		i -= n17
-		i = encodeVarintExecutions(dAtA, i, uint64(n17))
+		i -= n18
+		i = encodeVarintExecutions(dAtA, i, uint64(n18))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -4172,12 +4325,12 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xd0
 	}
 	if m.WorkflowTaskTimeout != nil {
-		n18, err18 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowTaskTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowTaskTimeout):])
-		if err18 != nil {
-			return 0, err18
+		n19, err19 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowTaskTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowTaskTimeout):])
+		if err19 != nil {
+			return 0, err19
 		}
-		i -= n18
-		i = encodeVarintExecutions(dAtA, i, uint64(n18))
+		i -= n19
+		i = encodeVarintExecutions(dAtA, i, uint64(n19))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -4205,24 +4358,24 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xb0
 	}
 	if m.LastUpdateTime != nil {
-		n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdateTime):])
-		if err19 != nil {
-			return 0, err19
+		n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpdateTime):])
+		if err20 != nil {
+			return 0, err20
 		}
-		i -= n19
-		i = encodeVarintExecutions(dAtA, i, uint64(n19))
+		i -= n20
+		i = encodeVarintExecutions(dAtA, i, uint64(n20))
 		i--
 		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0xaa
 	}
 	if m.StartTime != nil {
-		n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
-		if err20 != nil {
-			return 0, err20
+		n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
+		if err21 != nil {
+			return 0, err21
 		}
-		i -= n20
-		i = encodeVarintExecutions(dAtA, i, uint64(n20))
+		i -= n21
+		i = encodeVarintExecutions(dAtA, i, uint64(n21))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -4255,33 +4408,33 @@ func (m *WorkflowExecutionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x70
 	}
 	if m.DefaultWorkflowTaskTimeout != nil {
-		n21, err21 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.DefaultWorkflowTaskTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.DefaultWorkflowTaskTimeout):])
-		if err21 != nil {
-			return 0, err21
-		}
-		i -= n21
-		i = encodeVarintExecutions(dAtA, i, uint64(n21))
-		i--
-		dAtA[i] = 0x6a
-	}
-	if m.WorkflowRunTimeout != nil {
-		n22, err22 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowRunTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowRunTimeout):])
+		n22, err22 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.DefaultWorkflowTaskTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.DefaultWorkflowTaskTimeout):])
 		if err22 != nil {
 			return 0, err22
 		}
 		i -= n22
 		i = encodeVarintExecutions(dAtA, i, uint64(n22))
 		i--
-		dAtA[i] = 0x62
+		dAtA[i] = 0x6a
 	}
-	if m.WorkflowExecutionTimeout != nil {
-		n23, err23 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowExecutionTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowExecutionTimeout):])
+	if m.WorkflowRunTimeout != nil {
+		n23, err23 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowRunTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowRunTimeout):])
 		if err23 != nil {
 			return 0, err23
 		}
 		i -= n23
 		i = encodeVarintExecutions(dAtA, i, uint64(n23))
 		i--
+		dAtA[i] = 0x62
+	}
+	if m.WorkflowExecutionTimeout != nil {
+		n24, err24 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.WorkflowExecutionTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.WorkflowExecutionTimeout):])
+		if err24 != nil {
+			return 0, err24
+		}
+		i -= n24
+		i = encodeVarintExecutions(dAtA, i, uint64(n24))
+		i--
 		dAtA[i] = 0x5a
 	}
 	if len(m.WorkflowTypeName) > 0 {
@@ -4454,12 +4607,12 @@ func (m *TransferTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.VisibilityTime != nil {
-		n25, err25 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
-		if err25 != nil {
-			return 0, err25
+		n26, err26 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err26 != nil {
+			return 0, err26
 		}
-		i -= n25
-		i = encodeVarintExecutions(dAtA, i, uint64(n25))
+		i -= n26
+		i = encodeVarintExecutions(dAtA, i, uint64(n26))
 		i--
 		dAtA[i] = 0x6a
 	}
@@ -4654,12 +4807,12 @@ func (m *VisibilityTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.VisibilityTime != nil {
-		n26, err26 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
-		if err26 != nil {
-			return 0, err26
+		n27, err27 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err27 != nil {
+			return 0, err27
 		}
-		i -= n26
-		i = encodeVarintExecutions(dAtA, i, uint64(n26))
+		i -= n27
+		i = encodeVarintExecutions(dAtA, i, uint64(n27))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -4723,12 +4876,12 @@ func (m *TimerTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.VisibilityTime != nil {
-		n27, err27 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
-		if err27 != nil {
-			return 0, err27
+		n28, err28 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err28 != nil {
+			return 0, err28
 		}
-		i -= n27
-		i = encodeVarintExecutions(dAtA, i, uint64(n27))
+		i -= n28
+		i = encodeVarintExecutions(dAtA, i, uint64(n28))
 		i--
 		dAtA[i] = 0x5a
 	}
@@ -4812,12 +4965,12 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.LastHeartbeatUpdateTime != nil {
-		n28, err28 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatUpdateTime):])
-		if err28 != nil {
-			return 0, err28
+		n29, err29 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatUpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatUpdateTime):])
+		if err29 != nil {
+			return 0, err29
 		}
-		i -= n28
-		i = encodeVarintExecutions(dAtA, i, uint64(n28))
+		i -= n29
+		i = encodeVarintExecutions(dAtA, i, uint64(n29))
 		i--
 		dAtA[i] = 0x2
 		i--
@@ -4896,12 +5049,12 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xc9
 	}
 	if m.RetryExpirationTime != nil {
-		n31, err31 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.RetryExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.RetryExpirationTime):])
-		if err31 != nil {
-			return 0, err31
+		n32, err32 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.RetryExpirationTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.RetryExpirationTime):])
+		if err32 != nil {
+			return 0, err32
 		}
-		i -= n31
-		i = encodeVarintExecutions(dAtA, i, uint64(n31))
+		i -= n32
+		i = encodeVarintExecutions(dAtA, i, uint64(n32))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -4915,24 +5068,24 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xb8
 	}
 	if m.RetryMaximumInterval != nil {
-		n32, err32 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryMaximumInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryMaximumInterval):])
-		if err32 != nil {
-			return 0, err32
+		n33, err33 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryMaximumInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryMaximumInterval):])
+		if err33 != nil {
+			return 0, err33
 		}
-		i -= n32
-		i = encodeVarintExecutions(dAtA, i, uint64(n32))
+		i -= n33
+		i = encodeVarintExecutions(dAtA, i, uint64(n33))
 		i--
 		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0xb2
 	}
 	if m.RetryInitialInterval != nil {
-		n33, err33 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryInitialInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryInitialInterval):])
-		if err33 != nil {
-			return 0, err33
+		n34, err34 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RetryInitialInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RetryInitialInterval):])
+		if err34 != nil {
+			return 0, err34
 		}
-		i -= n33
-		i = encodeVarintExecutions(dAtA, i, uint64(n33))
+		i -= n34
+		i = encodeVarintExecutions(dAtA, i, uint64(n34))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -4998,43 +5151,43 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x70
 	}
 	if m.HeartbeatTimeout != nil {
-		n34, err34 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.HeartbeatTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.HeartbeatTimeout):])
-		if err34 != nil {
-			return 0, err34
-		}
-		i -= n34
-		i = encodeVarintExecutions(dAtA, i, uint64(n34))
-		i--
-		dAtA[i] = 0x6a
-	}
-	if m.StartToCloseTimeout != nil {
-		n35, err35 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StartToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StartToCloseTimeout):])
+		n35, err35 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.HeartbeatTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.HeartbeatTimeout):])
 		if err35 != nil {
 			return 0, err35
 		}
 		i -= n35
 		i = encodeVarintExecutions(dAtA, i, uint64(n35))
 		i--
-		dAtA[i] = 0x62
+		dAtA[i] = 0x6a
 	}
-	if m.ScheduleToCloseTimeout != nil {
-		n36, err36 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToCloseTimeout):])
+	if m.StartToCloseTimeout != nil {
+		n36, err36 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StartToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StartToCloseTimeout):])
 		if err36 != nil {
 			return 0, err36
 		}
 		i -= n36
 		i = encodeVarintExecutions(dAtA, i, uint64(n36))
 		i--
-		dAtA[i] = 0x5a
+		dAtA[i] = 0x62
 	}
-	if m.ScheduleToStartTimeout != nil {
-		n37, err37 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout):])
+	if m.ScheduleToCloseTimeout != nil {
+		n37, err37 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToCloseTimeout):])
 		if err37 != nil {
 			return 0, err37
 		}
 		i -= n37
 		i = encodeVarintExecutions(dAtA, i, uint64(n37))
 		i--
+		dAtA[i] = 0x5a
+	}
+	if m.ScheduleToStartTimeout != nil {
+		n38, err38 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout):])
+		if err38 != nil {
+			return 0, err38
+		}
+		i -= n38
+		i = encodeVarintExecutions(dAtA, i, uint64(n38))
+		i--
 		dAtA[i] = 0x52
 	}
 	if len(m.RequestId) > 0 {
@@ -5052,12 +5205,12 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x42
 	}
 	if m.StartedTime != nil {
-		n38, err38 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
-		if err38 != nil {
-			return 0, err38
+		n39, err39 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
+		if err39 != nil {
+			return 0, err39
 		}
-		i -= n38
-		i = encodeVarintExecutions(dAtA, i, uint64(n38))
+		i -= n39
+		i = encodeVarintExecutions(dAtA, i, uint64(n39))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -5079,12 +5232,12 @@ func (m *ActivityInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x28
 	}
 	if m.ScheduledTime != nil {
-		n40, err40 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
-		if err40 != nil {
-			return 0, err40
+		n41, err41 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
+		if err41 != nil {
+			return 0, err41
 		}
-		i -= n40
-		i = encodeVarintExecutions(dAtA, i, uint64(n40))
+		i -= n41
+		i = encodeVarintExecutions(dAtA, i, uint64(n41))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -5146,12 +5299,12 @@ func (m *TimerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x20
 	}
 	if m.ExpiryTime != nil {
-		n42, err42 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
-		if err42 != nil {
-			return 0, err42
+		n43, err43 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err43 != nil {
+			return 0, err43
 		}
-		i -= n42
-		i = encodeVarintExecutions(dAtA, i, uint64(n42))
+		i -= n43
+		i = encodeVarintExecutions(dAtA, i, uint64(n43))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -5431,6 +5584,61 @@ func (m *Checksum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ShardHandoffInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShardHandoffInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShardHandoffInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Completed {
+		i--
+		if m.Completed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartTime != nil {
+		n47, err47 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
+		if err47 != nil {
+			return 0, err47
+		}
+		i -= n47
+		i = encodeVarintExecutions(dAtA, i, uint64(n47))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RangeId != 0 {
+		i = encodeVarintExecutions(dAtA, i, uint64(m.RangeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintExecutions(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintExecutions(dAtA []byte, offset int, v uint64) int {
 	offset -= sovExecutions(v)
 	base := offset
@@ -5518,6 +5726,10 @@ func (m *ShardInfo) Size() (n int) {
 	if m.VisibilityAckLevel != 0 {
 		n += 1 + sovExecutions(uint64(m.VisibilityAckLevel))
 	}
+	if m.HandoffInfo != nil {
+		l = m.HandoffInfo.Size()
+		n += 1 + l + sovExecutions(uint64(l))
+	}
 	return n
 }
 
@@ -6242,6 +6454,29 @@ func (m *Checksum) Size() (n int) {
 	return n
 }
 
+func (m *ShardHandoffInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovExecutions(uint64(l))
+	}
+	if m.RangeId != 0 {
+		n += 1 + sovExecutions(uint64(m.RangeId))
+	}
+	if m.StartTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovExecutions(uint64(l))
+	}
+	if m.Completed {
+		n += 2
+	}
+	return n
+}
+
 func sovExecutions(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6307,6 +6542,7 @@ func (this *ShardInfo) String() string {
 		`ClusterReplicationLevel:` + mapStringForClusterReplicationLevel + `,`,
 		`ReplicationDlqAckLevel:` + mapStringForReplicationDlqAckLevel + `,`,
 		`VisibilityAckLevel:` + fmt.Sprintf("%v", this.VisibilityAckLevel) + `,`,
+		`HandoffInfo:` + strings.Replace(this.HandoffInfo.String(), "ShardHandoffInfo", "ShardHandoffInfo", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -6611,6 +6847,19 @@ func (this *Checksum) String() string {
 	}, "")
 	return s
 }
+func (this *ShardHandoffInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShardHandoffInfo{`,
+		`NewOwner:` + fmt.Sprintf("%v", this.NewOwner) + `,`,
+		`RangeId:` + fmt.Sprintf("%v", this.RangeId) + `,`,
+		`StartTime:` + strings.Replace(fmt.Sprintf("%v", this.StartTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Completed:` + fmt.Sprintf("%v", this.Completed) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringExecutions(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -7352,6 +7601,42 @@ func (m *ShardInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HandoffInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HandoffInfo == nil {
+				m.HandoffInfo = &ShardHandoffInfo{}
+			}
+			if err := m.HandoffInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExecutions(dAtA[iNdEx:])
@@ -12696,6 +12981,163 @@ func (m *Checksum) Unmarshal(dAtA []byte) error {
 				m.Value = []byte{}
 			}
 			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShardHandoffInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShardHandoffInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShardHandoffInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeId", wireType)
+			}
+			m.RangeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RangeId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Completed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExecutions(dAtA[iNdEx:])
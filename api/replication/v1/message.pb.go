@@ -38,6 +38,7 @@ import (
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	v14 "go.temporal.io/api/common/v1"
@@ -72,6 +73,8 @@ type ReplicationTask struct {
 	//	*ReplicationTask_HistoryMetadataTaskAttributes
 	//	*ReplicationTask_HistoryTaskV2Attributes
 	Attributes isReplicationTask_Attributes `protobuf_oneof:"attributes"`
+	// Trace context headers of the request that generated the task, restored on the receiving cluster.
+	TraceContext map[string]string `protobuf:"bytes,9,rep,name=trace_context,json=traceContext,proto3" json:"trace_context,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *ReplicationTask) Reset()      { *m = ReplicationTask{} }
@@ -202,6 +205,13 @@ func (m *ReplicationTask) GetHistoryTaskV2Attributes() *HistoryTaskV2Attributes
 	return nil
 }
 
+func (m *ReplicationTask) GetTraceContext() map[string]string {
+	if m != nil {
+		return m.TraceContext
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ReplicationTask) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1085,6 +1095,7 @@ func (m *HistoryTaskV2Attributes) GetNewRunEvents() *v14.DataBlob {
 
 func init() {
 	proto.RegisterType((*ReplicationTask)(nil), "temporal.server.api.replication.v1.ReplicationTask")
+	proto.RegisterMapType((map[string]string)(nil), "temporal.server.api.replication.v1.ReplicationTask.TraceContextEntry")
 	proto.RegisterType((*ReplicationToken)(nil), "temporal.server.api.replication.v1.ReplicationToken")
 	proto.RegisterType((*SyncShardStatus)(nil), "temporal.server.api.replication.v1.SyncShardStatus")
 	proto.RegisterType((*ReplicationMessages)(nil), "temporal.server.api.replication.v1.ReplicationMessages")
@@ -1102,101 +1113,105 @@ func init() {
 }
 
 var fileDescriptor_edd9fae2af6b0532 = []byte{
-	// 1503 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x3b, 0x6f, 0x1b, 0xc7,
-	0x16, 0xd6, 0xf2, 0xcd, 0xe1, 0xd3, 0xa3, 0xab, 0x2b, 0x8a, 0x80, 0x68, 0x89, 0xb0, 0xaf, 0xe5,
-	0x8b, 0x60, 0x69, 0x51, 0x45, 0x62, 0x3b, 0x08, 0x20, 0x39, 0x71, 0x44, 0x01, 0x76, 0x8c, 0xb5,
-	0x60, 0x03, 0x69, 0x36, 0x23, 0xee, 0x90, 0x5c, 0x88, 0xdc, 0x25, 0x66, 0x86, 0x54, 0x98, 0x2a,
-	0x40, 0x8a, 0x34, 0x09, 0xe0, 0xff, 0xe0, 0x20, 0x48, 0x95, 0xdf, 0xe1, 0xd2, 0x4d, 0x00, 0xa7,
-	0x4a, 0x4c, 0x37, 0x29, 0xdd, 0xa5, 0x0d, 0xe6, 0xb1, 0xe4, 0x2e, 0x97, 0xa4, 0x19, 0x07, 0xae,
-	0xd2, 0x71, 0xce, 0xe3, 0x3b, 0x33, 0x67, 0xce, 0x39, 0xdf, 0x2c, 0xc1, 0x0d, 0x86, 0x7b, 0x7d,
-	0x97, 0xa0, 0x6e, 0x8d, 0x62, 0x32, 0xc4, 0xa4, 0x86, 0xfa, 0x76, 0x8d, 0xe0, 0x7e, 0xd7, 0x6e,
-	0x22, 0x66, 0xbb, 0x4e, 0x6d, 0xb8, 0x5f, 0xeb, 0x61, 0x4a, 0x51, 0x1b, 0xeb, 0x7d, 0xe2, 0x32,
-	0x17, 0x56, 0x3d, 0x0f, 0x5d, 0x7a, 0xe8, 0xa8, 0x6f, 0xeb, 0x3e, 0x0f, 0x7d, 0xb8, 0x5f, 0xbe,
-	0xdc, 0x76, 0xdd, 0x76, 0x17, 0xd7, 0x84, 0xc7, 0xd9, 0xa0, 0x55, 0x63, 0x76, 0x0f, 0x53, 0x86,
-	0x7a, 0x7d, 0x09, 0x52, 0xde, 0xb5, 0x70, 0x1f, 0x3b, 0x16, 0x76, 0x9a, 0x36, 0xa6, 0xb5, 0xb6,
-	0xdb, 0x76, 0x85, 0x5c, 0xfc, 0x52, 0x26, 0xfa, 0xbc, 0x9d, 0x61, 0x67, 0xd0, 0xa3, 0x7c, 0x4f,
-	0xfe, 0x80, 0xd2, 0xfe, 0xda, 0x52, 0x7b, 0x86, 0xe8, 0xb9, 0x32, 0x7c, 0x6f, 0x9e, 0x61, 0xc7,
-	0xa6, 0xcc, 0x25, 0xa3, 0xd0, 0x71, 0xcb, 0x57, 0x26, 0xd6, 0xdc, 0xac, 0xe9, 0xf6, 0x7a, 0x73,
-	0x92, 0xe2, 0x0b, 0xce, 0xad, 0x1c, 0xd4, 0xc3, 0xb4, 0x8f, 0x9a, 0x38, 0x6c, 0x78, 0x3d, 0x60,
-	0xb8, 0x2c, 0xd1, 0xe5, 0xab, 0x01, 0xd3, 0x85, 0x1b, 0x0c, 0x9a, 0xb5, 0x90, 0xdd, 0x1d, 0x90,
-	0x70, 0xe0, 0xea, 0x8f, 0x49, 0x50, 0x30, 0xa6, 0xe1, 0x4e, 0x11, 0x3d, 0x87, 0xf7, 0x41, 0x9a,
-	0xe7, 0xc5, 0x64, 0xa3, 0x3e, 0x2e, 0x69, 0x3b, 0xda, 0x5e, 0xbe, 0xbe, 0xaf, 0xcf, 0xbb, 0x5e,
-	0x91, 0x46, 0x7d, 0xb8, 0xaf, 0xcf, 0x20, 0x9c, 0x8e, 0xfa, 0xd8, 0x48, 0x31, 0xf5, 0x0b, 0x5e,
-	0x01, 0x79, 0xea, 0x0e, 0x48, 0x13, 0x9b, 0x02, 0xd6, 0xb6, 0x4a, 0x91, 0x1d, 0x6d, 0x2f, 0x6a,
-	0x64, 0xa5, 0x94, 0x7b, 0x34, 0x2c, 0x38, 0x02, 0x5b, 0x93, 0x04, 0x49, 0x43, 0xc4, 0x18, 0xb1,
-	0xcf, 0x06, 0x0c, 0xd3, 0x52, 0x74, 0x47, 0xdb, 0xcb, 0xd4, 0x6f, 0xeb, 0x6f, 0x2e, 0x32, 0xfd,
-	0xbe, 0x07, 0xc2, 0x71, 0x0f, 0x27, 0x10, 0xc7, 0x6b, 0xc6, 0xa6, 0x33, 0x5f, 0x05, 0x29, 0xd8,
-	0x54, 0x79, 0x0c, 0x05, 0x8e, 0x89, 0xc0, 0x37, 0x57, 0x09, 0x7c, 0x2c, 0x21, 0x42, 0x61, 0x37,
-	0x3a, 0xf3, 0x14, 0xf0, 0x7b, 0x0d, 0xec, 0xd2, 0x91, 0xd3, 0x34, 0x69, 0x07, 0x11, 0xcb, 0xa4,
-	0x0c, 0xb1, 0x01, 0x0d, 0xc5, 0x8f, 0x8b, 0xf8, 0x87, 0xab, 0xc4, 0x7f, 0x38, 0x72, 0x9a, 0x0f,
-	0x39, 0xd6, 0x43, 0x01, 0x15, 0xda, 0xc7, 0x36, 0x5d, 0x66, 0x00, 0xbf, 0xd1, 0x80, 0xb0, 0x30,
-	0x51, 0x93, 0xd9, 0x43, 0x9b, 0x85, 0x73, 0x91, 0x10, 0x7b, 0xf9, 0x68, 0xd5, 0xbd, 0x1c, 0x2a,
-	0x9c, 0xd0, 0x46, 0xca, 0x74, 0xa1, 0x16, 0x7e, 0xa7, 0x81, 0x1d, 0xef, 0x2e, 0x7a, 0x98, 0x21,
-	0x0b, 0x31, 0x14, 0xda, 0x48, 0x72, 0xf5, 0xa4, 0xa8, 0x4b, 0xb9, 0xa7, 0xa0, 0xc2, 0x49, 0xe9,
-	0x2c, 0x33, 0x80, 0x5f, 0x81, 0x72, 0xa0, 0x32, 0x86, 0x75, 0xff, 0x3e, 0x52, 0xab, 0x57, 0xa5,
-	0xaf, 0x38, 0x1e, 0xd5, 0x83, 0x55, 0xd9, 0x99, 0xaf, 0x3a, 0xca, 0x02, 0x30, 0x8d, 0x55, 0x7d,
-	0xaa, 0x81, 0xa2, 0xbf, 0xcd, 0xdc, 0x73, 0xec, 0xc0, 0x2d, 0x90, 0x92, 0xd5, 0x63, 0x5b, 0xa2,
-	0x51, 0xe3, 0x46, 0x52, 0xac, 0x1b, 0x16, 0xbc, 0x09, 0xb6, 0xba, 0x88, 0x32, 0x93, 0x60, 0x46,
-	0x6c, 0x3c, 0xc4, 0x96, 0xa9, 0x1a, 0x7f, 0xda, 0x7f, 0xff, 0xe5, 0x06, 0x86, 0xa7, 0xbf, 0x27,
-	0xd5, 0x3e, 0xd7, 0x3e, 0x71, 0x9b, 0x98, 0xd2, 0xa0, 0x6b, 0x74, 0xea, 0xfa, 0xc0, 0xd3, 0x4f,
-	0x5c, 0xab, 0xa7, 0xa0, 0x30, 0x53, 0x86, 0xf0, 0x10, 0x64, 0xbc, 0xda, 0xb6, 0x7b, 0x72, 0x9e,
-	0x64, 0xea, 0x65, 0x5d, 0x52, 0x81, 0xee, 0x51, 0x81, 0x7e, 0xea, 0x51, 0xc1, 0x51, 0xec, 0xc9,
-	0x6f, 0x97, 0x35, 0x03, 0x48, 0x27, 0x2e, 0xae, 0xfe, 0x1c, 0x01, 0xeb, 0xbe, 0xb3, 0xab, 0x70,
-	0x14, 0x7e, 0x01, 0x2e, 0xf9, 0xd2, 0x2c, 0x6e, 0x88, 0x96, 0xb4, 0x9d, 0xe8, 0x5e, 0xa6, 0x7e,
-	0xb0, 0xca, 0xa5, 0xcc, 0x8c, 0x2d, 0xa3, 0x48, 0x82, 0x02, 0xfa, 0x4f, 0xb2, 0xb8, 0x05, 0x52,
-	0x1d, 0x44, 0xcd, 0x9e, 0x4b, 0xb0, 0x48, 0x5a, 0xca, 0x48, 0x76, 0x10, 0xbd, 0xe7, 0x12, 0x0c,
-	0x4d, 0x70, 0x29, 0xd4, 0xf9, 0x6a, 0xd2, 0x1c, 0xbc, 0x45, 0xa7, 0x1b, 0x85, 0x99, 0xce, 0xae,
-	0xfe, 0x12, 0x4c, 0x98, 0x98, 0xb0, 0x4e, 0xcb, 0x85, 0xbb, 0x20, 0x3b, 0x9d, 0xb1, 0xaa, 0x66,
-	0xd2, 0x46, 0x66, 0x22, 0x6b, 0x58, 0xf0, 0x32, 0xc8, 0x5c, 0xb8, 0xe4, 0xbc, 0xd5, 0x75, 0x2f,
-	0xbc, 0x33, 0xa6, 0x0d, 0xe0, 0x89, 0x1a, 0x16, 0xdc, 0x00, 0x09, 0x32, 0x70, 0xbc, 0x52, 0x48,
-	0x1b, 0x71, 0x32, 0x70, 0x1a, 0x16, 0xbc, 0xe3, 0x27, 0x8d, 0x98, 0x20, 0x8d, 0xff, 0x2d, 0x27,
-	0x8d, 0x39, 0x4c, 0xb1, 0x09, 0x92, 0x1e, 0x45, 0xc4, 0x45, 0x72, 0x13, 0x4c, 0x92, 0x43, 0x09,
-	0x24, 0x87, 0x98, 0x50, 0xdb, 0x75, 0xc4, 0x14, 0x8a, 0x1a, 0xde, 0x92, 0x93, 0x4b, 0xcb, 0x26,
-	0x94, 0x99, 0x78, 0x88, 0x1d, 0xc6, 0x3d, 0x93, 0x92, 0x5c, 0x84, 0xf4, 0x13, 0x2e, 0x6c, 0x58,
-	0xb0, 0x0a, 0x72, 0x0e, 0xfe, 0xd2, 0x67, 0x94, 0x12, 0x46, 0x19, 0x2e, 0xf4, 0x6c, 0x76, 0x41,
-	0x96, 0x36, 0x3b, 0xd8, 0x1a, 0x74, 0xb1, 0x68, 0xa8, 0xb4, 0x34, 0x99, 0xc8, 0x1a, 0x56, 0xf5,
-	0x59, 0x14, 0x6c, 0x2e, 0xe0, 0x17, 0x88, 0xc0, 0xfa, 0x34, 0xb7, 0x6e, 0x1f, 0x13, 0x91, 0x7a,
-	0xc5, 0x9f, 0x37, 0x96, 0xa7, 0x62, 0x82, 0xf9, 0x99, 0xe7, 0x67, 0x40, 0x27, 0x24, 0x83, 0x79,
-	0x10, 0x99, 0x5c, 0x49, 0xc4, 0xb6, 0xe0, 0x87, 0x20, 0x66, 0x3b, 0x2d, 0x57, 0xb1, 0xe3, 0xde,
-	0x34, 0x06, 0x07, 0x9f, 0xf8, 0x07, 0x02, 0xf0, 0x32, 0x30, 0x84, 0x17, 0x3c, 0x02, 0x89, 0xa6,
-	0xeb, 0xb4, 0xec, 0xb6, 0x2a, 0xbd, 0xff, 0xaf, 0xe2, 0x7f, 0x47, 0x78, 0x18, 0xca, 0x13, 0xb6,
-	0x00, 0xf4, 0x77, 0xa0, 0xc2, 0x93, 0xa4, 0xf5, 0x7e, 0x10, 0x6f, 0x11, 0x4d, 0xfb, 0xea, 0x54,
-	0x81, 0xfb, 0x9b, 0x5a, 0x8a, 0xe0, 0x55, 0x90, 0x97, 0xd8, 0x66, 0xb0, 0x0c, 0x72, 0x52, 0xfa,
-	0x48, 0x15, 0xc3, 0x75, 0x50, 0xe4, 0x2f, 0x1d, 0x77, 0x88, 0xc9, 0xc4, 0x50, 0x96, 0x43, 0xc1,
-	0x93, 0x2b, 0xd3, 0xea, 0xd3, 0x28, 0xd8, 0x98, 0xcb, 0xd8, 0xf0, 0x1a, 0x28, 0x30, 0x44, 0xda,
-	0x98, 0x99, 0xcd, 0xee, 0x80, 0x32, 0x4c, 0xe4, 0x4c, 0x49, 0x1b, 0x79, 0x29, 0xbe, 0xa3, 0xa4,
-	0xa1, 0x6e, 0x8a, 0xbc, 0xb1, 0x9b, 0xa2, 0x4b, 0xba, 0x29, 0xe6, 0xef, 0xa6, 0x70, 0x55, 0xc7,
-	0x57, 0xa9, 0xea, 0x44, 0xb8, 0xaa, 0x7d, 0x9d, 0x93, 0x0c, 0x76, 0xce, 0x2d, 0x90, 0x54, 0xd4,
-	0x23, 0x4a, 0x3d, 0x53, 0xdf, 0x09, 0x5e, 0x98, 0x52, 0xfa, 0xd8, 0xcb, 0xf0, 0x1c, 0xe0, 0x31,
-	0x28, 0x38, 0xf8, 0xc2, 0xe4, 0x5b, 0xf7, 0x30, 0xc0, 0x8a, 0x18, 0x39, 0x07, 0x5f, 0x18, 0x03,
-	0x47, 0x2d, 0x4f, 0x62, 0xa9, 0x54, 0x31, 0x7d, 0x12, 0x4b, 0x65, 0x8a, 0xd9, 0x93, 0x58, 0x2a,
-	0x5b, 0xcc, 0x9d, 0xc4, 0x52, 0xb9, 0x62, 0xfe, 0x24, 0x96, 0xca, 0x17, 0x0b, 0xd5, 0x6f, 0x23,
-	0x60, 0x7b, 0x29, 0x85, 0xff, 0x5b, 0x6e, 0xab, 0xfa, 0x83, 0x06, 0xb6, 0x97, 0xbe, 0xf0, 0x78,
-	0x8f, 0xa8, 0x67, 0xb6, 0xca, 0x84, 0x1a, 0xef, 0x39, 0x29, 0x55, 0x89, 0x08, 0xbc, 0x19, 0x22,
-	0xc1, 0x37, 0xc3, 0x0c, 0x55, 0x47, 0xdf, 0x82, 0xaa, 0x7f, 0x8d, 0x83, 0xf2, 0xe2, 0xc7, 0xdf,
-	0xbb, 0x24, 0x20, 0x5f, 0xea, 0x62, 0xc1, 0x42, 0x9f, 0x1d, 0xec, 0xf1, 0xd0, 0x60, 0x87, 0x9f,
-	0x82, 0xfc, 0xd4, 0x44, 0x1c, 0x3e, 0xb1, 0xe2, 0xe1, 0x73, 0x13, 0x3f, 0xae, 0x81, 0xdb, 0x80,
-	0x67, 0x83, 0x30, 0x19, 0x49, 0xde, 0x61, 0x5a, 0x49, 0x04, 0x4b, 0x66, 0x3d, 0xb5, 0x88, 0x92,
-	0x5a, 0x31, 0x4a, 0x46, 0x79, 0x89, 0x18, 0x0f, 0xc0, 0xba, 0x78, 0x94, 0x74, 0x30, 0x22, 0xec,
-	0x0c, 0x23, 0x26, 0xb1, 0xd2, 0x2b, 0x62, 0x5d, 0xe2, 0xce, 0xc7, 0x9e, 0xaf, 0x40, 0xbc, 0x05,
-	0x92, 0x16, 0x66, 0xc8, 0xee, 0xd2, 0xf9, 0x6d, 0x2c, 0xbf, 0x6f, 0x79, 0x17, 0x3f, 0x40, 0xa3,
-	0xae, 0x8b, 0x2c, 0x6a, 0x78, 0x0e, 0x3c, 0xef, 0x88, 0x71, 0x6b, 0x56, 0xca, 0xc8, 0x72, 0x52,
-	0x4b, 0x7e, 0x58, 0xb1, 0x4f, 0xf5, 0xf1, 0x59, 0xca, 0xce, 0x83, 0x56, 0x4a, 0x8e, 0x7d, 0x57,
-	0xfe, 0x34, 0x32, 0xdc, 0x4b, 0x2d, 0xe0, 0x0d, 0xf0, 0x1f, 0x01, 0xc2, 0x0b, 0x00, 0x13, 0xd3,
-	0xb6, 0xb0, 0xc3, 0x6c, 0x36, 0x2a, 0xe5, 0xc4, 0xdd, 0x43, 0xae, 0x7b, 0x2c, 0x54, 0x0d, 0xa5,
-	0x81, 0x8f, 0x41, 0x41, 0xdd, 0xfc, 0x64, 0x36, 0xe5, 0x45, 0x64, 0x7d, 0x2e, 0x09, 0xfb, 0x46,
-	0x94, 0xe2, 0x06, 0x6f, 0x52, 0xe5, 0x87, 0x81, 0x75, 0xf5, 0xcf, 0x08, 0xd8, 0x5c, 0xf0, 0x8e,
-	0xf7, 0xbf, 0x5c, 0xb4, 0xc0, 0xcb, 0xe5, 0x1d, 0x8e, 0x9d, 0x16, 0xd8, 0x98, 0x39, 0xa8, 0x69,
-	0x33, 0xdc, 0xe3, 0x1f, 0x8d, 0xfc, 0x09, 0x5c, 0xff, 0x7b, 0xc7, 0x6d, 0x30, 0xdc, 0x33, 0xd6,
-	0x87, 0x21, 0x19, 0x85, 0x1f, 0x80, 0x84, 0x98, 0x59, 0xde, 0x17, 0xe0, 0xc2, 0xe2, 0xf8, 0x18,
-	0x31, 0x74, 0xd4, 0x75, 0xcf, 0x0c, 0x65, 0x0f, 0xef, 0x82, 0xbc, 0x47, 0x13, 0x0a, 0x21, 0xb9,
-	0x22, 0x42, 0x56, 0xb2, 0x84, 0x98, 0x8b, 0xf4, 0xc8, 0x7e, 0xfe, 0xb2, 0xb2, 0xf6, 0xe2, 0x65,
-	0x65, 0xed, 0xf5, 0xcb, 0x8a, 0xf6, 0xf5, 0xb8, 0xa2, 0xfd, 0x34, 0xae, 0x68, 0xcf, 0xc6, 0x15,
-	0xed, 0xf9, 0xb8, 0xa2, 0xfd, 0x3e, 0xae, 0x68, 0x7f, 0x8c, 0x2b, 0x6b, 0xaf, 0xc7, 0x15, 0xed,
-	0xc9, 0xab, 0xca, 0xda, 0xf3, 0x57, 0x95, 0xb5, 0x17, 0xaf, 0x2a, 0x6b, 0x9f, 0x1f, 0xb4, 0xdd,
-	0x69, 0x1c, 0xdb, 0x5d, 0xfc, 0x57, 0xd6, 0x6d, 0x82, 0xfb, 0x6a, 0x75, 0x96, 0x10, 0x7d, 0x73,
-	0xf0, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x00, 0xb4, 0x0f, 0x2d, 0x02, 0x13, 0x00, 0x00,
+	// 1566 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xfa, 0xdb, 0xe3, 0xcf, 0x4c, 0x1a, 0xe2, 0x58, 0x8a, 0x9b, 0x58, 0x2d, 0x4d, 0x11,
+	0x5a, 0x37, 0xce, 0x81, 0x7e, 0x20, 0x50, 0x12, 0x5a, 0xe2, 0x48, 0x2d, 0xd5, 0x36, 0x6a, 0x25,
+	0x2e, 0x66, 0xe2, 0x1d, 0xdb, 0x4b, 0xec, 0x5d, 0x6b, 0x66, 0xec, 0xd4, 0x9c, 0x90, 0x38, 0x70,
+	0x01, 0xa9, 0xff, 0x43, 0x39, 0x70, 0xe2, 0xef, 0xe8, 0xb1, 0x12, 0x42, 0x2a, 0x27, 0x68, 0x7a,
+	0xe1, 0xd8, 0x1b, 0x57, 0x34, 0x1f, 0x6b, 0xef, 0x7a, 0x6d, 0xd7, 0x14, 0xf5, 0xc4, 0xcd, 0xf3,
+	0x3e, 0x7e, 0x6f, 0xe6, 0xcd, 0x7b, 0xef, 0x37, 0x6b, 0x70, 0x8d, 0xe1, 0x6e, 0xcf, 0x21, 0xa8,
+	0x53, 0xa1, 0x98, 0x0c, 0x30, 0xa9, 0xa0, 0x9e, 0x55, 0x21, 0xb8, 0xd7, 0xb1, 0x1a, 0x88, 0x59,
+	0x8e, 0x5d, 0x19, 0xec, 0x54, 0xba, 0x98, 0x52, 0xd4, 0xc2, 0x7a, 0x8f, 0x38, 0xcc, 0x81, 0x65,
+	0xd7, 0x43, 0x97, 0x1e, 0x3a, 0xea, 0x59, 0xba, 0xc7, 0x43, 0x1f, 0xec, 0x14, 0x2f, 0xb6, 0x1c,
+	0xa7, 0xd5, 0xc1, 0x15, 0xe1, 0x71, 0xd2, 0x6f, 0x56, 0x98, 0xd5, 0xc5, 0x94, 0xa1, 0x6e, 0x4f,
+	0x82, 0x14, 0xb7, 0x4c, 0xdc, 0xc3, 0xb6, 0x89, 0xed, 0x86, 0x85, 0x69, 0xa5, 0xe5, 0xb4, 0x1c,
+	0x21, 0x17, 0xbf, 0x94, 0x89, 0x3e, 0x6d, 0x67, 0xd8, 0xee, 0x77, 0x29, 0xdf, 0x93, 0x37, 0xa0,
+	0xb4, 0xbf, 0x32, 0xd7, 0x9e, 0x21, 0x7a, 0xaa, 0x0c, 0x3f, 0x9c, 0x66, 0xd8, 0xb6, 0x28, 0x73,
+	0xc8, 0x30, 0x70, 0xdc, 0xe2, 0xa5, 0x91, 0x35, 0x37, 0x6b, 0x38, 0xdd, 0xee, 0x94, 0xa4, 0x14,
+	0xaf, 0xf8, 0xac, 0x6c, 0xd4, 0xc5, 0xb4, 0x87, 0x1a, 0x38, 0x68, 0x78, 0xd5, 0x67, 0x38, 0x2f,
+	0xd1, 0xc5, 0xcb, 0x3e, 0xd3, 0x99, 0x1b, 0xf4, 0x9b, 0x35, 0x91, 0xd5, 0xe9, 0x93, 0x60, 0xe0,
+	0xf2, 0xaf, 0x09, 0x90, 0x33, 0xc6, 0xe1, 0x8e, 0x11, 0x3d, 0x85, 0xf7, 0x40, 0x92, 0xe7, 0xa5,
+	0xce, 0x86, 0x3d, 0x5c, 0xd0, 0x36, 0xb5, 0xed, 0x6c, 0x75, 0x47, 0x9f, 0x76, 0xbd, 0x22, 0x8d,
+	0xfa, 0x60, 0x47, 0x9f, 0x40, 0x38, 0x1e, 0xf6, 0xb0, 0x91, 0x60, 0xea, 0x17, 0xbc, 0x04, 0xb2,
+	0xd4, 0xe9, 0x93, 0x06, 0xae, 0x0b, 0x58, 0xcb, 0x2c, 0x84, 0x36, 0xb5, 0xed, 0xb0, 0x91, 0x96,
+	0x52, 0xee, 0x51, 0x33, 0xe1, 0x10, 0xac, 0x8f, 0x12, 0x24, 0x0d, 0x11, 0x63, 0xc4, 0x3a, 0xe9,
+	0x33, 0x4c, 0x0b, 0xe1, 0x4d, 0x6d, 0x3b, 0x55, 0xbd, 0xa5, 0xbf, 0xb9, 0xc8, 0xf4, 0x7b, 0x2e,
+	0x08, 0xc7, 0xdd, 0x1b, 0x41, 0x1c, 0x2e, 0x19, 0x6b, 0xf6, 0x74, 0x15, 0xa4, 0x60, 0x4d, 0xe5,
+	0x31, 0x10, 0x38, 0x22, 0x02, 0xdf, 0x58, 0x24, 0xf0, 0xa1, 0x84, 0x08, 0x84, 0x5d, 0x6d, 0x4f,
+	0x53, 0xc0, 0x1f, 0x35, 0xb0, 0x45, 0x87, 0x76, 0xa3, 0x4e, 0xdb, 0x88, 0x98, 0x75, 0xca, 0x10,
+	0xeb, 0xd3, 0x40, 0xfc, 0xa8, 0x88, 0xbf, 0xb7, 0x48, 0xfc, 0x07, 0x43, 0xbb, 0xf1, 0x80, 0x63,
+	0x3d, 0x10, 0x50, 0x81, 0x7d, 0x6c, 0xd0, 0x79, 0x06, 0xf0, 0x3b, 0x0d, 0x08, 0x8b, 0x3a, 0x6a,
+	0x30, 0x6b, 0x60, 0xb1, 0x60, 0x2e, 0x62, 0x62, 0x2f, 0x9f, 0x2c, 0xba, 0x97, 0x3d, 0x85, 0x13,
+	0xd8, 0x48, 0x91, 0xce, 0xd4, 0xc2, 0x1f, 0x34, 0xb0, 0xe9, 0xde, 0x45, 0x17, 0x33, 0x64, 0x22,
+	0x86, 0x02, 0x1b, 0x89, 0x2f, 0x9e, 0x14, 0x75, 0x29, 0x77, 0x15, 0x54, 0x30, 0x29, 0xed, 0x79,
+	0x06, 0xf0, 0x1b, 0x50, 0xf4, 0x55, 0xc6, 0xa0, 0xea, 0xdd, 0x47, 0x62, 0xf1, 0xaa, 0xf4, 0x14,
+	0xc7, 0xc3, 0xaa, 0xbf, 0x2a, 0xdb, 0xd3, 0x55, 0xf0, 0x6b, 0x90, 0x61, 0x84, 0x37, 0x43, 0xc3,
+	0xb1, 0x19, 0x7e, 0xcc, 0x0a, 0xc9, 0xcd, 0xf0, 0x76, 0xaa, 0x7a, 0x7b, 0x91, 0x70, 0x13, 0x0d,
+	0xa9, 0x1f, 0x73, 0xa0, 0x03, 0x89, 0x73, 0xdb, 0x66, 0x64, 0x68, 0xa4, 0x99, 0x47, 0x54, 0xfc,
+	0x14, 0x2c, 0x07, 0x4c, 0x60, 0x1e, 0x84, 0x4f, 0xf1, 0x50, 0x4c, 0x80, 0xa4, 0xc1, 0x7f, 0xc2,
+	0x0b, 0x20, 0x3a, 0x40, 0x9d, 0x3e, 0x16, 0x0d, 0x9c, 0x34, 0xe4, 0xe2, 0x66, 0xe8, 0xba, 0xb6,
+	0x9f, 0x06, 0x60, 0x9c, 0x98, 0xf2, 0x53, 0x0d, 0xe4, 0xbd, 0x5b, 0x70, 0x4e, 0xb1, 0x0d, 0xd7,
+	0x41, 0x42, 0x96, 0xba, 0x65, 0x0a, 0xcc, 0xa8, 0x11, 0x17, 0xeb, 0x9a, 0x09, 0x6f, 0x80, 0xf5,
+	0x0e, 0xa2, 0xac, 0x4e, 0x30, 0x23, 0x16, 0x1e, 0x60, 0xb3, 0xae, 0xa6, 0xd4, 0x78, 0x58, 0xbc,
+	0xc7, 0x0d, 0x0c, 0x57, 0x7f, 0x57, 0xaa, 0x3d, 0xae, 0x3d, 0xe2, 0x34, 0x30, 0xa5, 0x7e, 0xd7,
+	0xf0, 0xd8, 0xf5, 0xbe, 0xab, 0x1f, 0xb9, 0x96, 0x8f, 0x41, 0x6e, 0xa2, 0x67, 0xe0, 0x1e, 0x48,
+	0xb9, 0x8d, 0x68, 0x75, 0xe5, 0xf0, 0x4b, 0x55, 0x8b, 0xba, 0xe4, 0x2d, 0xdd, 0xe5, 0x2d, 0xfd,
+	0xd8, 0xe5, 0xad, 0xfd, 0xc8, 0x93, 0x3f, 0x2e, 0x6a, 0x06, 0x90, 0x4e, 0x5c, 0x5c, 0xfe, 0x25,
+	0x04, 0x56, 0x3c, 0x67, 0x57, 0xe1, 0x28, 0xfc, 0x0a, 0x2c, 0x7b, 0x2e, 0x49, 0x94, 0x13, 0x2d,
+	0x68, 0xe2, 0x4a, 0x77, 0xdf, 0xe2, 0x4a, 0x8d, 0x3c, 0xf1, 0x0b, 0xe8, 0x7f, 0xc9, 0xe2, 0x3a,
+	0x48, 0xb4, 0x11, 0xad, 0x77, 0x1d, 0x82, 0x45, 0xd2, 0x12, 0x46, 0xbc, 0x8d, 0xe8, 0x5d, 0x87,
+	0x60, 0x58, 0x07, 0xcb, 0x81, 0x31, 0xa5, 0xc6, 0xe2, 0xee, 0x5b, 0x8c, 0x25, 0x23, 0x37, 0x31,
+	0x86, 0xca, 0xbf, 0xf9, 0x13, 0x26, 0xe8, 0xc0, 0x6e, 0x3a, 0x70, 0x0b, 0xa4, 0xc7, 0x84, 0xa0,
+	0x6a, 0x26, 0x69, 0xa4, 0x46, 0xb2, 0x9a, 0x09, 0x2f, 0x82, 0xd4, 0x99, 0x43, 0x4e, 0x9b, 0x1d,
+	0xe7, 0xcc, 0x3d, 0x63, 0xd2, 0x00, 0xae, 0xa8, 0x66, 0xc2, 0x55, 0x10, 0x23, 0x7d, 0xdb, 0x2d,
+	0x85, 0xa4, 0x11, 0x25, 0x7d, 0xbb, 0x66, 0xc2, 0x03, 0x2f, 0xc3, 0x45, 0x04, 0xc3, 0xbd, 0x3f,
+	0x9f, 0xe1, 0xa6, 0xd0, 0xda, 0x1a, 0x88, 0xbb, 0x7c, 0x16, 0x15, 0xc9, 0x8d, 0x31, 0xc9, 0x64,
+	0x05, 0x10, 0x1f, 0x60, 0x42, 0x2d, 0xc7, 0x16, 0x23, 0x33, 0x6c, 0xb8, 0x4b, 0xce, 0x84, 0x4d,
+	0x8b, 0x50, 0x56, 0xc7, 0x03, 0x6c, 0x33, 0xee, 0x19, 0x97, 0x4c, 0x28, 0xa4, 0xb7, 0xb9, 0xb0,
+	0x66, 0xc2, 0x32, 0xc8, 0xd8, 0xf8, 0xb1, 0xc7, 0x28, 0x21, 0x8c, 0x52, 0x5c, 0xe8, 0xda, 0x6c,
+	0x81, 0x34, 0x6d, 0xb4, 0xb1, 0xd9, 0xef, 0x60, 0xd1, 0x50, 0x49, 0x69, 0x32, 0x92, 0xd5, 0xcc,
+	0xf2, 0xb3, 0x30, 0x58, 0x9b, 0x41, 0x86, 0x10, 0x81, 0x95, 0x71, 0x6e, 0x9d, 0x1e, 0x26, 0x22,
+	0xf5, 0x8a, 0xec, 0xaf, 0xcd, 0x4f, 0xc5, 0x08, 0xf3, 0x0b, 0xd7, 0xcf, 0x80, 0x76, 0x40, 0x06,
+	0xb3, 0x20, 0x34, 0xba, 0x92, 0x90, 0x65, 0xc2, 0x8f, 0x41, 0xc4, 0xb2, 0x9b, 0x8e, 0xa2, 0xf2,
+	0xed, 0x71, 0x0c, 0x0e, 0x3e, 0xf2, 0xf7, 0x05, 0xe0, 0x65, 0x60, 0x08, 0x2f, 0xb8, 0x0f, 0x62,
+	0x0d, 0xc7, 0x6e, 0x5a, 0x2d, 0x55, 0x7a, 0x1f, 0x2c, 0xe2, 0x7f, 0x20, 0x3c, 0x0c, 0xe5, 0x09,
+	0x9b, 0x00, 0x7a, 0x3b, 0x50, 0xe1, 0x49, 0x86, 0xfd, 0xc8, 0x8f, 0x37, 0xeb, 0x4d, 0xe1, 0xa9,
+	0x53, 0x05, 0xbe, 0x4c, 0x26, 0x45, 0xf0, 0x32, 0xc8, 0x4a, 0xec, 0xba, 0xbf, 0x0c, 0x32, 0x52,
+	0xfa, 0x50, 0x15, 0xc3, 0x55, 0x90, 0xe7, 0xcf, 0x32, 0x67, 0x80, 0xc9, 0xc8, 0x50, 0x96, 0x43,
+	0xce, 0x95, 0x2b, 0xd3, 0xf2, 0xd3, 0x30, 0x58, 0x9d, 0xfa, 0xbc, 0x80, 0x57, 0x40, 0x8e, 0x21,
+	0xd2, 0xc2, 0xac, 0xde, 0xe8, 0xf4, 0x29, 0xc3, 0x44, 0xce, 0x94, 0xa4, 0x91, 0x95, 0xe2, 0x03,
+	0x25, 0x0d, 0x74, 0x53, 0xe8, 0x8d, 0xdd, 0x14, 0x9e, 0xd3, 0x4d, 0x11, 0x6f, 0x37, 0x05, 0xab,
+	0x3a, 0xba, 0x48, 0x55, 0xc7, 0x82, 0x55, 0xed, 0xe9, 0x9c, 0xb8, 0xbf, 0x73, 0x6e, 0x82, 0xb8,
+	0xe2, 0x49, 0x51, 0xea, 0xa9, 0xea, 0xa6, 0xff, 0xc2, 0x94, 0xd2, 0x43, 0xb5, 0x86, 0xeb, 0x00,
+	0x0f, 0x41, 0xce, 0xc6, 0x67, 0x75, 0xbe, 0x75, 0x17, 0x03, 0x2c, 0x88, 0x91, 0xb1, 0xf1, 0x99,
+	0xd1, 0xb7, 0xd5, 0xf2, 0x28, 0x92, 0x48, 0xe4, 0x93, 0x47, 0x91, 0x44, 0x2a, 0x9f, 0x3e, 0x8a,
+	0x24, 0xd2, 0xf9, 0xcc, 0x51, 0x24, 0x91, 0xc9, 0x67, 0x8f, 0x22, 0x89, 0x6c, 0x3e, 0x57, 0xfe,
+	0x3e, 0x04, 0x36, 0xe6, 0xbe, 0x37, 0xfe, 0x2f, 0xb7, 0x55, 0xfe, 0x49, 0x03, 0x1b, 0x73, 0x9f,
+	0xa3, 0xbc, 0x47, 0xd4, 0x37, 0x81, 0xca, 0x84, 0x1a, 0xef, 0x19, 0x29, 0x55, 0x89, 0xf0, 0xbd,
+	0x19, 0x42, 0xfe, 0x37, 0xc3, 0x04, 0x55, 0x87, 0xdf, 0x82, 0xaa, 0x7f, 0x8f, 0x82, 0xe2, 0xec,
+	0x97, 0xea, 0xbb, 0x24, 0x20, 0x4f, 0xea, 0x22, 0xfe, 0x42, 0x9f, 0x1c, 0xec, 0xd1, 0xc0, 0x60,
+	0x87, 0x9f, 0x83, 0xec, 0xd8, 0x44, 0x1c, 0x3e, 0xb6, 0xe0, 0xe1, 0x33, 0x23, 0x3f, 0xae, 0x81,
+	0x1b, 0x80, 0x67, 0x83, 0x30, 0x19, 0x49, 0xde, 0x61, 0x52, 0x49, 0x04, 0x4b, 0xa6, 0x5d, 0xb5,
+	0x88, 0x92, 0x58, 0x30, 0x4a, 0x4a, 0x79, 0x89, 0x18, 0xf7, 0xc1, 0x8a, 0x78, 0x94, 0xb4, 0x31,
+	0x22, 0xec, 0x04, 0x23, 0x26, 0xb1, 0x92, 0x0b, 0x62, 0x2d, 0x73, 0xe7, 0x43, 0xd7, 0x57, 0x20,
+	0xde, 0x04, 0x71, 0x13, 0x33, 0x64, 0x75, 0xe8, 0xf4, 0x36, 0x96, 0x1f, 0xe3, 0xbc, 0x8b, 0xef,
+	0xa3, 0x61, 0xc7, 0x41, 0x26, 0x35, 0x5c, 0x07, 0x9e, 0x77, 0xc4, 0xb8, 0x35, 0x2b, 0xa4, 0x64,
+	0x39, 0xa9, 0x25, 0x3f, 0xac, 0xd8, 0xa7, 0xfa, 0x52, 0x2e, 0xa4, 0xa7, 0x41, 0x2b, 0x25, 0xc7,
+	0xbe, 0x23, 0x7f, 0x1a, 0x29, 0xee, 0xa5, 0x16, 0xf0, 0x1a, 0xb8, 0x20, 0x40, 0x78, 0x01, 0x60,
+	0x52, 0xb7, 0x4c, 0x6c, 0x33, 0x8b, 0x0d, 0x0b, 0x19, 0x71, 0xf7, 0x90, 0xeb, 0x1e, 0x09, 0x55,
+	0x4d, 0x69, 0xe0, 0x23, 0x90, 0x53, 0x37, 0x3f, 0x9a, 0x4d, 0x59, 0x11, 0x59, 0x9f, 0x4a, 0xc2,
+	0x9e, 0x11, 0xa5, 0xb8, 0xc1, 0x9d, 0x54, 0xd9, 0x81, 0x6f, 0x5d, 0xfe, 0x3b, 0x04, 0xd6, 0x66,
+	0x7c, 0x74, 0x78, 0x5f, 0x2e, 0x9a, 0xef, 0xe5, 0xf2, 0x0e, 0xc7, 0x4e, 0x13, 0xac, 0x4e, 0x1c,
+	0xb4, 0x6e, 0x31, 0xdc, 0xe5, 0x5f, 0xb8, 0xfc, 0x09, 0x5c, 0xfd, 0x77, 0xc7, 0xad, 0x31, 0xdc,
+	0x35, 0x56, 0x06, 0x01, 0x19, 0x85, 0xd7, 0x41, 0x4c, 0xcc, 0x2c, 0xf7, 0x73, 0x75, 0x66, 0x71,
+	0x7c, 0x86, 0x18, 0xda, 0xef, 0x38, 0x27, 0x86, 0xb2, 0x87, 0x77, 0x40, 0xd6, 0xa5, 0x09, 0x85,
+	0x10, 0x5f, 0x10, 0x21, 0x2d, 0x59, 0x42, 0xcc, 0x45, 0xba, 0x6f, 0x3d, 0x7f, 0x59, 0x5a, 0x7a,
+	0xf1, 0xb2, 0xb4, 0xf4, 0xfa, 0x65, 0x49, 0xfb, 0xf6, 0xbc, 0xa4, 0xfd, 0x7c, 0x5e, 0xd2, 0x9e,
+	0x9d, 0x97, 0xb4, 0xe7, 0xe7, 0x25, 0xed, 0xcf, 0xf3, 0x92, 0xf6, 0xd7, 0x79, 0x69, 0xe9, 0xf5,
+	0x79, 0x49, 0x7b, 0xf2, 0xaa, 0xb4, 0xf4, 0xfc, 0x55, 0x69, 0xe9, 0xc5, 0xab, 0xd2, 0xd2, 0x97,
+	0xbb, 0x2d, 0x67, 0x1c, 0xc7, 0x72, 0x66, 0xff, 0xef, 0x76, 0x8b, 0xe0, 0x9e, 0x5a, 0x9d, 0xc4,
+	0x44, 0xdf, 0xec, 0xfe, 0x33, 0x00, 0xab, 0xab, 0x1e, 0x8b, 0xaf, 0x13, 0x00, 0x00,
 }
 
 func (this *ReplicationTask) Equal(that interface{}) bool {
@@ -1233,6 +1248,14 @@ func (this *ReplicationTask) Equal(that interface{}) bool {
 	} else if !this.Attributes.Equal(that1.Attributes) {
 		return false
 	}
+	if len(this.TraceContext) != len(that1.TraceContext) {
+		return false
+	}
+	for i := range this.TraceContext {
+		if this.TraceContext[i] != that1.TraceContext[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *ReplicationTask_NamespaceTaskAttributes) Equal(that interface{}) bool {
@@ -1825,13 +1848,26 @@ func (this *ReplicationTask) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 12)
+	s := make([]string, 0, 13)
 	s = append(s, "&repication.ReplicationTask{")
 	s = append(s, "TaskType: "+fmt.Sprintf("%#v", this.TaskType)+",\n")
 	s = append(s, "SourceTaskId: "+fmt.Sprintf("%#v", this.SourceTaskId)+",\n")
 	if this.Attributes != nil {
 		s = append(s, "Attributes: "+fmt.Sprintf("%#v", this.Attributes)+",\n")
 	}
+	keysForTraceContext := make([]string, 0, len(this.TraceContext))
+	for k, _ := range this.TraceContext {
+		keysForTraceContext = append(keysForTraceContext, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForTraceContext)
+	mapStringForTraceContext := "map[string]string{"
+	for _, k := range keysForTraceContext {
+		mapStringForTraceContext += fmt.Sprintf("%#v: %#v,", k, this.TraceContext[k])
+	}
+	mapStringForTraceContext += "}"
+	if this.TraceContext != nil {
+		s = append(s, "TraceContext: "+mapStringForTraceContext+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2091,6 +2127,25 @@ func (m *ReplicationTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TraceContext) > 0 {
+		for k := range m.TraceContext {
+			v := m.TraceContext[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintMessage(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintMessage(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintMessage(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Attributes != nil {
 		{
 			size := m.Attributes.Size()
@@ -2984,6 +3039,14 @@ func (m *ReplicationTask) Size() (n int) {
 	if m.Attributes != nil {
 		n += m.Attributes.Size()
 	}
+	if len(m.TraceContext) > 0 {
+		for k, v := range m.TraceContext {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovMessage(uint64(len(k))) + 1 + len(v) + sovMessage(uint64(len(v)))
+			n += mapEntrySize + 1 + sovMessage(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -3397,10 +3460,21 @@ func (this *ReplicationTask) String() string {
 	if this == nil {
 		return "nil"
 	}
+	keysForTraceContext := make([]string, 0, len(this.TraceContext))
+	for k, _ := range this.TraceContext {
+		keysForTraceContext = append(keysForTraceContext, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForTraceContext)
+	mapStringForTraceContext := "map[string]string{"
+	for _, k := range keysForTraceContext {
+		mapStringForTraceContext += fmt.Sprintf("%v: %v,", k, this.TraceContext[k])
+	}
+	mapStringForTraceContext += "}"
 	s := strings.Join([]string{`&ReplicationTask{`,
 		`TaskType:` + fmt.Sprintf("%v", this.TaskType) + `,`,
 		`SourceTaskId:` + fmt.Sprintf("%v", this.SourceTaskId) + `,`,
 		`Attributes:` + fmt.Sprintf("%v", this.Attributes) + `,`,
+		`TraceContext:` + mapStringForTraceContext + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3914,6 +3988,133 @@ func (m *ReplicationTask) Unmarshal(dAtA []byte) error {
 			}
 			m.Attributes = &ReplicationTask_HistoryTaskV2Attributes{v}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceContext", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TraceContext == nil {
+				m.TraceContext = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthMessage
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipMessage(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthMessage
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.TraceContext[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
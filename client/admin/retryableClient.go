@@ -62,7 +62,7 @@ func (c *retryableClient) AddSearchAttributes(
 		resp, err = c.client.AddSearchAttributes(ctx, request, opts...)
 		return err
 	}
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -78,7 +78,7 @@ func (c *retryableClient) RemoveSearchAttributes(
 		resp, err = c.client.RemoveSearchAttributes(ctx, request, opts...)
 		return err
 	}
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -94,7 +94,7 @@ func (c *retryableClient) GetSearchAttributes(
 		resp, err = c.client.GetSearchAttributes(ctx, request, opts...)
 		return err
 	}
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -110,7 +110,7 @@ func (c *retryableClient) DescribeHistoryHost(
 		resp, err = c.client.DescribeHistoryHost(ctx, request, opts...)
 		return err
 	}
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -126,7 +126,7 @@ func (c *retryableClient) RemoveTask(
 		resp, err = c.client.RemoveTask(ctx, request, opts...)
 		return err
 	}
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -142,7 +142,7 @@ func (c *retryableClient) CloseShard(
 		resp, err = c.client.CloseShard(ctx, request, opts...)
 		return err
 	}
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -158,7 +158,7 @@ func (c *retryableClient) DescribeMutableState(
 		resp, err = c.client.DescribeMutableState(ctx, request, opts...)
 		return err
 	}
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -174,7 +174,7 @@ func (c *retryableClient) GetWorkflowExecutionRawHistoryV2(
 		resp, err = c.client.GetWorkflowExecutionRawHistoryV2(ctx, request, opts...)
 		return err
 	}
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -190,7 +190,7 @@ func (c *retryableClient) DescribeCluster(
 		resp, err = c.client.DescribeCluster(ctx, request, opts...)
 		return err
 	}
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -205,7 +205,7 @@ func (c *retryableClient) GetReplicationMessages(
 		resp, err = c.client.GetReplicationMessages(ctx, request, opts...)
 		return err
 	}
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -220,7 +220,7 @@ func (c *retryableClient) GetNamespaceReplicationMessages(
 		resp, err = c.client.GetNamespaceReplicationMessages(ctx, request, opts...)
 		return err
 	}
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -235,7 +235,7 @@ func (c *retryableClient) GetDLQReplicationMessages(
 		resp, err = c.client.GetDLQReplicationMessages(ctx, request, opts...)
 		return err
 	}
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -250,7 +250,7 @@ func (c *retryableClient) ReapplyEvents(
 		resp, err = c.client.ReapplyEvents(ctx, request, opts...)
 		return err
 	}
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -266,7 +266,7 @@ func (c *retryableClient) GetDLQMessages(
 		resp, err = c.client.GetDLQMessages(ctx, request, opts...)
 		return err
 	}
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -282,7 +282,7 @@ func (c *retryableClient) PurgeDLQMessages(
 		resp, err = c.client.PurgeDLQMessages(ctx, request, opts...)
 		return err
 	}
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -298,7 +298,7 @@ func (c *retryableClient) MergeDLQMessages(
 		resp, err = c.client.MergeDLQMessages(ctx, request, opts...)
 		return err
 	}
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -314,7 +314,7 @@ func (c *retryableClient) RefreshWorkflowTasks(
 		resp, err = c.client.RefreshWorkflowTasks(ctx, request, opts...)
 		return err
 	}
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -330,6 +330,6 @@ func (c *retryableClient) ResendReplicationTasks(
 		resp, err = c.client.ResendReplicationTasks(ctx, request, opts...)
 		return err
 	}
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
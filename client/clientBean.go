@@ -27,6 +27,7 @@
 package client
 
 import (
+	"errors"
 	"fmt"
 	"sync"
 	"sync/atomic"
@@ -41,8 +42,13 @@ import (
 	"go.temporal.io/server/common/cluster"
 )
 
+// ErrClientBeanClosed is returned by calls made through a client bean after it was closed
+var ErrClientBeanClosed = errors.New("client bean is closed")
+
 type (
 	// Bean in an collection of clients
+	// Outbound calls made through clients created by the bean carry the caller's remaining deadline
+	// and fail fast without a network round trip once the caller's context has expired.
 	Bean interface {
 		GetHistoryClient() historyservice.HistoryServiceClient
 		SetHistoryClient(client historyservice.HistoryServiceClient)
@@ -54,6 +60,8 @@ type (
 		SetRemoteAdminClient(cluster string, client adminservice.AdminServiceClient)
 		GetRemoteFrontendClient(cluster string) workflowservice.WorkflowServiceClient
 		SetRemoteFrontendClient(cluster string, client workflowservice.WorkflowServiceClient)
+		// Close closes the outbound connections of every client of the bean, it is safe to call more than once
+		Close() error
 	}
 
 	clientBeanImpl struct {
@@ -64,6 +72,7 @@ type (
 		remoteAdminClients    map[string]adminservice.AdminServiceClient
 		remoteFrontendClients map[string]workflowservice.WorkflowServiceClient
 		factory               Factory
+		closed                bool
 	}
 )
 
@@ -185,12 +194,26 @@ func (h *clientBeanImpl) SetRemoteFrontendClient(
 	h.remoteFrontendClients[cluster] = client
 }
 
+func (h *clientBeanImpl) Close() error {
+	h.Lock()
+	defer h.Unlock()
+
+	if h.closed {
+		return nil
+	}
+	h.closed = true
+	return h.factory.Close()
+}
+
 func (h *clientBeanImpl) lazyInitMatchingClient(namespaceIDToName NamespaceIDToNameFunc) (matchingservice.MatchingServiceClient, error) {
 	h.Lock()
 	defer h.Unlock()
 	if cached := h.matchingClient.Load(); cached != nil {
 		return cached.(matchingservice.MatchingServiceClient), nil
 	}
+	if h.closed {
+		return nil, ErrClientBeanClosed
+	}
 	client, err := h.factory.NewMatchingClient(namespaceIDToName)
 	if err != nil {
 		return nil, err
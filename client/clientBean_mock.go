@@ -61,6 +61,20 @@ func (m *MockBean) EXPECT() *MockBeanMockRecorder {
 	return m.recorder
 }
 
+// Close mocks base method.
+func (m *MockBean) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockBeanMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockBean)(nil).Close))
+}
+
 // GetFrontendClient mocks base method.
 func (m *MockBean) GetFrontendClient() v1.WorkflowServiceClient {
 	m.ctrl.T.Helper()
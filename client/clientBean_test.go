@@ -0,0 +1,136 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/membership"
+)
+
+type connectionRecordingRPCFactory struct {
+	deadlineCapturingRPCFactory
+
+	sync.Mutex
+	connections []*grpc.ClientConn
+}
+
+func (f *connectionRecordingRPCFactory) CreateFrontendGRPCConnection(hostName string, opts ...grpc.DialOption) *grpc.ClientConn {
+	return f.CreateInternodeGRPCConnection(hostName, opts...)
+}
+
+func (f *connectionRecordingRPCFactory) CreateInternodeGRPCConnection(hostName string, opts ...grpc.DialOption) *grpc.ClientConn {
+	conn := f.deadlineCapturingRPCFactory.CreateInternodeGRPCConnection(hostName, opts...)
+	f.Lock()
+	defer f.Unlock()
+	f.connections = append(f.connections, conn)
+	return conn
+}
+
+func TestClientBean_Close(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dcClient := dynamicconfig.NewMockClient(controller)
+	dcClient.EXPECT().GetDurationValue(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ dynamicconfig.Key, _ map[dynamicconfig.Filter]interface{}, defaultValue time.Duration) (time.Duration, error) {
+			return defaultValue, errors.New("unable to find key")
+		}).AnyTimes()
+	dcClient.EXPECT().GetIntValue(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ dynamicconfig.Key, _ map[dynamicconfig.Filter]interface{}, defaultValue int) (int, error) {
+			return defaultValue, errors.New("unable to find key")
+		}).AnyTimes()
+
+	resolver := membership.NewMockServiceResolver(controller)
+	resolver.EXPECT().Lookup(gomock.Any()).Return(membership.NewHostInfo("127.0.0.1:1", nil), nil).AnyTimes()
+	monitor := membership.NewMockMonitor(controller)
+	monitor.EXPECT().GetResolver(common.HistoryServiceName).Return(resolver, nil)
+
+	clusterMetadata := cluster.NewMockMetadata(controller)
+	clusterMetadata.EXPECT().GetAllClusterInfo().Return(map[string]config.ClusterInformation{
+		"active": {Enabled: true, RPCAddress: "127.0.0.1:2"},
+	})
+	clusterMetadata.EXPECT().GetCurrentClusterName().Return("active")
+
+	rpcFactory := &connectionRecordingRPCFactory{
+		deadlineCapturingRPCFactory: deadlineCapturingRPCFactory{deadlines: make(chan time.Duration, 10)},
+	}
+	factory := NewFactoryProvider().NewFactory(
+		rpcFactory,
+		monitor,
+		nil,
+		dynamicconfig.NewCollection(dcClient, log.NewNoopLogger()),
+		1,
+		log.NewNoopLogger(),
+	)
+	bean, err := NewClientBean(factory, clusterMetadata)
+	require.NoError(t, err)
+
+	getMutableStateRequest := &historyservice.GetMutableStateRequest{
+		NamespaceId: "namespace-id",
+		Execution:   &commonpb.WorkflowExecution{WorkflowId: "workflow-id"},
+	}
+	describeNamespaceRequest := &workflowservice.DescribeNamespaceRequest{Namespace: "namespace"}
+
+	_, err = bean.GetHistoryClient().GetMutableState(context.Background(), getMutableStateRequest)
+	require.NoError(t, err)
+	_, err = bean.GetFrontendClient().DescribeNamespace(context.Background(), describeNamespaceRequest)
+	require.NoError(t, err)
+	require.Len(t, rpcFactory.connections, 2)
+
+	require.NoError(t, bean.Close())
+	require.NoError(t, bean.Close())
+
+	for _, conn := range rpcFactory.connections {
+		require.Equal(t, connectivity.Shutdown, conn.GetState())
+	}
+
+	_, err = bean.GetHistoryClient().GetMutableState(context.Background(), getMutableStateRequest)
+	require.Equal(t, ErrClientBeanClosed, err)
+	_, err = bean.GetFrontendClient().DescribeNamespace(context.Background(), describeNamespaceRequest)
+	require.Equal(t, ErrClientBeanClosed, err)
+	_, err = bean.GetRemoteAdminClient("active").DescribeCluster(context.Background(), nil)
+	require.Equal(t, ErrClientBeanClosed, err)
+	_, err = bean.GetMatchingClient(func(string) (string, error) { return "namespace", nil })
+	require.Equal(t, ErrClientBeanClosed, err)
+	require.Len(t, rpcFactory.connections, 2)
+}
@@ -25,9 +25,14 @@
 package client
 
 import (
+	"context"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+
 	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
@@ -40,6 +45,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/rpc/interceptor"
 )
 
 const (
@@ -57,6 +63,10 @@ type (
 		NewMatchingClientWithTimeout(namespaceIDToName NamespaceIDToNameFunc, timeout time.Duration, longPollTimeout time.Duration) (matchingservice.MatchingServiceClient, error)
 		NewFrontendClientWithTimeout(rpcAddress string, timeout time.Duration, longPollTimeout time.Duration) (workflowservice.WorkflowServiceClient, error)
 		NewAdminClientWithTimeout(rpcAddress string, timeout time.Duration, largeTimeout time.Duration) (adminservice.AdminServiceClient, error)
+
+		// Close closes every connection opened by clients of the factory. Calls made through
+		// those clients afterwards fail with ErrClientBeanClosed.
+		Close() error
 	}
 
 	// FactoryProvider can be used to provide a customized client Factory implementation.
@@ -81,6 +91,11 @@ type (
 		dynConfig             *dynamicconfig.Collection
 		numberOfHistoryShards int32
 		logger                log.Logger
+		connectionPool        *connectionPool
+
+		closed              int32
+		frontendConnsLock   sync.Mutex
+		frontendConnections []*grpc.ClientConn
 	}
 
 	factoryProviderImpl struct {
@@ -101,7 +116,7 @@ func (p *factoryProviderImpl) NewFactory(
 	numberOfHistoryShards int32,
 	logger log.Logger,
 ) Factory {
-	return &rpcClientFactory{
+	cf := &rpcClientFactory{
 		rpcFactory:            rpcFactory,
 		monitor:               monitor,
 		metricsClient:         metricsClient,
@@ -109,18 +124,43 @@ func (p *factoryProviderImpl) NewFactory(
 		numberOfHistoryShards: numberOfHistoryShards,
 		logger:                logger,
 	}
+	cf.connectionPool = newConnectionPool(
+		func(hostName string, opts ...grpc.DialOption) *grpc.ClientConn {
+			opts = append([]grpc.DialOption{grpc.WithChainUnaryInterceptor(cf.closedInterceptor, deadlineInterceptor, interceptor.CorrelationIDClientInterceptor)}, opts...)
+			return rpcFactory.CreateInternodeGRPCConnection(hostName, opts...)
+		},
+		dc.GetIntProperty(dynamicconfig.RPCClientMaxInflightCallsPerHost, 0),
+		dc.GetIntProperty(dynamicconfig.RPCClientMaxIdleHostConnections, 0),
+		metricsClient,
+	)
+	return cf
 }
 
 func (cf *rpcClientFactory) NewHistoryClient() (historyservice.HistoryServiceClient, error) {
-	return cf.NewHistoryClientWithTimeout(history.DefaultTimeout)
+	return cf.NewHistoryClientWithTimeout(cf.clientTimeout(dynamicconfig.HistoryRPCClientTimeout, history.DefaultTimeout))
 }
 
 func (cf *rpcClientFactory) NewMatchingClient(namespaceIDToName NamespaceIDToNameFunc) (matchingservice.MatchingServiceClient, error) {
-	return cf.NewMatchingClientWithTimeout(namespaceIDToName, matching.DefaultTimeout, matching.DefaultLongPollTimeout)
+	return cf.NewMatchingClientWithTimeout(
+		namespaceIDToName,
+		cf.clientTimeout(dynamicconfig.MatchingRPCClientTimeout, matching.DefaultTimeout),
+		matching.DefaultLongPollTimeout,
+	)
 }
 
 func (cf *rpcClientFactory) NewFrontendClient(rpcAddress string) (workflowservice.WorkflowServiceClient, error) {
-	return cf.NewFrontendClientWithTimeout(rpcAddress, frontend.DefaultTimeout, frontend.DefaultLongPollTimeout)
+	return cf.NewFrontendClientWithTimeout(
+		rpcAddress,
+		cf.clientTimeout(dynamicconfig.FrontendRPCClientTimeout, frontend.DefaultTimeout),
+		frontend.DefaultLongPollTimeout,
+	)
+}
+
+// clientTimeout resolves the timeout of a client at construction time: the per-service key,
+// then the global RPCClientTimeout key, then the client's built-in default
+func (cf *rpcClientFactory) clientTimeout(key dynamicconfig.Key, defaultTimeout time.Duration) time.Duration {
+	globalTimeout := cf.dynConfig.GetDurationProperty(dynamicconfig.RPCClientTimeout, defaultTimeout)()
+	return cf.dynConfig.GetDurationProperty(key, globalTimeout)()
 }
 
 func (cf *rpcClientFactory) NewHistoryClientWithTimeout(timeout time.Duration) (historyservice.HistoryServiceClient, error) {
@@ -138,7 +178,10 @@ func (cf *rpcClientFactory) NewHistoryClientWithTimeout(timeout time.Duration) (
 	}
 
 	clientProvider := func(clientKey string) (interface{}, error) {
-		connection := cf.rpcFactory.CreateInternodeGRPCConnection(clientKey)
+		if cf.isClosed() {
+			return nil, ErrClientBeanClosed
+		}
+		connection := cf.connectionPool.getConnection(clientKey)
 		return historyservice.NewHistoryServiceClient(connection), nil
 	}
 
@@ -168,7 +211,10 @@ func (cf *rpcClientFactory) NewMatchingClientWithTimeout(
 	}
 
 	clientProvider := func(clientKey string) (interface{}, error) {
-		connection := cf.rpcFactory.CreateInternodeGRPCConnection(clientKey)
+		if cf.isClosed() {
+			return nil, ErrClientBeanClosed
+		}
+		connection := cf.connectionPool.getConnection(clientKey)
 		return matchingservice.NewMatchingServiceClient(connection), nil
 	}
 
@@ -196,7 +242,10 @@ func (cf *rpcClientFactory) NewFrontendClientWithTimeout(
 	}
 
 	clientProvider := func(clientKey string) (interface{}, error) {
-		connection := cf.rpcFactory.CreateFrontendGRPCConnection(rpcAddress)
+		connection, err := cf.createFrontendConnection(rpcAddress)
+		if err != nil {
+			return nil, err
+		}
 		return workflowservice.NewWorkflowServiceClient(connection), nil
 	}
 
@@ -217,7 +266,10 @@ func (cf *rpcClientFactory) NewAdminClientWithTimeout(
 	}
 
 	clientProvider := func(clientKey string) (interface{}, error) {
-		connection := cf.rpcFactory.CreateFrontendGRPCConnection(rpcAddress)
+		connection, err := cf.createFrontendConnection(rpcAddress)
+		if err != nil {
+			return nil, err
+		}
 		return adminservice.NewAdminServiceClient(connection), nil
 	}
 
@@ -227,3 +279,51 @@ func (cf *rpcClientFactory) NewAdminClientWithTimeout(
 	}
 	return client, nil
 }
+
+// Close closes the internode connection pool and every frontend connection opened by the factory
+func (cf *rpcClientFactory) Close() error {
+	if !atomic.CompareAndSwapInt32(&cf.closed, 0, 1) {
+		return nil
+	}
+
+	cf.connectionPool.close()
+
+	cf.frontendConnsLock.Lock()
+	defer cf.frontendConnsLock.Unlock()
+	for _, connection := range cf.frontendConnections {
+		_ = connection.Close()
+	}
+	cf.frontendConnections = nil
+	return nil
+}
+
+func (cf *rpcClientFactory) isClosed() bool {
+	return atomic.LoadInt32(&cf.closed) == 1
+}
+
+func (cf *rpcClientFactory) createFrontendConnection(rpcAddress string) (*grpc.ClientConn, error) {
+	cf.frontendConnsLock.Lock()
+	defer cf.frontendConnsLock.Unlock()
+
+	if cf.isClosed() {
+		return nil, ErrClientBeanClosed
+	}
+	connection := cf.rpcFactory.CreateFrontendGRPCConnection(rpcAddress, grpc.WithChainUnaryInterceptor(cf.closedInterceptor, deadlineInterceptor, interceptor.CorrelationIDClientInterceptor))
+	cf.frontendConnections = append(cf.frontendConnections, connection)
+	return connection, nil
+}
+
+// closedInterceptor fails calls made after Close instead of letting gRPC report a closing connection
+func (cf *rpcClientFactory) closedInterceptor(
+	ctx context.Context,
+	method string,
+	req, reply interface{},
+	cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	if cf.isClosed() {
+		return ErrClientBeanClosed
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
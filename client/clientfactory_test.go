@@ -0,0 +1,234 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/uber/tchannel-go"
+	commonpb "go.temporal.io/api/common/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/membership"
+)
+
+type deadlineCapturingRPCFactory struct {
+	deadlines chan time.Duration
+}
+
+func (f *deadlineCapturingRPCFactory) GetFrontendGRPCServerOptions() ([]grpc.ServerOption, error) {
+	return nil, nil
+}
+
+func (f *deadlineCapturingRPCFactory) GetInternodeGRPCServerOptions() ([]grpc.ServerOption, error) {
+	return nil, nil
+}
+
+func (f *deadlineCapturingRPCFactory) GetGRPCListener() net.Listener {
+	return nil
+}
+
+func (f *deadlineCapturingRPCFactory) GetRingpopChannel() *tchannel.Channel {
+	return nil
+}
+
+func (f *deadlineCapturingRPCFactory) CreateFrontendGRPCConnection(hostName string, opts ...grpc.DialOption) *grpc.ClientConn {
+	return f.CreateInternodeGRPCConnection(hostName, opts...)
+}
+
+// CreateInternodeGRPCConnection returns a connection which records the remaining time of each call instead of sending it
+func (f *deadlineCapturingRPCFactory) CreateInternodeGRPCConnection(hostName string, opts ...grpc.DialOption) *grpc.ClientConn {
+	capture := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		deadline, _ := ctx.Deadline()
+		f.deadlines <- time.Until(deadline)
+		return nil
+	}
+	opts = append(opts, grpc.WithInsecure(), grpc.WithChainUnaryInterceptor(capture))
+	conn, err := grpc.Dial(hostName, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return conn
+}
+
+func TestNewHistoryClient_PerServiceTimeout(t *testing.T) {
+	historyTimeout := 45 * time.Second
+	globalTimeout := 5 * time.Second
+	for _, tc := range []struct {
+		name     string
+		values   map[dynamicconfig.Key]time.Duration
+		expected time.Duration
+	}{
+		{
+			name:     "per-service timeout",
+			values:   map[dynamicconfig.Key]time.Duration{dynamicconfig.HistoryRPCClientTimeout: historyTimeout, dynamicconfig.RPCClientTimeout: globalTimeout},
+			expected: historyTimeout,
+		},
+		{
+			name:     "global timeout",
+			values:   map[dynamicconfig.Key]time.Duration{dynamicconfig.RPCClientTimeout: globalTimeout},
+			expected: globalTimeout,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			dcClient := dynamicconfig.NewMockClient(controller)
+			dcClient.EXPECT().GetDurationValue(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(name dynamicconfig.Key, _ map[dynamicconfig.Filter]interface{}, defaultValue time.Duration) (time.Duration, error) {
+					if value, ok := tc.values[name]; ok {
+						return value, nil
+					}
+					return defaultValue, errors.New("unable to find key")
+				}).AnyTimes()
+			dcClient.EXPECT().GetIntValue(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ dynamicconfig.Key, _ map[dynamicconfig.Filter]interface{}, defaultValue int) (int, error) {
+					return defaultValue, errors.New("unable to find key")
+				}).AnyTimes()
+
+			resolver := membership.NewMockServiceResolver(controller)
+			resolver.EXPECT().Lookup(gomock.Any()).Return(membership.NewHostInfo("127.0.0.1:1", nil), nil)
+			monitor := membership.NewMockMonitor(controller)
+			monitor.EXPECT().GetResolver(common.HistoryServiceName).Return(resolver, nil)
+
+			rpcFactory := &deadlineCapturingRPCFactory{deadlines: make(chan time.Duration, 1)}
+			factory := NewFactoryProvider().NewFactory(
+				rpcFactory,
+				monitor,
+				nil,
+				dynamicconfig.NewCollection(dcClient, log.NewNoopLogger()),
+				1,
+				log.NewNoopLogger(),
+			)
+			client, err := factory.NewHistoryClient()
+			require.NoError(t, err)
+
+			_, err = client.GetMutableState(context.Background(), &historyservice.GetMutableStateRequest{
+				NamespaceId: "namespace-id",
+				Execution:   &commonpb.WorkflowExecution{WorkflowId: "workflow-id"},
+			})
+			require.NoError(t, err)
+
+			remaining := <-rpcFactory.deadlines
+			require.LessOrEqual(t, int64(remaining), int64(tc.expected))
+			require.Greater(t, int64(remaining), int64(tc.expected-time.Second))
+		})
+	}
+}
+
+// blockingRPCFactory returns connections whose calls block until released instead of being sent
+type blockingRPCFactory struct {
+	deadlineCapturingRPCFactory
+	started  chan struct{}
+	released chan struct{}
+}
+
+func (f *blockingRPCFactory) CreateInternodeGRPCConnection(hostName string, opts ...grpc.DialOption) *grpc.ClientConn {
+	block := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		f.started <- struct{}{}
+		<-f.released
+		return nil
+	}
+	opts = append(opts, grpc.WithInsecure(), grpc.WithChainUnaryInterceptor(block))
+	conn, err := grpc.Dial(hostName, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return conn
+}
+
+func TestNewHistoryClient_MaxInflightCallsPerHost(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	dcClient := dynamicconfig.NewMockClient(controller)
+	dcClient.EXPECT().GetDurationValue(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ dynamicconfig.Key, _ map[dynamicconfig.Filter]interface{}, defaultValue time.Duration) (time.Duration, error) {
+			return defaultValue, errors.New("unable to find key")
+		}).AnyTimes()
+	dcClient.EXPECT().GetIntValue(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(name dynamicconfig.Key, _ map[dynamicconfig.Filter]interface{}, defaultValue int) (int, error) {
+			if name == dynamicconfig.RPCClientMaxInflightCallsPerHost {
+				return 1, nil
+			}
+			return defaultValue, errors.New("unable to find key")
+		}).AnyTimes()
+
+	resolver := membership.NewMockServiceResolver(controller)
+	resolver.EXPECT().Lookup(gomock.Any()).Return(membership.NewHostInfo("127.0.0.1:1", nil), nil).AnyTimes()
+	monitor := membership.NewMockMonitor(controller)
+	monitor.EXPECT().GetResolver(common.HistoryServiceName).Return(resolver, nil)
+
+	rpcFactory := &blockingRPCFactory{started: make(chan struct{}, 2), released: make(chan struct{})}
+	factory := NewFactoryProvider().NewFactory(
+		rpcFactory,
+		monitor,
+		nil,
+		dynamicconfig.NewCollection(dcClient, log.NewNoopLogger()),
+		1,
+		log.NewNoopLogger(),
+	)
+	client, err := factory.NewHistoryClient()
+	require.NoError(t, err)
+
+	request := &historyservice.GetMutableStateRequest{
+		NamespaceId: "namespace-id",
+		Execution:   &commonpb.WorkflowExecution{WorkflowId: "workflow-id"},
+	}
+	firstCallDone := make(chan error, 1)
+	go func() {
+		_, err := client.GetMutableState(context.Background(), request)
+		firstCallDone <- err
+	}()
+	select {
+	case <-rpcFactory.started:
+	case <-time.After(time.Second):
+		require.Fail(t, "first call should reach the connection")
+	}
+
+	// the host is at its limit, the second call waits until its context is done
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = client.GetMutableState(ctx, request)
+	require.Error(t, err)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	require.Empty(t, rpcFactory.started)
+
+	close(rpcFactory.released)
+	require.NoError(t, <-firstCallDone)
+}
@@ -0,0 +1,272 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/metrics"
+)
+
+type (
+	dialFunc func(hostName string, opts ...grpc.DialOption) *grpc.ClientConn
+
+	// connectionPool owns the internode gRPC connections of a client factory, exactly one per destination host.
+	// It does not cap the number of connections: gRPC multiplexes calls over the single connection,
+	// and the per host limit caps the in-flight calls on it instead. Callers past the limit block
+	// until a call completes or their context is done.
+	// Once more hosts than allowed have no in-flight calls, the least recently used connections
+	// are closed and redialed on next use. Clients keep the connection they were created with,
+	// so every unary and streaming call is routed by the pool interceptors to the live pooled
+	// connection of the same host, the connection held by the client only identifies the host.
+	connectionPool struct {
+		dial                    dialFunc
+		maxInflightCallsPerHost dynamicconfig.IntPropertyFn
+		maxIdleHostConnections  dynamicconfig.IntPropertyFn
+		metricsClient           metrics.Client
+
+		sync.Mutex
+		hosts         map[string]*pooledConnection
+		totalInFlight int
+		closed        bool
+	}
+
+	pooledConnection struct {
+		conn     *grpc.ClientConn
+		inFlight int
+		lastUsed time.Time
+		// released is closed and replaced every time an in-flight call completes
+		released chan struct{}
+	}
+)
+
+func newConnectionPool(
+	dial dialFunc,
+	maxInflightCallsPerHost dynamicconfig.IntPropertyFn,
+	maxIdleHostConnections dynamicconfig.IntPropertyFn,
+	metricsClient metrics.Client,
+) *connectionPool {
+	return &connectionPool{
+		dial:                    dial,
+		maxInflightCallsPerHost: maxInflightCallsPerHost,
+		maxIdleHostConnections:  maxIdleHostConnections,
+		metricsClient:           metricsClient,
+		hosts:                   make(map[string]*pooledConnection),
+	}
+}
+
+// getConnection returns the connection to hostName, dialing it if needed
+func (p *connectionPool) getConnection(hostName string) *grpc.ClientConn {
+	p.Lock()
+	pooled, ok := p.hosts[hostName]
+	p.Unlock()
+	if ok {
+		return pooled.conn
+	}
+	return p.dialConnection(hostName).conn
+}
+
+func (p *connectionPool) acquire(
+	ctx context.Context,
+	hostName string,
+) (*grpc.ClientConn, error) {
+	for {
+		p.Lock()
+		if p.closed {
+			p.Unlock()
+			return nil, ErrClientBeanClosed
+		}
+		pooled, ok := p.hosts[hostName]
+		if !ok {
+			p.Unlock()
+			p.dialConnection(hostName)
+			continue
+		}
+		maxInflightCalls := p.maxInflightCallsPerHost()
+		if maxInflightCalls <= 0 || pooled.inFlight < maxInflightCalls {
+			pooled.inFlight++
+			pooled.lastUsed = time.Now()
+			p.totalInFlight++
+			p.emitUtilizationLocked(maxInflightCalls)
+			p.Unlock()
+			return pooled.conn, nil
+		}
+		released := pooled.released
+		p.Unlock()
+
+		select {
+		case <-released:
+		case <-ctx.Done():
+			return nil, status.Errorf(codes.ResourceExhausted, "too many in-flight calls to host %v: %v", hostName, ctx.Err())
+		}
+	}
+}
+
+func (p *connectionPool) release(hostName string) {
+	p.Lock()
+	defer p.Unlock()
+
+	pooled, ok := p.hosts[hostName]
+	if !ok {
+		return
+	}
+	pooled.inFlight--
+	pooled.lastUsed = time.Now()
+	close(pooled.released)
+	pooled.released = make(chan struct{})
+	p.totalInFlight--
+	p.emitUtilizationLocked(p.maxInflightCallsPerHost())
+
+	if pooled.inFlight == 0 {
+		p.closeIdleConnectionsLocked()
+	}
+}
+
+func (p *connectionPool) unaryInterceptor(
+	ctx context.Context,
+	method string,
+	req, reply interface{},
+	cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	hostName := cc.Target()
+	conn, err := p.acquire(ctx, hostName)
+	if err != nil {
+		return err
+	}
+	defer p.release(hostName)
+
+	return invoker(ctx, method, req, reply, conn, opts...)
+}
+
+func (p *connectionPool) streamInterceptor(
+	ctx context.Context,
+	desc *grpc.StreamDesc,
+	cc *grpc.ClientConn,
+	method string,
+	streamer grpc.Streamer,
+	opts ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	hostName := cc.Target()
+	conn, err := p.acquire(ctx, hostName)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := streamer(ctx, desc, conn, method, opts...)
+	if err != nil {
+		p.release(hostName)
+		return nil, err
+	}
+	// the stream context is done once the stream finishes, successfully or not
+	go func() {
+		<-stream.Context().Done()
+		p.release(hostName)
+	}()
+	return stream, nil
+}
+
+// close closes every pooled connection, connections requested afterwards are closed as soon as they are dialed
+func (p *connectionPool) close() {
+	p.Lock()
+	defer p.Unlock()
+
+	p.closed = true
+	for hostName, pooled := range p.hosts {
+		_ = pooled.conn.Close()
+		delete(p.hosts, hostName)
+	}
+}
+
+// dialConnection dials hostName without holding the pool lock and adds the connection to the pool,
+// unless another caller added one for the same host in the meantime
+func (p *connectionPool) dialConnection(hostName string) *pooledConnection {
+	conn := p.dial(
+		hostName,
+		grpc.WithChainUnaryInterceptor(p.unaryInterceptor),
+		grpc.WithChainStreamInterceptor(p.streamInterceptor),
+	)
+
+	p.Lock()
+	defer p.Unlock()
+
+	if pooled, ok := p.hosts[hostName]; ok {
+		_ = conn.Close()
+		return pooled
+	}
+	pooled := &pooledConnection{
+		conn:     conn,
+		lastUsed: time.Now(),
+		released: make(chan struct{}),
+	}
+	if p.closed {
+		_ = conn.Close()
+		return pooled
+	}
+	p.hosts[hostName] = pooled
+	return pooled
+}
+
+func (p *connectionPool) closeIdleConnectionsLocked() {
+	maxIdle := p.maxIdleHostConnections()
+	if maxIdle <= 0 {
+		return
+	}
+
+	var idle []string
+	for hostName, pooled := range p.hosts {
+		if pooled.inFlight == 0 {
+			idle = append(idle, hostName)
+		}
+	}
+	if len(idle) <= maxIdle {
+		return
+	}
+
+	sort.Slice(idle, func(i, j int) bool {
+		return p.hosts[idle[i]].lastUsed.Before(p.hosts[idle[j]].lastUsed)
+	})
+	for _, hostName := range idle[:len(idle)-maxIdle] {
+		_ = p.hosts[hostName].conn.Close()
+		delete(p.hosts, hostName)
+	}
+}
+
+func (p *connectionPool) emitUtilizationLocked(maxInflightCalls int) {
+	if p.metricsClient == nil || maxInflightCalls <= 0 || len(p.hosts) == 0 {
+		return
+	}
+	utilization := float64(p.totalInFlight) / float64(maxInflightCalls*len(p.hosts))
+	p.metricsClient.UpdateGauge(metrics.RPCClientConnectionPoolScope, metrics.ClientConnectionPoolUtilization, utilization)
+}
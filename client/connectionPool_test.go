@@ -0,0 +1,156 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	"go.temporal.io/server/common/dynamicconfig"
+)
+
+func newTestConnectionPool(t *testing.T, maxInflightCallsPerHost int, maxIdleHostConnections int) *connectionPool {
+	dial := func(hostName string, opts ...grpc.DialOption) *grpc.ClientConn {
+		conn, err := grpc.Dial(hostName, append(opts, grpc.WithInsecure())...)
+		require.NoError(t, err)
+		return conn
+	}
+	return newConnectionPool(
+		dial,
+		dynamicconfig.GetIntPropertyFn(maxInflightCallsPerHost),
+		dynamicconfig.GetIntPropertyFn(maxIdleHostConnections),
+		nil,
+	)
+}
+
+func TestConnectionPool_BlocksPastMaxInflightCallsPerHost(t *testing.T) {
+	pool := newTestConnectionPool(t, 2, 0)
+
+	for i := 0; i < 2; i++ {
+		_, err := pool.acquire(context.Background(), "host-a:7233")
+		require.NoError(t, err)
+	}
+
+	// another host has its own cap
+	_, err := pool.acquire(context.Background(), "host-b:7233")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = pool.acquire(ctx, "host-a:7233")
+	require.Error(t, err)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	acquired := make(chan error, 1)
+	go func() {
+		_, err := pool.acquire(context.Background(), "host-a:7233")
+		acquired <- err
+	}()
+	select {
+	case <-acquired:
+		require.Fail(t, "acquire should block while the host is at capacity")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	pool.release("host-a:7233")
+	select {
+	case err := <-acquired:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		require.Fail(t, "acquire should proceed once a call is released")
+	}
+}
+
+func TestConnectionPool_ClosesIdleConnections(t *testing.T) {
+	pool := newTestConnectionPool(t, 0, 1)
+
+	hosts := []string{"host-a:7233", "host-b:7233", "host-c:7233"}
+	for _, host := range hosts {
+		_, err := pool.acquire(context.Background(), host)
+		require.NoError(t, err)
+	}
+	for _, host := range hosts {
+		pool.release(host)
+	}
+
+	require.Len(t, pool.hosts, 1)
+	require.Contains(t, pool.hosts, "host-c:7233")
+	require.Equal(t, 0, pool.totalInFlight)
+}
+
+func TestConnectionPool_CallsOnEvictedConnection(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	healthpb.RegisterHealthServer(server, health.NewServer())
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	pool := newTestConnectionPool(t, 0, 1)
+	defer pool.close()
+
+	hostName := listener.Addr().String()
+	client := healthpb.NewHealthClient(pool.getConnection(hostName))
+
+	// make the connection held by the client idle and evict it
+	for _, host := range []string{"host-b:7233", "host-c:7233"} {
+		_, err := pool.acquire(context.Background(), host)
+		require.NoError(t, err)
+		pool.release(host)
+	}
+	require.NotContains(t, pool.hosts, hostName)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err = client.Check(ctx, &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+
+	streamCtx, streamCancel := context.WithCancel(ctx)
+	stream, err := client.Watch(streamCtx, &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.NoError(t, err)
+
+	pool.Lock()
+	require.Equal(t, 1, pool.hosts[hostName].inFlight)
+	pool.Unlock()
+
+	streamCancel()
+	require.Eventually(t, func() bool {
+		pool.Lock()
+		defer pool.Unlock()
+		return pool.totalInFlight == 0
+	}, time.Second, 10*time.Millisecond)
+}
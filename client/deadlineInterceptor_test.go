@@ -0,0 +1,85 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.temporal.io/server/api/historyservice/v1"
+)
+
+func TestDeadlineInterceptor_ExpiredContextFailsFast(t *testing.T) {
+	invoked := false
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		invoked = true
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	<-ctx.Done()
+
+	start := time.Now()
+	err := deadlineInterceptor(ctx, "/test/Method", nil, nil, nil, invoker)
+	require.Less(t, int64(time.Since(start)), int64(100*time.Millisecond))
+	require.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	require.False(t, invoked)
+}
+
+func TestDeadlineInterceptor_PropagatesRemainingDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	expected, _ := ctx.Deadline()
+
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		deadline, ok := ctx.Deadline()
+		require.True(t, ok)
+		require.Equal(t, expected, deadline)
+		return nil
+	}
+	require.NoError(t, deadlineInterceptor(ctx, "/test/Method", nil, nil, nil, invoker))
+}
+
+func TestDeadlineInterceptor_NoNetworkRoundTrip(t *testing.T) {
+	// nothing listens on this address, a call that reached the network would fail with Unavailable
+	conn, err := grpc.Dial("127.0.0.1:1", grpc.WithInsecure(), grpc.WithChainUnaryInterceptor(deadlineInterceptor))
+	require.NoError(t, err)
+	defer func() { _ = conn.Close() }()
+	client := historyservice.NewHistoryServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	<-ctx.Done()
+
+	_, err = client.DescribeHistoryHost(ctx, &historyservice.DescribeHistoryHostRequest{})
+	require.Equal(t, codes.DeadlineExceeded, status.Code(err))
+}
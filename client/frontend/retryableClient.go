@@ -62,7 +62,7 @@ func (c *retryableClient) DeprecateNamespace(
 		return err
 	}
 
-	return resp, backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 }
 
 func (c *retryableClient) DescribeNamespace(
@@ -76,7 +76,7 @@ func (c *retryableClient) DescribeNamespace(
 		resp, err = c.client.DescribeNamespace(ctx, request, opts...)
 		return err
 	}
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -91,7 +91,7 @@ func (c *retryableClient) DescribeTaskQueue(
 		resp, err = c.client.DescribeTaskQueue(ctx, request, opts...)
 		return err
 	}
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -106,7 +106,7 @@ func (c *retryableClient) DescribeWorkflowExecution(
 		resp, err = c.client.DescribeWorkflowExecution(ctx, request, opts...)
 		return err
 	}
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -121,7 +121,7 @@ func (c *retryableClient) GetWorkflowExecutionHistory(
 		resp, err = c.client.GetWorkflowExecutionHistory(ctx, request, opts...)
 		return err
 	}
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -136,7 +136,7 @@ func (c *retryableClient) ListArchivedWorkflowExecutions(
 		resp, err = c.client.ListArchivedWorkflowExecutions(ctx, request, opts...)
 		return err
 	}
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -151,7 +151,7 @@ func (c *retryableClient) ListClosedWorkflowExecutions(
 		resp, err = c.client.ListClosedWorkflowExecutions(ctx, request, opts...)
 		return err
 	}
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -166,7 +166,7 @@ func (c *retryableClient) ListNamespaces(
 		resp, err = c.client.ListNamespaces(ctx, request, opts...)
 		return err
 	}
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -181,7 +181,7 @@ func (c *retryableClient) ListOpenWorkflowExecutions(
 		resp, err = c.client.ListOpenWorkflowExecutions(ctx, request, opts...)
 		return err
 	}
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -196,7 +196,7 @@ func (c *retryableClient) ListWorkflowExecutions(
 		resp, err = c.client.ListWorkflowExecutions(ctx, request, opts...)
 		return err
 	}
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -211,7 +211,7 @@ func (c *retryableClient) ScanWorkflowExecutions(
 		resp, err = c.client.ScanWorkflowExecutions(ctx, request, opts...)
 		return err
 	}
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -226,7 +226,7 @@ func (c *retryableClient) CountWorkflowExecutions(
 		resp, err = c.client.CountWorkflowExecutions(ctx, request, opts...)
 		return err
 	}
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -241,7 +241,7 @@ func (c *retryableClient) GetSearchAttributes(
 		resp, err = c.client.GetSearchAttributes(ctx, request, opts...)
 		return err
 	}
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -256,7 +256,7 @@ func (c *retryableClient) PollActivityTaskQueue(
 		resp, err = c.client.PollActivityTaskQueue(ctx, request, opts...)
 		return err
 	}
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -271,7 +271,7 @@ func (c *retryableClient) PollWorkflowTaskQueue(
 		resp, err = c.client.PollWorkflowTaskQueue(ctx, request, opts...)
 		return err
 	}
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -286,7 +286,7 @@ func (c *retryableClient) QueryWorkflow(
 		resp, err = c.client.QueryWorkflow(ctx, request, opts...)
 		return err
 	}
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -301,7 +301,7 @@ func (c *retryableClient) RecordActivityTaskHeartbeat(
 		resp, err = c.client.RecordActivityTaskHeartbeat(ctx, request, opts...)
 		return err
 	}
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -316,7 +316,7 @@ func (c *retryableClient) RecordActivityTaskHeartbeatById(
 		resp, err = c.client.RecordActivityTaskHeartbeatById(ctx, request, opts...)
 		return err
 	}
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -332,7 +332,7 @@ func (c *retryableClient) RegisterNamespace(
 		return err
 	}
 
-	return resp, backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 }
 
 func (c *retryableClient) RequestCancelWorkflowExecution(
@@ -347,7 +347,7 @@ func (c *retryableClient) RequestCancelWorkflowExecution(
 		return err
 	}
 
-	return resp, backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 }
 
 func (c *retryableClient) ResetStickyTaskQueue(
@@ -361,7 +361,7 @@ func (c *retryableClient) ResetStickyTaskQueue(
 		resp, err = c.client.ResetStickyTaskQueue(ctx, request, opts...)
 		return err
 	}
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -376,7 +376,7 @@ func (c *retryableClient) ResetWorkflowExecution(
 		resp, err = c.client.ResetWorkflowExecution(ctx, request, opts...)
 		return err
 	}
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -392,7 +392,7 @@ func (c *retryableClient) RespondActivityTaskCanceled(
 		return err
 	}
 
-	return resp, backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 }
 
 func (c *retryableClient) RespondActivityTaskCanceledById(
@@ -407,7 +407,7 @@ func (c *retryableClient) RespondActivityTaskCanceledById(
 		return err
 	}
 
-	return resp, backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 }
 
 func (c *retryableClient) RespondActivityTaskCompleted(
@@ -422,7 +422,7 @@ func (c *retryableClient) RespondActivityTaskCompleted(
 		return err
 	}
 
-	return resp, backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 }
 
 func (c *retryableClient) RespondActivityTaskCompletedById(
@@ -437,7 +437,7 @@ func (c *retryableClient) RespondActivityTaskCompletedById(
 		return err
 	}
 
-	return resp, backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 }
 
 func (c *retryableClient) RespondActivityTaskFailed(
@@ -452,7 +452,7 @@ func (c *retryableClient) RespondActivityTaskFailed(
 		return err
 	}
 
-	return resp, backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 }
 
 func (c *retryableClient) RespondActivityTaskFailedById(
@@ -467,7 +467,7 @@ func (c *retryableClient) RespondActivityTaskFailedById(
 		return err
 	}
 
-	return resp, backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 }
 
 func (c *retryableClient) RespondWorkflowTaskCompleted(
@@ -481,7 +481,7 @@ func (c *retryableClient) RespondWorkflowTaskCompleted(
 		resp, err = c.client.RespondWorkflowTaskCompleted(ctx, request, opts...)
 		return err
 	}
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -497,7 +497,7 @@ func (c *retryableClient) RespondWorkflowTaskFailed(
 		return err
 	}
 
-	return resp, backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 }
 
 func (c *retryableClient) RespondQueryTaskCompleted(
@@ -512,7 +512,7 @@ func (c *retryableClient) RespondQueryTaskCompleted(
 		return err
 	}
 
-	return resp, backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 }
 
 func (c *retryableClient) SignalWithStartWorkflowExecution(
@@ -526,7 +526,7 @@ func (c *retryableClient) SignalWithStartWorkflowExecution(
 		resp, err = c.client.SignalWithStartWorkflowExecution(ctx, request, opts...)
 		return err
 	}
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -542,7 +542,7 @@ func (c *retryableClient) SignalWorkflowExecution(
 		return err
 	}
 
-	return resp, backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 }
 
 func (c *retryableClient) StartWorkflowExecution(
@@ -556,7 +556,7 @@ func (c *retryableClient) StartWorkflowExecution(
 		resp, err = c.client.StartWorkflowExecution(ctx, request, opts...)
 		return err
 	}
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -572,7 +572,7 @@ func (c *retryableClient) TerminateWorkflowExecution(
 		return err
 	}
 
-	return resp, backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 }
 
 func (c *retryableClient) UpdateNamespace(
@@ -586,7 +586,7 @@ func (c *retryableClient) UpdateNamespace(
 		resp, err = c.client.UpdateNamespace(ctx, request, opts...)
 		return err
 	}
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -601,7 +601,7 @@ func (c *retryableClient) GetClusterInfo(
 		resp, err = c.client.GetClusterInfo(ctx, request, opts...)
 		return err
 	}
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -616,6 +616,6 @@ func (c *retryableClient) ListTaskQueuePartitions(
 		resp, err = c.client.ListTaskQueuePartitions(ctx, request, opts...)
 		return err
 	}
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
@@ -62,7 +62,7 @@ func (c *retryableClient) StartWorkflowExecution(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -78,7 +78,7 @@ func (c *retryableClient) DescribeHistoryHost(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -94,7 +94,7 @@ func (c *retryableClient) CloseShard(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -110,7 +110,7 @@ func (c *retryableClient) RemoveTask(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -126,7 +126,7 @@ func (c *retryableClient) DescribeMutableState(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -142,7 +142,7 @@ func (c *retryableClient) GetMutableState(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -158,7 +158,7 @@ func (c *retryableClient) PollMutableState(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -174,7 +174,7 @@ func (c *retryableClient) ResetStickyTaskQueue(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -190,7 +190,7 @@ func (c *retryableClient) DescribeWorkflowExecution(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -206,7 +206,7 @@ func (c *retryableClient) RecordWorkflowTaskStarted(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -222,7 +222,7 @@ func (c *retryableClient) RecordActivityTaskStarted(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -238,7 +238,7 @@ func (c *retryableClient) RespondWorkflowTaskCompleted(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -254,7 +254,7 @@ func (c *retryableClient) RespondWorkflowTaskFailed(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -270,7 +270,7 @@ func (c *retryableClient) RespondActivityTaskCompleted(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -286,7 +286,7 @@ func (c *retryableClient) RespondActivityTaskFailed(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -302,7 +302,7 @@ func (c *retryableClient) RespondActivityTaskCanceled(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -318,7 +318,7 @@ func (c *retryableClient) RecordActivityTaskHeartbeat(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -334,7 +334,7 @@ func (c *retryableClient) RequestCancelWorkflowExecution(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -350,7 +350,7 @@ func (c *retryableClient) SignalWorkflowExecution(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -366,7 +366,7 @@ func (c *retryableClient) SignalWithStartWorkflowExecution(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -382,7 +382,7 @@ func (c *retryableClient) RemoveSignalMutableState(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -398,7 +398,7 @@ func (c *retryableClient) TerminateWorkflowExecution(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -414,7 +414,7 @@ func (c *retryableClient) ResetWorkflowExecution(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -430,7 +430,7 @@ func (c *retryableClient) ScheduleWorkflowTask(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -446,7 +446,7 @@ func (c *retryableClient) RecordChildExecutionCompleted(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -462,7 +462,7 @@ func (c *retryableClient) ReplicateEventsV2(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -478,7 +478,7 @@ func (c *retryableClient) SyncShardStatus(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -494,7 +494,7 @@ func (c *retryableClient) SyncActivity(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -509,7 +509,7 @@ func (c *retryableClient) GetReplicationMessages(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -524,7 +524,7 @@ func (c *retryableClient) GetDLQReplicationMessages(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -539,7 +539,7 @@ func (c *retryableClient) QueryWorkflow(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -555,7 +555,7 @@ func (c *retryableClient) ReapplyEvents(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -572,7 +572,7 @@ func (c *retryableClient) GetDLQMessages(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -589,7 +589,7 @@ func (c *retryableClient) PurgeDLQMessages(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -606,7 +606,7 @@ func (c *retryableClient) MergeDLQMessages(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -623,6 +623,6 @@ func (c *retryableClient) RefreshWorkflowTasks(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
@@ -62,7 +62,7 @@ func (c *retryableClient) AddActivityTask(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -78,7 +78,7 @@ func (c *retryableClient) AddWorkflowTask(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -94,7 +94,7 @@ func (c *retryableClient) PollActivityTaskQueue(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -110,7 +110,7 @@ func (c *retryableClient) PollWorkflowTaskQueue(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -126,7 +126,7 @@ func (c *retryableClient) QueryWorkflow(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -142,7 +142,7 @@ func (c *retryableClient) RespondQueryTaskCompleted(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -158,7 +158,7 @@ func (c *retryableClient) CancelOutstandingPoll(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -174,7 +174,7 @@ func (c *retryableClient) DescribeTaskQueue(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
 
@@ -190,6 +190,6 @@ func (c *retryableClient) ListTaskQueuePartitions(
 		return err
 	}
 
-	err := backoff.Retry(op, c.policy, c.isRetryable)
+	err := backoff.RetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
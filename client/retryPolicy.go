@@ -0,0 +1,135 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/grpc/codes"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/dynamicconfig"
+)
+
+const (
+	historyClientRetryMaximumAttempts  = 10
+	matchingClientRetryMaximumAttempts = 6
+	frontendClientRetryMaximumAttempts = 7
+
+	defaultClientRetryableCodes = "UNAVAILABLE,RESOURCE_EXHAUSTED"
+)
+
+var _ backoff.RetryPolicy = (*dynamicRetryPolicy)(nil)
+
+type dynamicRetryPolicy struct {
+	policy          backoff.RetryPolicy
+	maximumAttempts dynamicconfig.IntPropertyFn
+}
+
+// NewServiceRetryPolicy creates the retry policy for calls to the given service, i.e. the default retry policy
+// of the service capped by the maximum attempts read from dynamic config on every retry.
+func NewServiceRetryPolicy(serviceName string, dc *dynamicconfig.Collection) backoff.RetryPolicy {
+	switch serviceName {
+	case common.HistoryServiceName:
+		return &dynamicRetryPolicy{
+			policy:          common.CreateHistoryServiceRetryPolicy(),
+			maximumAttempts: dc.GetIntProperty(dynamicconfig.HistoryClientRetryMaximumAttempts, historyClientRetryMaximumAttempts),
+		}
+	case common.MatchingServiceName:
+		return &dynamicRetryPolicy{
+			policy:          common.CreateMatchingServiceRetryPolicy(),
+			maximumAttempts: dc.GetIntProperty(dynamicconfig.MatchingClientRetryMaximumAttempts, matchingClientRetryMaximumAttempts),
+		}
+	default:
+		return &dynamicRetryPolicy{
+			policy:          common.CreateFrontendServiceRetryPolicy(),
+			maximumAttempts: dc.GetIntProperty(dynamicconfig.FrontendClientRetryMaximumAttempts, frontendClientRetryMaximumAttempts),
+		}
+	}
+}
+
+// ComputeNextDelay returns the next delay interval, or backoff.Done once the maximum attempts are exhausted
+func (p *dynamicRetryPolicy) ComputeNextDelay(elapsedTime time.Duration, numAttempts int) time.Duration {
+	if maximumAttempts := p.maximumAttempts(); maximumAttempts > 0 && numAttempts > maximumAttempts {
+		return backoff.Done
+	}
+	return p.policy.ComputeNextDelay(elapsedTime, numAttempts)
+}
+
+// IsRetryableErrorCode returns an IsRetryable handler which retries the errors retried by
+// common.IsWhitelistServiceTransientError and the errors whose gRPC code is one of retryableCodes
+func IsRetryableErrorCode(retryableCodes ...codes.Code) backoff.IsRetryable {
+	return func(err error) bool {
+		if common.IsWhitelistServiceTransientError(err) {
+			return true
+		}
+		code := serviceerror.ToStatus(err).Code()
+		for _, retryableCode := range retryableCodes {
+			if code == retryableCode {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// NewServiceIsRetryable creates the IsRetryable handler for calls to the given service, see IsRetryableErrorCode.
+// The retryable gRPC codes are read from dynamic config on every failed call.
+func NewServiceIsRetryable(serviceName string, dc *dynamicconfig.Collection) backoff.IsRetryable {
+	var retryableCodes dynamicconfig.StringPropertyFn
+	switch serviceName {
+	case common.HistoryServiceName:
+		retryableCodes = dc.GetStringProperty(dynamicconfig.HistoryClientRetryableCodes, defaultClientRetryableCodes)
+	case common.MatchingServiceName:
+		retryableCodes = dc.GetStringProperty(dynamicconfig.MatchingClientRetryableCodes, defaultClientRetryableCodes)
+	default:
+		retryableCodes = dc.GetStringProperty(dynamicconfig.FrontendClientRetryableCodes, defaultClientRetryableCodes)
+	}
+	return func(err error) bool {
+		return IsRetryableErrorCode(parseCodes(retryableCodes())...)(err)
+	}
+}
+
+// parseCodes parses a comma separated list of gRPC code names, e.g. "UNAVAILABLE,RESOURCE_EXHAUSTED",
+// skipping the names which are not valid codes
+func parseCodes(value string) []codes.Code {
+	var result []codes.Code
+	for _, name := range strings.Split(value, ",") {
+		name = strings.ToUpper(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		var code codes.Code
+		if err := code.UnmarshalJSON([]byte(strconv.Quote(name))); err != nil {
+			continue
+		}
+		result = append(result, code)
+	}
+	return result
+}
@@ -0,0 +1,156 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/grpc/codes"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	"go.temporal.io/server/client/history"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	serviceerrors "go.temporal.io/server/common/serviceerror"
+)
+
+func TestRetryableClient_RetriesTransientErrorCodes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rawClient := historyservicemock.NewMockHistoryServiceClient(ctrl)
+	gomock.InOrder(
+		rawClient.EXPECT().DescribeHistoryHost(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewUnavailable("unavailable")),
+		rawClient.EXPECT().DescribeHistoryHost(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewResourceExhausted("busy")),
+		rawClient.EXPECT().DescribeHistoryHost(gomock.Any(), gomock.Any()).Return(&historyservice.DescribeHistoryHostResponse{}, nil),
+	)
+
+	client := history.NewRetryableClient(
+		rawClient,
+		NewServiceRetryPolicy(common.HistoryServiceName, dynamicconfig.NewNoopCollection()),
+		IsRetryableErrorCode(codes.Unavailable, codes.ResourceExhausted),
+	)
+	resp, err := client.DescribeHistoryHost(context.Background(), &historyservice.DescribeHistoryHostRequest{})
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+}
+
+func TestRetryableClient_DoesNotRetryOtherErrorCodes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rawClient := historyservicemock.NewMockHistoryServiceClient(ctrl)
+	rawClient.EXPECT().DescribeHistoryHost(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewInvalidArgument("invalid")).Times(1)
+
+	client := history.NewRetryableClient(
+		rawClient,
+		NewServiceRetryPolicy(common.HistoryServiceName, dynamicconfig.NewNoopCollection()),
+		IsRetryableErrorCode(codes.Unavailable, codes.ResourceExhausted),
+	)
+	_, err := client.DescribeHistoryHost(context.Background(), &historyservice.DescribeHistoryHostRequest{})
+	require.IsType(t, &serviceerror.InvalidArgument{}, err)
+}
+
+func TestRetryableClient_StopsOnContextCancellation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	rawClient := historyservicemock.NewMockHistoryServiceClient(ctrl)
+	rawClient.EXPECT().DescribeHistoryHost(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(context.Context, *historyservice.DescribeHistoryHostRequest, ...interface{}) (*historyservice.DescribeHistoryHostResponse, error) {
+			cancel()
+			return nil, serviceerror.NewUnavailable("unavailable")
+		},
+	).Times(1)
+
+	client := history.NewRetryableClient(
+		rawClient,
+		NewServiceRetryPolicy(common.MatchingServiceName, dynamicconfig.NewNoopCollection()),
+		IsRetryableErrorCode(codes.Unavailable),
+	)
+	start := time.Now()
+	_, err := client.DescribeHistoryHost(ctx, &historyservice.DescribeHistoryHostRequest{})
+	require.IsType(t, &serviceerror.Unavailable{}, err)
+	require.Less(t, int64(time.Since(start)), int64(time.Second))
+}
+
+func TestIsRetryableErrorCode_RetriesServiceTransientErrors(t *testing.T) {
+	isRetryable := IsRetryableErrorCode(codes.Unavailable, codes.ResourceExhausted)
+	require.True(t, isRetryable(serviceerror.NewUnavailable("unavailable")))
+	require.True(t, isRetryable(serviceerror.NewResourceExhausted("busy")))
+	require.True(t, isRetryable(serviceerror.NewInternal("internal")))
+	require.True(t, isRetryable(serviceerrors.NewShardOwnershipLost("owner", "current")))
+	require.False(t, isRetryable(serviceerror.NewInvalidArgument("invalid")))
+	require.False(t, isRetryable(serviceerror.NewNotFound("not found")))
+}
+
+func TestServiceRetryPolicy_MaximumAttempts(t *testing.T) {
+	policy := &dynamicRetryPolicy{
+		policy:          common.CreateHistoryServiceRetryPolicy(),
+		maximumAttempts: dynamicconfig.GetIntPropertyFn(2),
+	}
+	require.True(t, policy.ComputeNextDelay(0, 1) > 0)
+	require.True(t, policy.ComputeNextDelay(0, 2) > 0)
+	require.Equal(t, backoff.Done, policy.ComputeNextDelay(0, 3))
+
+	policy.maximumAttempts = dynamicconfig.GetIntPropertyFn(0)
+	require.True(t, policy.ComputeNextDelay(0, 3) > 0)
+}
+
+func TestServiceRetryPolicy_DefaultMaximumAttempts(t *testing.T) {
+	policy := NewServiceRetryPolicy(common.HistoryServiceName, dynamicconfig.NewNoopCollection())
+	require.True(t, policy.ComputeNextDelay(0, historyClientRetryMaximumAttempts) > 0)
+	require.Equal(t, backoff.Done, policy.ComputeNextDelay(0, historyClientRetryMaximumAttempts+1))
+}
+
+func TestServiceIsRetryable_DefaultCodes(t *testing.T) {
+	isRetryable := NewServiceIsRetryable(common.MatchingServiceName, dynamicconfig.NewNoopCollection())
+	require.True(t, isRetryable(serviceerror.NewUnavailable("unavailable")))
+	require.True(t, isRetryable(serviceerror.NewResourceExhausted("busy")))
+	require.False(t, isRetryable(serviceerror.NewDeadlineExceeded("timeout")))
+}
+
+func TestServiceIsRetryable_DynamicCodes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dcClient := dynamicconfig.NewMockClient(ctrl)
+	dcClient.EXPECT().GetStringValue(dynamicconfig.HistoryClientRetryableCodes, gomock.Any(), defaultClientRetryableCodes).
+		Return(" deadline_exceeded, NOT_A_CODE,", nil).AnyTimes()
+
+	isRetryable := NewServiceIsRetryable(common.HistoryServiceName, dynamicconfig.NewCollection(dcClient, log.NewNoopLogger()))
+	require.True(t, isRetryable(serviceerror.NewDeadlineExceeded("timeout")))
+	require.False(t, isRetryable(serviceerror.NewNotFound("not found")))
+	require.True(t, isRetryable(serviceerrors.NewShardOwnershipLost("owner", "current")))
+}
@@ -27,9 +27,11 @@ package main
 import (
 	"fmt"
 	"log"
+	"math/rand"
 	"os"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/urfave/cli/v2"
 
@@ -46,6 +48,8 @@ import (
 
 // main entry point for the temporal server
 func main() {
+	// seed the global random generator once for the whole process
+	rand.Seed(time.Now().UnixNano())
 	app := buildCLI()
 	_ = app.Run(os.Args)
 }
@@ -148,6 +152,8 @@ func buildCLI() *cli.App {
 				s := temporal.NewServer(
 					temporal.ForServices(services),
 					temporal.WithConfig(cfg),
+					temporal.WithConfigLoader(configDir, env, zone),
+					temporal.ReloadConfigOn(temporal.ConfigReloadCh()),
 					temporal.WithDynamicConfigClient(dynamicConfigClient),
 					temporal.WithLogger(logger),
 					temporal.InterruptOn(temporal.InterruptCh()),
@@ -164,6 +170,30 @@ func buildCLI() *cli.App {
 				return cli.Exit("All services are stopped.", 0)
 			},
 		},
+		{
+			Name:      "validate-config",
+			Usage:     "Validate Temporal server config without starting any service",
+			ArgsUsage: " ",
+			Action: func(c *cli.Context) error {
+				env := c.String("env")
+				zone := c.String("zone")
+				configDir := path.Join(c.String("root"), c.String("config"))
+
+				cfg, err := config.LoadConfig(env, configDir, zone)
+				if err != nil {
+					return cli.Exit(fmt.Sprintf("Unable to load configuration: %v.", err), 1)
+				}
+
+				errs := config.Validate(cfg)
+				for _, err := range errs {
+					log.Println(err)
+				}
+				if len(errs) > 0 {
+					return cli.Exit(fmt.Sprintf("Configuration has %v problem(s).", len(errs)), 1)
+				}
+				return cli.Exit("Configuration is valid.", 0)
+			},
+		},
 	}
 	return app
 }
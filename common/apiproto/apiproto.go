@@ -0,0 +1,99 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package apiproto resolves temporal.server.api.* proto messages by their fully-qualified names.
+package apiproto
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	_ "unsafe" // for go:linkname
+
+	"github.com/gogo/protobuf/proto"
+
+	// register all server api types
+	_ "go.temporal.io/server/api/adminservice/v1"
+	_ "go.temporal.io/server/api/archiver/v1"
+	_ "go.temporal.io/server/api/checksum/v1"
+	_ "go.temporal.io/server/api/cli/v1"
+	_ "go.temporal.io/server/api/cluster/v1"
+	_ "go.temporal.io/server/api/enums/v1"
+	_ "go.temporal.io/server/api/errordetails/v1"
+	_ "go.temporal.io/server/api/history/v1"
+	_ "go.temporal.io/server/api/historyservice/v1"
+	_ "go.temporal.io/server/api/matchingservice/v1"
+	_ "go.temporal.io/server/api/metrics/v1"
+	_ "go.temporal.io/server/api/namespace/v1"
+	_ "go.temporal.io/server/api/persistence/v1"
+	_ "go.temporal.io/server/api/replication/v1"
+	_ "go.temporal.io/server/api/token/v1"
+	_ "go.temporal.io/server/api/workflow/v1"
+)
+
+const (
+	serverAPIPrefix = "temporal.server.api."
+)
+
+var (
+	fullNamesOnce sync.Once
+	fullNames     []string
+)
+
+// registeredTypes is the gogo registry populated by proto.RegisterType from the generated code.
+// gogo does not expose a way to iterate over it, so it is linked directly.
+//
+//go:linkname registeredTypes github.com/gogo/protobuf/proto.protoTypedNils
+var registeredTypes map[string]proto.Message
+
+// NewMessage returns a new empty instance of the message registered with fullName.
+func NewMessage(fullName string) (proto.Message, error) {
+	typ := proto.MessageType(fullName)
+	if typ == nil || typ.Kind() != reflect.Ptr {
+		return nil, fmt.Errorf("unknown message type: %v", fullName)
+	}
+	message, ok := reflect.New(typ.Elem()).Interface().(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("type %v is not a proto message", fullName)
+	}
+	return message, nil
+}
+
+// FullNames returns sorted fully-qualified names of all messages known to NewMessage.
+func FullNames() []string {
+	fullNamesOnce.Do(func() {
+		for name := range registeredTypes {
+			if strings.HasPrefix(name, serverAPIPrefix) {
+				fullNames = append(fullNames, name)
+			}
+		}
+		sort.Strings(fullNames)
+	})
+
+	result := make([]string, len(fullNames))
+	copy(result, fullNames)
+	return result
+}
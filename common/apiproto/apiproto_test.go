@@ -0,0 +1,76 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package apiproto
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	historyspb "go.temporal.io/server/api/history/v1"
+)
+
+type (
+	apiProtoSuite struct {
+		suite.Suite
+	}
+)
+
+func TestAPIProtoSuite(t *testing.T) {
+	s := new(apiProtoSuite)
+	suite.Run(t, s)
+}
+
+func (s *apiProtoSuite) TestNewMessage() {
+	message, err := NewMessage("temporal.server.api.history.v1.VersionHistories")
+	s.NoError(err)
+	s.IsType(&historyspb.VersionHistories{}, message)
+
+	another, err := NewMessage("temporal.server.api.history.v1.VersionHistories")
+	s.NoError(err)
+	s.NotSame(message, another)
+}
+
+func (s *apiProtoSuite) TestNewMessage_Unknown() {
+	_, err := NewMessage("temporal.server.api.history.v1.Unknown")
+	s.Error(err)
+}
+
+func (s *apiProtoSuite) TestFullNames() {
+	names := FullNames()
+	s.Contains(names, "temporal.server.api.history.v1.TransientWorkflowTaskInfo")
+	s.Contains(names, "temporal.server.api.historyservice.v1.StartWorkflowExecutionRequest")
+	s.Contains(names, "temporal.server.api.persistence.v1.WorkflowMutableState")
+	s.NotContains(names, "temporal.api.common.v1.Payload")
+	s.True(sort.StringsAreSorted(names))
+
+	for _, name := range names {
+		s.True(strings.HasPrefix(name, "temporal.server.api."), name)
+		_, err := NewMessage(name)
+		s.NoError(err, name)
+	}
+}
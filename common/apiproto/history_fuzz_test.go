@@ -0,0 +1,53 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:build go1.18
+// +build go1.18
+
+package apiproto
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// FuzzHistoryMessages round trips randomly generated api/history messages through
+// Marshal and Unmarshal, then feeds corrupted copies of the encoded bytes back into
+// Unmarshal to make sure malformed input only ever results in an error.
+// Run with: go test -fuzz=FuzzHistoryMessages ./common/apiproto
+func FuzzHistoryMessages(f *testing.F) {
+	for seed := int64(0); seed < 16; seed++ {
+		f.Add(seed, []byte{})
+		f.Add(seed, []byte{0xff, 0x00, 0x7f, 0x80})
+	}
+
+	f.Fuzz(func(t *testing.T, seed int64, corruption []byte) {
+		r := rand.New(rand.NewSource(seed))
+		for _, message := range randomHistoryMessages(r) {
+			data := assertRoundTrip(t, message)
+			assertUnmarshalNoPanic(t, message, corruptBytes(data, corruption))
+			assertUnmarshalNoPanic(t, message, corruption)
+		}
+	})
+}
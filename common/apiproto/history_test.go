@@ -0,0 +1,216 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package apiproto
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+
+	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+type (
+	historyMessage interface {
+		proto.Message
+		Marshal() ([]byte, error)
+		Unmarshal([]byte) error
+		Equal(interface{}) bool
+	}
+)
+
+// TestHistoryMessagesRoundTrip round trips a few randomly generated api/history messages through
+// Marshal and Unmarshal, and checks that corrupted copies of the encoded bytes never make Unmarshal panic.
+// FuzzHistoryMessages explores the same properties with go 1.18 fuzzing.
+func TestHistoryMessagesRoundTrip(t *testing.T) {
+	testCases := []struct {
+		name       string
+		seed       int64
+		corruption []byte
+	}{
+		{name: "no corruption", seed: 0, corruption: []byte{}},
+		{name: "truncated", seed: 1, corruption: []byte{0x03}},
+		{name: "flipped bits", seed: 2, corruption: []byte{0x00, 0xff, 0x7f, 0x80}},
+		{name: "truncated and flipped bits", seed: 3, corruption: []byte{0x05, 0x01, 0x10}},
+		{name: "garbage", seed: 4, corruption: []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := rand.New(rand.NewSource(tc.seed))
+			for _, message := range randomHistoryMessages(r) {
+				data := assertRoundTrip(t, message)
+				assertUnmarshalNoPanic(t, message, corruptBytes(data, tc.corruption))
+				assertUnmarshalNoPanic(t, message, tc.corruption)
+			}
+		})
+	}
+}
+
+func randomHistoryMessages(r *rand.Rand) []historyMessage {
+	return []historyMessage{
+		randomTransientWorkflowTaskInfo(r),
+		randomVersionHistoryItem(r),
+		randomVersionHistory(r),
+		randomVersionHistories(r),
+	}
+}
+
+func assertRoundTrip(t *testing.T, message historyMessage) []byte {
+	data, err := message.Marshal()
+	if err != nil {
+		t.Fatalf("failed to marshal %T: %v", message, err)
+	}
+	decoded := newEmpty(message)
+	if err := decoded.Unmarshal(data); err != nil {
+		t.Fatalf("failed to unmarshal %T: %v", message, err)
+	}
+	if !message.Equal(decoded) {
+		t.Fatalf("%T changed after round trip: %v != %v", message, message, decoded)
+	}
+	return data
+}
+
+func assertUnmarshalNoPanic(t *testing.T, message historyMessage, data []byte) {
+	defer func() {
+		if p := recover(); p != nil {
+			t.Fatalf("unmarshal of %T panicked on input %x: %v", message, data, p)
+		}
+	}()
+	_ = newEmpty(message).Unmarshal(data)
+}
+
+func newEmpty(message historyMessage) historyMessage {
+	decoded := proto.Clone(message).(historyMessage)
+	decoded.Reset()
+	return decoded
+}
+
+// corruptBytes truncates data and flips bits in it according to corruption.
+func corruptBytes(data []byte, corruption []byte) []byte {
+	corrupted := append([]byte(nil), data...)
+	if len(corrupted) == 0 || len(corruption) == 0 {
+		return corrupted
+	}
+	corrupted = corrupted[:len(corrupted)-int(corruption[0])%len(corrupted)]
+	for i, b := range corruption[1:] {
+		if len(corrupted) == 0 {
+			break
+		}
+		corrupted[(i*31+int(b))%len(corrupted)] ^= b
+	}
+	return corrupted
+}
+
+func randomTransientWorkflowTaskInfo(r *rand.Rand) *historyspb.TransientWorkflowTaskInfo {
+	info := &historyspb.TransientWorkflowTaskInfo{}
+	if r.Intn(4) != 0 {
+		info.ScheduledEvent = randomWorkflowTaskEvent(r, enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED)
+	}
+	if r.Intn(4) != 0 {
+		info.StartedEvent = randomWorkflowTaskEvent(r, enumspb.EVENT_TYPE_WORKFLOW_TASK_STARTED)
+	}
+	return info
+}
+
+func randomWorkflowTaskEvent(r *rand.Rand, eventType enumspb.EventType) *historypb.HistoryEvent {
+	event := &historypb.HistoryEvent{
+		EventId:   r.Int63(),
+		EventTime: timestamp.TimePtr(time.Unix(0, r.Int63()).UTC()),
+		EventType: eventType,
+		Version:   r.Int63(),
+		TaskId:    r.Int63(),
+	}
+	switch eventType {
+	case enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED:
+		event.Attributes = &historypb.HistoryEvent_WorkflowTaskScheduledEventAttributes{
+			WorkflowTaskScheduledEventAttributes: &historypb.WorkflowTaskScheduledEventAttributes{
+				TaskQueue: &taskqueuepb.TaskQueue{
+					Name: randomString(r),
+					Kind: enumspb.TASK_QUEUE_KIND_NORMAL,
+				},
+				StartToCloseTimeout: timestamp.DurationPtr(time.Duration(r.Int63())),
+				Attempt:             r.Int31(),
+			},
+		}
+	case enumspb.EVENT_TYPE_WORKFLOW_TASK_STARTED:
+		event.Attributes = &historypb.HistoryEvent_WorkflowTaskStartedEventAttributes{
+			WorkflowTaskStartedEventAttributes: &historypb.WorkflowTaskStartedEventAttributes{
+				ScheduledEventId: r.Int63(),
+				Identity:         randomString(r),
+				RequestId:        randomString(r),
+			},
+		}
+	}
+	return event
+}
+
+func randomVersionHistoryItem(r *rand.Rand) *historyspb.VersionHistoryItem {
+	return &historyspb.VersionHistoryItem{
+		EventId: r.Int63(),
+		Version: r.Int63(),
+	}
+}
+
+func randomVersionHistory(r *rand.Rand) *historyspb.VersionHistory {
+	history := &historyspb.VersionHistory{
+		BranchToken: randomBytes(r),
+	}
+	for i := r.Intn(8); i > 0; i-- {
+		history.Items = append(history.Items, randomVersionHistoryItem(r))
+	}
+	return history
+}
+
+func randomVersionHistories(r *rand.Rand) *historyspb.VersionHistories {
+	histories := &historyspb.VersionHistories{
+		CurrentVersionHistoryIndex: r.Int31(),
+	}
+	for i := r.Intn(4); i > 0; i-- {
+		histories.Histories = append(histories.Histories, randomVersionHistory(r))
+	}
+	return histories
+}
+
+func randomBytes(r *rand.Rand) []byte {
+	data := make([]byte, r.Intn(32))
+	_, _ = r.Read(data)
+	return data
+}
+
+func randomString(r *rand.Rand) string {
+	const letters = "abcdefghijklmnopqrstuvwxyz0123456789"
+	s := make([]byte, r.Intn(16))
+	for i := range s {
+		s[i] = letters[r.Intn(len(letters))]
+	}
+	return string(s)
+}
@@ -0,0 +1,105 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"context"
+
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/metrics"
+)
+
+type (
+	cachingHistoryArchiver struct {
+		HistoryArchiver
+
+		cache         cache.Cache
+		metricsClient metrics.Client
+	}
+
+	historyCacheKey struct {
+		uri                  string
+		namespaceID          string
+		workflowID           string
+		runID                string
+		closeFailoverVersion int64
+		nextPageToken        string
+		pageSize             int
+	}
+)
+
+// NewCachingHistoryArchiver returns a HistoryArchiver which serves repeated Get calls from cache.
+// Only requests which pin CloseFailoverVersion are cached: without it the newest archived history
+// of the run is returned, which changes if the run is archived again after a failover.
+// The history archived for a given version is immutable, so cached responses are never invalidated
+// and only leave the cache when evicted by it, use a size bounded cache such as cache.NewLRU.
+func NewCachingHistoryArchiver(
+	inner HistoryArchiver,
+	cache cache.Cache,
+	metricsClient metrics.Client,
+) HistoryArchiver {
+	return &cachingHistoryArchiver{
+		HistoryArchiver: inner,
+		cache:           cache,
+		metricsClient:   metricsClient,
+	}
+}
+
+func (a *cachingHistoryArchiver) Get(
+	ctx context.Context,
+	uri URI,
+	request *GetHistoryRequest,
+) (*GetHistoryResponse, error) {
+	if request.CloseFailoverVersion == nil {
+		return a.HistoryArchiver.Get(ctx, uri, request)
+	}
+
+	scope := a.metricsClient.Scope(metrics.HistoryArchiverScope)
+	key := newHistoryCacheKey(uri, request)
+	if response, ok := a.cache.Get(key).(*GetHistoryResponse); ok {
+		scope.IncCounter(metrics.HistoryArchiverCacheHitCount)
+		return response, nil
+	}
+	scope.IncCounter(metrics.HistoryArchiverCacheMissCount)
+
+	response, err := a.HistoryArchiver.Get(ctx, uri, request)
+	if err != nil {
+		return nil, err
+	}
+	a.cache.Put(key, response)
+	return response, nil
+}
+
+func newHistoryCacheKey(uri URI, request *GetHistoryRequest) historyCacheKey {
+	return historyCacheKey{
+		uri:                  uri.String(),
+		namespaceID:          request.NamespaceID,
+		workflowID:           request.WorkflowID,
+		runID:                request.RunID,
+		closeFailoverVersion: *request.CloseFailoverVersion,
+		nextPageToken:        string(request.NextPageToken),
+		pageSize:             request.PageSize,
+	}
+}
@@ -0,0 +1,167 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	historypb "go.temporal.io/api/history/v1"
+
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/metrics"
+)
+
+type (
+	cachingHistoryArchiverSuite struct {
+		*require.Assertions
+		suite.Suite
+
+		controller    *gomock.Controller
+		innerArchiver *MockHistoryArchiver
+		metricsScope  tally.TestScope
+		archiver      HistoryArchiver
+		uri           URI
+	}
+)
+
+func TestCachingHistoryArchiverSuite(t *testing.T) {
+	suite.Run(t, new(cachingHistoryArchiverSuite))
+}
+
+func (s *cachingHistoryArchiverSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.innerArchiver = NewMockHistoryArchiver(s.controller)
+	s.metricsScope = tally.NewTestScope("", nil)
+	s.archiver = NewCachingHistoryArchiver(
+		s.innerArchiver,
+		cache.NewLRU(10),
+		metrics.NewClient(s.metricsScope, metrics.History),
+	)
+
+	var err error
+	s.uri, err = NewURI("file:///tmp/archival")
+	s.NoError(err)
+}
+
+func (s *cachingHistoryArchiverSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *cachingHistoryArchiverSuite) TestGet_SecondGetServedFromCache() {
+	request := s.newGetHistoryRequest()
+	response := &GetHistoryResponse{
+		HistoryBatches: []*historypb.History{{Events: []*historypb.HistoryEvent{{EventId: 1}}}},
+	}
+	s.innerArchiver.EXPECT().Get(gomock.Any(), s.uri, request).Return(response, nil).Times(1)
+
+	firstResponse, err := s.archiver.Get(context.Background(), s.uri, request)
+	s.NoError(err)
+	s.Equal(response, firstResponse)
+
+	secondResponse, err := s.archiver.Get(context.Background(), s.uri, s.newGetHistoryRequest())
+	s.NoError(err)
+	s.Equal(response, secondResponse)
+
+	s.Equal(int64(1), s.counterValue("history_archiver_cache_hit"))
+	s.Equal(int64(1), s.counterValue("history_archiver_cache_miss"))
+}
+
+func (s *cachingHistoryArchiverSuite) TestGet_DifferentPageNotServedFromCache() {
+	request := s.newGetHistoryRequest()
+	nextPageRequest := s.newGetHistoryRequest()
+	nextPageRequest.NextPageToken = []byte{1}
+	s.innerArchiver.EXPECT().Get(gomock.Any(), s.uri, request).Return(&GetHistoryResponse{}, nil).Times(1)
+	s.innerArchiver.EXPECT().Get(gomock.Any(), s.uri, nextPageRequest).Return(&GetHistoryResponse{}, nil).Times(1)
+
+	_, err := s.archiver.Get(context.Background(), s.uri, request)
+	s.NoError(err)
+	_, err = s.archiver.Get(context.Background(), s.uri, nextPageRequest)
+	s.NoError(err)
+}
+
+func (s *cachingHistoryArchiverSuite) TestGet_DifferentVersionNotServedFromCache() {
+	request := s.newGetHistoryRequest()
+	otherVersionRequest := s.newGetHistoryRequest()
+	otherVersionRequest.CloseFailoverVersion = convert.Int64Ptr(2)
+	s.innerArchiver.EXPECT().Get(gomock.Any(), s.uri, request).Return(&GetHistoryResponse{}, nil).Times(1)
+	s.innerArchiver.EXPECT().Get(gomock.Any(), s.uri, otherVersionRequest).Return(&GetHistoryResponse{}, nil).Times(1)
+
+	_, err := s.archiver.Get(context.Background(), s.uri, request)
+	s.NoError(err)
+	_, err = s.archiver.Get(context.Background(), s.uri, otherVersionRequest)
+	s.NoError(err)
+}
+
+func (s *cachingHistoryArchiverSuite) TestGet_UnpinnedVersionNotCached() {
+	request := s.newGetHistoryRequest()
+	request.CloseFailoverVersion = nil
+	s.innerArchiver.EXPECT().Get(gomock.Any(), s.uri, request).Return(&GetHistoryResponse{}, nil).Times(2)
+
+	_, err := s.archiver.Get(context.Background(), s.uri, request)
+	s.NoError(err)
+	_, err = s.archiver.Get(context.Background(), s.uri, request)
+	s.NoError(err)
+
+	s.Equal(int64(0), s.counterValue("history_archiver_cache_hit"))
+	s.Equal(int64(0), s.counterValue("history_archiver_cache_miss"))
+}
+
+func (s *cachingHistoryArchiverSuite) TestGet_ErrorNotCached() {
+	request := s.newGetHistoryRequest()
+	getErr := errors.New("some random error")
+	s.innerArchiver.EXPECT().Get(gomock.Any(), s.uri, request).Return(nil, getErr).Times(2)
+
+	_, err := s.archiver.Get(context.Background(), s.uri, request)
+	s.Equal(getErr, err)
+	_, err = s.archiver.Get(context.Background(), s.uri, request)
+	s.Equal(getErr, err)
+}
+
+func (s *cachingHistoryArchiverSuite) newGetHistoryRequest() *GetHistoryRequest {
+	return &GetHistoryRequest{
+		NamespaceID:          "test-namespace-id",
+		WorkflowID:           "test-workflow-id",
+		RunID:                "test-run-id",
+		CloseFailoverVersion: convert.Int64Ptr(1),
+		PageSize:             100,
+	}
+}
+
+func (s *cachingHistoryArchiverSuite) counterValue(name string) int64 {
+	for _, counter := range s.metricsScope.Snapshot().Counters() {
+		if counter.Name() == name {
+			return counter.Value()
+		}
+	}
+	return 0
+}
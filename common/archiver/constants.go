@@ -26,6 +26,8 @@ package archiver
 
 import (
 	"errors"
+
+	"go.temporal.io/api/serviceerror"
 )
 
 const (
@@ -63,4 +65,23 @@ var (
 	ErrNextPageTokenCorrupted = errors.New("next page token is corrupted")
 	// ErrHistoryNotExist is the error for non-exist history
 	ErrHistoryNotExist = errors.New("requested workflow history does not exist")
+	// ErrChecksumMismatch is the error for archived history which does not match its checksum
+	ErrChecksumMismatch = errors.New("archived history does not match its checksum")
 )
+
+type checksumMismatchError struct {
+	*serviceerror.DataLoss
+}
+
+// NewChecksumMismatchError returns a DataLoss error which matches ErrChecksumMismatch with errors.Is
+func NewChecksumMismatchError() error {
+	return &checksumMismatchError{DataLoss: &serviceerror.DataLoss{Message: ErrChecksumMismatch.Error()}}
+}
+
+func (e *checksumMismatchError) Is(target error) bool {
+	return target == ErrChecksumMismatch
+}
+
+func (e *checksumMismatchError) Unwrap() error {
+	return e.DataLoss
+}
@@ -0,0 +1,83 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package filestore
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"strings"
+)
+
+const (
+	// ChecksumAlgorithmCRC32C is the CRC32 checksum with the Castagnoli polynomial, used by default
+	ChecksumAlgorithmCRC32C = "crc32c"
+	// ChecksumAlgorithmSHA256 is the SHA-256 checksum
+	ChecksumAlgorithmSHA256 = "sha256"
+
+	// checksumFileSuffix is appended to the history filename to name the file holding its checksum
+	checksumFileSuffix = ".checksum"
+)
+
+var (
+	errInvalidChecksumAlgorithm = errors.New("invalid checksum algorithm")
+	errInvalidChecksumFormat    = errors.New("invalid checksum format")
+
+	crc32cTable = crc32.MakeTable(crc32.Castagnoli)
+)
+
+// computeChecksum returns the checksum of data in the format <algorithm>:<hex encoded digest>
+func computeChecksum(algorithm string, data []byte) (string, error) {
+	var digest []byte
+	switch algorithm {
+	case ChecksumAlgorithmCRC32C:
+		sum := crc32.Checksum(data, crc32cTable)
+		digest = []byte{byte(sum >> 24), byte(sum >> 16), byte(sum >> 8), byte(sum)}
+	case ChecksumAlgorithmSHA256:
+		sum := sha256.Sum256(data)
+		digest = sum[:]
+	default:
+		return "", errInvalidChecksumAlgorithm
+	}
+	return fmt.Sprintf("%s:%s", algorithm, hex.EncodeToString(digest)), nil
+}
+
+// verifyChecksum recomputes the checksum of data with the algorithm recorded in checksum,
+// so blobs stay readable after the configured algorithm is changed
+func verifyChecksum(checksum string, data []byte) (bool, error) {
+	checksum = strings.TrimSpace(checksum)
+	algorithm := strings.SplitN(checksum, ":", 2)[0]
+	expected, err := computeChecksum(algorithm, data)
+	if err != nil {
+		return false, errInvalidChecksumFormat
+	}
+	return expected == checksum, nil
+}
+
+func constructChecksumFilename(historyFilename string) string {
+	return historyFilename + checksumFileSuffix
+}
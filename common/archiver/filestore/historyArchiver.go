@@ -55,6 +55,7 @@ import (
 	"go.temporal.io/server/common/codec"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
 )
 
 const (
@@ -64,6 +65,7 @@ const (
 	errEncodeHistory = "failed to encode history batches"
 	errMakeDirectory = "failed to make directory"
 	errWriteFile     = "failed to write history to file"
+	errChecksum      = "failed to checksum history"
 
 	targetHistoryBlobSize = 2 * 1024 * 1024 // 2MB
 )
@@ -75,9 +77,10 @@ var (
 
 type (
 	historyArchiver struct {
-		container *archiver.HistoryBootstrapContainer
-		fileMode  os.FileMode
-		dirMode   os.FileMode
+		container         *archiver.HistoryBootstrapContainer
+		fileMode          os.FileMode
+		dirMode           os.FileMode
+		checksumAlgorithm string
 
 		// only set in test code
 		historyIterator archiver.HistoryIterator
@@ -110,11 +113,19 @@ func newHistoryArchiver(
 	if err != nil {
 		return nil, errInvalidDirMode
 	}
+	checksumAlgorithm := config.ChecksumAlgorithm
+	if checksumAlgorithm == "" {
+		checksumAlgorithm = ChecksumAlgorithmCRC32C
+	}
+	if _, err := computeChecksum(checksumAlgorithm, nil); err != nil {
+		return nil, err
+	}
 	return &historyArchiver{
-		container:       container,
-		fileMode:        os.FileMode(fileMode),
-		dirMode:         os.FileMode(dirMode),
-		historyIterator: historyIterator,
+		container:         container,
+		fileMode:          os.FileMode(fileMode),
+		dirMode:           os.FileMode(dirMode),
+		checksumAlgorithm: checksumAlgorithm,
+		historyIterator:   historyIterator,
 	}, nil
 }
 
@@ -176,12 +187,31 @@ func (h *historyArchiver) Archive(
 	}
 
 	dirPath := URI.Path()
+	filename := constructHistoryFilename(request.NamespaceID, request.WorkflowID, request.RunID, request.CloseFailoverVersion)
+	if featureCatalog.DryRun {
+		scope := h.container.MetricsClient.Scope(metrics.HistoryArchiverScope, metrics.NamespaceTag(request.Namespace))
+		archiver.RecordDryRunWrite(scope, logger, path.Join(dirPath, filename), len(encodedHistoryBatches))
+		return nil
+	}
+
 	if err = mkdirAll(dirPath, h.dirMode); err != nil {
 		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errMakeDirectory), tag.Error(err))
 		return err
 	}
 
-	filename := constructHistoryFilename(request.NamespaceID, request.WorkflowID, request.RunID, request.CloseFailoverVersion)
+	checksum, err := computeChecksum(h.checksumAlgorithm, encodedHistoryBatches)
+	if err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errChecksum), tag.Error(err))
+		return err
+	}
+
+	// write the checksum before the history: a history without a checksum is read back unverified,
+	// while a checksum without a matching history is reported as data loss until the archive is retried
+	if err := writeFile(path.Join(dirPath, constructChecksumFilename(filename)), []byte(checksum), h.fileMode); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errWriteFile), tag.Error(err))
+		return err
+	}
+
 	if err := writeFile(path.Join(dirPath, filename), encodedHistoryBatches, h.fileMode); err != nil {
 		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errWriteFile), tag.Error(err))
 		return err
@@ -249,6 +279,10 @@ func (h *historyArchiver) Get(
 		return nil, serviceerror.NewInternal(err.Error())
 	}
 
+	if err := h.verifyHistoryChecksum(filepath, encodedHistoryBatches); err != nil {
+		return nil, err
+	}
+
 	encoder := codec.NewJSONPBEncoder()
 	historyBatches, err := encoder.DecodeHistories(encodedHistoryBatches)
 	if err != nil {
@@ -280,6 +314,37 @@ func (h *historyArchiver) Get(
 	return response, nil
 }
 
+// verifyHistoryChecksum verifies encodedHistoryBatches against the checksum stored next to the
+// history file. Histories archived before checksums were introduced are not verified.
+func (h *historyArchiver) verifyHistoryChecksum(filepath string, encodedHistoryBatches []byte) error {
+	scope := h.container.MetricsClient.Scope(metrics.HistoryArchiverScope)
+	checksumFilepath := constructChecksumFilename(filepath)
+	exists, err := fileExists(checksumFilepath)
+	if err != nil {
+		return serviceerror.NewInternal(err.Error())
+	}
+	if !exists {
+		scope.IncCounter(metrics.HistoryArchiverChecksumMissingCount)
+		return nil
+	}
+
+	checksum, err := readFile(checksumFilepath)
+	if err != nil {
+		return serviceerror.NewInternal(err.Error())
+	}
+
+	scope.IncCounter(metrics.HistoryArchiverRunningBlobIntegrityCheckCount)
+	match, err := verifyChecksum(string(checksum), encodedHistoryBatches)
+	if err != nil {
+		return serviceerror.NewInternal(err.Error())
+	}
+	if !match {
+		scope.IncCounter(metrics.HistoryArchiverBlobIntegrityCheckFailedCount)
+		return archiver.NewChecksumMismatchError()
+	}
+	return nil
+}
+
 func (h *historyArchiver) ValidateURI(URI archiver.URI) error {
 	if URI.Scheme() != URIScheme {
 		return archiver.ErrURISchemeMismatch
@@ -42,8 +42,10 @@ import (
 	archiverspb "go.temporal.io/server/api/archiver/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/codec"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/primitives/timestamp"
 )
 
@@ -95,7 +97,8 @@ func (s *historyArchiverSuite) TearDownSuite() {
 func (s *historyArchiverSuite) SetupTest() {
 	s.Assertions = require.New(s.T())
 	s.container = &archiver.HistoryBootstrapContainer{
-		Logger: log.NewNoopLogger(),
+		Logger:        log.NewNoopLogger(),
+		MetricsClient: metrics.NewNoopMetricsClient(),
 	}
 }
 
@@ -332,6 +335,65 @@ func (s *historyArchiverSuite) TestArchive_Success() {
 	s.assertFileExists(path.Join(dir, expectedFilename))
 }
 
+func (s *historyArchiverSuite) TestArchive_DryRun() {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	historyBatches := []*historypb.History{
+		{
+			Events: []*historypb.HistoryEvent{
+				{
+					EventId:   testNextEventID - 1,
+					EventTime: timestamp.TimePtr(time.Now().UTC()),
+					Version:   testCloseFailoverVersion,
+				},
+			},
+		},
+	}
+	historyBlob := &archiverspb.HistoryBlob{
+		Header: &archiverspb.HistoryBlobHeader{
+			IsLast: true,
+		},
+		Body: historyBatches,
+	}
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(historyBlob, nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+	encodedHistoryBatches, err := codec.NewJSONPBEncoder().EncodeHistories(historyBatches)
+	s.NoError(err)
+
+	metricsClient := metrics.NewMockClient(mockCtrl)
+	metricsScope := metrics.NewMockScope(mockCtrl)
+	metricsClient.EXPECT().Scope(metrics.HistoryArchiverScope, gomock.Any()).Return(metricsScope)
+	metricsScope.EXPECT().RecordDistribution(metrics.ArchiverDryRunWriteSize, len(encodedHistoryBatches))
+	s.container.MetricsClient = metricsClient
+
+	dir, err := ioutil.TempDir("", "TestArchiveDryRun")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	request := &archiver.ArchiveHistoryRequest{
+		NamespaceID:          testNamespaceID,
+		Namespace:            testNamespace,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+	}
+	URI, err := archiver.NewURI("file://" + path.Join(dir, "archive"))
+	s.NoError(err)
+	err = historyArchiver.Archive(context.Background(), URI, request, archiver.GetDryRunArchiveOption())
+	s.NoError(err)
+
+	exists, err := directoryExists(URI.Path())
+	s.NoError(err)
+	s.False(exists)
+}
+
 func (s *historyArchiverSuite) TestGet_Fail_InvalidURI() {
 	historyArchiver := s.newTestHistoryArchiver(nil)
 	request := &archiver.GetHistoryRequest{
@@ -529,6 +591,79 @@ func (s *historyArchiverSuite) TestArchiveAndGet() {
 	s.Equal(s.historyBatchesV100, response.HistoryBatches)
 }
 
+func (s *historyArchiverSuite) TestArchiveAndGet_ChecksumMismatch() {
+	for _, checksumAlgorithm := range []string{ChecksumAlgorithmCRC32C, ChecksumAlgorithmSHA256} {
+		dir, err := ioutil.TempDir("", "TestArchiveAndGetChecksum")
+		s.NoError(err)
+		defer os.RemoveAll(dir)
+		URI, err := archiver.NewURI("file://" + dir)
+		s.NoError(err)
+
+		mockCtrl := gomock.NewController(s.T())
+		historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+		gomock.InOrder(
+			historyIterator.EXPECT().HasNext().Return(true),
+			historyIterator.EXPECT().Next().Return(&archiverspb.HistoryBlob{
+				Header: &archiverspb.HistoryBlobHeader{IsLast: true},
+				Body:   s.historyBatchesV100,
+			}, nil),
+			historyIterator.EXPECT().HasNext().Return(false),
+		)
+		historyArchiver, err := newHistoryArchiver(s.container, &config.FilestoreArchiver{
+			FileMode:          testFileModeStr,
+			DirMode:           testDirModeStr,
+			ChecksumAlgorithm: checksumAlgorithm,
+		}, historyIterator)
+		s.NoError(err)
+		s.NoError(historyArchiver.Archive(context.Background(), URI, &archiver.ArchiveHistoryRequest{
+			NamespaceID:          testNamespaceID,
+			Namespace:            testNamespace,
+			WorkflowID:           testWorkflowID,
+			RunID:                testRunID,
+			BranchToken:          testBranchToken,
+			NextEventID:          testNextEventID,
+			CloseFailoverVersion: testCloseFailoverVersion,
+		}))
+		mockCtrl.Finish()
+
+		filename := constructHistoryFilename(testNamespaceID, testWorkflowID, testRunID, testCloseFailoverVersion)
+		s.assertFileExists(path.Join(dir, constructChecksumFilename(filename)))
+		getRequest := &archiver.GetHistoryRequest{
+			NamespaceID: testNamespaceID,
+			WorkflowID:  testWorkflowID,
+			RunID:       testRunID,
+			PageSize:    testPageSize,
+		}
+		response, err := historyArchiver.Get(context.Background(), URI, getRequest)
+		s.NoError(err)
+		s.Equal(s.historyBatchesV100, response.HistoryBatches)
+
+		// corrupt the archived history, keeping it decodable
+		data, err := readFile(path.Join(dir, filename))
+		s.NoError(err)
+		corrupted, err := encodeHistories(s.historyBatchesV1)
+		s.NoError(err)
+		s.NotEqual(data, corrupted)
+		s.NoError(writeFile(path.Join(dir, filename), corrupted, testFileMode))
+
+		response, err = historyArchiver.Get(context.Background(), URI, getRequest)
+		s.Nil(response)
+		s.True(errors.Is(err, archiver.ErrChecksumMismatch))
+		var dataLossErr *serviceerror.DataLoss
+		s.True(errors.As(err, &dataLossErr))
+		s.Equal(archiver.ErrChecksumMismatch.Error(), err.Error())
+	}
+}
+
+func (s *historyArchiverSuite) TestNewHistoryArchiver_InvalidChecksumAlgorithm() {
+	_, err := newHistoryArchiver(s.container, &config.FilestoreArchiver{
+		FileMode:          testFileModeStr,
+		DirMode:           testDirModeStr,
+		ChecksumAlgorithm: "md5",
+	}, nil)
+	s.Equal(errInvalidChecksumAlgorithm, err)
+}
+
 func (s *historyArchiverSuite) newTestHistoryArchiver(historyIterator archiver.HistoryIterator) *historyArchiver {
 	config := &config.FilestoreArchiver{
 		FileMode: testFileModeStr,
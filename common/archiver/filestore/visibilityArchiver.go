@@ -42,6 +42,7 @@ import (
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/searchattribute"
 )
@@ -117,21 +118,27 @@ func (v *visibilityArchiver) Archive(
 		return err
 	}
 
-	dirPath := path.Join(URI.Path(), request.GetNamespaceId())
-	if err = mkdirAll(dirPath, v.dirMode); err != nil {
-		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errMakeDirectory), tag.Error(err))
-		return err
-	}
-
 	encodedVisibilityRecord, err := encode(request)
 	if err != nil {
 		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeVisibilityRecord), tag.Error(err))
 		return err
 	}
 
+	dirPath := path.Join(URI.Path(), request.GetNamespaceId())
 	// The filename has the format: closeTimestamp_hash(runID).visibility
 	// This format allows the archiver to sort all records without reading the file contents
 	filename := constructVisibilityFilename(request.CloseTime, request.GetRunId())
+	if featureCatalog.DryRun {
+		scope := v.container.MetricsClient.Scope(metrics.VisibilityArchiverScope, metrics.NamespaceTag(request.Namespace))
+		archiver.RecordDryRunWrite(scope, logger, path.Join(dirPath, filename), len(encodedVisibilityRecord))
+		return nil
+	}
+
+	if err = mkdirAll(dirPath, v.dirMode); err != nil {
+		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errMakeDirectory), tag.Error(err))
+		return err
+	}
+
 	if err := writeFile(path.Join(dirPath, filename), encodedVisibilityRecord, v.fileMode); err != nil {
 		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errWriteFile), tag.Error(err))
 		return err
@@ -237,7 +244,7 @@ func (v *visibilityArchiver) query(
 
 			response.Executions = append(response.Executions, executionInfo)
 			if len(response.Executions) == request.pageSize {
-				if idx != len(files) {
+				if idx != len(files)-1 {
 					newToken := &queryVisibilityToken{
 						LastCloseTime: timestamp.TimeValue(record.CloseTime),
 						LastRunID:     record.GetRunId(),
@@ -27,6 +27,7 @@ package filestore
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path"
@@ -511,6 +512,68 @@ func (s *visibilityArchiverSuite) TestArchiveAndQuery() {
 	s.Equal(ei, executions[1])
 }
 
+func (s *visibilityArchiverSuite) TestArchiveAndQuery_Pagination() {
+	dir, err := ioutil.TempDir("", "TestArchiveAndQueryPagination")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	mockParser := NewMockQueryParser(s.controller)
+	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+		earliestCloseTime: time.Unix(0, 0),
+		latestCloseTime:   time.Unix(0, 10001),
+	}, nil).AnyTimes()
+	visibilityArchiver.queryParser = mockParser
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+
+	numRecords := 50
+	for i := 0; i < numRecords; i++ {
+		// every other record shares a close time so ties are broken by run ID
+		record := &archiverspb.VisibilityRecord{
+			NamespaceId:      testNamespaceID,
+			Namespace:        testNamespace,
+			WorkflowId:       testWorkflowID,
+			RunId:            fmt.Sprintf("%v-%v", testRunID, i),
+			WorkflowTypeName: testWorkflowTypeName,
+			StartTime:        timestamp.UnixOrZeroTimePtr(1),
+			CloseTime:        timestamp.UnixOrZeroTimePtr(int64(100 + i/2)),
+			Status:           enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+			HistoryLength:    int64(10),
+		}
+		s.NoError(visibilityArchiver.Archive(context.Background(), URI, record))
+	}
+
+	request := &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    10,
+		Query:       "parsed by mockParser",
+	}
+	seen := make(map[string]struct{})
+	var executions []*workflowpb.WorkflowExecutionInfo
+	for page := 0; ; page++ {
+		response, err := visibilityArchiver.Query(context.Background(), URI, request, searchattribute.TestNameTypeMap)
+		s.NoError(err)
+		s.Len(response.Executions, 10)
+		for _, execution := range response.Executions {
+			runID := execution.GetExecution().GetRunId()
+			_, ok := seen[runID]
+			s.False(ok, "run %v returned more than once", runID)
+			seen[runID] = struct{}{}
+		}
+		executions = append(executions, response.Executions...)
+		if response.NextPageToken == nil {
+			s.Equal(numRecords/10-1, page)
+			break
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+	s.Len(seen, numRecords)
+	for i := 1; i < len(executions); i++ {
+		s.False(executions[i].GetCloseTime().After(timestamp.TimeValue(executions[i-1].GetCloseTime())))
+	}
+}
+
 func (s *visibilityArchiverSuite) newTestVisibilityArchiver() *visibilityArchiver {
 	config := &config.FilestoreArchiver{
 		FileMode: testFileModeStr,
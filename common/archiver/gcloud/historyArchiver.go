@@ -29,6 +29,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"path/filepath"
+	"sync"
 
 	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
@@ -53,15 +54,17 @@ const (
 	// URIScheme is the scheme for the gcloud storage implementation
 	URIScheme = "gs"
 
-	targetHistoryBlobSize = 2 * 1024 * 1024 // 2MB
-	errEncodeHistory      = "failed to encode history batches"
-	errBucketHistory      = "failed to get google storage bucket handle"
-	errWriteFile          = "failed to write history to google storage"
+	targetHistoryBlobSize    = 2 * 1024 * 1024 // 2MB
+	defaultUploadConcurrency = 1
+	errEncodeHistory         = "failed to encode history batches"
+	errBucketHistory         = "failed to get google storage bucket handle"
+	errWriteFile             = "failed to write history to google storage"
 )
 
 type historyArchiver struct {
-	container     *archiver.HistoryBootstrapContainer
-	gcloudStorage connector.Client
+	container         *archiver.HistoryBootstrapContainer
+	gcloudStorage     connector.Client
+	uploadConcurrency int
 
 	// only set in test code
 	historyIterator archiver.HistoryIterator
@@ -72,6 +75,11 @@ type progress struct {
 	IteratorState     []byte
 }
 
+type historyPart struct {
+	filename string
+	data     []byte
+}
+
 type getHistoryToken struct {
 	CloseFailoverVersion int64
 	HighestPart          int
@@ -85,17 +93,22 @@ func NewHistoryArchiver(
 	config *config.GstorageArchiver,
 ) (archiver.HistoryArchiver, error) {
 	storage, err := connector.NewClient(context.Background(), config)
-	if err == nil {
-		return newHistoryArchiver(container, nil, storage), nil
+	if err != nil {
+		return nil, err
 	}
-	return nil, err
+	historyArchiver := newHistoryArchiver(container, nil, storage)
+	if config.UploadConcurrency > 0 {
+		historyArchiver.uploadConcurrency = config.UploadConcurrency
+	}
+	return historyArchiver, nil
 }
 
-func newHistoryArchiver(container *archiver.HistoryBootstrapContainer, historyIterator archiver.HistoryIterator, storage connector.Client) archiver.HistoryArchiver {
+func newHistoryArchiver(container *archiver.HistoryBootstrapContainer, historyIterator archiver.HistoryIterator, storage connector.Client) *historyArchiver {
 	return &historyArchiver{
-		container:       container,
-		gcloudStorage:   storage,
-		historyIterator: historyIterator,
+		container:         container,
+		gcloudStorage:     storage,
+		uploadConcurrency: defaultUploadConcurrency,
+		historyIterator:   historyIterator,
 	}
 }
 
@@ -147,9 +160,10 @@ func (h *historyArchiver) Archive(ctx context.Context, URI archiver.URI, request
 	}
 
 	encoder := codec.NewJSONPBEncoder()
+	part := progress.CurrentPageNumber
+	var pendingParts []*historyPart
 
 	for historyIterator.HasNext() {
-		part := progress.CurrentPageNumber
 		historyBlob, err := getNextHistoryBlob(ctx, historyIterator)
 
 		if err != nil {
@@ -174,16 +188,32 @@ func (h *historyArchiver) Archive(ctx context.Context, URI archiver.URI, request
 
 		filename := constructHistoryFilenameMultipart(request.NamespaceID, request.WorkflowID, request.RunID, request.CloseFailoverVersion, part)
 		if exist, _ := h.gcloudStorage.Exist(ctx, URI, filename); !exist {
-			if err := h.gcloudStorage.Upload(ctx, URI, filename, encodedHistoryPart); err != nil {
-				logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(errWriteFile), tag.Error(err))
-				scope.IncCounter(metrics.HistoryArchiverArchiveTransientErrorCount)
-				return err
+			if featureCatalog.DryRun {
+				archiver.RecordDryRunWrite(scope, logger, filename, len(encodedHistoryPart))
+			} else {
+				pendingParts = append(pendingParts, &historyPart{filename: filename, data: encodedHistoryPart})
 			}
+		}
+		part++
 
-			totalUploadSize = totalUploadSize + int64(binary.Size(encodedHistoryPart))
+		// keep reading until the batch is full so its parts can be uploaded concurrently
+		if len(pendingParts) > 0 && len(pendingParts) < h.uploadConcurrency && historyIterator.HasNext() {
+			continue
+		}
+
+		if err := uploadHistoryParts(ctx, h.gcloudStorage, URI, pendingParts, h.uploadConcurrency); err != nil {
+			logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(errWriteFile), tag.Error(err))
+			scope.IncCounter(metrics.HistoryArchiverArchiveTransientErrorCount)
+			return err
+		}
+		for _, pendingPart := range pendingParts {
+			totalUploadSize = totalUploadSize + int64(binary.Size(pendingPart.data))
 		}
+		pendingParts = nil
 
-		saveHistoryIteratorState(ctx, featureCatalog, historyIterator, part, &progress)
+		// progress is only recorded once every part of the batch is uploaded, parts are named after
+		// their position in the history so the stored order does not depend on upload order
+		saveHistoryIteratorState(ctx, featureCatalog, historyIterator, part-1, &progress)
 	}
 
 	scope.AddCounter(metrics.HistoryArchiverTotalUploadSize, totalUploadSize)
@@ -397,6 +427,58 @@ func loadHistoryIterator(ctx context.Context, request *archiver.ArchiveHistoryRe
 	return
 }
 
+// uploadHistoryParts uploads parts with at most concurrency uploads in flight. The first failed upload
+// cancels the remaining ones and its error is returned.
+func uploadHistoryParts(ctx context.Context, storage connector.Client, URI archiver.URI, parts []*historyPart, concurrency int) error {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	uploadCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+		started  int
+	)
+	semaphore := make(chan struct{}, concurrency)
+	for _, part := range parts {
+		select {
+		case semaphore <- struct{}{}:
+		case <-uploadCtx.Done():
+		}
+		if uploadCtx.Err() != nil {
+			break
+		}
+
+		started++
+		wg.Add(1)
+		go func(part *historyPart) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+			if err := storage.Upload(uploadCtx, URI, part.filename, part.data); err != nil {
+				errOnce.Do(func() {
+					firstErr = err
+					cancel()
+				})
+			}
+		}(part)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	if started < len(parts) {
+		return ctx.Err()
+	}
+	return nil
+}
+
 func saveHistoryIteratorState(ctx context.Context, featureCatalog *archiver.ArchiveFeatureCatalog, historyIterator archiver.HistoryIterator, currentPartNum int, progress *progress) (err error) {
 	var state []byte
 	if featureCatalog.ProgressManager != nil {
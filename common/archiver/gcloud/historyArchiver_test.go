@@ -27,6 +27,8 @@ package gcloud
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -91,6 +93,56 @@ type historyArchiverSuite struct {
 	testArchivalURI archiver.URI
 }
 
+type fakeUploadStorage struct {
+	connector.Client
+
+	failFilename string
+	uploadErr    error
+
+	sync.Mutex
+	inFlight    int
+	maxInFlight int
+	started     []string
+	canceled    int
+}
+
+func (s *fakeUploadStorage) Upload(ctx context.Context, _ archiver.URI, filename string, _ []byte) error {
+	s.Lock()
+	s.inFlight++
+	if s.inFlight > s.maxInFlight {
+		s.maxInFlight = s.inFlight
+	}
+	s.started = append(s.started, filename)
+	s.Unlock()
+
+	defer func() {
+		s.Lock()
+		s.inFlight--
+		s.Unlock()
+	}()
+
+	if filename == s.failFilename {
+		return s.uploadErr
+	}
+	select {
+	case <-ctx.Done():
+		s.Lock()
+		s.canceled++
+		s.Unlock()
+		return ctx.Err()
+	case <-time.After(10 * time.Millisecond):
+		return nil
+	}
+}
+
+func getHistoryParts(count int) []*historyPart {
+	parts := make([]*historyPart, count)
+	for i := range parts {
+		parts[i] = &historyPart{filename: fmt.Sprintf("part_%v.history", i), data: []byte{byte(i)}}
+	}
+	return parts
+}
+
 func getCanceledContext() context.Context {
 	ctx, cancel := context.WithCancel(context.Background())
 	cancel()
@@ -319,7 +371,7 @@ func (h *historyArchiverSuite) TestArchive_Success() {
 
 	storageWrapper := connector.NewMockClient(h.controller)
 	storageWrapper.EXPECT().Exist(ctx, URI, gomock.Any()).Return(false, nil).Times(2)
-	storageWrapper.EXPECT().Upload(ctx, URI, gomock.Any(), gomock.Any()).Return(nil)
+	storageWrapper.EXPECT().Upload(gomock.Any(), URI, gomock.Any(), gomock.Any()).Return(nil)
 
 	historyIterator := archiver.NewMockHistoryIterator(h.controller)
 	historyBatches := []*historypb.History{
@@ -537,3 +589,29 @@ func (h *historyArchiverSuite) TestGet_Success_FromToken() {
 
 	h.EqualValues(4, numOfEvents)
 }
+
+func (h *historyArchiverSuite) TestUploadHistoryParts_ConcurrencyBounded() {
+	storage := &fakeUploadStorage{}
+	parts := getHistoryParts(10)
+
+	err := uploadHistoryParts(context.Background(), storage, h.testArchivalURI, parts, 3)
+	h.NoError(err)
+	h.Len(storage.started, len(parts))
+	h.Equal(3, storage.maxInFlight)
+}
+
+func (h *historyArchiverSuite) TestUploadHistoryParts_ErrorCancelsRemaining() {
+	uploadErr := errors.New("some upload error")
+	storage := &fakeUploadStorage{
+		failFilename: "part_3.history",
+		uploadErr:    uploadErr,
+	}
+	parts := getHistoryParts(10)
+
+	err := uploadHistoryParts(context.Background(), storage, h.testArchivalURI, parts, 2)
+	h.Equal(uploadErr, err)
+	h.LessOrEqual(storage.maxInFlight, 2)
+	h.Less(len(storage.started), len(parts))
+	h.NotContains(storage.started, "part_9.history")
+	h.Equal(1, storage.canceled)
+}
@@ -134,6 +134,8 @@ type (
 		// The request includes a string field called query, which describes what kind of visibility records should be returned.
 		// For example, it can be  some SQL-like syntax query string.
 		// Your implementation is responsible for parsing and validating the query, and also returning all visibility records that match the query.
+		// Results are returned at most PageSize at a time. The NextPageToken in the response is opaque to the caller and should be passed
+		// back unchanged to continue the query. Records must be returned in a stable order so that consecutive pages neither overlap nor skip records.
 		// Currently the maximum context timeout passed into the method is 3 minutes, so it's acceptable if this method takes some time to run.
 		Query(ctx context.Context, uri URI, request *QueryVisibilityRequest, saTypeMap searchattribute.NameTypeMap) (*QueryVisibilityResponse, error)
 		// ValidateURI is used to define what a valid URI for an implementation is.
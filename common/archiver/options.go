@@ -41,6 +41,7 @@ type (
 	ArchiveFeatureCatalog struct {
 		ProgressManager   ProgressManager
 		NonRetryableError NonRetryableError
+		DryRun            bool
 	}
 
 	// NonRetryableError returns an error indicating archiver has encountered an non-retryable error
@@ -99,3 +100,12 @@ func GetNonRetryableErrorOption(nonRetryableErr error) ArchiveOption {
 		}
 	}
 }
+
+// GetDryRunArchiveOption returns an ArchiveOption for enabling dry-run mode.
+// In dry-run mode the archiver performs every step except the final write,
+// and reports the target and size of the data it would have written instead.
+func GetDryRunArchiveOption() ArchiveOption {
+	return func(catalog *ArchiveFeatureCatalog) {
+		catalog.DryRun = true
+	}
+}
@@ -198,6 +198,8 @@ func (h *historyArchiver) Archive(
 		blobSize := int64(binary.Size(encodedHistoryBlob))
 		if exists {
 			scope.IncCounter(metrics.HistoryArchiverBlobExistsCount)
+		} else if featureCatalog.DryRun {
+			archiver.RecordDryRunWrite(scope, logger, key, int(blobSize))
 		} else {
 			if err := upload(ctx, h.s3cli, URI, key, encodedHistoryBlob); err != nil {
 				if isRetryableError(err) {
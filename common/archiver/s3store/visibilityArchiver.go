@@ -146,6 +146,10 @@ func (v *visibilityArchiver) Archive(
 	// Upload archive to all indexes
 	for _, element := range indexes {
 		key := constructTimestampIndex(URI.Path(), request.GetNamespaceId(), element.primaryIndex, element.primaryIndexValue, element.secondaryIndex, element.secondaryIndexTimestamp, request.GetRunId())
+		if featureCatalog.DryRun {
+			archiver.RecordDryRunWrite(scope, logger, key, len(encodedVisibilityRecord))
+			continue
+		}
 		if err := upload(ctx, v.s3cli, URI, key, encodedVisibilityRecord); err != nil {
 			archiveFailReason = errWriteKey
 			return err
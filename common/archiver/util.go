@@ -30,6 +30,7 @@ import (
 	archiverspb "go.temporal.io/server/api/archiver/v1"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
 )
 
 var (
@@ -44,6 +45,12 @@ var (
 	errEmptyQuery            = errors.New("Query string is empty")
 )
 
+// RecordDryRunWrite reports a write skipped because the archiver is running in dry-run mode
+func RecordDryRunWrite(scope metrics.Scope, logger log.Logger, target string, size int) {
+	scope.RecordDistribution(metrics.ArchiverDryRunWriteSize, size)
+	logger.Info("Archival dry-run skipped write.", tag.ArchivalDryRunTarget(target), tag.ArchivalDryRunSize(size))
+}
+
 // TagLoggerWithArchiveHistoryRequestAndURI tags logger with fields in the archive history request and the URI
 func TagLoggerWithArchiveHistoryRequestAndURI(logger log.Logger, request *ArchiveHistoryRequest, URI string) log.Logger {
 	return log.With(
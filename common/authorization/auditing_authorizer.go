@@ -0,0 +1,110 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"math/rand"
+
+	"google.golang.org/grpc/metadata"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+const (
+	// RequestIDHeaderName is the gRPC metadata key callers may use to correlate audit entries with their requests
+	RequestIDHeaderName = "x-request-id"
+)
+
+type (
+	auditingAuthorizer struct {
+		inner      Authorizer
+		logger     log.Logger
+		sampleRate float64
+	}
+
+	hasRequestID interface {
+		GetRequestId() string
+	}
+)
+
+// NewAuditingAuthorizer creates an authorizer which logs the decisions of the inner authorizer.
+// Denies and failures are always logged, allows only at the given sample rate in [0, 1].
+func NewAuditingAuthorizer(inner Authorizer, logger log.Logger, sampleRate float64) Authorizer {
+	return &auditingAuthorizer{
+		inner:      inner,
+		logger:     logger,
+		sampleRate: sampleRate,
+	}
+}
+
+func (a *auditingAuthorizer) Authorize(ctx context.Context, caller *Claims, target *CallTarget) (Result, error) {
+	result, err := a.inner.Authorize(ctx, caller, target)
+
+	if err == nil && result.Decision == DecisionAllow && rand.Float64() >= a.sampleRate {
+		return result, err
+	}
+
+	tags := []tag.Tag{
+		tag.WorkflowNamespace(target.Namespace),
+		tag.AuthorizationAPIName(target.APIName),
+		tag.AuthorizationDecision(decisionName(result.Decision)),
+		tag.RequestID(requestID(ctx, target)),
+	}
+	if result.Reason != "" {
+		tags = append(tags, tag.AuthorizationReason(result.Reason))
+	}
+	if err != nil {
+		a.logger.Warn("Authorization audit: authorizer failed", append(tags, tag.Error(err))...)
+	} else {
+		a.logger.Info("Authorization audit", tags...)
+	}
+	return result, err
+}
+
+func decisionName(decision Decision) string {
+	switch decision {
+	case DecisionAllow:
+		return "allow"
+	case DecisionDeny:
+		return "deny"
+	default:
+		return "unknown"
+	}
+}
+
+// requestID prefers the ID supplied by the caller in metadata, then the ID carried by the request itself
+func requestID(ctx context.Context, target *CallTarget) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get(RequestIDHeaderName); len(ids) > 0 {
+			return ids[0]
+		}
+	}
+	if request, ok := target.Request.(hasRequestID); ok {
+		return request.GetRequestId()
+	}
+	return ""
+}
@@ -0,0 +1,143 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/metadata"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+type (
+	auditingAuthorizerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller     *gomock.Controller
+		mockAuthorizer *MockAuthorizer
+		mockLogger     *log.MockLogger
+	}
+)
+
+func TestAuditingAuthorizerSuite(t *testing.T) {
+	s := new(auditingAuthorizerSuite)
+	suite.Run(t, s)
+}
+
+func (s *auditingAuthorizerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+
+	s.mockAuthorizer = NewMockAuthorizer(s.controller)
+	s.mockLogger = log.NewMockLogger(s.controller)
+}
+
+func (s *auditingAuthorizerSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *auditingAuthorizerSuite) TestDeniesAlwaysLogged() {
+	s.mockAuthorizer.EXPECT().Authorize(gomock.Any(), nil, describeNamespaceTarget).
+		Return(Result{Decision: DecisionDeny, Reason: "no role"}, nil).Times(100)
+	var lines []map[string]interface{}
+	s.mockLogger.EXPECT().Info("Authorization audit", gomock.Any()).Do(func(_ string, tags ...tag.Tag) {
+		lines = append(lines, tagValues(tags))
+	}).Times(100)
+
+	authorizer := NewAuditingAuthorizer(s.mockAuthorizer, s.mockLogger, 0)
+	requestCtx := metadata.NewIncomingContext(ctx, metadata.Pairs(RequestIDHeaderName, "request-1"))
+	for i := 0; i < 100; i++ {
+		result, err := authorizer.Authorize(requestCtx, nil, describeNamespaceTarget)
+		s.NoError(err)
+		s.Equal(DecisionDeny, result.Decision)
+	}
+
+	s.Equal(map[string]interface{}{
+		"wf-namespace":   testNamespace,
+		"authz-api-name": describeNamespaceTarget.APIName,
+		"authz-decision": "deny",
+		"authz-reason":   "no role",
+		"request-id":     "request-1",
+	}, lines[0])
+}
+
+func (s *auditingAuthorizerSuite) TestAllowsSampled() {
+	const calls = 10000
+	const sampleRate = 0.1
+	s.mockAuthorizer.EXPECT().Authorize(gomock.Any(), nil, describeNamespaceTarget).
+		Return(Result{Decision: DecisionAllow}, nil).Times(calls)
+	logged := 0
+	s.mockLogger.EXPECT().Info("Authorization audit", gomock.Any()).Do(func(_ string, tags ...tag.Tag) {
+		s.Equal("allow", tagValues(tags)["authz-decision"])
+		logged++
+	}).AnyTimes()
+
+	authorizer := NewAuditingAuthorizer(s.mockAuthorizer, s.mockLogger, sampleRate)
+	for i := 0; i < calls; i++ {
+		result, err := authorizer.Authorize(ctx, nil, describeNamespaceTarget)
+		s.NoError(err)
+		s.Equal(DecisionAllow, result.Decision)
+	}
+
+	s.InDelta(calls*sampleRate, logged, calls*sampleRate*0.2)
+}
+
+func (s *auditingAuthorizerSuite) TestFailuresAlwaysLogged() {
+	s.mockAuthorizer.EXPECT().Authorize(gomock.Any(), nil, startWorkflowExecutionTarget).
+		Return(Result{Decision: DecisionDeny}, errUnauthorized)
+	s.mockLogger.EXPECT().Warn("Authorization audit: authorizer failed", gomock.Any())
+
+	authorizer := NewAuditingAuthorizer(s.mockAuthorizer, s.mockLogger, 0)
+	_, err := authorizer.Authorize(context.Background(), nil, startWorkflowExecutionTarget)
+	s.Equal(errUnauthorized, err)
+}
+
+func (s *auditingAuthorizerSuite) TestRequestIDFromRequest() {
+	request := *startWorkflowExecutionRequest
+	request.RequestId = "request-2"
+	target := &CallTarget{Namespace: testNamespace, Request: &request, APIName: startWorkflowExecutionTarget.APIName}
+	s.mockAuthorizer.EXPECT().Authorize(gomock.Any(), nil, target).Return(Result{Decision: DecisionDeny}, nil)
+	s.mockLogger.EXPECT().Info("Authorization audit", gomock.Any()).Do(func(_ string, tags ...tag.Tag) {
+		s.Equal("request-2", tagValues(tags)["request-id"])
+	})
+
+	_, err := NewAuditingAuthorizer(s.mockAuthorizer, s.mockLogger, 0).Authorize(ctx, nil, target)
+	s.NoError(err)
+}
+
+func tagValues(tags []tag.Tag) map[string]interface{} {
+	values := make(map[string]interface{}, len(tags))
+	for _, t := range tags {
+		values[t.Key()] = t.Value()
+	}
+	return values
+}
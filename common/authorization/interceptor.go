@@ -136,6 +136,11 @@ func (a *interceptor) Interceptor(
 			Request:   req,
 		}, scope)
 		if err != nil {
+			if _, ok := err.(*serviceerror.ResourceExhausted); ok {
+				// callers are expected to back off and retry, so the error is returned as is
+				scope.IncCounter(metrics.ServiceErrResourceExhaustedCounter)
+				return nil, err
+			}
 			scope.IncCounter(metrics.ServiceErrAuthorizeFailedCounter)
 			a.logAuthError(err)
 			return nil, errUnauthorized // return a generic error to the caller without disclosing details
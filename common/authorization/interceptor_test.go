@@ -130,6 +130,16 @@ func (s *authorizerInterceptorSuite) TestIsUnauthorized() {
 	s.Error(err)
 }
 
+func (s *authorizerInterceptorSuite) TestRateLimited() {
+	s.mockAuthorizer.EXPECT().Authorize(ctx, nil, describeNamespaceTarget).
+		Return(Result{Decision: DecisionDeny}, ErrCallerRateLimitExceeded)
+	s.mockMetricsScope.EXPECT().IncCounter(metrics.ServiceErrResourceExhaustedCounter)
+
+	res, err := s.interceptor(ctx, describeNamespaceRequest, describeNamespaceInfo, s.handler)
+	s.Nil(res)
+	s.Equal(ErrCallerRateLimitExceeded, err)
+}
+
 func (s *authorizerInterceptorSuite) TestAuthorizationFailed() {
 	s.mockAuthorizer.EXPECT().Authorize(ctx, nil, describeNamespaceTarget).
 		Return(Result{Decision: DecisionDeny}, errUnauthorized)
@@ -0,0 +1,109 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"crypto/subtle"
+
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"go.temporal.io/server/common/config"
+)
+
+const (
+	// InternodeTokenHeaderName is the gRPC metadata key carrying the internode token
+	InternodeTokenHeaderName = "temporal-internode-token"
+)
+
+var (
+	errInvalidInternodeToken = serviceerror.NewPermissionDenied("Request unauthorized: missing or invalid internode token.", "")
+
+	// internodeTokenExemptMethods are called by load balancers and probes which don't have the internode token
+	internodeTokenExemptMethods = map[string]struct{}{
+		"/grpc.health.v1.Health/Check": {},
+		"/grpc.health.v1.Health/Watch": {},
+	}
+)
+
+// NewInternodeTokenClientInterceptor returns a client interceptor attaching the internode token to every call
+func NewInternodeTokenClientInterceptor(token string) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		ctx = metadata.AppendToOutgoingContext(ctx, InternodeTokenHeaderName, token)
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// NewInternodeTokenServerInterceptor returns a server interceptor rejecting calls which do not carry
+// the current or one of the previous internode tokens, health checks are exempt. It must run before any
+// user authorization.
+func NewInternodeTokenServerInterceptor(cfg config.InternodeAuth) grpc.UnaryServerInterceptor {
+	validTokens := make([][]byte, 0, len(cfg.PreviousTokens)+1)
+	for _, token := range append([]string{cfg.Token}, cfg.PreviousTokens...) {
+		if token != "" {
+			validTokens = append(validTokens, []byte(token))
+		}
+	}
+
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if _, ok := internodeTokenExemptMethods[info.FullMethod]; ok {
+			return handler(ctx, req)
+		}
+		if !hasValidInternodeToken(ctx, validTokens) {
+			// this interceptor runs ahead of the service error interceptor, so convert here
+			return nil, serviceerror.ToStatus(errInvalidInternodeToken).Err()
+		}
+		return handler(ctx, req)
+	}
+}
+
+func hasValidInternodeToken(ctx context.Context, validTokens [][]byte) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+	for _, token := range md.Get(InternodeTokenHeaderName) {
+		for _, validToken := range validTokens {
+			if subtle.ConstantTimeCompare([]byte(token), validToken) == 1 {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,137 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+)
+
+type (
+	internodeTokenSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller           *gomock.Controller
+		mockAuthorizer       *MockAuthorizer
+		internodeInterceptor grpc.UnaryServerInterceptor
+		interceptor          grpc.UnaryServerInterceptor
+	}
+)
+
+func TestInternodeTokenSuite(t *testing.T) {
+	s := new(internodeTokenSuite)
+	suite.Run(t, s)
+}
+
+func (s *internodeTokenSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+
+	s.mockAuthorizer = NewMockAuthorizer(s.controller)
+	s.internodeInterceptor = NewInternodeTokenServerInterceptor(config.InternodeAuth{
+		Token:          "current-token",
+		PreviousTokens: []string{"previous-token"},
+	})
+	authInterceptor := NewAuthorizationInterceptor(
+		NewMockClaimMapper(s.controller),
+		s.mockAuthorizer,
+		metrics.NewNoopMetricsClient(),
+		log.NewNoopLogger(),
+		nil)
+	s.interceptor = func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return s.internodeInterceptor(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return authInterceptor(ctx, req, info, handler)
+		})
+	}
+}
+
+func (s *internodeTokenSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *internodeTokenSuite) TestMissingToken() {
+	_, err := s.interceptor(ctx, describeNamespaceRequest, describeNamespaceInfo, s.handler)
+	s.Equal(codes.PermissionDenied, status.Code(err))
+}
+
+func (s *internodeTokenSuite) TestInvalidToken() {
+	tokenCtx := metadata.NewIncomingContext(ctx, metadata.Pairs(InternodeTokenHeaderName, "forged-token"))
+
+	_, err := s.interceptor(tokenCtx, describeNamespaceRequest, describeNamespaceInfo, s.handler)
+	s.Equal(codes.PermissionDenied, status.Code(err))
+}
+
+func (s *internodeTokenSuite) TestValidToken() {
+	for _, token := range []string{"current-token", "previous-token"} {
+		s.mockAuthorizer.EXPECT().Authorize(gomock.Any(), nil, describeNamespaceTarget).
+			Return(Result{Decision: DecisionAllow}, nil)
+		tokenCtx := metadata.NewIncomingContext(ctx, metadata.Pairs(InternodeTokenHeaderName, token))
+
+		res, err := s.interceptor(tokenCtx, describeNamespaceRequest, describeNamespaceInfo, s.handler)
+		s.NoError(err)
+		s.True(res.(bool))
+	}
+}
+
+func (s *internodeTokenSuite) TestHealthCheckExempt() {
+	for _, method := range []string{"/grpc.health.v1.Health/Check", "/grpc.health.v1.Health/Watch"} {
+		res, err := s.internodeInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, s.handler)
+		s.NoError(err)
+		s.True(res.(bool))
+	}
+
+	_, err := s.internodeInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/grpc.health.v1.Health/Other"}, s.handler)
+	s.Equal(codes.PermissionDenied, status.Code(err))
+}
+
+func (s *internodeTokenSuite) TestClientAttachesToken() {
+	clientInterceptor := NewInternodeTokenClientInterceptor("current-token")
+
+	err := clientInterceptor(ctx, "method", nil, nil, nil,
+		func(ctx context.Context, _ string, _, _ interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+			md, ok := metadata.FromOutgoingContext(ctx)
+			s.True(ok)
+			s.Equal([]string{"current-token"}, md.Get(InternodeTokenHeaderName))
+			return nil
+		})
+	s.NoError(err)
+}
+
+func (s *internodeTokenSuite) handler(ctx context.Context, req interface{}) (interface{}, error) {
+	return true, nil
+}
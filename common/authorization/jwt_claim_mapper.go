@@ -0,0 +1,189 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/dgrijalva/jwt-go/v4"
+)
+
+const (
+	defaultRolesClaimName      = "roles"
+	defaultNamespacesClaimName = "namespaces"
+)
+
+type (
+	// JWTClaimMapperOption configures the claim mapper created by NewJWTClaimMapper
+	JWTClaimMapperOption func(*jwtClaimMapper)
+
+	// ExpiredTokenError is returned when the token is past its expiry time
+	ExpiredTokenError struct {
+		Msg string
+	}
+
+	// MalformedTokenError is returned when the token or its claims cannot be parsed
+	MalformedTokenError struct {
+		Msg string
+	}
+
+	// InvalidTokenSignatureError is returned when the token signature does not verify, e.g. it was tampered with
+	InvalidTokenSignatureError struct {
+		Msg string
+	}
+
+	// jwtClaimMapper maps a bearer token to system roles, listed in the roles claim,
+	// and namespace roles, listed per namespace in the namespaces claim:
+	//  {"sub": "user", "roles": ["admin"], "namespaces": {"orders": ["read", "write"]}}
+	jwtClaimMapper struct {
+		keyProvider         TokenKeyProvider
+		rolesClaimName      string
+		namespacesClaimName string
+	}
+)
+
+var _ ClaimMapper = (*jwtClaimMapper)(nil)
+
+// NewJWTClaimMapper creates a claim mapper which validates the signature of bearer tokens with keys
+// of the given provider and maps their claims to roles
+func NewJWTClaimMapper(keyProvider TokenKeyProvider, options ...JWTClaimMapperOption) ClaimMapper {
+	mapper := &jwtClaimMapper{
+		keyProvider:         keyProvider,
+		rolesClaimName:      defaultRolesClaimName,
+		namespacesClaimName: defaultNamespacesClaimName,
+	}
+	for _, option := range options {
+		option(mapper)
+	}
+	return mapper
+}
+
+// WithRolesClaimName sets the name of the claim listing system roles, "roles" by default
+func WithRolesClaimName(name string) JWTClaimMapperOption {
+	return func(mapper *jwtClaimMapper) {
+		mapper.rolesClaimName = name
+	}
+}
+
+// WithNamespacesClaimName sets the name of the claim mapping namespaces to roles, "namespaces" by default
+func WithNamespacesClaimName(name string) JWTClaimMapperOption {
+	return func(mapper *jwtClaimMapper) {
+		mapper.namespacesClaimName = name
+	}
+}
+
+func (m *jwtClaimMapper) GetClaims(authInfo *AuthInfo) (*Claims, error) {
+
+	claims := Claims{}
+
+	if authInfo.AuthToken == "" {
+		return &claims, nil
+	}
+
+	parts := strings.Split(authInfo.AuthToken, " ")
+	if len(parts) != 2 || !strings.EqualFold(parts[0], authorizationBearer) {
+		return nil, &MalformedTokenError{Msg: "expected authorization header in \"Bearer <token>\" format"}
+	}
+	jwtClaims, err := parseJWTWithAudience(parts[1], m.keyProvider, authInfo.Audience)
+	if err != nil {
+		return nil, toTypedTokenError(err)
+	}
+
+	subject, ok := jwtClaims[headerSubject].(string)
+	if !ok {
+		return nil, &MalformedTokenError{Msg: "unexpected value type of \"sub\" claim"}
+	}
+	claims.Subject = subject
+
+	if value, ok := jwtClaims[m.rolesClaimName]; ok {
+		roles, err := toRoles(m.rolesClaimName, value)
+		if err != nil {
+			return nil, err
+		}
+		claims.System = roles
+	}
+
+	if value, ok := jwtClaims[m.namespacesClaimName]; ok {
+		namespaces, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, &MalformedTokenError{Msg: fmt.Sprintf("unexpected value type of %q claim", m.namespacesClaimName)}
+		}
+		claims.Namespaces = make(map[string]Role, len(namespaces))
+		for namespace, value := range namespaces {
+			roles, err := toRoles(m.namespacesClaimName, value)
+			if err != nil {
+				return nil, err
+			}
+			claims.Namespaces[namespace] = roles
+		}
+	}
+	return &claims, nil
+}
+
+func toRoles(claimName string, value interface{}) (Role, error) {
+	names, ok := value.([]interface{})
+	if !ok {
+		return RoleUndefined, &MalformedTokenError{Msg: fmt.Sprintf("unexpected value type of %q claim", claimName)}
+	}
+	role := RoleUndefined
+	for _, name := range names {
+		nameString, ok := name.(string)
+		if !ok {
+			return RoleUndefined, &MalformedTokenError{Msg: fmt.Sprintf("unexpected role type in %q claim", claimName)}
+		}
+		role |= permissionToRole(nameString)
+	}
+	return role, nil
+}
+
+func toTypedTokenError(err error) error {
+	var expiredErr *jwt.TokenExpiredError
+	var signatureErr *jwt.InvalidSignatureError
+	var malformedErr *jwt.MalformedTokenError
+	switch {
+	case errors.As(err, &expiredErr):
+		return &ExpiredTokenError{Msg: err.Error()}
+	case errors.As(err, &signatureErr):
+		return &InvalidTokenSignatureError{Msg: err.Error()}
+	case errors.As(err, &malformedErr):
+		return &MalformedTokenError{Msg: err.Error()}
+	default:
+		return err
+	}
+}
+
+func (e *ExpiredTokenError) Error() string {
+	return e.Msg
+}
+
+func (e *MalformedTokenError) Error() string {
+	return e.Msg
+}
+
+func (e *InvalidTokenSignatureError) Error() string {
+	return e.Msg
+}
@@ -0,0 +1,136 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go/v4"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	jwtClaimMapperSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		tokenGenerator *tokenGenerator
+		claimMapper    ClaimMapper
+	}
+)
+
+func TestJWTClaimMapperSuite(t *testing.T) {
+	s := new(jwtClaimMapperSuite)
+	suite.Run(t, s)
+}
+
+func (s *jwtClaimMapperSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.tokenGenerator = newTokenGenerator()
+	s.claimMapper = NewJWTClaimMapper(s.tokenGenerator)
+}
+
+func (s *jwtClaimMapperSuite) TestSignedToken() {
+	token := s.signToken(jwt.MapClaims{
+		"sub":        testSubject,
+		"exp":        time.Now().Add(time.Hour).Unix(),
+		"roles":      []string{"admin"},
+		"namespaces": map[string][]string{defaultNamespace: {"read", "write"}, "other": {"worker"}},
+	})
+
+	claims, err := s.claimMapper.GetClaims(&AuthInfo{AuthToken: AddBearer(token)})
+	s.NoError(err)
+	s.Equal(testSubject, claims.Subject)
+	s.Equal(RoleAdmin, claims.System)
+	s.Equal(map[string]Role{defaultNamespace: RoleReader | RoleWriter, "other": RoleWorker}, claims.Namespaces)
+}
+
+func (s *jwtClaimMapperSuite) TestCustomClaimNames() {
+	claimMapper := NewJWTClaimMapper(s.tokenGenerator, WithRolesClaimName("temporal-roles"), WithNamespacesClaimName("temporal-namespaces"))
+	token := s.signToken(jwt.MapClaims{
+		"sub":                 testSubject,
+		"roles":               []string{"admin"},
+		"temporal-roles":      []string{"read"},
+		"temporal-namespaces": map[string][]string{defaultNamespace: {"write"}},
+	})
+
+	claims, err := claimMapper.GetClaims(&AuthInfo{AuthToken: AddBearer(token)})
+	s.NoError(err)
+	s.Equal(RoleReader, claims.System)
+	s.Equal(map[string]Role{defaultNamespace: RoleWriter}, claims.Namespaces)
+}
+
+func (s *jwtClaimMapperSuite) TestExpiredToken() {
+	token := s.signToken(jwt.MapClaims{
+		"sub": testSubject,
+		"exp": time.Now().Add(-time.Hour).Unix(),
+	})
+
+	_, err := s.claimMapper.GetClaims(&AuthInfo{AuthToken: AddBearer(token)})
+	s.IsType(&ExpiredTokenError{}, err)
+}
+
+func (s *jwtClaimMapperSuite) TestTamperedToken() {
+	token := s.signToken(jwt.MapClaims{
+		"sub":   testSubject,
+		"roles": []string{"read"},
+	})
+	forged := s.signToken(jwt.MapClaims{
+		"sub":   testSubject,
+		"roles": []string{"admin"},
+	})
+	// swap in the claims of another token, keeping the original signature
+	parts := strings.Split(token, ".")
+	parts[1] = strings.Split(forged, ".")[1]
+
+	_, err := s.claimMapper.GetClaims(&AuthInfo{AuthToken: AddBearer(strings.Join(parts, "."))})
+	s.IsType(&InvalidTokenSignatureError{}, err)
+}
+
+func (s *jwtClaimMapperSuite) TestMalformedToken() {
+	_, err := s.claimMapper.GetClaims(&AuthInfo{AuthToken: AddBearer("not-a-token")})
+	s.IsType(&MalformedTokenError{}, err)
+
+	_, err = s.claimMapper.GetClaims(&AuthInfo{AuthToken: "not-a-bearer-header"})
+	s.IsType(&MalformedTokenError{}, err)
+
+	token := s.signToken(jwt.MapClaims{
+		"sub":   testSubject,
+		"roles": "admin",
+	})
+	_, err = s.claimMapper.GetClaims(&AuthInfo{AuthToken: AddBearer(token)})
+	s.IsType(&MalformedTokenError{}, err)
+}
+
+func (s *jwtClaimMapperSuite) signToken(claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = "test-key"
+	signed, err := token.SignedString(s.tokenGenerator.rsaPrivateKey)
+	s.NoError(err)
+	return signed
+}
@@ -0,0 +1,92 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/quotas"
+)
+
+const (
+	// rateLimitersCacheSize bounds how many caller identities have a token bucket, the least recently seen
+	// callers lose theirs first
+	rateLimitersCacheSize = 10000
+)
+
+var (
+	// ErrCallerRateLimitExceeded is returned when a caller is denied for exceeding its request rate
+	ErrCallerRateLimitExceeded = serviceerror.NewResourceExhausted("Caller request rate limit exceeded.")
+)
+
+type (
+	rateLimitingAuthorizer struct {
+		inner          Authorizer
+		limitPerSecond func(identity string) float64
+		rateLimiters   cache.Cache
+	}
+)
+
+// NewRateLimitingAuthorizer creates an authorizer which denies callers exceeding their request rate with
+// ErrCallerRateLimitExceeded before consulting the inner authorizer. Each caller identity, the subject of
+// its claims, gets its own token bucket.
+func NewRateLimitingAuthorizer(inner Authorizer, limitPerSecond func(identity string) float64) Authorizer {
+	return &rateLimitingAuthorizer{
+		inner:          inner,
+		limitPerSecond: limitPerSecond,
+		rateLimiters:   cache.NewLRU(rateLimitersCacheSize),
+	}
+}
+
+func (a *rateLimitingAuthorizer) Authorize(ctx context.Context, caller *Claims, target *CallTarget) (Result, error) {
+	var identity string
+	if caller != nil {
+		identity = caller.Subject
+	}
+
+	if !a.getOrInitRateLimiter(identity).Allow() {
+		return Result{Decision: DecisionDeny}, ErrCallerRateLimitExceeded
+	}
+	return a.inner.Authorize(ctx, caller, target)
+}
+
+func (a *rateLimitingAuthorizer) getOrInitRateLimiter(identity string) quotas.RateLimiter {
+	if rateLimiter := a.rateLimiters.Get(identity); rateLimiter != nil {
+		return rateLimiter.(quotas.RateLimiter)
+	}
+
+	rateLimiter, err := a.rateLimiters.PutIfNotExist(
+		identity,
+		quotas.NewDefaultIncomingDynamicRateLimiter(func() float64 { return a.limitPerSecond(identity) }),
+	)
+	if err != nil {
+		// the cache doesn't pin entries, so it is never full
+		return quotas.NewDefaultIncomingDynamicRateLimiter(func() float64 { return a.limitPerSecond(identity) })
+	}
+	return rateLimiter.(quotas.RateLimiter)
+}
@@ -0,0 +1,118 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	rateLimitingAuthorizerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller     *gomock.Controller
+		mockAuthorizer *MockAuthorizer
+		authorizer     Authorizer
+	}
+)
+
+func TestRateLimitingAuthorizerSuite(t *testing.T) {
+	s := new(rateLimitingAuthorizerSuite)
+	suite.Run(t, s)
+}
+
+func (s *rateLimitingAuthorizerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+
+	s.mockAuthorizer = NewMockAuthorizer(s.controller)
+	s.mockAuthorizer.EXPECT().Authorize(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(Result{Decision: DecisionAllow}, nil).AnyTimes()
+	s.authorizer = NewRateLimitingAuthorizer(s.mockAuthorizer, func(identity string) float64 {
+		if identity == "abusive-user" {
+			return 5
+		}
+		return 1000
+	})
+}
+
+func (s *rateLimitingAuthorizerSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *rateLimitingAuthorizerSuite) TestBurstPastLimit() {
+	abusive := &Claims{Subject: "abusive-user"}
+	other := &Claims{Subject: "other-user"}
+
+	allowed, denied := s.burst(abusive, 50)
+	s.Greater(allowed, 0)
+	s.Less(allowed, 50)
+	s.Equal(50, allowed+denied)
+
+	// other callers have their own bucket
+	allowed, denied = s.burst(other, 50)
+	s.Equal(50, allowed)
+	s.Equal(0, denied)
+
+	result, err := s.authorizer.Authorize(ctx, abusive, describeNamespaceTarget)
+	s.Equal(ErrCallerRateLimitExceeded, err)
+	s.Equal(DecisionDeny, result.Decision)
+
+	// tokens refill at 5 per second
+	time.Sleep(time.Second)
+	result, err = s.authorizer.Authorize(ctx, abusive, describeNamespaceTarget)
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+}
+
+func (s *rateLimitingAuthorizerSuite) TestRateLimitersBounded() {
+	for i := 0; i < rateLimitersCacheSize+100; i++ {
+		_, err := s.authorizer.Authorize(ctx, &Claims{Subject: fmt.Sprintf("user-%v", i)}, describeNamespaceTarget)
+		s.NoError(err)
+	}
+	s.Equal(rateLimitersCacheSize, s.authorizer.(*rateLimitingAuthorizer).rateLimiters.Size())
+}
+
+func (s *rateLimitingAuthorizerSuite) burst(caller *Claims, count int) (int, int) {
+	allowed, denied := 0, 0
+	for i := 0; i < count; i++ {
+		result, err := s.authorizer.Authorize(ctx, caller, describeNamespaceTarget)
+		if err == nil {
+			s.Equal(DecisionAllow, result.Decision)
+			allowed++
+		} else {
+			s.Equal(ErrCallerRateLimitExceeded, err)
+			denied++
+		}
+	}
+	return allowed, denied
+}
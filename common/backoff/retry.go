@@ -25,6 +25,7 @@
 package backoff
 
 import (
+	"context"
 	"sync"
 	"time"
 )
@@ -117,6 +118,37 @@ func Retry(operation Operation, policy RetryPolicy, isRetryable IsRetryable) err
 	}
 }
 
+// RetryContext is like Retry but stops retrying once ctx is done, returning the last error of the operation
+func RetryContext(ctx context.Context, operation Operation, policy RetryPolicy, isRetryable IsRetryable) error {
+	var err error
+	var next time.Duration
+
+	r := NewRetrier(policy, SystemClock)
+	for {
+		// operation completed successfully.  No need to retry.
+		if err = operation(); err == nil {
+			return nil
+		}
+
+		if next = r.NextBackOff(); next == done {
+			return err
+		}
+
+		// Check if the error is retryable
+		if isRetryable != nil && !isRetryable(err) {
+			return err
+		}
+
+		timer := time.NewTimer(next)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
 // IgnoreErrors can be used as IsRetryable handler for Retry function to exclude certain errors from the retry list
 func IgnoreErrors(errorsToExclude []error) func(error) bool {
 	return func(err error) bool {
@@ -25,6 +25,7 @@
 package backoff
 
 import (
+	"context"
 	"fmt"
 	"testing"
 	"time"
@@ -141,6 +142,28 @@ func (s *RetrySuite) TestIsRetryableFailure() {
 	s.Equal(1, i)
 }
 
+func (s *RetrySuite) TestRetryContextCanceled() {
+	ctx, cancel := context.WithCancel(context.Background())
+	i := 0
+	op := func() error {
+		i++
+
+		if i == 2 {
+			cancel()
+		}
+
+		return &someError{}
+	}
+
+	policy := NewExponentialRetryPolicy(1 * time.Millisecond)
+	policy.SetMaximumInterval(5 * time.Millisecond)
+	policy.SetMaximumAttempts(10)
+
+	err := RetryContext(ctx, op, policy, nil)
+	s.Error(err)
+	s.Equal(2, i)
+}
+
 func (s *RetrySuite) TestConcurrentRetrier() {
 	policy := NewExponentialRetryPolicy(1 * time.Millisecond)
 	policy.SetMaximumInterval(10 * time.Millisecond)
@@ -36,6 +36,9 @@ const (
 	done              time.Duration = -1
 	noMaximumAttempts               = 0
 
+	// Done is returned by RetryPolicy.ComputeNextDelay when no more retries should be made
+	Done = done
+
 	defaultBackoffCoefficient = 2.0
 	defaultMaximumInterval    = 10 * time.Second
 	defaultExpirationInterval = time.Minute
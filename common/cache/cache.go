@@ -70,6 +70,13 @@ type Options struct {
 	// RemovedFunc is an optional function called when an element
 	// is scheduled for deletion
 	RemovedFunc RemovedFunc
+
+	// SizeFunc is an optional function returning the size of a value. If set, the cache max size
+	// bounds the total size of all values instead of the number of entries.
+	SizeFunc SizeFunc
+
+	// MetricsHook is optionally notified of cache hits and misses on Get.
+	MetricsHook MetricsHook
 }
 
 // SimpleOptions provides options that can be used to configure SimpleCache
@@ -88,6 +95,17 @@ type SimpleOptions struct {
 // deletion, Cache calls go f(i)
 type RemovedFunc func(interface{})
 
+// SizeFunc returns the size of a cached value, in whatever unit the cache max size is expressed in.
+type SizeFunc func(interface{}) int
+
+// MetricsHook is notified of cache lookups, it must be safe for concurrent use.
+type MetricsHook interface {
+	// RecordHit is called when Get finds a live entry
+	RecordHit()
+	// RecordMiss is called when Get finds no entry or an expired one
+	RecordMiss()
+}
+
 // Iterator represents the interface for cache iterators
 type Iterator interface {
 	// Close closes the iterator
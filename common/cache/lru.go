@@ -43,9 +43,12 @@ type (
 		byAccess *list.List
 		byKey    map[interface{}]*list.Element
 		maxSize  int
+		currSize int
 		ttl      time.Duration
 		pin      bool
 		rmFunc   RemovedFunc
+		sizeFunc SizeFunc
+		hook     MetricsHook
 	}
 
 	iteratorImpl struct {
@@ -58,6 +61,7 @@ type (
 		key        interface{}
 		createTime time.Time
 		value      interface{}
+		size       int
 		refCount   int
 	}
 )
@@ -142,6 +146,8 @@ func New(maxSize int, opts *Options) Cache {
 		maxSize:  maxSize,
 		pin:      opts.Pin,
 		rmFunc:   opts.RemovedFunc,
+		sizeFunc: opts.SizeFunc,
+		hook:     opts.MetricsHook,
 	}
 }
 
@@ -166,6 +172,7 @@ func (c *lru) Get(key interface{}) interface{} {
 
 	element := c.byKey[key]
 	if element == nil {
+		c.recordMiss()
 		return nil
 	}
 
@@ -174,9 +181,11 @@ func (c *lru) Get(key interface{}) interface{} {
 	if c.isEntryExpired(entry, time.Now().UTC()) {
 		// Entry has expired
 		c.deleteInternal(element)
+		c.recordMiss()
 		return nil
 	}
 
+	c.recordHit()
 	if c.pin {
 		entry.refCount++
 	}
@@ -232,7 +241,8 @@ func (c *lru) Release(key interface{}) {
 	entry.refCount--
 }
 
-// Size returns the number of entries currently in the lru, useful if cache is not full
+// Size returns the number of entries currently in the lru, useful if cache is not full.
+// Note that if SizeFunc is set, the cache is bounded by the total size of values, not by this number.
 func (c *lru) Size() int {
 	c.mut.Lock()
 	defer c.mut.Unlock()
@@ -259,12 +269,19 @@ func (c *lru) putInternal(key interface{}, value interface{}, allowUpdate bool)
 				if c.ttl != 0 {
 					entry.createTime = time.Now().UTC()
 				}
+				newSize := c.valueSize(value)
+				c.currSize += newSize - entry.size
+				entry.size = newSize
 			}
 
 			c.byAccess.MoveToFront(elt)
 			if c.pin {
 				entry.refCount++
 			}
+			// only reachable with allowUpdate in non pin mode, so nothing is pinned
+			for c.isOverLimit() {
+				c.deleteInternal(c.byAccess.Back())
+			}
 			return existing, nil
 		}
 	}
@@ -272,6 +289,7 @@ func (c *lru) putInternal(key interface{}, value interface{}, allowUpdate bool)
 	entry := &entryImpl{
 		key:   key,
 		value: value,
+		size:  c.valueSize(value),
 	}
 
 	if c.pin {
@@ -283,7 +301,8 @@ func (c *lru) putInternal(key interface{}, value interface{}, allowUpdate bool)
 	}
 
 	c.byKey[key] = c.byAccess.PushFront(entry)
-	if len(c.byKey) > c.maxSize {
+	c.currSize += entry.size
+	for c.isOverLimit() {
 		oldest := c.byAccess.Back().Value.(*entryImpl)
 
 		if oldest.refCount > 0 {
@@ -305,6 +324,30 @@ func (c *lru) deleteInternal(element *list.Element) {
 		go c.rmFunc(entry.value)
 	}
 	delete(c.byKey, entry.key)
+	c.currSize -= entry.size
+}
+
+func (c *lru) valueSize(value interface{}) int {
+	if c.sizeFunc == nil {
+		return 1
+	}
+	return c.sizeFunc(value)
+}
+
+func (c *lru) isOverLimit() bool {
+	return c.currSize > c.maxSize
+}
+
+func (c *lru) recordHit() {
+	if c.hook != nil {
+		c.hook.RecordHit()
+	}
+}
+
+func (c *lru) recordMiss() {
+	if c.hook != nil {
+		c.hook.RecordMiss()
+	}
 }
 
 func (c *lru) isEntryExpired(entry *entryImpl, currentTime time.Time) bool {
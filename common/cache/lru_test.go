@@ -25,6 +25,7 @@
 package cache
 
 import (
+	"strconv"
 	"sync"
 	"testing"
 	"time"
@@ -303,3 +304,171 @@ func TestIterator(t *testing.T) {
 	it.Close()
 	assert.Equal(t, expected, actual)
 }
+
+type countingMetricsHook struct {
+	sync.Mutex
+	hits   int
+	misses int
+}
+
+func (h *countingMetricsHook) RecordHit() {
+	h.Lock()
+	defer h.Unlock()
+	h.hits++
+}
+
+func (h *countingMetricsHook) RecordMiss() {
+	h.Lock()
+	defer h.Unlock()
+	h.misses++
+}
+
+func TestLRUCountEviction(t *testing.T) {
+	cache := NewLRU(2)
+
+	cache.Put("A", "Foo")
+	cache.Put("B", "Bar")
+	assert.Equal(t, "Foo", cache.Get("A"))
+
+	// B is the least recently used
+	cache.Put("C", "Cid")
+	assert.Equal(t, 2, cache.Size())
+	assert.Nil(t, cache.Get("B"))
+	assert.Equal(t, "Foo", cache.Get("A"))
+	assert.Equal(t, "Cid", cache.Get("C"))
+}
+
+func TestLRUSizeEviction(t *testing.T) {
+	cache := New(10, &Options{
+		SizeFunc: func(value interface{}) int { return len(value.(string)) },
+	})
+
+	cache.Put("A", "aaaa")
+	cache.Put("B", "bbbb")
+	assert.Equal(t, 2, cache.Size())
+
+	// 4 + 4 + 3 > 10, A is evicted
+	cache.Put("C", "ccc")
+	assert.Nil(t, cache.Get("A"))
+	assert.Equal(t, "bbbb", cache.Get("B"))
+	assert.Equal(t, "ccc", cache.Get("C"))
+
+	// a single large value evicts everything older
+	cache.Put("D", "dddddddddd")
+	assert.Equal(t, 1, cache.Size())
+	assert.Equal(t, "dddddddddd", cache.Get("D"))
+
+	// a value larger than the cache does not stay in it
+	cache.Put("E", "eeeeeeeeeee")
+	assert.Equal(t, 0, cache.Size())
+	assert.Nil(t, cache.Get("E"))
+
+	// growing a value on update evicts older entries
+	cache.Put("F", "ff")
+	cache.Put("G", "gg")
+	cache.Put("F", "fffffffff")
+	assert.Nil(t, cache.Get("G"))
+	assert.Equal(t, "fffffffff", cache.Get("F"))
+}
+
+func TestLRUSizeEviction_Pin(t *testing.T) {
+	cache := New(10, &Options{
+		Pin:      true,
+		SizeFunc: func(value interface{}) int { return len(value.(string)) },
+	})
+
+	_, err := cache.PutIfNotExist("A", "aaaaaa")
+	assert.NoError(t, err)
+	_, err = cache.PutIfNotExist("B", "bbbbbb")
+	assert.Equal(t, ErrCacheFull, err)
+	assert.Nil(t, cache.Get("B"))
+
+	cache.Release("A")
+	_, err = cache.PutIfNotExist("B", "bbbbbb")
+	assert.NoError(t, err)
+	assert.Nil(t, cache.Get("A"))
+}
+
+func TestLRUTTLEviction(t *testing.T) {
+	cache := New(5, &Options{
+		TTL: time.Millisecond * 100,
+	})
+
+	cache.Put("A", "Foo")
+	cache.Put("B", "Bar")
+	time.Sleep(time.Millisecond * 50)
+	cache.Put("B", "Baz")
+	time.Sleep(time.Millisecond * 60)
+
+	// A expired, B was refreshed by update
+	assert.Nil(t, cache.Get("A"))
+	assert.Equal(t, "Baz", cache.Get("B"))
+	assert.Equal(t, 1, cache.Size())
+}
+
+func TestLRUMetricsHook(t *testing.T) {
+	hook := &countingMetricsHook{}
+	cache := New(5, &Options{
+		TTL:         time.Millisecond * 100,
+		MetricsHook: hook,
+	})
+
+	cache.Put("A", "Foo")
+	cache.Get("A")
+	cache.Get("A")
+	cache.Get("B")
+	time.Sleep(time.Millisecond * 110)
+	cache.Get("A")
+
+	assert.Equal(t, 2, hook.hits)
+	assert.Equal(t, 2, hook.misses)
+}
+
+func BenchmarkLRUPut(b *testing.B) {
+	cache := NewLRU(1000)
+	keys := make([]string, 2000)
+	for i := range keys {
+		keys[i] = strconv.Itoa(i)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		cache.Put(keys[i%len(keys)], i)
+	}
+}
+
+func BenchmarkLRUGet(b *testing.B) {
+	cache := NewLRU(1000)
+	keys := make([]string, 2000)
+	for i := range keys {
+		keys[i] = strconv.Itoa(i)
+		cache.Put(keys[i], i)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		cache.Get(keys[i%len(keys)])
+	}
+}
+
+func BenchmarkLRUConcurrentGetPut(b *testing.B) {
+	cache := New(1000, &Options{
+		SizeFunc: func(interface{}) int { return 1 },
+	})
+	keys := make([]string, 2000)
+	for i := range keys {
+		keys[i] = strconv.Itoa(i)
+	}
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			key := keys[i%len(keys)]
+			if cache.Get(key) == nil {
+				cache.Put(key, i)
+			}
+			i++
+		}
+	})
+}
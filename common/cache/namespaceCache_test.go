@@ -615,6 +615,8 @@ func Test_NamespaceCacheEntry_GetNamespaceNotActiveErr(t *testing.T) {
 		cluster.TestCurrentClusterName,
 		cluster.TestCurrentClusterName,
 		cluster.TestAllClusterInfo,
+		metrics.NewNoopMetricsClient(),
+		log.NewNoopLogger(),
 	)
 	namespaceEntry := NewGlobalNamespaceCacheEntryForTest(
 		&persistencespb.NamespaceInfo{Name: "test-namespace"},
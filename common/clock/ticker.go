@@ -0,0 +1,92 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package clock
+
+import (
+	"time"
+)
+
+type (
+	// Ticker delivers ticks at intervals like time.Ticker,
+	// but can be driven by a fake TimeSource in unit test
+	Ticker interface {
+		// Chan returns the channel on which the ticks are delivered
+		Chan() <-chan time.Time
+		// Stop turns off the ticker, no more ticks will be delivered
+		Stop()
+	}
+
+	realTicker struct {
+		*time.Ticker
+	}
+
+	// eventTicker is driven by the updates of an EventTimeSource,
+	// it is guarded by the tickersLock of its EventTimeSource
+	eventTicker struct {
+		c          chan time.Time
+		interval   time.Duration
+		next       time.Time
+		timeSource *EventTimeSource
+	}
+)
+
+// NewTicker returns a Ticker driven by timeSource. An EventTimeSource delivers ticks
+// as its fake time is updated, any other time source uses real wall clock time.
+func NewTicker(timeSource TimeSource, d time.Duration) Ticker {
+	if eventTimeSource, ok := timeSource.(*EventTimeSource); ok {
+		return eventTimeSource.NewTicker(d)
+	}
+	return &realTicker{Ticker: time.NewTicker(d)}
+}
+
+// Chan returns the channel on which the ticks are delivered
+func (t *realTicker) Chan() <-chan time.Time {
+	return t.C
+}
+
+// Chan returns the channel on which the ticks are delivered
+func (t *eventTicker) Chan() <-chan time.Time {
+	return t.c
+}
+
+// Stop turns off the ticker, no more ticks will be delivered
+func (t *eventTicker) Stop() {
+	t.timeSource.removeTicker(t)
+}
+
+// fire delivers a tick if the ticker is due at now, dropping it if the previous
+// tick has not been consumed yet, the same way time.Ticker does for slow receivers
+func (t *eventTicker) fire(now time.Time) {
+	if now.Before(t.next) {
+		return
+	}
+
+	select {
+	case t.c <- now:
+	default:
+	}
+	missed := now.Sub(t.next)/t.interval + 1
+	t.next = t.next.Add(missed * t.interval)
+}
@@ -0,0 +1,93 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	tickerSuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+)
+
+func TestTickerSuite(t *testing.T) {
+	s := new(tickerSuite)
+	suite.Run(t, s)
+}
+
+func (s *tickerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *tickerSuite) TestEventTicker() {
+	start := time.Unix(0, 0).UTC()
+	timeSource := NewEventTimeSource().Update(start)
+	ticker := NewTicker(timeSource, time.Minute)
+
+	timeSource.Update(start.Add(30 * time.Second))
+	s.assertNoTick(ticker)
+
+	timeSource.Update(start.Add(time.Minute))
+	s.Equal(start.Add(time.Minute), <-ticker.Chan())
+	s.assertNoTick(ticker)
+
+	// ticks missed while the receiver is not reading are dropped
+	timeSource.Update(start.Add(5 * time.Minute))
+	s.Equal(start.Add(5*time.Minute), <-ticker.Chan())
+	timeSource.Update(start.Add(5*time.Minute + 30*time.Second))
+	s.assertNoTick(ticker)
+	timeSource.Update(start.Add(6 * time.Minute))
+	s.Equal(start.Add(6*time.Minute), <-ticker.Chan())
+
+	ticker.Stop()
+	timeSource.Update(start.Add(time.Hour))
+	s.assertNoTick(ticker)
+}
+
+func (s *tickerSuite) TestRealTicker() {
+	ticker := NewTicker(NewRealTimeSource(), time.Millisecond)
+	defer ticker.Stop()
+
+	select {
+	case <-ticker.Chan():
+	case <-time.After(time.Second):
+		s.Fail("real ticker did not tick")
+	}
+}
+
+func (s *tickerSuite) assertNoTick(ticker Ticker) {
+	select {
+	case tick := <-ticker.Chan():
+		s.Fail("unexpected tick", "tick at %v", tick)
+	default:
+	}
+}
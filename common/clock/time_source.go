@@ -25,6 +25,7 @@
 package clock
 
 import (
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -47,6 +48,9 @@ type (
 	// EventTimeSource serves fake controlled time
 	EventTimeSource struct {
 		now int64
+
+		tickersLock sync.Mutex
+		tickers     map[*eventTicker]struct{}
 	}
 )
 
@@ -72,8 +76,41 @@ func (ts *EventTimeSource) Now() time.Time {
 	return time.Unix(0, atomic.LoadInt64(&ts.now)).UTC()
 }
 
-// Update update the fake current time
+// Update update the fake current time, delivering ticks to the tickers which are due
 func (ts *EventTimeSource) Update(now time.Time) *EventTimeSource {
 	atomic.StoreInt64(&ts.now, now.UnixNano())
+
+	ts.tickersLock.Lock()
+	defer ts.tickersLock.Unlock()
+	for ticker := range ts.tickers {
+		ticker.fire(now)
+	}
 	return ts
 }
+
+// NewTicker returns a ticker which delivers ticks as the fake current time is updated
+func (ts *EventTimeSource) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		panic("non-positive interval for NewTicker")
+	}
+
+	ticker := &eventTicker{
+		c:          make(chan time.Time, 1),
+		interval:   d,
+		next:       ts.Now().Add(d),
+		timeSource: ts,
+	}
+	ts.tickersLock.Lock()
+	defer ts.tickersLock.Unlock()
+	if ts.tickers == nil {
+		ts.tickers = make(map[*eventTicker]struct{})
+	}
+	ts.tickers[ticker] = struct{}{}
+	return ticker
+}
+
+func (ts *EventTimeSource) removeTicker(ticker *eventTicker) {
+	ts.tickersLock.Lock()
+	defer ts.tickersLock.Unlock()
+	delete(ts.tickers, ticker)
+}
@@ -28,9 +28,16 @@ package cluster
 
 import (
 	"fmt"
+	"sort"
+	"sync"
+
+	"go.temporal.io/api/serviceerror"
 
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
 )
 
 type (
@@ -53,6 +60,27 @@ type (
 		GetAllClusterInfo() map[string]config.ClusterInformation
 		// ClusterNameForFailoverVersion return the corresponding cluster name for a given failover version
 		ClusterNameForFailoverVersion(failoverVersion int64) string
+		// GetClusterNameForFailoverVersion return the corresponding cluster name for a given failover version,
+		// or an error if the version does not map to any configured cluster
+		GetClusterNameForFailoverVersion(failoverVersion int64) (string, error)
+		// IsVersionFromCluster return true if the failover version belongs to the given cluster
+		IsVersionFromCluster(failoverVersion int64, clusterName string) bool
+		// RegisterCluster adds a cluster to the set of known clusters
+		RegisterCluster(clusterName string, info config.ClusterInformation) error
+		// DeregisterCluster removes a cluster from the set of known clusters
+		DeregisterCluster(clusterName string) error
+		// Snapshot return a consistent, immutable view of the cluster metadata
+		Snapshot() ClusterMetadataSnapshot
+	}
+
+	// ClusterMetadataSnapshot is an immutable view of cluster metadata captured at a single point in time
+	ClusterMetadataSnapshot struct {
+		enableGlobalNamespace    bool
+		failoverVersionIncrement int64
+		masterClusterName        string
+		currentClusterName       string
+		clusterInfo              map[string]config.ClusterInformation
+		versionToClusterName     map[int64]string
 	}
 
 	metadataImpl struct {
@@ -65,6 +93,10 @@ type (
 		masterClusterName string
 		// currentClusterName is the name of the current cluster
 		currentClusterName string
+		metricsClient      metrics.Client
+
+		// clusterLock guards clusterInfo and versionToClusterName, which change on cluster registration
+		clusterLock sync.RWMutex
 		// clusterInfo contains all cluster name -> corresponding information
 		clusterInfo map[string]config.ClusterInformation
 		// versionToClusterName contains all initial version -> corresponding cluster name
@@ -79,6 +111,8 @@ func NewMetadata(
 	masterClusterName string,
 	currentClusterName string,
 	clusterInfo map[string]config.ClusterInformation,
+	metricsClient metrics.Client,
+	logger log.Logger,
 ) Metadata {
 
 	if len(clusterInfo) == 0 {
@@ -92,7 +126,9 @@ func NewMetadata(
 	}
 
 	versionToClusterName := make(map[int64]string)
+	clusterInfoCopy := make(map[string]config.ClusterInformation, len(clusterInfo))
 	for clusterName, info := range clusterInfo {
+		clusterInfoCopy[clusterName] = info
 		if failoverVersionIncrement <= info.InitialFailoverVersion || info.InitialFailoverVersion <= 0 {
 			panic(fmt.Sprintf(
 				"Version increment %v is smaller than initial version: %v.",
@@ -120,12 +156,27 @@ func NewMetadata(
 		panic("Cluster info initial versions have duplicates")
 	}
 
+	// log the failover version math once, it is the first thing needed to diagnose replication misconfiguration
+	clusterNames := make([]string, 0, len(clusterInfo))
+	for clusterName := range clusterInfo {
+		clusterNames = append(clusterNames, clusterName)
+	}
+	sort.Strings(clusterNames)
+	for _, clusterName := range clusterNames {
+		logger.Info("Cluster failover version",
+			tag.ClusterName(clusterName),
+			tag.InitialFailoverVersion(clusterInfo[clusterName].InitialFailoverVersion),
+			tag.FailoverVersionIncrement(failoverVersionIncrement),
+		)
+	}
+
 	return &metadataImpl{
 		enableGlobalNamespace:    enableGlobalNamespace,
 		failoverVersionIncrement: failoverVersionIncrement,
 		masterClusterName:        masterClusterName,
 		currentClusterName:       currentClusterName,
-		clusterInfo:              clusterInfo,
+		metricsClient:            metricsClient,
+		clusterInfo:              clusterInfoCopy,
 		versionToClusterName:     versionToClusterName,
 	}
 }
@@ -138,6 +189,9 @@ func (m *metadataImpl) IsGlobalNamespaceEnabled() bool {
 
 // GetNextFailoverVersion return the next failover version based on input
 func (m *metadataImpl) GetNextFailoverVersion(cluster string, currentFailoverVersion int64) int64 {
+	m.clusterLock.RLock()
+	defer m.clusterLock.RUnlock()
+
 	info, ok := m.clusterInfo[cluster]
 	if !ok {
 		panic(fmt.Sprintf(
@@ -174,29 +228,199 @@ func (m *metadataImpl) GetCurrentClusterName() string {
 
 // GetAllClusterInfo return the all cluster name -> corresponding information
 func (m *metadataImpl) GetAllClusterInfo() map[string]config.ClusterInformation {
-	return m.clusterInfo
+	m.clusterLock.RLock()
+	defer m.clusterLock.RUnlock()
+
+	clusterInfo := make(map[string]config.ClusterInformation, len(m.clusterInfo))
+	for clusterName, info := range m.clusterInfo {
+		clusterInfo[clusterName] = info
+	}
+	return clusterInfo
 }
 
 // ClusterNameForFailoverVersion return the corresponding cluster name for a given failover version
 func (m *metadataImpl) ClusterNameForFailoverVersion(failoverVersion int64) string {
+	clusterName, err := m.GetClusterNameForFailoverVersion(failoverVersion)
+	if err != nil {
+		panic(err.Error())
+	}
+	return clusterName
+}
+
+// GetClusterNameForFailoverVersion return the corresponding cluster name for a given failover version,
+// or an error if the version does not map to any configured cluster
+func (m *metadataImpl) GetClusterNameForFailoverVersion(failoverVersion int64) (string, error) {
+	m.clusterLock.RLock()
+	defer m.clusterLock.RUnlock()
+
+	return clusterNameForFailoverVersion(
+		failoverVersion,
+		m.failoverVersionIncrement,
+		m.currentClusterName,
+		m.clusterInfo,
+		m.versionToClusterName,
+	)
+}
+
+// IsVersionFromCluster return true if the failover version belongs to the given cluster
+func (m *metadataImpl) IsVersionFromCluster(failoverVersion int64, clusterName string) bool {
+	versionClusterName, err := m.GetClusterNameForFailoverVersion(failoverVersion)
+	return err == nil && versionClusterName == clusterName
+}
+
+// RegisterCluster adds a cluster to the set of known clusters.
+// The initial failover version of the cluster must not collide with any known cluster.
+func (m *metadataImpl) RegisterCluster(clusterName string, info config.ClusterInformation) error {
+	if len(clusterName) == 0 {
+		return serviceerror.NewInvalidArgument("Cluster name is empty.")
+	}
+	if info.InitialFailoverVersion <= 0 || info.InitialFailoverVersion >= m.failoverVersionIncrement {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf(
+			"Cluster %v: initial failover version %v must be positive and smaller than version increment %v.",
+			clusterName,
+			info.InitialFailoverVersion,
+			m.failoverVersionIncrement,
+		))
+	}
+	if info.Enabled && info.RPCAddress == "" {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("Cluster %v: RPCAddress is empty.", clusterName))
+	}
+
+	m.clusterLock.Lock()
+	defer m.clusterLock.Unlock()
+
+	if _, ok := m.clusterInfo[clusterName]; ok {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("Cluster %v is already registered.", clusterName))
+	}
+	if existingClusterName, ok := m.versionToClusterName[info.InitialFailoverVersion]; ok {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf(
+			"Cluster %v: initial failover version %v collides with cluster %v.",
+			clusterName,
+			info.InitialFailoverVersion,
+			existingClusterName,
+		))
+	}
+
+	m.clusterInfo[clusterName] = info
+	m.versionToClusterName[info.InitialFailoverVersion] = clusterName
+	m.metricsClient.IncCounter(metrics.ClusterMetadataRegisterClusterScope, metrics.ClusterMetadataChanges)
+	return nil
+}
+
+// DeregisterCluster removes a cluster from the set of known clusters.
+// The current and master clusters cannot be deregistered.
+func (m *metadataImpl) DeregisterCluster(clusterName string) error {
+	if clusterName == m.currentClusterName {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("Cluster %v is the current cluster and cannot be deregistered.", clusterName))
+	}
+	if clusterName == m.masterClusterName {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("Cluster %v is the master cluster and cannot be deregistered.", clusterName))
+	}
+
+	m.clusterLock.Lock()
+	defer m.clusterLock.Unlock()
+
+	info, ok := m.clusterInfo[clusterName]
+	if !ok {
+		return serviceerror.NewNotFound(fmt.Sprintf("Cluster %v is not registered.", clusterName))
+	}
+
+	delete(m.clusterInfo, clusterName)
+	delete(m.versionToClusterName, info.InitialFailoverVersion)
+	m.metricsClient.IncCounter(metrics.ClusterMetadataDeregisterClusterScope, metrics.ClusterMetadataChanges)
+	return nil
+}
+
+// Snapshot return a consistent, immutable view of the cluster metadata
+func (m *metadataImpl) Snapshot() ClusterMetadataSnapshot {
+	m.clusterLock.RLock()
+	defer m.clusterLock.RUnlock()
+
+	clusterInfo := make(map[string]config.ClusterInformation, len(m.clusterInfo))
+	for clusterName, info := range m.clusterInfo {
+		clusterInfo[clusterName] = info
+	}
+	versionToClusterName := make(map[int64]string, len(m.versionToClusterName))
+	for version, clusterName := range m.versionToClusterName {
+		versionToClusterName[version] = clusterName
+	}
+
+	return ClusterMetadataSnapshot{
+		enableGlobalNamespace:    m.enableGlobalNamespace,
+		failoverVersionIncrement: m.failoverVersionIncrement,
+		masterClusterName:        m.masterClusterName,
+		currentClusterName:       m.currentClusterName,
+		clusterInfo:              clusterInfo,
+		versionToClusterName:     versionToClusterName,
+	}
+}
+
+// IsGlobalNamespaceEnabled whether the global namespace is enabled
+func (s ClusterMetadataSnapshot) IsGlobalNamespaceEnabled() bool {
+	return s.enableGlobalNamespace
+}
+
+// GetFailoverVersionIncrement return the failover version increment
+func (s ClusterMetadataSnapshot) GetFailoverVersionIncrement() int64 {
+	return s.failoverVersionIncrement
+}
+
+// GetMasterClusterName return the master cluster name
+func (s ClusterMetadataSnapshot) GetMasterClusterName() string {
+	return s.masterClusterName
+}
+
+// GetCurrentClusterName return the current cluster name
+func (s ClusterMetadataSnapshot) GetCurrentClusterName() string {
+	return s.currentClusterName
+}
+
+// GetAllClusterInfo return the all cluster name -> corresponding information
+func (s ClusterMetadataSnapshot) GetAllClusterInfo() map[string]config.ClusterInformation {
+	clusterInfo := make(map[string]config.ClusterInformation, len(s.clusterInfo))
+	for clusterName, info := range s.clusterInfo {
+		clusterInfo[clusterName] = info
+	}
+	return clusterInfo
+}
+
+// GetClusterNameForFailoverVersion return the corresponding cluster name for a given failover version,
+// or an error if the version does not map to any cluster in the snapshot
+func (s ClusterMetadataSnapshot) GetClusterNameForFailoverVersion(failoverVersion int64) (string, error) {
+	return clusterNameForFailoverVersion(
+		failoverVersion,
+		s.failoverVersionIncrement,
+		s.currentClusterName,
+		s.clusterInfo,
+		s.versionToClusterName,
+	)
+}
+
+func clusterNameForFailoverVersion(
+	failoverVersion int64,
+	failoverVersionIncrement int64,
+	currentClusterName string,
+	clusterInfo map[string]config.ClusterInformation,
+	versionToClusterName map[int64]string,
+) (string, error) {
 	if failoverVersion == common.EmptyVersion {
-		return m.currentClusterName
+		return currentClusterName, nil
 	}
 
-	initialFailoverVersion := failoverVersion % m.failoverVersionIncrement
+	initialFailoverVersion := failoverVersion % failoverVersionIncrement
 	// Failover version starts with 1.  Zero is an invalid value for failover version
 	if initialFailoverVersion == common.EmptyVersion {
-		initialFailoverVersion = m.failoverVersionIncrement
+		initialFailoverVersion = failoverVersionIncrement
 	}
 
-	clusterName, ok := m.versionToClusterName[initialFailoverVersion]
+	clusterName, ok := versionToClusterName[initialFailoverVersion]
 	if !ok {
-		panic(fmt.Sprintf(
-			"Unknown initial failover version %v with given cluster initial failover version map: %v and failover version increment %v.",
+		return "", fmt.Errorf(
+			"unknown initial failover version %v with given cluster initial failover version map: %v and failover version increment %v",
 			initialFailoverVersion,
-			m.clusterInfo,
-			m.failoverVersionIncrement,
-		))
+			clusterInfo,
+			failoverVersionIncrement,
+		)
 	}
-	return clusterName
+	return clusterName, nil
 }
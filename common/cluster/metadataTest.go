@@ -26,6 +26,8 @@ package cluster
 
 import (
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
 )
 
 const (
@@ -108,5 +110,7 @@ func NewTestClusterMetadata(cfg *config.ClusterMetadata) Metadata {
 		cfg.MasterClusterName,
 		cfg.CurrentClusterName,
 		cfg.ClusterInformation,
+		metrics.NewNoopMetricsClient(),
+		log.NewNoopLogger(),
 	)
 }
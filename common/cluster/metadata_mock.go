@@ -72,6 +72,20 @@ func (mr *MockMetadataMockRecorder) ClusterNameForFailoverVersion(failoverVersio
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClusterNameForFailoverVersion", reflect.TypeOf((*MockMetadata)(nil).ClusterNameForFailoverVersion), failoverVersion)
 }
 
+// DeregisterCluster mocks base method.
+func (m *MockMetadata) DeregisterCluster(clusterName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeregisterCluster", clusterName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeregisterCluster indicates an expected call of DeregisterCluster.
+func (mr *MockMetadataMockRecorder) DeregisterCluster(clusterName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterCluster", reflect.TypeOf((*MockMetadata)(nil).DeregisterCluster), clusterName)
+}
+
 // GetAllClusterInfo mocks base method.
 func (m *MockMetadata) GetAllClusterInfo() map[string]config.ClusterInformation {
 	m.ctrl.T.Helper()
@@ -86,6 +100,21 @@ func (mr *MockMetadataMockRecorder) GetAllClusterInfo() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllClusterInfo", reflect.TypeOf((*MockMetadata)(nil).GetAllClusterInfo))
 }
 
+// GetClusterNameForFailoverVersion mocks base method.
+func (m *MockMetadata) GetClusterNameForFailoverVersion(failoverVersion int64) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterNameForFailoverVersion", failoverVersion)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClusterNameForFailoverVersion indicates an expected call of GetClusterNameForFailoverVersion.
+func (mr *MockMetadataMockRecorder) GetClusterNameForFailoverVersion(failoverVersion interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterNameForFailoverVersion", reflect.TypeOf((*MockMetadata)(nil).GetClusterNameForFailoverVersion), failoverVersion)
+}
+
 // GetCurrentClusterName mocks base method.
 func (m *MockMetadata) GetCurrentClusterName() string {
 	m.ctrl.T.Helper()
@@ -156,6 +185,20 @@ func (mr *MockMetadataMockRecorder) IsMasterCluster() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsMasterCluster", reflect.TypeOf((*MockMetadata)(nil).IsMasterCluster))
 }
 
+// IsVersionFromCluster mocks base method.
+func (m *MockMetadata) IsVersionFromCluster(failoverVersion int64, clusterName string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsVersionFromCluster", failoverVersion, clusterName)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsVersionFromCluster indicates an expected call of IsVersionFromCluster.
+func (mr *MockMetadataMockRecorder) IsVersionFromCluster(failoverVersion, clusterName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsVersionFromCluster", reflect.TypeOf((*MockMetadata)(nil).IsVersionFromCluster), failoverVersion, clusterName)
+}
+
 // IsVersionFromSameCluster mocks base method.
 func (m *MockMetadata) IsVersionFromSameCluster(version1, version2 int64) bool {
 	m.ctrl.T.Helper()
@@ -169,3 +212,31 @@ func (mr *MockMetadataMockRecorder) IsVersionFromSameCluster(version1, version2
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsVersionFromSameCluster", reflect.TypeOf((*MockMetadata)(nil).IsVersionFromSameCluster), version1, version2)
 }
+
+// RegisterCluster mocks base method.
+func (m *MockMetadata) RegisterCluster(clusterName string, info config.ClusterInformation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterCluster", clusterName, info)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterCluster indicates an expected call of RegisterCluster.
+func (mr *MockMetadataMockRecorder) RegisterCluster(clusterName, info interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterCluster", reflect.TypeOf((*MockMetadata)(nil).RegisterCluster), clusterName, info)
+}
+
+// Snapshot mocks base method.
+func (m *MockMetadata) Snapshot() ClusterMetadataSnapshot {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Snapshot")
+	ret0, _ := ret[0].(ClusterMetadataSnapshot)
+	return ret0
+}
+
+// Snapshot indicates an expected call of Snapshot.
+func (mr *MockMetadataMockRecorder) Snapshot() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Snapshot", reflect.TypeOf((*MockMetadata)(nil).Snapshot))
+}
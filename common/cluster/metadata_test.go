@@ -0,0 +1,261 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cluster
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+)
+
+type (
+	metadataSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		metadata Metadata
+	}
+)
+
+const (
+	testFailoverVersionIncrement = int64(100)
+	testClusterA                 = "cluster-a"
+	testClusterB                 = "cluster-b"
+	testClusterC                 = "cluster-c"
+)
+
+func TestMetadataSuite(t *testing.T) {
+	s := new(metadataSuite)
+	suite.Run(t, s)
+}
+
+func (s *metadataSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.metadata = NewMetadata(
+		true,
+		testFailoverVersionIncrement,
+		testClusterA,
+		testClusterA,
+		map[string]config.ClusterInformation{
+			testClusterA: {Enabled: true, InitialFailoverVersion: 1, RPCAddress: "127.0.0.1:7233"},
+			testClusterB: {Enabled: true, InitialFailoverVersion: 2, RPCAddress: "127.0.0.1:8233"},
+			testClusterC: {Enabled: false, InitialFailoverVersion: 3},
+		},
+		metrics.NewNoopMetricsClient(),
+		log.NewNoopLogger(),
+	)
+}
+
+func (s *metadataSuite) TestGetClusterNameForFailoverVersion_InitialVersion() {
+	clusterName, err := s.metadata.GetClusterNameForFailoverVersion(1)
+	s.NoError(err)
+	s.Equal(testClusterA, clusterName)
+
+	clusterName, err = s.metadata.GetClusterNameForFailoverVersion(2)
+	s.NoError(err)
+	s.Equal(testClusterB, clusterName)
+
+	clusterName, err = s.metadata.GetClusterNameForFailoverVersion(common.EmptyVersion)
+	s.NoError(err)
+	s.Equal(testClusterA, clusterName)
+}
+
+func (s *metadataSuite) TestGetClusterNameForFailoverVersion_MultiCluster() {
+	testCases := []struct {
+		version     int64
+		clusterName string
+	}{
+		{version: 101, clusterName: testClusterA},
+		{version: 1001, clusterName: testClusterA},
+		{version: 102, clusterName: testClusterB},
+		{version: 902, clusterName: testClusterB},
+		{version: 203, clusterName: testClusterC},
+	}
+
+	for _, tc := range testCases {
+		clusterName, err := s.metadata.GetClusterNameForFailoverVersion(tc.version)
+		s.NoError(err)
+		s.Equal(tc.clusterName, clusterName, "version %v", tc.version)
+		s.True(s.metadata.IsVersionFromCluster(tc.version, tc.clusterName))
+		s.Equal(tc.clusterName, s.metadata.ClusterNameForFailoverVersion(tc.version))
+	}
+
+	s.False(s.metadata.IsVersionFromCluster(101, testClusterB))
+	s.False(s.metadata.IsVersionFromCluster(102, testClusterA))
+	s.False(s.metadata.IsVersionFromCluster(101, "unknown-cluster"))
+}
+
+func (s *metadataSuite) TestGetClusterNameForFailoverVersion_Unknown() {
+	for _, version := range []int64{4, 99, 100, 205, -1} {
+		_, err := s.metadata.GetClusterNameForFailoverVersion(version)
+		s.Error(err, "version %v", version)
+		s.False(s.metadata.IsVersionFromCluster(version, testClusterA))
+	}
+
+	s.Panics(func() { s.metadata.ClusterNameForFailoverVersion(4) })
+}
+
+func (s *metadataSuite) TestRegisterCluster() {
+	err := s.metadata.RegisterCluster("cluster-d", config.ClusterInformation{Enabled: true, InitialFailoverVersion: 4, RPCAddress: "127.0.0.1:9233"})
+	s.NoError(err)
+	s.Contains(s.metadata.GetAllClusterInfo(), "cluster-d")
+
+	clusterName, err := s.metadata.GetClusterNameForFailoverVersion(104)
+	s.NoError(err)
+	s.Equal("cluster-d", clusterName)
+}
+
+func (s *metadataSuite) TestRegisterCluster_CollidingVersionRejected() {
+	err := s.metadata.RegisterCluster("cluster-d", config.ClusterInformation{Enabled: true, InitialFailoverVersion: 2, RPCAddress: "127.0.0.1:9233"})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	err = s.metadata.RegisterCluster("cluster-d", config.ClusterInformation{Enabled: true, InitialFailoverVersion: testFailoverVersionIncrement + 2, RPCAddress: "127.0.0.1:9233"})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	err = s.metadata.RegisterCluster(testClusterB, config.ClusterInformation{Enabled: true, InitialFailoverVersion: 5, RPCAddress: "127.0.0.1:9233"})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+
+	s.NotContains(s.metadata.GetAllClusterInfo(), "cluster-d")
+	clusterName, err := s.metadata.GetClusterNameForFailoverVersion(2)
+	s.NoError(err)
+	s.Equal(testClusterB, clusterName)
+}
+
+func (s *metadataSuite) TestDeregisterCluster() {
+	s.NoError(s.metadata.DeregisterCluster(testClusterB))
+	s.NotContains(s.metadata.GetAllClusterInfo(), testClusterB)
+	_, err := s.metadata.GetClusterNameForFailoverVersion(2)
+	s.Error(err)
+
+	s.IsType(&serviceerror.NotFound{}, s.metadata.DeregisterCluster(testClusterB))
+
+	// the version of a deregistered cluster can be reused
+	s.NoError(s.metadata.RegisterCluster("cluster-d", config.ClusterInformation{InitialFailoverVersion: 2}))
+}
+
+func (s *metadataSuite) TestDeregisterCluster_CurrentClusterForbidden() {
+	err := s.metadata.DeregisterCluster(testClusterA)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+	s.Contains(s.metadata.GetAllClusterInfo(), testClusterA)
+}
+
+func (s *metadataSuite) TestSnapshot() {
+	snapshot := s.metadata.Snapshot()
+	s.True(snapshot.IsGlobalNamespaceEnabled())
+	s.Equal(testFailoverVersionIncrement, snapshot.GetFailoverVersionIncrement())
+	s.Equal(testClusterA, snapshot.GetMasterClusterName())
+	s.Equal(testClusterA, snapshot.GetCurrentClusterName())
+	s.Equal(s.metadata.GetAllClusterInfo(), snapshot.GetAllClusterInfo())
+
+	// later changes are not visible in an existing snapshot
+	s.NoError(s.metadata.DeregisterCluster(testClusterB))
+	s.Contains(snapshot.GetAllClusterInfo(), testClusterB)
+	clusterName, err := snapshot.GetClusterNameForFailoverVersion(102)
+	s.NoError(err)
+	s.Equal(testClusterB, clusterName)
+
+	// mutating the returned cluster info does not change the snapshot
+	snapshot.GetAllClusterInfo()["cluster-d"] = config.ClusterInformation{}
+	s.NotContains(snapshot.GetAllClusterInfo(), "cluster-d")
+}
+
+func (s *metadataSuite) TestSnapshot_ConsistentUnderConcurrentMutation() {
+	const iterations = 1000
+	info := config.ClusterInformation{Enabled: true, InitialFailoverVersion: 4, RPCAddress: "127.0.0.1:9233"}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < iterations; i++ {
+			s.NoError(s.metadata.RegisterCluster("cluster-d", info))
+			s.NoError(s.metadata.DeregisterCluster("cluster-d"))
+		}
+	}()
+
+	for i := 0; i < iterations; i++ {
+		snapshot := s.metadata.Snapshot()
+		allClusterInfo := snapshot.GetAllClusterInfo()
+		_, registered := allClusterInfo["cluster-d"]
+		clusterName, err := snapshot.GetClusterNameForFailoverVersion(info.InitialFailoverVersion)
+		if registered {
+			s.Len(allClusterInfo, 4)
+			s.NoError(err)
+			s.Equal("cluster-d", clusterName)
+		} else {
+			s.Len(allClusterInfo, 3)
+			s.Error(err)
+		}
+		s.Equal(testClusterA, snapshot.GetCurrentClusterName())
+		s.True(snapshot.IsGlobalNamespaceEnabled())
+	}
+	wg.Wait()
+}
+
+func (s *metadataSuite) TestNewMetadata_LogsFailoverVersions() {
+	controller := gomock.NewController(s.T())
+	defer controller.Finish()
+
+	logged := make(map[string]map[string]interface{})
+	logger := log.NewMockLogger(controller)
+	logger.EXPECT().Info("Cluster failover version", gomock.Any()).Do(func(_ string, tags ...tag.Tag) {
+		fields := make(map[string]interface{}, len(tags))
+		for _, t := range tags {
+			fields[t.Key()] = t.Value()
+		}
+		logged[fields["cluster-name"].(string)] = fields
+	}).Times(3)
+
+	NewMetadata(
+		true,
+		testFailoverVersionIncrement,
+		testClusterA,
+		testClusterB,
+		map[string]config.ClusterInformation{
+			testClusterA: {Enabled: true, InitialFailoverVersion: 1, RPCAddress: "127.0.0.1:7233"},
+			testClusterB: {Enabled: true, InitialFailoverVersion: 2, RPCAddress: "127.0.0.1:8233"},
+			testClusterC: {Enabled: false, InitialFailoverVersion: 3},
+		},
+		metrics.NewNoopMetricsClient(),
+		logger,
+	)
+
+	for clusterName, initialVersion := range map[string]int64{testClusterA: 1, testClusterB: 2, testClusterC: 3} {
+		s.Contains(logged, clusterName)
+		s.Equal(initialVersion, logged[clusterName]["xdc-initial-failover-version"])
+		s.Equal(testFailoverVersionIncrement, logged[clusterName]["xdc-failover-version-increment"])
+	}
+}
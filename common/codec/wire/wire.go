@@ -0,0 +1,178 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package wire walks the protobuf wire format without decoding messages into structs.
+package wire
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+const (
+	// WireTypeVarint is the wire type of int32, int64, uint32, uint64, sint32, sint64, bool and enum fields.
+	WireTypeVarint = 0
+	// WireTypeFixed64 is the wire type of fixed64, sfixed64 and double fields.
+	WireTypeFixed64 = 1
+	// WireTypeBytes is the wire type of string, bytes, embedded message and packed repeated fields.
+	WireTypeBytes = 2
+	// WireTypeStartGroup is the wire type of a (deprecated) group start.
+	WireTypeStartGroup = 3
+	// WireTypeEndGroup is the wire type of a (deprecated) group end.
+	WireTypeEndGroup = 4
+	// WireTypeFixed32 is the wire type of fixed32, sfixed32 and float fields.
+	WireTypeFixed32 = 5
+)
+
+type (
+	// Field is a single top level field of a message in wire format.
+	Field struct {
+		Number   int32
+		WireType int
+		// Value is the payload of length-delimited field, or the raw encoded value for other wire types.
+		Value []byte
+	}
+
+	// Reader iterates over top level fields of a message in wire format.
+	Reader struct {
+		data  []byte
+		index int
+	}
+)
+
+var (
+	// ErrIntOverflow is returned when a varint is longer than 64 bits.
+	ErrIntOverflow = errors.New("proto: integer overflow")
+	// ErrUnexpectedEndOfGroup is returned when a group end has no matching group start.
+	ErrUnexpectedEndOfGroup = errors.New("proto: unexpected end of group")
+)
+
+// NewReader creates a new Reader over data.
+func NewReader(data []byte) *Reader {
+	return &Reader{data: data}
+}
+
+// Next returns the next field, or io.EOF when all fields have been read.
+// Byte slices of returned field are not copied and reference the underlying data.
+func (r *Reader) Next() (Field, error) {
+	if r.index >= len(r.data) {
+		return Field{}, io.EOF
+	}
+
+	tag, n, err := decodeVarint(r.data[r.index:])
+	if err != nil {
+		return Field{}, err
+	}
+	number := int32(tag >> 3)
+	wireType := int(tag & 0x7)
+	if wireType == WireTypeEndGroup {
+		return Field{}, ErrUnexpectedEndOfGroup
+	}
+	if number <= 0 {
+		return Field{}, fmt.Errorf("proto: illegal tag %d (wire type %d)", number, wireType)
+	}
+
+	start := r.index + n
+	length, err := SkipValue(r.data[start:], wireType)
+	if err != nil {
+		return Field{}, err
+	}
+	r.index = start + length
+
+	value := r.data[start:r.index]
+	if wireType == WireTypeBytes {
+		_, n, _ := decodeVarint(value)
+		value = value[n:]
+	}
+	return Field{Number: number, WireType: wireType, Value: value}, nil
+}
+
+// SkipValue returns the length of the encoded value of given wire type at the beginning of data.
+// For groups data must start right after the group start tag and the returned length includes the group end tag.
+func SkipValue(data []byte, wireType int) (int, error) {
+	switch wireType {
+	case WireTypeVarint:
+		_, n, err := decodeVarint(data)
+		return n, err
+	case WireTypeFixed64:
+		if len(data) < 8 {
+			return 0, io.ErrUnexpectedEOF
+		}
+		return 8, nil
+	case WireTypeBytes:
+		length, n, err := decodeVarint(data)
+		if err != nil {
+			return 0, err
+		}
+		if length > uint64(len(data)-n) {
+			return 0, io.ErrUnexpectedEOF
+		}
+		return n + int(length), nil
+	case WireTypeStartGroup:
+		index := 0
+		for {
+			tag, n, err := decodeVarint(data[index:])
+			if err != nil {
+				return 0, err
+			}
+			index += n
+			if int(tag&0x7) == WireTypeEndGroup {
+				return index, nil
+			}
+			length, err := SkipValue(data[index:], int(tag&0x7))
+			if err != nil {
+				return 0, err
+			}
+			index += length
+		}
+	case WireTypeEndGroup:
+		return 0, ErrUnexpectedEndOfGroup
+	case WireTypeFixed32:
+		if len(data) < 4 {
+			return 0, io.ErrUnexpectedEOF
+		}
+		return 4, nil
+	default:
+		return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+	}
+}
+
+func decodeVarint(data []byte) (uint64, int, error) {
+	var x uint64
+	for shift, index := uint(0), 0; ; shift += 7 {
+		if shift >= 64 {
+			return 0, 0, ErrIntOverflow
+		}
+		if index >= len(data) {
+			return 0, 0, io.ErrUnexpectedEOF
+		}
+		b := data[index]
+		index++
+		x |= uint64(b&0x7F) << shift
+		if b < 0x80 {
+			return x, index, nil
+		}
+	}
+}
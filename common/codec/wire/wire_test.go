@@ -0,0 +1,120 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package wire
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	historyspb "go.temporal.io/server/api/history/v1"
+)
+
+type (
+	wireSuite struct {
+		suite.Suite
+	}
+)
+
+func TestWireSuite(t *testing.T) {
+	s := new(wireSuite)
+	suite.Run(t, s)
+}
+
+func (s *wireSuite) TestReader() {
+	history := &historyspb.VersionHistory{
+		BranchToken: []byte("some random branch token"),
+		Items: []*historyspb.VersionHistoryItem{
+			{EventId: 3, Version: 0},
+			{EventId: 6, Version: 4},
+		},
+	}
+	data, err := history.Marshal()
+	s.NoError(err)
+
+	reader := NewReader(data)
+	field, err := reader.Next()
+	s.NoError(err)
+	s.Equal(Field{Number: 1, WireType: WireTypeBytes, Value: history.BranchToken}, field)
+
+	for _, item := range history.Items {
+		field, err = reader.Next()
+		s.NoError(err)
+		s.Equal(int32(2), field.Number)
+		s.Equal(WireTypeBytes, field.WireType)
+		decoded := &historyspb.VersionHistoryItem{}
+		s.NoError(decoded.Unmarshal(field.Value))
+		s.Equal(item, decoded)
+	}
+
+	_, err = reader.Next()
+	s.Equal(io.EOF, err)
+}
+
+func (s *wireSuite) TestReader_AllWireTypes() {
+	data := []byte{
+		0x08, 0x96, 0x01, // field 1, varint 150
+		0x11, 1, 2, 3, 4, 5, 6, 7, 8, // field 2, fixed64
+		0x1a, 0x02, 'h', 'i', // field 3, bytes
+		0x23, 0x08, 0x01, 0x24, // field 4, group containing field 1 varint 1
+		0x2d, 1, 2, 3, 4, // field 5, fixed32
+	}
+
+	reader := NewReader(data)
+	expected := []Field{
+		{Number: 1, WireType: WireTypeVarint, Value: []byte{0x96, 0x01}},
+		{Number: 2, WireType: WireTypeFixed64, Value: []byte{1, 2, 3, 4, 5, 6, 7, 8}},
+		{Number: 3, WireType: WireTypeBytes, Value: []byte("hi")},
+		{Number: 4, WireType: WireTypeStartGroup, Value: []byte{0x08, 0x01, 0x24}},
+		{Number: 5, WireType: WireTypeFixed32, Value: []byte{1, 2, 3, 4}},
+	}
+	for _, expectedField := range expected {
+		field, err := reader.Next()
+		s.NoError(err)
+		s.Equal(expectedField, field)
+	}
+	_, err := reader.Next()
+	s.Equal(io.EOF, err)
+}
+
+func (s *wireSuite) TestReader_Malformed() {
+	testCases := map[string][]byte{
+		"truncated varint":     {0x08, 0x96},
+		"truncated fixed64":    {0x11, 1, 2, 3},
+		"truncated bytes":      {0x1a, 0x05, 'h', 'i'},
+		"unterminated group":   {0x23, 0x08, 0x01},
+		"unexpected group end": {0x24},
+		"truncated fixed32":    {0x2d, 1, 2},
+		"illegal wire type":    {0x0e},
+		"illegal tag":          {0x00},
+		"varint overflow":      {0x08, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01},
+	}
+
+	for name, data := range testCases {
+		_, err := NewReader(data).Next()
+		s.Error(err, name)
+	}
+}
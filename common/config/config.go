@@ -207,6 +207,8 @@ type (
 		// This is generally used when BindOnIP would be the same across several nodes (ie: 0.0.0.0)
 		// and for nat traversal scenarios. Check net.ParseIP for supported syntax, only IPv4 is supported.
 		BroadcastAddress string `yaml:"broadcastAddress"`
+		// HashFunc places keys onto the members of each service ring, one of ringpop (default) or rendezvous
+		HashFunc string `yaml:"hashFunc"`
 	}
 
 	// Persistence contains the configuration for data store / persistence layer
@@ -226,6 +228,11 @@ type (
 		VisibilityConfig *VisibilityConfig `yaml:"-" json:"-"`
 		// TransactionSizeLimit is the largest allowed transaction size
 		TransactionSizeLimit dynamicconfig.IntPropertyFn `yaml:"-" json:"-"`
+		// MutableStateSizeLimit is the largest allowed mutable state size
+		MutableStateSizeLimit dynamicconfig.IntPropertyFn `yaml:"-" json:"-"`
+		// RetryMaxAttempts is the max attempts of persistence calls which can be retried, retries are disabled
+		// when it is nil or not positive
+		RetryMaxAttempts dynamicconfig.IntPropertyFn `yaml:"-" json:"-"`
 	}
 
 	// DataStore is the configuration for a single datastore
@@ -257,6 +264,8 @@ type (
 		// ESProcessorAckTimeout is the timeout that store will wait to get ack signal from ES processor.
 		// Should be at least ESProcessorFlushInterval+<time to process request>.
 		ESProcessorAckTimeout dynamicconfig.DurationPropertyFn `yaml:"-" json:"-"`
+		// ESVisibilityRequestTimeout is the timeout for a single ElasticSearch visibility call.
+		ESVisibilityRequestTimeout dynamicconfig.DurationPropertyFn `yaml:"-" json:"-"`
 	}
 
 	// Cassandra contains configuration to connect to Cassandra cluster
@@ -420,11 +429,15 @@ type (
 	FilestoreArchiver struct {
 		FileMode string `yaml:"fileMode"`
 		DirMode  string `yaml:"dirMode"`
+		// ChecksumAlgorithm is used to checksum archived histories, one of crc32c (default) or sha256
+		ChecksumAlgorithm string `yaml:"checksumAlgorithm"`
 	}
 
 	// GstorageArchiver contain the config for google storage archiver
 	GstorageArchiver struct {
 		CredentialsPath string `yaml:"credentialsPath"`
+		// UploadConcurrency is the maximum number of history blobs uploaded concurrently, defaults to 1
+		UploadConcurrency int `yaml:"uploadConcurrency"`
 	}
 
 	// S3Archiver contains the config for S3 archiver
@@ -478,6 +491,18 @@ type (
 		Authorizer string `yaml:"authorizer"`
 		// Empty string for noopClaimMapper or "default" for defaultJWTClaimMapper
 		ClaimMapper string `yaml:"claimMapper"`
+		// Token services present to each other on internode calls
+		InternodeAuth InternodeAuth `yaml:"internodeAuth"`
+	}
+
+	// InternodeAuth contains the cluster-internal token used to authenticate internode calls
+	InternodeAuth struct {
+		// Token is attached to every outbound internode call and required on inbound ones.
+		// Empty string disables internode token authentication
+		Token string `yaml:"token"`
+		// PreviousTokens are still accepted on inbound calls so the token can be rotated
+		// without downtime: deploy the new token with the old one listed here first
+		PreviousTokens []string `yaml:"previousTokens"`
 	}
 
 	// @@@SNIPSTART temporal-common-service-config-jwtkeyprovider
@@ -0,0 +1,133 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+)
+
+// Validate checks cfg and returns every problem found instead of stopping at the first one,
+// so a config can be verified before it is rolled out. Datastores are not contacted.
+func Validate(cfg *Config) []error {
+	var errs []error
+	errs = append(errs, validatePersistence(&cfg.Persistence)...)
+	errs = append(errs, validateClusterMetadata(cfg.ClusterMetadata)...)
+	errs = append(errs, validateArchival(&cfg.Archival, &cfg.NamespaceDefaults.Archival)...)
+	return errs
+}
+
+func validatePersistence(c *Persistence) []error {
+	var errs []error
+	if c.NumHistoryShards <= 0 {
+		errs = append(errs, fmt.Errorf("persistence config: numHistoryShards must be positive, got %v", c.NumHistoryShards))
+	}
+	if err := c.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+func validateClusterMetadata(c *ClusterMetadata) []error {
+	if c == nil {
+		return []error{errors.New("cluster metadata config: missing")}
+	}
+
+	var errs []error
+	if c.FailoverVersionIncrement <= 0 {
+		errs = append(errs, fmt.Errorf("cluster metadata config: failoverVersionIncrement must be positive, got %v", c.FailoverVersionIncrement))
+	}
+	if len(c.ClusterInformation) == 0 {
+		errs = append(errs, errors.New("cluster metadata config: clusterInformation is empty"))
+	}
+	if c.CurrentClusterName == "" {
+		errs = append(errs, errors.New("cluster metadata config: currentClusterName is empty"))
+	} else if _, ok := c.ClusterInformation[c.CurrentClusterName]; !ok {
+		errs = append(errs, fmt.Errorf("cluster metadata config: current cluster %q is not in clusterInformation", c.CurrentClusterName))
+	}
+	if c.MasterClusterName == "" {
+		errs = append(errs, errors.New("cluster metadata config: masterClusterName is empty"))
+	} else if _, ok := c.ClusterInformation[c.MasterClusterName]; !ok {
+		errs = append(errs, fmt.Errorf("cluster metadata config: master cluster %q is not in clusterInformation", c.MasterClusterName))
+	}
+
+	clusterNames := make([]string, 0, len(c.ClusterInformation))
+	for clusterName := range c.ClusterInformation {
+		clusterNames = append(clusterNames, clusterName)
+	}
+	sort.Strings(clusterNames)
+
+	versionToClusterName := make(map[int64]string, len(clusterNames))
+	for _, clusterName := range clusterNames {
+		info := c.ClusterInformation[clusterName]
+		if clusterName == "" {
+			errs = append(errs, errors.New("cluster metadata config: cluster name is empty"))
+		}
+		if info.InitialFailoverVersion <= 0 || info.InitialFailoverVersion >= c.FailoverVersionIncrement {
+			errs = append(errs, fmt.Errorf("cluster metadata config: cluster %q: initialFailoverVersion %v must be in range [1, %v)",
+				clusterName, info.InitialFailoverVersion, c.FailoverVersionIncrement))
+		}
+		if other, ok := versionToClusterName[info.InitialFailoverVersion]; ok {
+			errs = append(errs, fmt.Errorf("cluster metadata config: clusters %q and %q have the same initialFailoverVersion %v",
+				other, clusterName, info.InitialFailoverVersion))
+		} else {
+			versionToClusterName[info.InitialFailoverVersion] = clusterName
+		}
+		if info.Enabled && info.RPCAddress == "" {
+			errs = append(errs, fmt.Errorf("cluster metadata config: cluster %q: rpcAddress is empty", clusterName))
+		}
+	}
+	return errs
+}
+
+func validateArchival(a *Archival, namespaceDefaults *ArchivalNamespaceDefaults) []error {
+	var errs []error
+	if err := a.Validate(namespaceDefaults); err != nil {
+		errs = append(errs, fmt.Errorf("archival config: %s", err.Error()))
+	}
+	if err := validateArchivalURI(namespaceDefaults.History.URI); err != nil {
+		errs = append(errs, fmt.Errorf("archival config: namespace default history URI: %s", err.Error()))
+	}
+	if err := validateArchivalURI(namespaceDefaults.Visibility.URI); err != nil {
+		errs = append(errs, fmt.Errorf("archival config: namespace default visibility URI: %s", err.Error()))
+	}
+	return errs
+}
+
+func validateArchivalURI(uri string) error {
+	if uri == "" {
+		return nil
+	}
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return err
+	}
+	if parsed.Scheme == "" {
+		return fmt.Errorf("%q has no scheme", uri)
+	}
+	return nil
+}
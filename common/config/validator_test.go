@@ -0,0 +1,140 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func loadValidTestConfig(t *testing.T) *Config {
+	var cfg Config
+	require.NoError(t, Load("", "../../config", "", &cfg))
+	return &cfg
+}
+
+func TestValidate_ValidConfig(t *testing.T) {
+	require.Empty(t, Validate(loadValidTestConfig(t)))
+}
+
+func TestValidate_BrokenConfigs(t *testing.T) {
+	testCases := []struct {
+		name     string
+		breakCfg func(cfg *Config)
+		expected []string
+	}{
+		{
+			name: "no history shards",
+			breakCfg: func(cfg *Config) {
+				cfg.Persistence.NumHistoryShards = 0
+			},
+			expected: []string{"numHistoryShards must be positive"},
+		},
+		{
+			name: "missing default store",
+			breakCfg: func(cfg *Config) {
+				cfg.Persistence.DefaultStore = "missing"
+			},
+			expected: []string{`missing config for datastore "missing"`},
+		},
+		{
+			name: "missing cluster metadata",
+			breakCfg: func(cfg *Config) {
+				cfg.ClusterMetadata = nil
+			},
+			expected: []string{"cluster metadata config: missing"},
+		},
+		{
+			name: "current cluster not in cluster info",
+			breakCfg: func(cfg *Config) {
+				cfg.ClusterMetadata.CurrentClusterName = "unknown"
+			},
+			expected: []string{`current cluster "unknown" is not in clusterInformation`},
+		},
+		{
+			name: "failover version collision",
+			breakCfg: func(cfg *Config) {
+				info := cfg.ClusterMetadata.ClusterInformation[cfg.ClusterMetadata.CurrentClusterName]
+				cfg.ClusterMetadata.ClusterInformation["other"] = ClusterInformation{
+					Enabled:                true,
+					InitialFailoverVersion: info.InitialFailoverVersion,
+					RPCAddress:             "other:7233",
+				}
+			},
+			expected: []string{"have the same initialFailoverVersion"},
+		},
+		{
+			name: "failover version out of range",
+			breakCfg: func(cfg *Config) {
+				cfg.ClusterMetadata.ClusterInformation["other"] = ClusterInformation{
+					InitialFailoverVersion: cfg.ClusterMetadata.FailoverVersionIncrement,
+				}
+			},
+			expected: []string{`cluster "other": initialFailoverVersion`},
+		},
+		{
+			name: "archival URI without scheme",
+			breakCfg: func(cfg *Config) {
+				cfg.NamespaceDefaults.Archival.History.URI = "/tmp/temporal_archival/development"
+			},
+			expected: []string{"namespace default history URI"},
+		},
+		{
+			name: "archival enabled without provider",
+			breakCfg: func(cfg *Config) {
+				cfg.Archival.Visibility.Provider = nil
+			},
+			expected: []string{"Invalid visibility archival config"},
+		},
+		{
+			name: "several problems at once",
+			breakCfg: func(cfg *Config) {
+				cfg.Persistence.NumHistoryShards = -1
+				cfg.ClusterMetadata.MasterClusterName = ""
+				cfg.NamespaceDefaults.Archival.Visibility.URI = "no-scheme"
+			},
+			expected: []string{
+				"numHistoryShards must be positive",
+				"masterClusterName is empty",
+				"namespace default visibility URI",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := loadValidTestConfig(t)
+			tc.breakCfg(cfg)
+
+			errs := Validate(cfg)
+			require.Len(t, errs, len(tc.expected), "%v", errs)
+			for i, expected := range tc.expected {
+				require.True(t, strings.Contains(errs[i].Error(), expected), "%q does not contain %q", errs[i].Error(), expected)
+			}
+		})
+	}
+}
@@ -110,6 +110,8 @@ const (
 const (
 	// DefaultTransactionSizeLimit is the largest allowed transaction size to persistence
 	DefaultTransactionSizeLimit = 4 * 1024 * 1024
+	// DefaultMutableStateSizeLimit is the largest allowed mutable state size to persistence
+	DefaultMutableStateSizeLimit = 16 * 1024 * 1024
 )
 
 // enum for dynamic config AdvancedVisibilityWritingMode
@@ -25,6 +25,8 @@
 package dynamicconfig
 
 import (
+	"bytes"
+	"encoding/json"
 	"reflect"
 	"sync"
 	"sync/atomic"
@@ -58,6 +60,9 @@ type Collection struct {
 	logger   log.Logger
 	keys     *sync.Map // map of config Key to strongly typed value
 	errCount int64
+
+	featureFlagsOnce sync.Once
+	featureFlags     CompositePropertyFn
 }
 
 func (c *Collection) logError(key Key, err error) {
@@ -131,6 +136,13 @@ type StringPropertyFn func(opts ...FilterOption) string
 // MapPropertyFn is a wrapper to get map property from dynamic config
 type MapPropertyFn func(opts ...FilterOption) map[string]interface{}
 
+// CompositePropertyFn is a wrapper to get composite property from dynamic config,
+// the returned value is an immutable snapshot of all its fields
+type CompositePropertyFn func(opts ...FilterOption) interface{}
+
+// CompositePropertyParser converts the map value of a composite property to an immutable value
+type CompositePropertyParser func(value map[string]interface{}) (interface{}, error)
+
 // StringPropertyFnWithNamespaceFilter is a wrapper to get string property from dynamic config
 type StringPropertyFnWithNamespaceFilter func(namespace string) string
 
@@ -428,6 +440,67 @@ func (c *Collection) GetMapProperty(key Key, defaultValue map[string]interface{}
 	}
 }
 
+// GetCompositeProperty gets a property made of several interdependent fields, e.g. a rate and its burst.
+// The map value of the property is converted by parse and the result is swapped atomically, so that
+// readers always see all fields of the same config version instead of reading them one by one.
+// parse must not mutate its input and callers must not mutate the returned value.
+func (c *Collection) GetCompositeProperty(key Key, defaultValue interface{}, parse CompositePropertyParser) CompositePropertyFn {
+	type snapshot struct {
+		raw   map[string]interface{}
+		value interface{}
+	}
+	var current atomic.Value // *snapshot
+
+	return func(opts ...FilterOption) interface{} {
+		raw, err := c.client.GetMapValue(key, getFilterMap(opts...), nil)
+		if err != nil {
+			c.logError(key, err)
+		}
+		if raw == nil {
+			return defaultValue
+		}
+		if s, ok := current.Load().(*snapshot); ok && reflect.DeepEqual(s.raw, raw) {
+			return s.value
+		}
+
+		value, err := parse(raw)
+		if err != nil {
+			// the default is kept until the value changes, so that the error is logged once
+			c.logger.Warn("Invalid dynamic config value, using default", tag.Key(key.String()), tag.Value(raw), tag.Error(err))
+			value = defaultValue
+		} else {
+			c.logValue(key, raw, defaultValue, reflect.DeepEqual)
+		}
+		current.Store(&snapshot{raw: raw, value: value})
+		return value
+	}
+}
+
+// GetTypedMapProperty gets a map property decoded into a new value of the struct type defaultValue points to.
+// Map keys are matched to struct fields as encoding/json does and unknown keys are rejected, the decoded value
+// is then checked by validate unless it is nil. defaultValue is returned when the map can't be decoded or is invalid.
+func (c *Collection) GetTypedMapProperty(key Key, defaultValue interface{}, validate func(interface{}) error) CompositePropertyFn {
+	targetType := reflect.TypeOf(defaultValue).Elem()
+	return c.GetCompositeProperty(key, defaultValue, func(value map[string]interface{}) (interface{}, error) {
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		target := reflect.New(targetType).Interface()
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(target); err != nil {
+			return nil, err
+		}
+		if validate != nil {
+			if err := validate(target); err != nil {
+				return nil, err
+			}
+		}
+		return target, nil
+	})
+}
+
 // GetStringPropertyFnWithNamespaceFilter gets property with namespace filter and asserts that its namespace
 func (c *Collection) GetStringPropertyFnWithNamespaceFilter(key Key, defaultValue string) StringPropertyFnWithNamespaceFilter {
 	return func(namespace string) string {
@@ -27,6 +27,7 @@ package dynamicconfig
 import (
 	"errors"
 	"fmt"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -48,7 +49,12 @@ func newInMemoryClient() *inMemoryClient {
 }
 
 func (mc *inMemoryClient) SetValue(key Key, value interface{}) {
-	v := mc.globalValues.Load().(map[Key]interface{})
+	// copy on write, so that values can be set while being read
+	current := mc.globalValues.Load().(map[Key]interface{})
+	v := make(map[Key]interface{}, len(current)+1)
+	for k, val := range current {
+		v[k] = val
+	}
 	v[key] = value
 	mc.globalValues.Store(v)
 }
@@ -255,6 +261,110 @@ func (s *configSuite) TestGetMapProperty() {
 	s.Equal("321", value()["testKey"])
 }
 
+type testRateConfig struct {
+	rps   int
+	burst int
+}
+
+func parseTestRateConfig(value map[string]interface{}) (interface{}, error) {
+	rps, ok := value["rps"].(int)
+	if !ok {
+		return nil, errors.New("rps is not an int")
+	}
+	burst, ok := value["burst"].(int)
+	if !ok {
+		return nil, errors.New("burst is not an int")
+	}
+	return &testRateConfig{rps: rps, burst: burst}, nil
+}
+
+func (s *configSuite) TestGetCompositeProperty() {
+	key := testGetCompositePropertyKey
+	defaultValue := &testRateConfig{rps: 1, burst: 1}
+	value := s.cln.GetCompositeProperty(key, defaultValue, parseTestRateConfig)
+	s.Equal(defaultValue, value())
+
+	s.client.SetValue(key, map[string]interface{}{"rps": 10, "burst": 20})
+	snapshot := value()
+	s.Equal(&testRateConfig{rps: 10, burst: 20}, snapshot)
+	// unchanged config returns the same snapshot
+	s.True(snapshot == value())
+
+	s.client.SetValue(key, map[string]interface{}{"rps": "invalid", "burst": 20})
+	s.Equal(defaultValue, value())
+}
+
+func (s *configSuite) TestGetCompositeProperty_NoTornReads() {
+	key := testGetCompositePropertyKey
+	value := s.cln.GetCompositeProperty(key, &testRateConfig{}, parseTestRateConfig)
+	s.client.SetValue(key, map[string]interface{}{"rps": 0, "burst": 0})
+
+	var wg sync.WaitGroup
+	doneCh := make(chan struct{})
+	var tornReads int32
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-doneCh:
+					return
+				default:
+				}
+				// each config version sets burst to twice the rps
+				config := value().(*testRateConfig)
+				if config.burst != 2*config.rps {
+					atomic.AddInt32(&tornReads, 1)
+				}
+			}
+		}()
+	}
+	for i := 1; i <= 1000; i++ {
+		s.client.SetValue(key, map[string]interface{}{"rps": i, "burst": 2 * i})
+	}
+	close(doneCh)
+	wg.Wait()
+
+	s.Equal(int32(0), atomic.LoadInt32(&tornReads))
+	s.Equal(&testRateConfig{rps: 1000, burst: 2000}, value())
+}
+
+type testConcurrencyLimits struct {
+	Default      int            `json:"default"`
+	PerNamespace map[string]int `json:"perNamespace"`
+}
+
+func validateTestConcurrencyLimits(value interface{}) error {
+	if value.(*testConcurrencyLimits).Default <= 0 {
+		return errors.New("default must be positive")
+	}
+	return nil
+}
+
+func (s *configSuite) TestGetTypedMapProperty() {
+	key := testGetTypedMapPropertyKey
+	defaultValue := &testConcurrencyLimits{Default: 10}
+	value := s.cln.GetTypedMapProperty(key, defaultValue, validateTestConcurrencyLimits)
+	s.Equal(defaultValue, value())
+
+	s.client.SetValue(key, map[string]interface{}{
+		"default":      5,
+		"perNamespace": map[string]interface{}{"test-namespace": 20},
+	})
+	s.Equal(&testConcurrencyLimits{Default: 5, PerNamespace: map[string]int{"test-namespace": 20}}, value())
+
+	malformedValues := []map[string]interface{}{
+		{"default": "five"},
+		{"default": 5, "unknownField": 1},
+		{"default": 0},
+	}
+	for _, malformed := range malformedValues {
+		s.client.SetValue(key, malformed)
+		s.Equal(defaultValue, value())
+	}
+}
+
 func TestDynamicConfigKeyIsMapped(t *testing.T) {
 	for i := unknownKey; i < lastKeyForTest; i++ {
 		key, ok := Keys[i]
@@ -52,6 +52,8 @@ var Keys = map[Key]string{
 	testGetBoolPropertyKey:                            "testGetBoolPropertyKey",
 	testGetStringPropertyKey:                          "testGetStringPropertyKey",
 	testGetMapPropertyKey:                             "testGetMapPropertyKey",
+	testGetCompositePropertyKey:                       "testGetCompositePropertyKey",
+	testGetTypedMapPropertyKey:                        "testGetTypedMapPropertyKey",
 	testGetIntPropertyFilteredByNamespaceKey:          "testGetIntPropertyFilteredByNamespaceKey",
 	testGetDurationPropertyFilteredByNamespaceKey:     "testGetDurationPropertyFilteredByNamespaceKey",
 	testGetIntPropertyFilteredByTaskQueueInfoKey:      "testGetIntPropertyFilteredByTaskQueueInfoKey",
@@ -75,8 +77,11 @@ var Keys = map[Key]string{
 	EnableReadFromHistoryArchival:          "system.enableReadFromHistoryArchival",
 	VisibilityArchivalState:                "system.visibilityArchivalState",
 	EnableReadFromVisibilityArchival:       "system.enableReadFromVisibilityArchival",
+	ArchivalDryRun:                         "system.archivalDryRun",
 	EnableNamespaceNotActiveAutoForwarding: "system.enableNamespaceNotActiveAutoForwarding",
 	TransactionSizeLimit:                   "system.transactionSizeLimit",
+	MutableStateSizeLimit:                  "system.mutableStateSizeLimit",
+	PersistenceRetryMaxAttempts:            "system.persistenceRetryMaxAttempts",
 	DisallowQuery:                          "system.disallowQuery",
 	EnableBatcher:                          "worker.enableBatcher",
 	EnableParentClosePolicyWorker:          "system.enableParentClosePolicyWorker",
@@ -84,6 +89,22 @@ var Keys = map[Key]string{
 	EnablePriorityTaskProcessor:            "system.enablePriorityTaskProcessor",
 	EnableAuthorization:                    "system.enableAuthorization",
 	EnableCrossNamespaceCommands:           "system.enableCrossNamespaceCommands",
+	RPCClientMaxInflightCallsPerHost:       "system.rpcClientMaxInflightCallsPerHost",
+	RPCClientMaxIdleHostConnections:        "system.rpcClientMaxIdleHostConnections",
+	RPCClientTimeout:                       "client.timeout",
+	HistoryRPCClientTimeout:                "client.history.timeout",
+	MatchingRPCClientTimeout:               "client.matching.timeout",
+	FrontendRPCClientTimeout:               "client.frontend.timeout",
+	HistoryClientRetryMaximumAttempts:      "system.historyClientRetryMaximumAttempts",
+	MatchingClientRetryMaximumAttempts:     "system.matchingClientRetryMaximumAttempts",
+	FrontendClientRetryMaximumAttempts:     "system.frontendClientRetryMaximumAttempts",
+	HistoryClientRetryableCodes:            "system.historyClientRetryableCodes",
+	MatchingClientRetryableCodes:           "system.matchingClientRetryableCodes",
+	FrontendClientRetryableCodes:           "system.frontendClientRetryableCodes",
+	RuntimeMetricsReportInterval:           "system.runtimeMetricsReportInterval",
+	MembershipLeavePropagationDelay:        "system.membershipLeavePropagationDelay",
+	ThrottledLogPerKeyRPS:                  "system.throttledLogPerKeyRPS",
+	FeatureFlags:                           "system.featureFlags",
 
 	// size limit
 	BlobSizeLimitError:     "limit.blobSize.error",
@@ -102,6 +123,7 @@ var Keys = map[Key]string{
 	FrontendESVisibilityListMaxQPS:        "frontend.esVisibilityListMaxQPS",
 	FrontendMaxBadBinaries:                "frontend.maxBadBinaries",
 	FrontendESIndexMaxResultWindow:        "frontend.esIndexMaxResultWindow",
+	FrontendESVisibilityRequestTimeout:    "frontend.esVisibilityRequestTimeout",
 	FrontendHistoryMaxPageSize:            "frontend.historyMaxPageSize",
 	FrontendRPS:                           "frontend.rps",
 	FrontendMaxNamespaceRPSPerInstance:    "frontend.namespaceRPS",
@@ -157,8 +179,10 @@ var Keys = map[Key]string{
 	HistoryRPS:                                           "history.rps",
 	HistoryPersistenceMaxQPS:                             "history.persistenceMaxQPS",
 	HistoryPersistenceGlobalMaxQPS:                       "history.persistenceGlobalMaxQPS",
+	HistoryPersistencePerShardMaxQPS:                     "history.persistencePerShardMaxQPS",
 	HistoryVisibilityOpenMaxQPS:                          "history.historyVisibilityOpenMaxQPS",
 	HistoryVisibilityClosedMaxQPS:                        "history.historyVisibilityClosedMaxQPS",
+	HistoryESVisibilityRequestTimeout:                    "history.esVisibilityRequestTimeout",
 	HistoryLongPollExpirationInterval:                    "history.longPollExpirationInterval",
 	HistoryCacheInitialSize:                              "history.cacheInitialSize",
 	HistoryMaxAutoResetPoints:                            "history.historyMaxAutoResetPoints",
@@ -170,6 +194,7 @@ var Keys = map[Key]string{
 	EventsCacheTTL:                                       "history.eventsCacheTTL",
 	AcquireShardInterval:                                 "history.acquireShardInterval",
 	AcquireShardConcurrency:                              "history.acquireShardConcurrency",
+	ShardHandoffTimeout:                                  "history.shardHandoffTimeout",
 	StandbyClusterDelay:                                  "history.standbyClusterDelay",
 	StandbyTaskMissingEventsResendDelay:                  "history.standbyTaskMissingEventsResendDelay",
 	StandbyTaskMissingEventsDiscardDelay:                 "history.standbyTaskMissingEventsDiscardDelay",
@@ -334,6 +359,8 @@ const (
 	testGetBoolPropertyKey
 	testGetStringPropertyKey
 	testGetMapPropertyKey
+	testGetCompositePropertyKey
+	testGetTypedMapPropertyKey
 	testGetIntPropertyFilteredByNamespaceKey
 	testGetDurationPropertyFilteredByNamespaceKey
 	testGetIntPropertyFilteredByTaskQueueInfoKey
@@ -369,11 +396,19 @@ const (
 	VisibilityArchivalState
 	// EnableReadFromVisibilityArchival is key for enabling reading visibility from archival store
 	EnableReadFromVisibilityArchival
+	// ArchivalDryRun is key for running archival in dry-run mode, where archivers perform every step
+	// except the final write and report the size of the data that would have been written
+	ArchivalDryRun
 	// EnableNamespaceNotActiveAutoForwarding whether enabling DC auto forwarding to active cluster
 	// for signal / start / signal with start API if namespace is not active
 	EnableNamespaceNotActiveAutoForwarding
 	// TransactionSizeLimit is the largest allowed transaction size to persistence
 	TransactionSizeLimit
+	// MutableStateSizeLimit is the largest allowed mutable state size to persistence
+	MutableStateSizeLimit
+	// PersistenceRetryMaxAttempts is the max attempts of persistence reads and idempotent writes failing with
+	// transient errors, retries are disabled when it is not positive
+	PersistenceRetryMaxAttempts
 	// DisallowQuery is the key to disallow query for a namespace
 	DisallowQuery
 	// EnablePriorityTaskProcessor is the key for enabling priority task processor
@@ -382,6 +417,43 @@ const (
 	EnableAuthorization
 	// EnableCrossNamespaceCommands is the key to enable commands for external namespaces
 	EnableCrossNamespaceCommands
+	// RPCClientMaxInflightCallsPerHost is the max number of concurrent internode calls to a single destination host,
+	// calls past the limit wait for one to complete. 0 means no limit. It does not limit connections,
+	// internode clients always share a single connection per destination host
+	RPCClientMaxInflightCallsPerHost
+	// RPCClientMaxIdleHostConnections is the max number of destination hosts without in-flight internode calls
+	// whose connection is kept open, the least recently used ones are closed first. 0 means no limit
+	RPCClientMaxIdleHostConnections
+	// RPCClientTimeout is the default timeout of calls made by RPC clients, overriding each client's built-in default
+	RPCClientTimeout
+	// HistoryRPCClientTimeout is the timeout of calls made to history service, falling back to RPCClientTimeout
+	HistoryRPCClientTimeout
+	// MatchingRPCClientTimeout is the timeout of calls made to matching service, falling back to RPCClientTimeout
+	MatchingRPCClientTimeout
+	// FrontendRPCClientTimeout is the timeout of calls made to frontend service, falling back to RPCClientTimeout
+	FrontendRPCClientTimeout
+	// HistoryClientRetryMaximumAttempts is the max number of retries of failed calls to history service, 0 means no limit
+	HistoryClientRetryMaximumAttempts
+	// MatchingClientRetryMaximumAttempts is the max number of retries of failed calls to matching service, 0 means no limit
+	MatchingClientRetryMaximumAttempts
+	// FrontendClientRetryMaximumAttempts is the max number of retries of failed calls to frontend service, 0 means no limit
+	FrontendClientRetryMaximumAttempts
+	// HistoryClientRetryableCodes is the comma separated list of gRPC codes, e.g. UNAVAILABLE, of failed calls to history service which are retried on top of the transient service errors
+	HistoryClientRetryableCodes
+	// MatchingClientRetryableCodes is the comma separated list of gRPC codes, e.g. UNAVAILABLE, of failed calls to matching service which are retried on top of the transient service errors
+	MatchingClientRetryableCodes
+	// FrontendClientRetryableCodes is the comma separated list of gRPC codes, e.g. UNAVAILABLE, of failed calls to frontend service which are retried on top of the transient service errors
+	FrontendClientRetryableCodes
+	// RuntimeMetricsReportInterval is the interval at which go runtime metrics are reported
+	RuntimeMetricsReportInterval
+	// MembershipLeavePropagationDelay is how long a stopping host waits after leaving the membership ring
+	// before stopping its gRPC server, so peers stop routing to it
+	MembershipLeavePropagationDelay
+	// ThrottledLogPerKeyRPS is the rate limit on number of log messages with the same level and message
+	// emitted per second by throttled logger, the per message rate limit is disabled by default (0)
+	ThrottledLogPerKeyRPS
+	// FeatureFlags is the map of feature flag name to its rollout config, see Collection.IsFeatureEnabled
+	FeatureFlags
 	// BlobSizeLimitError is the per event blob size limit
 	BlobSizeLimitError
 	// BlobSizeLimitWarn is the per event blob size limit for warning
@@ -413,6 +485,8 @@ const (
 	FrontendESVisibilityListMaxQPS
 	// FrontendESIndexMaxResultWindow is ElasticSearch index setting max_result_window
 	FrontendESIndexMaxResultWindow
+	// FrontendESVisibilityRequestTimeout is the timeout for a single ElasticSearch visibility call
+	FrontendESVisibilityRequestTimeout
 	// FrontendHistoryMaxPageSize is default max size for GetWorkflowExecutionHistory in one page
 	FrontendHistoryMaxPageSize
 	// FrontendRPS is workflow rate limit per second
@@ -536,10 +610,14 @@ const (
 	HistoryPersistenceMaxQPS
 	// HistoryPersistenceGlobalMaxQPS is the max qps history cluster can query DB
 	HistoryPersistenceGlobalMaxQPS
+	// HistoryPersistencePerShardMaxQPS is the max qps each history shard can query execution persistence, 0 means no limit
+	HistoryPersistencePerShardMaxQPS
 	// HistoryVisibilityOpenMaxQPS is max qps one history host can write visibility open_executions
 	HistoryVisibilityOpenMaxQPS
 	// HistoryVisibilityClosedMaxQPS is max qps one history host can write visibility closed_executions
 	HistoryVisibilityClosedMaxQPS
+	// HistoryESVisibilityRequestTimeout is the timeout for a single ElasticSearch visibility call made by history
+	HistoryESVisibilityRequestTimeout
 	// HistoryLongPollExpirationInterval is the long poll expiration interval in the history service
 	HistoryLongPollExpirationInterval
 	// HistoryCacheInitialSize is initial size of history cache
@@ -560,6 +638,8 @@ const (
 	AcquireShardInterval
 	// AcquireShardConcurrency is number of goroutines that can be used to acquire shards in the shard controller.
 	AcquireShardConcurrency
+	// ShardHandoffTimeout is the max time a host waits for the previous owner to hand off a shard before acquiring it
+	ShardHandoffTimeout
 	// StandbyClusterDelay is the artificial delay added to standby cluster's view of active cluster's time
 	StandbyClusterDelay
 	// StandbyTaskMissingEventsResendDelay is the amount of time standby cluster's will wait (if events are missing)
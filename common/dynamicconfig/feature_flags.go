@@ -0,0 +1,91 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"github.com/dgryski/go-farm"
+)
+
+type (
+	// FeatureFlag is the rollout config of a feature flag, set under the FeatureFlags key, e.g.
+	//   system.featureFlags:
+	//     - value:
+	//         newTaskQueueScheduler:
+	//           enabled: true
+	//           rolloutPercentage: 10
+	//           denyNamespaces: [critical-namespace]
+	FeatureFlag struct {
+		// Enabled turns the feature on for namespaces which are not in AllowNamespaces or DenyNamespaces
+		Enabled bool `json:"enabled"`
+		// AllowNamespaces always have the feature on, even if it is disabled globally
+		AllowNamespaces []string `json:"allowNamespaces"`
+		// DenyNamespaces never have the feature on, they take precedence over AllowNamespaces
+		DenyNamespaces []string `json:"denyNamespaces"`
+		// RolloutPercentage limits an enabled feature to the given percentage of the namespaces, all of them when unset
+		RolloutPercentage *int `json:"rolloutPercentage"`
+	}
+
+	featureFlags map[string]*FeatureFlag
+)
+
+// IsFeatureEnabled returns whether the feature flag is on for namespace.
+// Flags missing from the FeatureFlags config are off.
+func (c *Collection) IsFeatureEnabled(flag string, namespace string) bool {
+	c.featureFlagsOnce.Do(func() {
+		c.featureFlags = c.GetTypedMapProperty(FeatureFlags, &featureFlags{}, nil)
+	})
+
+	featureFlag, ok := (*c.featureFlags().(*featureFlags))[flag]
+	if !ok || featureFlag == nil {
+		return false
+	}
+	return featureFlag.isEnabled(flag, namespace)
+}
+
+func (f *FeatureFlag) isEnabled(flag string, namespace string) bool {
+	for _, denied := range f.DenyNamespaces {
+		if denied == namespace {
+			return false
+		}
+	}
+	for _, allowed := range f.AllowNamespaces {
+		if allowed == namespace {
+			return true
+		}
+	}
+	if !f.Enabled {
+		return false
+	}
+	if f.RolloutPercentage == nil {
+		return true
+	}
+	return int(rolloutBucket(flag, namespace)) < *f.RolloutPercentage
+}
+
+// rolloutBucket returns the stable bucket, in [0, 100), of namespace for flag.
+// The flag name is part of the hash so that different flags are not rolled out to the same namespaces first.
+func rolloutBucket(flag string, namespace string) uint32 {
+	return farm.Fingerprint32([]byte(flag+"/"+namespace)) % 100
+}
@@ -0,0 +1,117 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamicconfig
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/common/log"
+)
+
+func newFeatureFlagsCollection(flags map[string]interface{}) *Collection {
+	client := newInMemoryClient()
+	client.SetValue(FeatureFlags, flags)
+	return NewCollection(client, log.NewNoopLogger())
+}
+
+func TestIsFeatureEnabled_Global(t *testing.T) {
+	collection := newFeatureFlagsCollection(map[string]interface{}{
+		"enabledFeature":  map[string]interface{}{"enabled": true},
+		"disabledFeature": map[string]interface{}{"enabled": false},
+	})
+
+	require.True(t, collection.IsFeatureEnabled("enabledFeature", "namespace-1"))
+	require.True(t, collection.IsFeatureEnabled("enabledFeature", "namespace-2"))
+	require.False(t, collection.IsFeatureEnabled("disabledFeature", "namespace-1"))
+	require.False(t, collection.IsFeatureEnabled("unknownFeature", "namespace-1"))
+
+	require.False(t, NewCollection(newInMemoryClient(), log.NewNoopLogger()).IsFeatureEnabled("enabledFeature", "namespace-1"))
+}
+
+func TestIsFeatureEnabled_NamespaceOverride(t *testing.T) {
+	collection := newFeatureFlagsCollection(map[string]interface{}{
+		"enabledFeature": map[string]interface{}{
+			"enabled":        true,
+			"denyNamespaces": []interface{}{"denied"},
+		},
+		"disabledFeature": map[string]interface{}{
+			"enabled":         false,
+			"allowNamespaces": []interface{}{"allowed", "allowed-and-denied"},
+			"denyNamespaces":  []interface{}{"allowed-and-denied"},
+		},
+		"partialFeature": map[string]interface{}{
+			"enabled":           true,
+			"rolloutPercentage": 0,
+			"allowNamespaces":   []interface{}{"allowed"},
+		},
+	})
+
+	require.False(t, collection.IsFeatureEnabled("enabledFeature", "denied"))
+	require.True(t, collection.IsFeatureEnabled("enabledFeature", "other"))
+	require.True(t, collection.IsFeatureEnabled("disabledFeature", "allowed"))
+	require.False(t, collection.IsFeatureEnabled("disabledFeature", "allowed-and-denied"))
+	require.False(t, collection.IsFeatureEnabled("disabledFeature", "other"))
+	require.True(t, collection.IsFeatureEnabled("partialFeature", "allowed"))
+	require.False(t, collection.IsFeatureEnabled("partialFeature", "other"))
+}
+
+func TestIsFeatureEnabled_PercentageRollout(t *testing.T) {
+	collection := newFeatureFlagsCollection(map[string]interface{}{
+		"halfFeature":  map[string]interface{}{"enabled": true, "rolloutPercentage": 50},
+		"otherFeature": map[string]interface{}{"enabled": true, "rolloutPercentage": 50},
+		"allFeature":   map[string]interface{}{"enabled": true, "rolloutPercentage": 100},
+	})
+
+	enabled := 0
+	sameAsOtherFeature := 0
+	for i := 0; i < 1000; i++ {
+		namespace := fmt.Sprintf("namespace-%d", i)
+		isEnabled := collection.IsFeatureEnabled("halfFeature", namespace)
+		// the bucket of a namespace is stable
+		for j := 0; j < 3; j++ {
+			require.Equal(t, isEnabled, collection.IsFeatureEnabled("halfFeature", namespace))
+		}
+		if isEnabled {
+			enabled++
+		}
+		if isEnabled == collection.IsFeatureEnabled("otherFeature", namespace) {
+			sameAsOtherFeature++
+		}
+		require.True(t, collection.IsFeatureEnabled("allFeature", namespace))
+	}
+	require.InDelta(t, 500, enabled, 60)
+	// flags are bucketed independently
+	require.InDelta(t, 500, sameAsOtherFeature, 60)
+
+	// pinned buckets, the hash must not change or namespaces would move in and out of rolled out features
+	require.Equal(t, uint32(36), rolloutBucket("halfFeature", "namespace-0"))
+	require.Equal(t, uint32(97), rolloutBucket("halfFeature", "namespace-1"))
+	require.Equal(t, uint32(98), rolloutBucket("halfFeature", "namespace-2"))
+	require.True(t, collection.IsFeatureEnabled("halfFeature", "namespace-0"))
+	require.False(t, collection.IsFeatureEnabled("halfFeature", "namespace-1"))
+}
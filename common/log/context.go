@@ -0,0 +1,96 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package log
+
+import (
+	"context"
+
+	"go.temporal.io/server/common/log/tag"
+)
+
+type (
+	workflowTagsContextKey struct{}
+
+	// workflowTags holds the IDs WithWorkflowTags turns into logger tags, zero values are not tagged
+	workflowTags struct {
+		namespaceID string
+		workflowID  string
+		runID       string
+		shardID     int32
+		hasShardID  bool
+	}
+)
+
+// ContextWithWorkflowExecution returns a copy of ctx carrying the given workflow execution, to be tagged by WithWorkflowTags.
+// Empty IDs don't override the ones already carried by ctx.
+func ContextWithWorkflowExecution(ctx context.Context, namespaceID string, workflowID string, runID string) context.Context {
+	tags := workflowTagsFromContext(ctx)
+	if namespaceID != "" {
+		tags.namespaceID = namespaceID
+	}
+	if workflowID != "" {
+		tags.workflowID = workflowID
+	}
+	if runID != "" {
+		tags.runID = runID
+	}
+	return context.WithValue(ctx, workflowTagsContextKey{}, tags)
+}
+
+// ContextWithShardID returns a copy of ctx carrying the given shard ID, to be tagged by WithWorkflowTags
+func ContextWithShardID(ctx context.Context, shardID int32) context.Context {
+	tags := workflowTagsFromContext(ctx)
+	tags.shardID = shardID
+	tags.hasShardID = true
+	return context.WithValue(ctx, workflowTagsContextKey{}, tags)
+}
+
+// WithWorkflowTags returns logger with the namespace, workflow, run and shard IDs found in ctx attached as tags.
+// logger is returned as is when ctx carries none of them.
+func WithWorkflowTags(ctx context.Context, logger Logger) Logger {
+	wt := workflowTagsFromContext(ctx)
+	var tags []tag.Tag
+	if wt.namespaceID != "" {
+		tags = append(tags, tag.WorkflowNamespaceID(wt.namespaceID))
+	}
+	if wt.workflowID != "" {
+		tags = append(tags, tag.WorkflowID(wt.workflowID))
+	}
+	if wt.runID != "" {
+		tags = append(tags, tag.WorkflowRunID(wt.runID))
+	}
+	if wt.hasShardID {
+		tags = append(tags, tag.ShardID(wt.shardID))
+	}
+	if len(tags) == 0 {
+		return logger
+	}
+	return With(logger, tags...)
+}
+
+func workflowTagsFromContext(ctx context.Context) workflowTags {
+	tags, _ := ctx.Value(workflowTagsContextKey{}).(workflowTags)
+	return tags
+}
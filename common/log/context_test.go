@@ -0,0 +1,61 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package log
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestWithWorkflowTags(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	logger := NewZapLogger(zap.New(core))
+
+	ctx := ContextWithWorkflowExecution(context.Background(), "namespace-id", "workflow-id", "")
+	ctx = ContextWithShardID(ctx, 12)
+	ctx = ContextWithWorkflowExecution(ctx, "", "", "run-id")
+	WithWorkflowTags(ctx, logger).Info("populated context")
+
+	WithWorkflowTags(context.Background(), logger).Info("empty context")
+
+	entries := logs.AllUntimed()
+	require.Len(t, entries, 2)
+	require.Equal(t, map[string]interface{}{
+		"wf-namespace-id": "namespace-id",
+		"wf-id":           "workflow-id",
+		"wf-run-id":       "run-id",
+		"shard-id":        int32(12),
+	}, withoutCallSite(entries[0].ContextMap()))
+	require.Empty(t, withoutCallSite(entries[1].ContextMap()))
+}
+
+func withoutCallSite(fields map[string]interface{}) map[string]interface{} {
+	delete(fields, "logging-call-at")
+	return fields
+}
@@ -71,6 +71,11 @@ func TimestampPtr(t *time.Time) ZapTag {
 	return NewTimeTag("timestamp", timestamp.TimeValue(t))
 }
 
+// CorrelationID returns tag for CorrelationID
+func CorrelationID(correlationID string) ZapTag {
+	return NewStringTag("correlation-id", correlationID)
+}
+
 ///////////////////  Workflow tags defined here: ( wf is short for workflow) ///////////////////
 
 // WorkflowAction returns tag for WorkflowAction
@@ -350,6 +355,41 @@ func Service(sv string) ZapTag {
 	return NewStringTag("service", sv)
 }
 
+// ShutdownReason returns tag for ShutdownReason
+func ShutdownReason(reason string) ZapTag {
+	return NewStringTag("shutdown-reason", reason)
+}
+
+// ShutdownPhase returns tag for ShutdownPhase
+func ShutdownPhase(phase string) ZapTag {
+	return NewStringTag("shutdown-phase", phase)
+}
+
+// RequestID returns tag for the ID correlating log lines of a single request
+func RequestID(requestID string) ZapTag {
+	return NewStringTag("request-id", requestID)
+}
+
+// AuthorizationAPIName returns tag for the API an authorization decision was made for
+func AuthorizationAPIName(apiName string) ZapTag {
+	return NewStringTag("authz-api-name", apiName)
+}
+
+// AuthorizationDecision returns tag for AuthorizationDecision
+func AuthorizationDecision(decision string) ZapTag {
+	return NewStringTag("authz-decision", decision)
+}
+
+// AuthorizationReason returns tag for the reason given with an authorization decision
+func AuthorizationReason(reason string) ZapTag {
+	return NewStringTag("authz-reason", reason)
+}
+
+// ShutdownDuration returns tag for ShutdownDuration
+func ShutdownDuration(duration time.Duration) ZapTag {
+	return NewDurationTag("shutdown-duration", duration)
+}
+
 // Addresses returns tag for Addresses
 func Addresses(ads []string) ZapTag {
 	return NewStringsTag("addresses", ads)
@@ -370,6 +410,11 @@ func HostID(hid string) ZapTag {
 	return NewStringTag("hostId", hid)
 }
 
+// HostName return tag for HostName
+func HostName(hn string) ZapTag {
+	return NewStringTag("hostName", hn)
+}
+
 // Env return tag for runtime environment
 func Env(env string) ZapTag {
 	return NewStringTag("env", env)
@@ -673,6 +718,16 @@ func FailoverVersion(version int64) ZapTag {
 	return NewInt64("xdc-failover-version", version)
 }
 
+// InitialFailoverVersion returns tag for InitialFailoverVersion
+func InitialFailoverVersion(version int64) ZapTag {
+	return NewInt64("xdc-initial-failover-version", version)
+}
+
+// FailoverVersionIncrement returns tag for FailoverVersionIncrement
+func FailoverVersionIncrement(increment int64) ZapTag {
+	return NewInt64("xdc-failover-version-increment", increment)
+}
+
 // CurrentVersion returns tag for CurrentVersion
 func CurrentVersion(currentVersion int64) ZapTag {
 	return NewInt64("xdc-current-version", currentVersion)
@@ -766,6 +821,16 @@ func ArchivalURI(URI string) ZapTag {
 	return NewStringTag("archival-URI", URI)
 }
 
+// ArchivalDryRunTarget returns tag for the location a dry-run archival would have written to
+func ArchivalDryRunTarget(target string) ZapTag {
+	return NewStringTag("archival-dry-run-target", target)
+}
+
+// ArchivalDryRunSize returns tag for the number of bytes a dry-run archival would have written
+func ArchivalDryRunSize(size int) ZapTag {
+	return NewInt("archival-dry-run-size", size)
+}
+
 // ArchivalArchiveFailReason returns tag for ArchivalArchiveFailReason
 func ArchivalArchiveFailReason(archiveFailReason string) ZapTag {
 	return NewStringTag("archival-archive-fail-reason", archiveFailReason)
@@ -25,16 +25,35 @@
 package log
 
 import (
+	"sync"
+
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/quotas"
 )
 
-const extraSkipForThrottleLogger = 3
+const (
+	extraSkipForThrottleLogger = 3
 
-type throttledLogger struct {
-	limiter quotas.RateLimiter
-	logger  Logger
-}
+	// maxSampledKeys bounds the number of per key rate limiters kept by the sampler,
+	// all of them are dropped once the bound is reached
+	maxSampledKeys = 1024
+)
+
+type (
+	throttledLogger struct {
+		limiter quotas.RateLimiter
+		sampler *keySampler
+		logger  Logger
+	}
+
+	// keySampler rate limits log messages per key, a key being the level and message of a log call
+	keySampler struct {
+		rps quotas.RateFn
+
+		sync.Mutex
+		limiters map[string]quotas.RateLimiter
+	}
+)
 
 var _ Logger = (*throttledLogger)(nil)
 
@@ -44,6 +63,14 @@ var _ Logger = (*throttledLogger)(nil)
 //
 // Fatal/Panic logs are always emitted without any throttling
 func NewThrottledLogger(logger Logger, rps quotas.RateFn) *throttledLogger {
+	return NewSampledThrottledLogger(logger, rps, nil)
+}
+
+// NewSampledThrottledLogger returns a throttled logger which additionally rate limits log calls with the
+// same level and message to perKeyRPS, whatever their tags. A message repeated in a loop then only uses
+// a small share of the rps budget and does not starve distinct messages.
+// Sampling is disabled when perKeyRPS is nil or returns a value <= 0.
+func NewSampledThrottledLogger(logger Logger, rps quotas.RateFn, perKeyRPS quotas.RateFn) *throttledLogger {
 	if sl, ok := logger.(SkipLogger); ok {
 		logger = sl.Skip(extraSkipForThrottleLogger)
 	}
@@ -53,35 +80,41 @@ func NewThrottledLogger(logger Logger, rps quotas.RateFn) *throttledLogger {
 		limiter: limiter,
 		logger:  logger,
 	}
+	if perKeyRPS != nil {
+		tl.sampler = &keySampler{
+			rps:      perKeyRPS,
+			limiters: make(map[string]quotas.RateLimiter),
+		}
+	}
 	return tl
 }
 
 func (tl *throttledLogger) Debug(msg string, tags ...tag.Tag) {
-	tl.rateLimit(func() {
+	tl.rateLimit("debug", msg, func() {
 		tl.logger.Debug(msg, tags...)
 	})
 }
 
 func (tl *throttledLogger) Info(msg string, tags ...tag.Tag) {
-	tl.rateLimit(func() {
+	tl.rateLimit("info", msg, func() {
 		tl.logger.Info(msg, tags...)
 	})
 }
 
 func (tl *throttledLogger) Warn(msg string, tags ...tag.Tag) {
-	tl.rateLimit(func() {
+	tl.rateLimit("warn", msg, func() {
 		tl.logger.Warn(msg, tags...)
 	})
 }
 
 func (tl *throttledLogger) Error(msg string, tags ...tag.Tag) {
-	tl.rateLimit(func() {
+	tl.rateLimit("error", msg, func() {
 		tl.logger.Error(msg, tags...)
 	})
 }
 
 func (tl *throttledLogger) Fatal(msg string, tags ...tag.Tag) {
-	tl.rateLimit(func() {
+	tl.rateLimit("fatal", msg, func() {
 		tl.logger.Fatal(msg, tags...)
 	})
 }
@@ -90,13 +123,38 @@ func (tl *throttledLogger) Fatal(msg string, tags ...tag.Tag) {
 func (tl *throttledLogger) With(tags ...tag.Tag) Logger {
 	result := &throttledLogger{
 		limiter: tl.limiter,
+		sampler: tl.sampler,
 		logger:  With(tl.logger, tags...),
 	}
 	return result
 }
 
-func (tl *throttledLogger) rateLimit(f func()) {
+func (tl *throttledLogger) rateLimit(level string, msg string, f func()) {
+	// per key sampling goes first so that dropped repeated messages don't use tokens of the shared limiter
+	if tl.sampler != nil && !tl.sampler.allow(level, msg) {
+		return
+	}
 	if ok := tl.limiter.Allow(); ok {
 		f()
 	}
 }
+
+func (s *keySampler) allow(level string, msg string) bool {
+	rps := s.rps()
+	if rps <= 0 {
+		return true
+	}
+
+	key := level + "|" + msg
+	s.Lock()
+	limiter, ok := s.limiters[key]
+	if !ok {
+		if len(s.limiters) >= maxSampledKeys {
+			s.limiters = make(map[string]quotas.RateLimiter)
+		}
+		limiter = quotas.NewDefaultOutgoingDynamicRateLimiter(s.rps)
+		s.limiters[key] = limiter
+	}
+	s.Unlock()
+	return limiter.Allow()
+}
@@ -0,0 +1,81 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package log
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	"go.temporal.io/server/common/log/tag"
+)
+
+func TestSampledThrottledLogger_RepeatedMessageIsBounded(t *testing.T) {
+	core, logs := observer.New(zap.DebugLevel)
+	logger := NewSampledThrottledLogger(
+		NewZapLogger(zap.New(core)),
+		func() float64 { return 10000 },
+		func() float64 { return 1 },
+	)
+
+	for i := 0; i < 1000; i++ {
+		logger.Error("persistence call failed", tag.ShardID(1), tag.Error(errors.New("timeout")))
+	}
+	require.LessOrEqual(t, logs.Len(), 2)
+	require.GreaterOrEqual(t, logs.Len(), 1)
+
+	// distinct messages are not starved by the repeated one
+	logs.TakeAll()
+	for i := 0; i < 100; i++ {
+		logger.Error(fmt.Sprintf("persistence call %v failed", i))
+	}
+	require.Equal(t, 100, logs.Len())
+
+	// tags are not part of the key, the level is
+	logs.TakeAll()
+	logger.With(tag.ShardID(2)).Error("persistence call failed", tag.Error(errors.New("timeout")))
+	logger.Error("persistence call failed", tag.ShardID(3))
+	logger.Warn("persistence call failed", tag.ShardID(1), tag.Error(errors.New("timeout")))
+	require.Equal(t, 1, logs.Len())
+	require.Equal(t, zap.WarnLevel, logs.All()[0].Level)
+}
+
+func TestSampledThrottledLogger_SamplingDisabled(t *testing.T) {
+	core, logs := observer.New(zap.DebugLevel)
+	logger := NewSampledThrottledLogger(
+		NewZapLogger(zap.New(core)),
+		func() float64 { return 10000 },
+		func() float64 { return 0 },
+	)
+
+	for i := 0; i < 1000; i++ {
+		logger.Info(fmt.Sprintf("message %v", i%10))
+	}
+	require.Equal(t, 1000, logs.Len())
+}
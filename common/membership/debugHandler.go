@@ -0,0 +1,91 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"go.temporal.io/server/common/primitives"
+)
+
+type (
+	debugHost struct {
+		Address string            `json:"address"`
+		Labels  map[string]string `json:"labels"`
+	}
+
+	debugMembership struct {
+		Self  *debugHost             `json:"self,omitempty"`
+		Rings map[string][]debugHost `json:"rings"`
+	}
+
+	debugHandler struct {
+		monitor Monitor
+	}
+)
+
+// debugRingServices are the rings a monitor may track, in the order they are reported
+var debugRingServices = []string{
+	primitives.FrontendService,
+	primitives.HistoryService,
+	primitives.MatchingService,
+	primitives.WorkerService,
+}
+
+// NewDebugHandler returns an http.Handler which serializes the current members of every ring
+// tracked by the monitor as JSON, along with the address and labels of this host
+func NewDebugHandler(monitor Monitor) http.Handler {
+	return &debugHandler{monitor: monitor}
+}
+
+func (h *debugHandler) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	response := debugMembership{Rings: make(map[string][]debugHost)}
+	if self, err := h.monitor.WhoAmI(); err == nil {
+		host := newDebugHost(self)
+		response.Self = &host
+	}
+	for _, service := range debugRingServices {
+		resolver, err := h.monitor.GetResolver(service)
+		if err != nil {
+			continue
+		}
+		members := resolver.Members()
+		hosts := make([]debugHost, 0, len(members))
+		for _, member := range members {
+			hosts = append(hosts, newDebugHost(member))
+		}
+		response.Rings[service] = hosts
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func newDebugHost(host *HostInfo) debugHost {
+	return debugHost{Address: host.GetAddress(), Labels: host.labels}
+}
@@ -0,0 +1,76 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/common/primitives"
+)
+
+func TestDebugHandler(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	historyResolver := NewMockServiceResolver(controller)
+	historyResolver.EXPECT().Members().Return([]*HostInfo{
+		NewHostInfo("10.0.0.1:7234", map[string]string{RoleKey: primitives.HistoryService}),
+		NewHostInfo("10.0.0.2:7234", map[string]string{RoleKey: primitives.HistoryService}),
+	})
+	matchingResolver := NewMockServiceResolver(controller)
+	matchingResolver.EXPECT().Members().Return([]*HostInfo{
+		NewHostInfo("10.0.0.3:7235", map[string]string{RoleKey: primitives.MatchingService}),
+	})
+
+	monitor := NewMockMonitor(controller)
+	monitor.EXPECT().WhoAmI().Return(NewHostInfo("10.0.0.1:7234", map[string]string{RoleKey: primitives.HistoryService}), nil)
+	monitor.EXPECT().GetResolver(primitives.HistoryService).Return(historyResolver, nil)
+	monitor.EXPECT().GetResolver(primitives.MatchingService).Return(matchingResolver, nil)
+	monitor.EXPECT().GetResolver(gomock.Any()).Return(nil, ErrUnknownService).Times(2)
+
+	recorder := httptest.NewRecorder()
+	NewDebugHandler(monitor).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/debug/membership/history", nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+
+	var response debugMembership
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	require.Equal(t, "10.0.0.1:7234", response.Self.Address)
+	require.Equal(t, map[string][]debugHost{
+		primitives.HistoryService: {
+			{Address: "10.0.0.1:7234", Labels: map[string]string{RoleKey: primitives.HistoryService}},
+			{Address: "10.0.0.2:7234", Labels: map[string]string{RoleKey: primitives.HistoryService}},
+		},
+		primitives.MatchingService: {
+			{Address: "10.0.0.3:7235", Labels: map[string]string{RoleKey: primitives.MatchingService}},
+		},
+	}, response.Rings)
+}
@@ -0,0 +1,188 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/dgryski/go-farm"
+	"github.com/temporalio/ringpop-go/hashring"
+)
+
+const (
+	// HashFuncRingpop places members on a ringpop consistent hash ring, this is the default
+	HashFuncRingpop = "ringpop"
+	// HashFuncRendezvous places keys with rendezvous (highest random weight) hashing
+	HashFuncRendezvous = "rendezvous"
+)
+
+type (
+	// HashFunc builds the consistent hash used by a service ring to map keys onto its members
+	HashFunc interface {
+		// NewRing returns a ring placing keys onto the given member addresses
+		NewRing(members []string) HashRing
+	}
+
+	// HashRing maps keys onto the addresses of the members of a ring
+	HashRing interface {
+		// Lookup returns the member owning key, false if the ring has no members
+		Lookup(key string) (string, bool)
+		// Servers returns the addresses of all members
+		Servers() []string
+		// ServerCount returns the number of members
+		ServerCount() int
+	}
+
+	ringpopHashFunc struct{}
+
+	rendezvousHashFunc struct{}
+
+	rendezvousRing struct {
+		members []string
+		seeds   []uint64
+	}
+)
+
+// NewRingpopHashFunc returns the default HashFunc, placing replicaPoints virtual nodes per member
+// on a ringpop hash ring
+func NewRingpopHashFunc() HashFunc {
+	return ringpopHashFunc{}
+}
+
+// NewRendezvousHashFunc returns a HashFunc assigning each key to the member with the highest hash of
+// the pair. It spreads keys more evenly than a ring and a membership change only moves the keys of
+// the members added or removed, at the cost of lookups linear in the number of members.
+func NewRendezvousHashFunc() HashFunc {
+	return rendezvousHashFunc{}
+}
+
+// NewHashFunc returns the HashFunc of the given name, the default one when name is empty
+func NewHashFunc(name string) (HashFunc, error) {
+	switch name {
+	case "", HashFuncRingpop:
+		return NewRingpopHashFunc(), nil
+	case HashFuncRendezvous:
+		return NewRendezvousHashFunc(), nil
+	default:
+		return nil, fmt.Errorf("unknown membership hash function %q", name)
+	}
+}
+
+func (ringpopHashFunc) NewRing(members []string) HashRing {
+	ring := hashring.New(farm.Fingerprint32, replicaPoints)
+	for _, member := range members {
+		ring.AddMembers(NewHostInfo(member, nil))
+	}
+	return ring
+}
+
+func (rendezvousHashFunc) NewRing(members []string) HashRing {
+	ring := &rendezvousRing{
+		members: make([]string, len(members)),
+		seeds:   make([]uint64, len(members)),
+	}
+	copy(ring.members, members)
+	sort.Strings(ring.members)
+	for i, member := range ring.members {
+		ring.seeds[i] = farm.Fingerprint64([]byte(member))
+	}
+	return ring
+}
+
+func (r *rendezvousRing) Lookup(key string) (string, bool) {
+	if len(r.members) == 0 {
+		return "", false
+	}
+
+	owner := 0
+	var highest uint64
+	for i, seed := range r.seeds {
+		if weight := farm.Hash64WithSeed([]byte(key), seed); i == 0 || weight > highest {
+			owner = i
+			highest = weight
+		}
+	}
+	return r.members[owner], true
+}
+
+func (r *rendezvousRing) Servers() []string {
+	servers := make([]string, len(r.members))
+	copy(servers, r.members)
+	return servers
+}
+
+func (r *rendezvousRing) ServerCount() int {
+	return len(r.members)
+}
+
+// diffOnChange returns the keys changing owner when the given hosts are added to and removed from ring
+func diffOnChange(
+	ring HashRing,
+	hashFunc HashFunc,
+	labels map[string]string,
+	added []*HostInfo,
+	removed []*HostInfo,
+	keys []string,
+) map[string]OwnershipChange {
+
+	removedMap := make(map[string]struct{}, len(removed))
+	for _, host := range removed {
+		removedMap[host.GetAddress()] = struct{}{}
+	}
+	membersMap := make(map[string]struct{})
+	var members []string
+	for _, addr := range ring.Servers() {
+		if _, ok := removedMap[addr]; !ok {
+			membersMap[addr] = struct{}{}
+			members = append(members, addr)
+		}
+	}
+	for _, host := range added {
+		if _, ok := membersMap[host.GetAddress()]; !ok {
+			membersMap[host.GetAddress()] = struct{}{}
+			members = append(members, host.GetAddress())
+		}
+	}
+	newRing := hashFunc.NewRing(members)
+
+	owner := func(ring HashRing, key string) (string, *HostInfo) {
+		addr, found := ring.Lookup(key)
+		if !found {
+			return "", nil
+		}
+		return addr, NewHostInfo(addr, labels)
+	}
+
+	changes := make(map[string]OwnershipChange)
+	for _, key := range keys {
+		oldAddr, oldOwner := owner(ring, key)
+		newAddr, newOwner := owner(newRing, key)
+		if oldAddr != newAddr {
+			changes[key] = OwnershipChange{OldOwner: oldOwner, NewOwner: newOwner}
+		}
+	}
+	return changes
+}
@@ -0,0 +1,219 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/primitives"
+)
+
+type (
+	hashFuncSuite struct {
+		*require.Assertions
+		suite.Suite
+	}
+
+	firstMemberHashFunc struct{}
+
+	firstMemberRing struct {
+		members []string
+	}
+)
+
+func TestHashFuncSuite(t *testing.T) {
+	suite.Run(t, new(hashFuncSuite))
+}
+
+func (s *hashFuncSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (firstMemberHashFunc) NewRing(members []string) HashRing {
+	return &firstMemberRing{members: members}
+}
+
+func (r *firstMemberRing) Lookup(_ string) (string, bool) {
+	if len(r.members) == 0 {
+		return "", false
+	}
+	return r.members[0], true
+}
+
+func (r *firstMemberRing) Servers() []string {
+	return r.members
+}
+
+func (r *firstMemberRing) ServerCount() int {
+	return len(r.members)
+}
+
+func (s *hashFuncSuite) TestNewHashFunc() {
+	hashFunc, err := NewHashFunc("")
+	s.NoError(err)
+	s.Equal(NewRingpopHashFunc(), hashFunc)
+	hashFunc, err = NewHashFunc(HashFuncRendezvous)
+	s.NoError(err)
+	s.Equal(NewRendezvousHashFunc(), hashFunc)
+	_, err = NewHashFunc("jump")
+	s.Error(err)
+}
+
+func (s *hashFuncSuite) TestDistribution() {
+	members := testMembers(10)
+	const numKeys = 100000
+
+	deviations := make(map[string]float64)
+	for name, hashFunc := range map[string]HashFunc{
+		HashFuncRingpop:    NewRingpopHashFunc(),
+		HashFuncRendezvous: NewRendezvousHashFunc(),
+	} {
+		ring := hashFunc.NewRing(members)
+		s.Equal(len(members), ring.ServerCount())
+
+		counts := make(map[string]int)
+		for i := 0; i < numKeys; i++ {
+			owner, ok := ring.Lookup(fmt.Sprintf("key-%v", i))
+			s.True(ok)
+			counts[owner]++
+		}
+		s.Len(counts, len(members), name)
+
+		expected := float64(numKeys) / float64(len(members))
+		for _, count := range counts {
+			deviations[name] = math.Max(deviations[name], math.Abs(float64(count)-expected)/expected)
+		}
+		s.Less(deviations[name], 0.3, name)
+	}
+	s.Less(deviations[HashFuncRendezvous], deviations[HashFuncRingpop])
+}
+
+func (s *hashFuncSuite) TestRendezvous_MinimalDisruption() {
+	members := testMembers(10)
+	ring := NewRendezvousHashFunc().NewRing(members)
+	shrunkRing := NewRendezvousHashFunc().NewRing(members[1:])
+
+	for i := 0; i < 10000; i++ {
+		key := fmt.Sprintf("key-%v", i)
+		owner, _ := ring.Lookup(key)
+		newOwner, _ := shrunkRing.Lookup(key)
+		if owner != members[0] {
+			s.Equal(owner, newOwner, key)
+		}
+	}
+}
+
+func (s *hashFuncSuite) TestEmptyRing() {
+	for _, hashFunc := range []HashFunc{NewRingpopHashFunc(), NewRendezvousHashFunc()} {
+		_, ok := hashFunc.NewRing(nil).Lookup("key")
+		s.False(ok)
+	}
+}
+
+func (s *hashFuncSuite) TestResolverUsesInjectedHashFunc() {
+	resolver := newStaticServiceResolver(
+		primitives.HistoryService,
+		[]string{"127.0.0.1:7234", "127.0.0.2:7234", "127.0.0.3:7234"},
+		func(host string) ([]string, error) { return []string{host}, nil },
+		firstMemberHashFunc{},
+		log.NewNoopLogger(),
+	)
+	s.NoError(resolver.refresh())
+
+	for i := 0; i < 10; i++ {
+		host, err := resolver.Lookup(fmt.Sprintf("key-%v", i))
+		s.NoError(err)
+		s.Equal("127.0.0.1:7234", host.GetAddress())
+	}
+}
+
+func (s *hashFuncSuite) TestDiffOnChange_AddHost() {
+	members := testMembers(10)
+	keys := make([]string, 10000)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("key-%v", i)
+	}
+	added := NewHostInfo("10.0.0.11:7234", nil)
+
+	for name, hashFunc := range map[string]HashFunc{
+		HashFuncRingpop:    NewRingpopHashFunc(),
+		HashFuncRendezvous: NewRendezvousHashFunc(),
+	} {
+		resolver := newRingpopServiceResolver(primitives.HistoryService, 0, nil, hashFunc, metrics.NewClient(tally.NoopScope, metrics.Common), log.NewNoopLogger())
+		resolver.storeRing(hashFunc.NewRing(members), len(members))
+
+		changes := resolver.DiffOnChange([]*HostInfo{added}, nil, keys)
+		// about a 1/11th of the keys move to the new host, and only to it
+		s.NotEmpty(changes, name)
+		s.Less(len(changes), 2*len(keys)/(len(members)+1), name)
+		for key, change := range changes {
+			oldOwner, err := resolver.Lookup(key)
+			s.NoError(err)
+			s.Equal(oldOwner.GetAddress(), change.OldOwner.GetAddress(), name)
+			s.Equal(added.GetAddress(), change.NewOwner.GetAddress(), name)
+		}
+
+		// the real ring is left untouched
+		s.Equal(len(members), resolver.MemberCount(), name)
+	}
+}
+
+func (s *hashFuncSuite) TestDiffOnChange_RemoveHost() {
+	members := testMembers(10)
+	keys := make([]string, 1000)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("key-%v", i)
+	}
+	resolver := newRingpopServiceResolver(primitives.HistoryService, 0, nil, NewRingpopHashFunc(), metrics.NewClient(tally.NoopScope, metrics.Common), log.NewNoopLogger())
+	resolver.storeRing(NewRingpopHashFunc().NewRing(members), len(members))
+
+	removed := NewHostInfo(members[0], nil)
+	changes := resolver.DiffOnChange(nil, []*HostInfo{removed}, keys)
+	s.NotEmpty(changes)
+	for _, change := range changes {
+		s.Equal(removed.GetAddress(), change.OldOwner.GetAddress())
+		s.NotEqual(removed.GetAddress(), change.NewOwner.GetAddress())
+	}
+
+	changes = resolver.DiffOnChange(nil, resolver.Members(), keys[:1])
+	s.Len(changes, 1)
+	s.Nil(changes[keys[0]].NewOwner)
+}
+
+func testMembers(count int) []string {
+	members := make([]string, count)
+	for i := range members {
+		members[i] = fmt.Sprintf("10.0.0.%v:7234", i+1)
+	}
+	return members
+}
@@ -27,6 +27,7 @@
 package membership
 
 import (
+	"context"
 	"errors"
 
 	"go.temporal.io/api/serviceerror"
@@ -46,6 +47,12 @@ var ErrListenerAlreadyExist = errors.New("Listener already exist for the service
 // ErrIncorrectAddressFormat is thrown on incorrect address format
 var ErrIncorrectAddressFormat = errors.New("Incorrect address format")
 
+// ErrConflictingRingOwnership is thrown when more than one Monitor tracks the same service
+var ErrConflictingRingOwnership = errors.New("Service tracked by more than one Monitor")
+
+// ErrMonitorNotStarted is thrown when an operation requires the Monitor to be started
+var ErrMonitorNotStarted = errors.New("Monitor not started")
+
 type (
 
 	// ChangedEvent describes a change in membership
@@ -64,6 +71,8 @@ type (
 		// EvictSelf evicts this member from the membership ring. After this method is
 		// called, other members will discover that this node is no longer part of the
 		// ring. This primitive is useful to carry out graceful host shutdown during deployments.
+		// It returns ErrMonitorNotStarted if called before Start, and is a no-op once this
+		// member has already been evicted or the Monitor has been stopped.
 		EvictSelf() error
 		Lookup(service string, key string) (*HostInfo, error)
 		GetResolver(service string) (ServiceResolver, error)
@@ -87,6 +96,9 @@ type (
 	// It can be used to resolve which member host is responsible for serving a given key.
 	ServiceResolver interface {
 		Lookup(key string) (*HostInfo, error)
+		// LookupWithContext is like Lookup, but if no members have been resolved yet it waits
+		// for the first resolution until the context is done, returning the context error.
+		LookupWithContext(ctx context.Context, key string) (*HostInfo, error)
 		// AddListener adds a listener which will get notified on the given
 		// channel, whenever membership changes.
 		// @name: The name for identifying the listener
@@ -98,5 +110,33 @@ type (
 		MemberCount() int
 		// Members returns all host addresses in hashring for any particular role
 		Members() []*HostInfo
+		// DiffOnChange simulates adding and removing the given hosts without changing the ring, and returns
+		// the old and new owners of the keys which would change ownership
+		DiffOnChange(added []*HostInfo, removed []*HostInfo, keys []string) map[string]OwnershipChange
+	}
+
+	// OwnershipChange describes a key moving between hosts, an owner is nil when the ring has no members
+	OwnershipChange struct {
+		OldOwner *HostInfo
+		NewOwner *HostInfo
 	}
+
+	// ShardResolver resolves which history host owns a shard.
+	// Shards are mapped to hosts by consistent hashing of the shard ID over the history ring.
+	ShardResolver interface {
+		// OwnerForShard returns the history host owning the given shard
+		OwnerForShard(shardID int) (*HostInfo, error)
+		// OwnerForWorkflow returns the history host owning the shard of the given workflow
+		OwnerForWorkflow(namespaceID string, workflowID string) (*HostInfo, error)
+		// ShardsForHost returns the IDs of the shards owned by the given history host
+		ShardsForHost(host *HostInfo) []int
+		// SubscribeShardOwnership invokes the callback with the shards gained and lost
+		// by the given host whenever history membership changes its ownership
+		SubscribeShardOwnership(host *HostInfo, callback ShardOwnershipCallback) error
+		// UnsubscribeShardOwnership stops notifying ownership changes of the given host
+		UnsubscribeShardOwnership(host *HostInfo) error
+	}
+
+	// ShardOwnershipCallback is invoked with the shards a host gained and lost after a membership change
+	ShardOwnershipCallback func(gained []int, lost []int)
 )
@@ -29,6 +29,7 @@
 package membership
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -235,6 +236,20 @@ func (mr *MockServiceResolverMockRecorder) AddListener(name, notifyChannel inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddListener", reflect.TypeOf((*MockServiceResolver)(nil).AddListener), name, notifyChannel)
 }
 
+// DiffOnChange mocks base method.
+func (m *MockServiceResolver) DiffOnChange(added, removed []*HostInfo, keys []string) map[string]OwnershipChange {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiffOnChange", added, removed, keys)
+	ret0, _ := ret[0].(map[string]OwnershipChange)
+	return ret0
+}
+
+// DiffOnChange indicates an expected call of DiffOnChange.
+func (mr *MockServiceResolverMockRecorder) DiffOnChange(added, removed, keys interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffOnChange", reflect.TypeOf((*MockServiceResolver)(nil).DiffOnChange), added, removed, keys)
+}
+
 // Lookup mocks base method.
 func (m *MockServiceResolver) Lookup(key string) (*HostInfo, error) {
 	m.ctrl.T.Helper()
@@ -250,6 +265,21 @@ func (mr *MockServiceResolverMockRecorder) Lookup(key interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lookup", reflect.TypeOf((*MockServiceResolver)(nil).Lookup), key)
 }
 
+// LookupWithContext mocks base method.
+func (m *MockServiceResolver) LookupWithContext(ctx context.Context, key string) (*HostInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LookupWithContext", ctx, key)
+	ret0, _ := ret[0].(*HostInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LookupWithContext indicates an expected call of LookupWithContext.
+func (mr *MockServiceResolverMockRecorder) LookupWithContext(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookupWithContext", reflect.TypeOf((*MockServiceResolver)(nil).LookupWithContext), ctx, key)
+}
+
 // MemberCount mocks base method.
 func (m *MockServiceResolver) MemberCount() int {
 	m.ctrl.T.Helper()
@@ -291,3 +321,98 @@ func (mr *MockServiceResolverMockRecorder) RemoveListener(name interface{}) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveListener", reflect.TypeOf((*MockServiceResolver)(nil).RemoveListener), name)
 }
+
+// MockShardResolver is a mock of ShardResolver interface.
+type MockShardResolver struct {
+	ctrl     *gomock.Controller
+	recorder *MockShardResolverMockRecorder
+}
+
+// MockShardResolverMockRecorder is the mock recorder for MockShardResolver.
+type MockShardResolverMockRecorder struct {
+	mock *MockShardResolver
+}
+
+// NewMockShardResolver creates a new mock instance.
+func NewMockShardResolver(ctrl *gomock.Controller) *MockShardResolver {
+	mock := &MockShardResolver{ctrl: ctrl}
+	mock.recorder = &MockShardResolverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShardResolver) EXPECT() *MockShardResolverMockRecorder {
+	return m.recorder
+}
+
+// OwnerForShard mocks base method.
+func (m *MockShardResolver) OwnerForShard(shardID int) (*HostInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OwnerForShard", shardID)
+	ret0, _ := ret[0].(*HostInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OwnerForShard indicates an expected call of OwnerForShard.
+func (mr *MockShardResolverMockRecorder) OwnerForShard(shardID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OwnerForShard", reflect.TypeOf((*MockShardResolver)(nil).OwnerForShard), shardID)
+}
+
+// OwnerForWorkflow mocks base method.
+func (m *MockShardResolver) OwnerForWorkflow(namespaceID, workflowID string) (*HostInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OwnerForWorkflow", namespaceID, workflowID)
+	ret0, _ := ret[0].(*HostInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OwnerForWorkflow indicates an expected call of OwnerForWorkflow.
+func (mr *MockShardResolverMockRecorder) OwnerForWorkflow(namespaceID, workflowID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OwnerForWorkflow", reflect.TypeOf((*MockShardResolver)(nil).OwnerForWorkflow), namespaceID, workflowID)
+}
+
+// ShardsForHost mocks base method.
+func (m *MockShardResolver) ShardsForHost(host *HostInfo) []int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShardsForHost", host)
+	ret0, _ := ret[0].([]int)
+	return ret0
+}
+
+// ShardsForHost indicates an expected call of ShardsForHost.
+func (mr *MockShardResolverMockRecorder) ShardsForHost(host interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShardsForHost", reflect.TypeOf((*MockShardResolver)(nil).ShardsForHost), host)
+}
+
+// SubscribeShardOwnership mocks base method.
+func (m *MockShardResolver) SubscribeShardOwnership(host *HostInfo, callback ShardOwnershipCallback) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeShardOwnership", host, callback)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubscribeShardOwnership indicates an expected call of SubscribeShardOwnership.
+func (mr *MockShardResolverMockRecorder) SubscribeShardOwnership(host, callback interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeShardOwnership", reflect.TypeOf((*MockShardResolver)(nil).SubscribeShardOwnership), host, callback)
+}
+
+// UnsubscribeShardOwnership mocks base method.
+func (m *MockShardResolver) UnsubscribeShardOwnership(host *HostInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsubscribeShardOwnership", host)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnsubscribeShardOwnership indicates an expected call of UnsubscribeShardOwnership.
+func (mr *MockShardResolverMockRecorder) UnsubscribeShardOwnership(host interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsubscribeShardOwnership", reflect.TypeOf((*MockShardResolver)(nil).UnsubscribeShardOwnership), host)
+}
@@ -0,0 +1,140 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"go.uber.org/multierr"
+)
+
+type multiMonitor struct {
+	monitors []Monitor
+}
+
+var _ Monitor = (*multiMonitor)(nil)
+
+// NewMultiMonitor returns a Monitor aggregating the rings of several underlying monitors.
+// Ring operations are delegated to the single monitor tracking the requested service.
+// The first monitor is the one this host is a member of, and answers WhoAmI.
+func NewMultiMonitor(monitors ...Monitor) Monitor {
+	return &multiMonitor{
+		monitors: monitors,
+	}
+}
+
+func (m *multiMonitor) Start() {
+	for _, monitor := range m.monitors {
+		monitor.Start()
+	}
+}
+
+func (m *multiMonitor) Stop() {
+	for _, monitor := range m.monitors {
+		monitor.Stop()
+	}
+}
+
+func (m *multiMonitor) WhoAmI() (*HostInfo, error) {
+	if len(m.monitors) == 0 {
+		return nil, ErrUnknownService
+	}
+	return m.monitors[0].WhoAmI()
+}
+
+func (m *multiMonitor) EvictSelf() error {
+	var err error
+	for _, monitor := range m.monitors {
+		err = multierr.Append(err, monitor.EvictSelf())
+	}
+	return err
+}
+
+func (m *multiMonitor) GetResolver(service string) (ServiceResolver, error) {
+	var resolver ServiceResolver
+	for _, monitor := range m.monitors {
+		r, err := monitor.GetResolver(service)
+		if err != nil {
+			continue
+		}
+		if resolver != nil {
+			return nil, ErrConflictingRingOwnership
+		}
+		resolver = r
+	}
+	if resolver == nil {
+		return nil, ErrUnknownService
+	}
+	return resolver, nil
+}
+
+func (m *multiMonitor) Lookup(service string, key string) (*HostInfo, error) {
+	resolver, err := m.GetResolver(service)
+	if err != nil {
+		return nil, err
+	}
+	return resolver.Lookup(key)
+}
+
+func (m *multiMonitor) AddListener(service string, name string, notifyChannel chan<- *ChangedEvent) error {
+	resolver, err := m.GetResolver(service)
+	if err != nil {
+		return err
+	}
+	return resolver.AddListener(name, notifyChannel)
+}
+
+func (m *multiMonitor) RemoveListener(service string, name string) error {
+	resolver, err := m.GetResolver(service)
+	if err != nil {
+		return err
+	}
+	return resolver.RemoveListener(name)
+}
+
+func (m *multiMonitor) GetReachableMembers() ([]string, error) {
+	var members []string
+	seen := make(map[string]struct{})
+	for _, monitor := range m.monitors {
+		monitorMembers, err := monitor.GetReachableMembers()
+		if err != nil {
+			return nil, err
+		}
+		for _, member := range monitorMembers {
+			if _, ok := seen[member]; ok {
+				continue
+			}
+			seen[member] = struct{}{}
+			members = append(members, member)
+		}
+	}
+	return members, nil
+}
+
+func (m *multiMonitor) GetMemberCount(service string) (int, error) {
+	resolver, err := m.GetResolver(service)
+	if err != nil {
+		return 0, err
+	}
+	return resolver.MemberCount(), nil
+}
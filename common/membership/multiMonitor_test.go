@@ -0,0 +1,128 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common"
+)
+
+type (
+	multiMonitorSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller       *gomock.Controller
+		frontendMonitor  *MockMonitor
+		historyMonitor   *MockMonitor
+		frontendResolver *MockServiceResolver
+		historyResolver  *MockServiceResolver
+
+		monitor Monitor
+	}
+)
+
+func TestMultiMonitorSuite(t *testing.T) {
+	s := new(multiMonitorSuite)
+	suite.Run(t, s)
+}
+
+func (s *multiMonitorSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+
+	s.frontendMonitor = NewMockMonitor(s.controller)
+	s.historyMonitor = NewMockMonitor(s.controller)
+	s.frontendResolver = NewMockServiceResolver(s.controller)
+	s.historyResolver = NewMockServiceResolver(s.controller)
+
+	s.frontendMonitor.EXPECT().GetResolver(common.FrontendServiceName).Return(s.frontendResolver, nil).AnyTimes()
+	s.frontendMonitor.EXPECT().GetResolver(gomock.Any()).Return(nil, ErrUnknownService).AnyTimes()
+	s.historyMonitor.EXPECT().GetResolver(common.HistoryServiceName).Return(s.historyResolver, nil).AnyTimes()
+	s.historyMonitor.EXPECT().GetResolver(gomock.Any()).Return(nil, ErrUnknownService).AnyTimes()
+
+	s.monitor = NewMultiMonitor(s.frontendMonitor, s.historyMonitor)
+}
+
+func (s *multiMonitorSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *multiMonitorSuite) TestResolveRingFromEachMonitor() {
+	frontendHost := NewHostInfo("frontend:7233", nil)
+	historyHost := NewHostInfo("history:7234", nil)
+	s.frontendResolver.EXPECT().Lookup("key").Return(frontendHost, nil)
+	s.historyResolver.EXPECT().Lookup("key").Return(historyHost, nil)
+
+	host, err := s.monitor.Lookup(common.FrontendServiceName, "key")
+	s.NoError(err)
+	s.Equal(frontendHost, host)
+
+	host, err = s.monitor.Lookup(common.HistoryServiceName, "key")
+	s.NoError(err)
+	s.Equal(historyHost, host)
+
+	resolver, err := s.monitor.GetResolver(common.HistoryServiceName)
+	s.NoError(err)
+	s.Equal(s.historyResolver, resolver)
+
+	_, err = s.monitor.GetResolver(common.MatchingServiceName)
+	s.Equal(ErrUnknownService, err)
+}
+
+func (s *multiMonitorSuite) TestWhoAmI() {
+	self := NewHostInfo("frontend:7233", nil)
+	s.frontendMonitor.EXPECT().WhoAmI().Return(self, nil)
+
+	host, err := s.monitor.WhoAmI()
+	s.NoError(err)
+	s.Equal(self, host)
+}
+
+func (s *multiMonitorSuite) TestConflictingRingOwnership() {
+	otherHistoryMonitor := NewMockMonitor(s.controller)
+	otherHistoryMonitor.EXPECT().GetResolver(common.HistoryServiceName).Return(NewMockServiceResolver(s.controller), nil).AnyTimes()
+	monitor := NewMultiMonitor(s.frontendMonitor, s.historyMonitor, otherHistoryMonitor)
+
+	_, err := monitor.GetResolver(common.HistoryServiceName)
+	s.Equal(ErrConflictingRingOwnership, err)
+
+	_, err = monitor.Lookup(common.HistoryServiceName, "key")
+	s.Equal(ErrConflictingRingOwnership, err)
+}
+
+func (s *multiMonitorSuite) TestGetReachableMembers() {
+	s.frontendMonitor.EXPECT().GetReachableMembers().Return([]string{"a", "b"}, nil)
+	s.historyMonitor.EXPECT().GetReachableMembers().Return([]string{"b", "c"}, nil)
+
+	members, err := s.monitor.GetReachableMembers()
+	s.NoError(err)
+	s.Equal([]string{"a", "b", "c"}, members)
+}
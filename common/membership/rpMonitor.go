@@ -31,9 +31,13 @@ import (
 	"net"
 	"strconv"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/temporalio/ringpop-go/events"
+	"github.com/temporalio/ringpop-go/swim"
+
 	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/primitives"
 
@@ -44,6 +48,7 @@ import (
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
 )
 
 const (
@@ -54,29 +59,42 @@ const (
 )
 
 type ringpopMonitor struct {
-	status int32
+	status  int32
+	evicted int32
 
 	serviceName               string
 	services                  map[string]int
 	rp                        *RingPop
 	rings                     map[string]*ringpopServiceResolver
 	logger                    log.Logger
+	metricsScope              metrics.Scope
 	metadataManager           persistence.ClusterMetadataManager
 	broadcastHostPortResolver func() (string, error)
 	hostID                    uuid.UUID
+
+	// self identity resolved by WhoAmI, dropped when ringpop reports a change to this host
+	selfLock           sync.RWMutex
+	selfRingpopAddress string
+	selfHostInfo       *HostInfo
 }
 
 var _ Monitor = (*ringpopMonitor)(nil)
 
-// NewRingpopMonitor returns a ringpop-based membership monitor
+// NewRingpopMonitor returns a ringpop-based membership monitor, placing keys on the rings of each service
+// with hashFunc or the default HashFunc when nil
 func NewRingpopMonitor(
 	serviceName string,
 	services map[string]int,
 	rp *RingPop,
+	metricsClient metrics.Client,
 	logger log.Logger,
 	metadataManager persistence.ClusterMetadataManager,
 	broadcastHostPortResolver func() (string, error),
+	hashFunc HashFunc,
 ) Monitor {
+	if hashFunc == nil {
+		hashFunc = NewRingpopHashFunc()
+	}
 
 	rpo := &ringpopMonitor{
 		broadcastHostPortResolver: broadcastHostPortResolver,
@@ -86,11 +104,12 @@ func NewRingpopMonitor(
 		services:                  services,
 		rp:                        rp,
 		logger:                    logger,
+		metricsScope:              metricsClient.Scope(metrics.MembershipMonitorScope, metrics.RingTag(serviceName)),
 		rings:                     make(map[string]*ringpopServiceResolver),
 		hostID:                    uuid.NewUUID(),
 	}
 	for service, port := range services {
-		rpo.rings[service] = newRingpopServiceResolver(service, port, rp, logger)
+		rpo.rings[service] = newRingpopServiceResolver(service, port, rp, hashFunc, metricsClient, logger)
 	}
 	return rpo
 }
@@ -134,6 +153,7 @@ func (rpo *ringpopMonitor) Start() {
 		rpo.logger.Fatal("unable to set ring pop ServiceRole label", tag.Error(err))
 	}
 
+	rpo.rp.AddListener(rpo)
 	for _, ring := range rpo.rings {
 		ring.Start()
 	}
@@ -305,7 +325,15 @@ func (rpo *ringpopMonitor) Stop() {
 // This is different from service address as we register ringpop handlers on a separate port.
 // For this reason we need to lookup the port for the service and replace ringpop port with service port before
 // returning HostInfo back.
+// The result is cached until ringpop reports that this host was added, updated or removed.
 func (rpo *ringpopMonitor) WhoAmI() (*HostInfo, error) {
+	rpo.selfLock.RLock()
+	hostInfo := rpo.selfHostInfo
+	rpo.selfLock.RUnlock()
+	if hostInfo != nil {
+		return hostInfo, nil
+	}
+
 	address, err := rpo.rp.WhoAmI()
 	if err != nil {
 		return nil, err
@@ -324,11 +352,66 @@ func (rpo *ringpopMonitor) WhoAmI() (*HostInfo, error) {
 	if err != nil {
 		return nil, err
 	}
-	return NewHostInfo(serviceAddress, labels.AsMap()), nil
+	hostInfo = NewHostInfo(serviceAddress, labels.AsMap())
+
+	rpo.selfLock.Lock()
+	rpo.selfRingpopAddress = address
+	rpo.selfHostInfo = hostInfo
+	rpo.selfLock.Unlock()
+	return hostInfo, nil
+}
+
+// HandleEvent handles updates from ringpop, invalidating the cached self identity when it changes
+// and recording the round trip time of gossip pings sent by this host
+func (rpo *ringpopMonitor) HandleEvent(
+	event events.Event,
+) {
+
+	switch e := event.(type) {
+	case swim.PingSendCompleteEvent:
+		rpo.metricsScope.RecordTimer(metrics.MembershipGossipLatency, e.Duration)
+	case events.RingChangedEvent:
+		rpo.invalidateSelf(e)
+	}
+}
+
+func (rpo *ringpopMonitor) invalidateSelf(
+	e events.RingChangedEvent,
+) {
+
+	rpo.selfLock.Lock()
+	defer rpo.selfLock.Unlock()
+
+	if rpo.selfHostInfo == nil {
+		return
+	}
+	for _, servers := range [][]string{e.ServersAdded, e.ServersUpdated, e.ServersRemoved} {
+		for _, server := range servers {
+			if server == rpo.selfRingpopAddress {
+				rpo.logger.Info("Self membership changed, invalidating cached host info")
+				rpo.selfHostInfo = nil
+				return
+			}
+		}
+	}
 }
 
 func (rpo *ringpopMonitor) EvictSelf() error {
-	return rpo.rp.SelfEvict()
+	switch atomic.LoadInt32(&rpo.status) {
+	case common.DaemonStatusInitialized:
+		return ErrMonitorNotStarted
+	case common.DaemonStatusStopped:
+		return nil
+	}
+
+	if !atomic.CompareAndSwapInt32(&rpo.evicted, 0, 1) {
+		return nil
+	}
+	if err := rpo.rp.SelfEvict(); err != nil {
+		atomic.StoreInt32(&rpo.evicted, 0)
+		return err
+	}
+	return nil
 }
 
 func (rpo *ringpopMonitor) GetResolver(service string) (ServiceResolver, error) {
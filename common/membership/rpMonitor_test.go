@@ -25,10 +25,16 @@
 package membership
 
 import (
+	"context"
 	"testing"
 	"time"
 
+	"github.com/temporalio/ringpop-go/events"
+	"github.com/temporalio/ringpop-go/swim"
+	"github.com/uber-go/tally"
+
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/primitives"
 
 	"github.com/stretchr/testify/require"
@@ -117,3 +123,112 @@ func (s *RpoSuite) testCompareMembers(curr []string, new []string, hasDiff bool)
 		s.True(ok)
 	}
 }
+
+func (s *RpoSuite) TestLookupWithContext() {
+	resolver := newRingpopServiceResolver(primitives.HistoryService, 0, nil, NewRingpopHashFunc(), metrics.NewClient(tally.NoopScope, metrics.Common), log.NewNoopLogger())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	go func() {
+		time.Sleep(time.Second)
+		resolver.storeRing(NewRingpopHashFunc().NewRing([]string{"127.0.0.1:7234"}), 1)
+	}()
+	host, err := resolver.LookupWithContext(ctx, "key")
+	s.Equal(context.DeadlineExceeded, err)
+	s.Nil(host)
+
+	host, err = resolver.LookupWithContext(context.Background(), "key")
+	s.NoError(err)
+	s.Equal("127.0.0.1:7234", host.GetAddress())
+
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	host, err = resolver.LookupWithContext(ctx, "key")
+	s.NoError(err, "known members should be returned without waiting")
+	s.Equal("127.0.0.1:7234", host.GetAddress())
+}
+
+func (s *RpoSuite) TestEvictSelf() {
+	serviceName := primitives.HistoryService
+	rpm := NewRingpopMonitor(serviceName, map[string]int{serviceName: 0}, nil, metrics.NewClient(tally.NoopScope, metrics.Common), log.NewNoopLogger(), nil, nil, nil)
+	s.Equal(ErrMonitorNotStarted, rpm.EvictSelf())
+
+	testService := NewTestRingpopCluster(s.T(), "rpm-evict-test", 2, "0.0.0.0", "", serviceName, "127.0.0.1")
+	s.NotNil(testService, "Failed to create test service")
+	defer testService.Stop()
+
+	time.Sleep(time.Second)
+
+	listenCh := make(chan *ChangedEvent, 5)
+	s.NoError(testService.rings[0].AddListener(serviceName, "test-listener", listenCh))
+
+	rpm = testService.rings[1]
+	s.NoError(rpm.EvictSelf())
+	s.NoError(rpm.EvictSelf())
+
+	select {
+	case e := <-listenCh:
+		s.Equal(1, len(e.HostsRemoved), "ringpop monitor event does not report the evicted host")
+		s.Equal(testService.hostAddrs[1], e.HostsRemoved[0].GetAddress(), "ringpop monitor reported that a wrong host was removed")
+	case <-time.After(time.Minute):
+		s.Fail("Timed out waiting for eviction to be detected by ringpop")
+	}
+
+	rpm.Stop()
+	s.NoError(rpm.EvictSelf())
+}
+
+func (s *RpoSuite) TestWhoAmI_Cached() {
+	serviceName := primitives.HistoryService
+	testService := NewTestRingpopCluster(s.T(), "rpm-whoami-test", 2, "0.0.0.0", "", serviceName, "127.0.0.1")
+	s.NotNil(testService, "Failed to create test service")
+	defer testService.Stop()
+
+	rpm := testService.rings[0].(*ringpopMonitor)
+
+	self, err := rpm.WhoAmI()
+	s.NoError(err)
+	s.NotNil(self)
+
+	cached, err := rpm.WhoAmI()
+	s.NoError(err)
+	s.True(self == cached, "WhoAmI should return the cached host info")
+
+	peerAddress, err := testService.rings[1].(*ringpopMonitor).rp.WhoAmI()
+	s.NoError(err)
+	rpm.HandleEvent(events.RingChangedEvent{ServersUpdated: []string{peerAddress}})
+	cached, err = rpm.WhoAmI()
+	s.NoError(err)
+	s.True(self == cached, "a change to another host should not invalidate the cache")
+
+	selfAddress, err := rpm.rp.WhoAmI()
+	s.NoError(err)
+	rpm.HandleEvent(events.RingChangedEvent{ServersUpdated: []string{selfAddress}})
+	refreshed, err := rpm.WhoAmI()
+	s.NoError(err)
+	s.False(self == refreshed, "a change to this host should invalidate the cache")
+	s.Equal(self.GetAddress(), refreshed.GetAddress())
+}
+
+func (s *RpoSuite) TestMembershipMetrics() {
+	serviceName := primitives.HistoryService
+	scope := tally.NewTestScope("", nil)
+	rpm := NewRingpopMonitor(serviceName, map[string]int{serviceName: 0}, nil, metrics.NewClient(scope, metrics.Common), log.NewNoopLogger(), nil, nil, nil).(*ringpopMonitor)
+	resolver := rpm.rings[serviceName]
+
+	// simulate hosts joining and leaving the ring as seen on successive refreshes
+	resolver.updateMembersNoLock([]string{"127.0.0.1:7234", "127.0.0.2:7234"})
+	resolver.updateMembersNoLock([]string{"127.0.0.1:7234", "127.0.0.2:7234", "127.0.0.3:7234"})
+	resolver.updateMembersNoLock([]string{"127.0.0.1:7234", "127.0.0.3:7234"})
+	resolver.updateMembersNoLock([]string{"127.0.0.1:7234", "127.0.0.3:7234"})
+
+	rpm.HandleEvent(swim.PingSendCompleteEvent{Duration: 5 * time.Millisecond})
+	rpm.HandleEvent(swim.PingSendCompleteEvent{Duration: 7 * time.Millisecond})
+
+	snapshot := scope.Snapshot()
+	tags := "+namespace=all,operation=MembershipMonitor,ring=" + serviceName
+	s.Equal(int64(3), snapshot.Counters()["membership_ring_joins"+tags].Value())
+	s.Equal(int64(1), snapshot.Counters()["membership_ring_leaves"+tags].Value())
+	s.Equal(float64(2), snapshot.Gauges()["membership_ring_member_count"+tags].Value())
+	s.Len(snapshot.Timers()["membership_gossip_latency"+tags].Values(), 2)
+}
@@ -25,6 +25,7 @@
 package membership
 
 import (
+	"context"
 	"errors"
 	"net"
 	"strconv"
@@ -35,14 +36,13 @@ import (
 	"github.com/temporalio/ringpop-go"
 	"github.com/uber/tchannel-go"
 
-	"github.com/dgryski/go-farm"
 	"github.com/temporalio/ringpop-go/events"
-	"github.com/temporalio/ringpop-go/hashring"
 	"github.com/temporalio/ringpop-go/swim"
 
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
 )
 
 const (
@@ -65,12 +65,17 @@ type ringpopServiceResolver struct {
 	service     string
 	port        int
 	rp          *RingPop
+	hashFunc    HashFunc
 	refreshChan chan struct{}
 	shutdownCh  chan struct{}
 	shutdownWG  sync.WaitGroup
 	logger      log.Logger
+	// metricsScope is tagged with the ring, i.e. the service name
+	metricsScope metrics.Scope
 
 	ringValue atomic.Value // this stores the current hashring
+	readyOnce sync.Once
+	readyCh   chan struct{} // closed once the hashring first has members
 
 	refreshLock     sync.Mutex
 	lastRefreshTime time.Time
@@ -86,28 +91,29 @@ func newRingpopServiceResolver(
 	service string,
 	port int,
 	rp *RingPop,
+	hashFunc HashFunc,
+	metricsClient metrics.Client,
 	logger log.Logger,
 ) *ringpopServiceResolver {
 
 	resolver := &ringpopServiceResolver{
-		status:      common.DaemonStatusInitialized,
-		service:     service,
-		port:        port,
-		rp:          rp,
-		refreshChan: make(chan struct{}),
-		shutdownCh:  make(chan struct{}),
-		logger:      log.With(logger, tag.ComponentServiceResolver, tag.Service(service)),
-		membersMap:  make(map[string]struct{}),
-		listeners:   make(map[string]chan<- *ChangedEvent),
-	}
-	resolver.ringValue.Store(newHashRing())
+		status:       common.DaemonStatusInitialized,
+		service:      service,
+		port:         port,
+		rp:           rp,
+		hashFunc:     hashFunc,
+		refreshChan:  make(chan struct{}),
+		shutdownCh:   make(chan struct{}),
+		readyCh:      make(chan struct{}),
+		logger:       log.With(logger, tag.ComponentServiceResolver, tag.Service(service)),
+		metricsScope: metricsClient.Scope(metrics.MembershipMonitorScope, metrics.RingTag(service)),
+		membersMap:   make(map[string]struct{}),
+		listeners:    make(map[string]chan<- *ChangedEvent),
+	}
+	resolver.ringValue.Store(hashFunc.NewRing(nil))
 	return resolver
 }
 
-func newHashRing() *hashring.HashRing {
-	return hashring.New(farm.Fingerprint32, replicaPoints)
-}
-
 // Start starts the oracle
 func (r *ringpopServiceResolver) Start() {
 	if !atomic.CompareAndSwapInt32(
@@ -140,7 +146,7 @@ func (r *ringpopServiceResolver) Stop() {
 	r.listenerLock.Lock()
 	defer r.listenerLock.Unlock()
 	r.rp.RemoveListener(r)
-	r.ringValue.Store(newHashRing())
+	r.ringValue.Store(r.hashFunc.NewRing(nil))
 	r.listeners = make(map[string]chan<- *ChangedEvent)
 	close(r.shutdownCh)
 
@@ -166,6 +172,24 @@ func (r *ringpopServiceResolver) Lookup(
 	return NewHostInfo(addr, r.getLabelsMap()), nil
 }
 
+func (r *ringpopServiceResolver) LookupWithContext(
+	ctx context.Context,
+	key string,
+) (*HostInfo, error) {
+
+	host, err := r.Lookup(key)
+	if err != ErrInsufficientHosts {
+		return host, err
+	}
+
+	select {
+	case <-r.readyCh:
+		return r.Lookup(key)
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
 func (r *ringpopServiceResolver) AddListener(
 	name string,
 	notifyChannel chan<- *ChangedEvent,
@@ -249,22 +273,48 @@ func (r *ringpopServiceResolver) refreshNoLock() error {
 		return err
 	}
 
+	r.updateMembersNoLock(addrs)
+	return nil
+}
+
+func (r *ringpopServiceResolver) updateMembersNoLock(addrs []string) {
 	newMembersMap, changed := r.compareMembers(addrs)
 	if !changed {
-		return nil
+		return
 	}
 
-	ring := newHashRing()
-	for _, addr := range addrs {
-		host := NewHostInfo(addr, r.getLabelsMap())
-		ring.AddMembers(host)
-	}
+	ring := r.hashFunc.NewRing(addrs)
 
+	r.emitMembershipChanges(newMembersMap)
 	r.membersMap = newMembersMap
 	r.lastRefreshTime = time.Now().UTC()
-	r.ringValue.Store(ring)
+	r.storeRing(ring, len(addrs))
 	r.logger.Info("Current reachable members", tag.Addresses(addrs))
-	return nil
+}
+
+// emitMembershipChanges counts the members which joined or left the ring since the last refresh
+func (r *ringpopServiceResolver) emitMembershipChanges(newMembersMap map[string]struct{}) {
+	joins, leaves := 0, 0
+	for addr := range newMembersMap {
+		if _, ok := r.membersMap[addr]; !ok {
+			joins++
+		}
+	}
+	for addr := range r.membersMap {
+		if _, ok := newMembersMap[addr]; !ok {
+			leaves++
+		}
+	}
+	r.metricsScope.AddCounter(metrics.MembershipRingJoins, int64(joins))
+	r.metricsScope.AddCounter(metrics.MembershipRingLeaves, int64(leaves))
+	r.metricsScope.UpdateGauge(metrics.MembershipRingMemberCount, float64(len(newMembersMap)))
+}
+
+func (r *ringpopServiceResolver) storeRing(ring HashRing, memberCount int) {
+	r.ringValue.Store(ring)
+	if memberCount > 0 {
+		r.readyOnce.Do(func() { close(r.readyCh) })
+	}
 }
 
 func (r *ringpopServiceResolver) getReachableMembers() ([]string, error) {
@@ -352,8 +402,17 @@ func (r *ringpopServiceResolver) refreshRingWorker() {
 	}
 }
 
-func (r *ringpopServiceResolver) ring() *hashring.HashRing {
-	return r.ringValue.Load().(*hashring.HashRing)
+func (r *ringpopServiceResolver) ring() HashRing {
+	return r.ringValue.Load().(HashRing)
+}
+
+func (r *ringpopServiceResolver) DiffOnChange(
+	added []*HostInfo,
+	removed []*HostInfo,
+	keys []string,
+) map[string]OwnershipChange {
+
+	return diffOnChange(r.ring(), r.hashFunc, r.getLabelsMap(), added, removed, keys)
 }
 
 func (r *ringpopServiceResolver) getLabelsMap() map[string]string {
@@ -31,10 +31,12 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/pborman/uuid"
 	"github.com/temporalio/ringpop-go"
+	"github.com/uber-go/tally"
 	"github.com/uber/tchannel-go"
 
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
 )
 
@@ -142,6 +144,7 @@ func NewTestRingpopCluster(
 		}).AnyTimes()
 
 	for i := 0; i < size; i++ {
+		i := i
 		resolver := func() (string, error) {
 			return BuildBroadcastHostPort(cluster.channels[i].PeerInfo(), broadcastAddress)
 		}
@@ -156,9 +159,11 @@ func NewTestRingpopCluster(
 			serviceName,
 			map[string]int{serviceName: 0},
 			rpWrapper,
+			metrics.NewClient(tally.NoopScope, metrics.Common),
 			logger,
 			mockMgr,
 			resolver,
+			nil,
 		)
 		cluster.rings[i].Start()
 	}
@@ -0,0 +1,209 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common"
+)
+
+type (
+	shardResolver struct {
+		monitor   Monitor
+		numShards int
+
+		sync.Mutex
+		subscriptions map[string]chan struct{}
+	}
+)
+
+const shardOwnershipListenerPrefix = "shard-ownership-"
+
+var _ ShardResolver = (*shardResolver)(nil)
+
+// NewShardResolver creates a new shard resolver for a cluster with numShards history shards
+func NewShardResolver(
+	monitor Monitor,
+	numShards int,
+) ShardResolver {
+	return &shardResolver{
+		monitor:       monitor,
+		numShards:     numShards,
+		subscriptions: make(map[string]chan struct{}),
+	}
+}
+
+func (r *shardResolver) OwnerForShard(
+	shardID int,
+) (*HostInfo, error) {
+
+	if shardID < 1 || shardID > r.numShards {
+		return nil, serviceerror.NewInvalidArgument(
+			fmt.Sprintf("shard ID %v is out of range [1, %v]", shardID, r.numShards),
+		)
+	}
+
+	resolver, err := r.monitor.GetResolver(common.HistoryServiceName)
+	if err != nil {
+		return nil, err
+	}
+	return resolver.Lookup(shardKey(shardID))
+}
+
+func (r *shardResolver) OwnerForWorkflow(
+	namespaceID string,
+	workflowID string,
+) (*HostInfo, error) {
+
+	return r.OwnerForShard(WorkflowShard(namespaceID, workflowID, r.numShards))
+}
+
+func (r *shardResolver) ShardsForHost(
+	host *HostInfo,
+) []int {
+
+	resolver, err := r.monitor.GetResolver(common.HistoryServiceName)
+	if err != nil {
+		return nil
+	}
+
+	var shardIDs []int
+	for shardID := 1; shardID <= r.numShards; shardID++ {
+		owner, err := resolver.Lookup(shardKey(shardID))
+		if err != nil {
+			continue
+		}
+		if owner.GetAddress() == host.GetAddress() {
+			shardIDs = append(shardIDs, shardID)
+		}
+	}
+	return shardIDs
+}
+
+func (r *shardResolver) SubscribeShardOwnership(
+	host *HostInfo,
+	callback ShardOwnershipCallback,
+) error {
+
+	resolver, err := r.monitor.GetResolver(common.HistoryServiceName)
+	if err != nil {
+		return err
+	}
+
+	r.Lock()
+	defer r.Unlock()
+
+	if _, ok := r.subscriptions[host.GetAddress()]; ok {
+		return ErrListenerAlreadyExist
+	}
+
+	// take the initial snapshot before listening so no change is missed in between
+	owned := r.ShardsForHost(host)
+	notifyCh := make(chan *ChangedEvent, 1)
+	if err := resolver.AddListener(shardOwnershipListenerPrefix+host.GetAddress(), notifyCh); err != nil {
+		return err
+	}
+	stopCh := make(chan struct{})
+	r.subscriptions[host.GetAddress()] = stopCh
+
+	go r.notifyShardOwnershipChanges(host, owned, callback, notifyCh, stopCh)
+	return nil
+}
+
+func (r *shardResolver) UnsubscribeShardOwnership(
+	host *HostInfo,
+) error {
+
+	resolver, err := r.monitor.GetResolver(common.HistoryServiceName)
+	if err != nil {
+		return err
+	}
+
+	r.Lock()
+	defer r.Unlock()
+
+	stopCh, ok := r.subscriptions[host.GetAddress()]
+	if !ok {
+		return nil
+	}
+	delete(r.subscriptions, host.GetAddress())
+	close(stopCh)
+	return resolver.RemoveListener(shardOwnershipListenerPrefix + host.GetAddress())
+}
+
+func (r *shardResolver) notifyShardOwnershipChanges(
+	host *HostInfo,
+	owned []int,
+	callback ShardOwnershipCallback,
+	notifyCh <-chan *ChangedEvent,
+	stopCh <-chan struct{},
+) {
+
+	for {
+		select {
+		case <-notifyCh:
+			newOwned := r.ShardsForHost(host)
+			gained := shardsDifference(newOwned, owned)
+			lost := shardsDifference(owned, newOwned)
+			owned = newOwned
+			if len(gained) > 0 || len(lost) > 0 {
+				callback(gained, lost)
+			}
+		case <-stopCh:
+			return
+		}
+	}
+}
+
+// shardsDifference returns the shards in a which are not in b
+func shardsDifference(a []int, b []int) []int {
+	inB := make(map[int]struct{}, len(b))
+	for _, shardID := range b {
+		inB[shardID] = struct{}{}
+	}
+	var difference []int
+	for _, shardID := range a {
+		if _, ok := inB[shardID]; !ok {
+			difference = append(difference, shardID)
+		}
+	}
+	return difference
+}
+
+// WorkflowShard returns the ID of the history shard owning the workflow, shard IDs start with 1.
+// It is the same assignment as common.WorkflowIDToHistoryShard used by history and matching.
+func WorkflowShard(namespaceID string, workflowID string, numShards int) int {
+	return int(common.WorkflowIDToHistoryShard(namespaceID, workflowID, int32(numShards)))
+}
+
+// shardKey is the ring key of a shard, it must match the key used by the history shard controller
+func shardKey(shardID int) string {
+	return strconv.Itoa(shardID)
+}
@@ -0,0 +1,272 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common"
+)
+
+type (
+	shardResolverSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller *gomock.Controller
+	}
+)
+
+const testNumShards = 64
+
+func TestShardResolverSuite(t *testing.T) {
+	s := new(shardResolverSuite)
+	suite.Run(t, s)
+}
+
+func (s *shardResolverSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+}
+
+func (s *shardResolverSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *shardResolverSuite) TestEveryShardHasExactlyOneOwner() {
+	hosts := []string{"10.0.0.1:7234", "10.0.0.2:7234", "10.0.0.3:7234"}
+	resolver := NewShardResolver(s.newMonitor(hosts...), testNumShards)
+
+	owners := make(map[int]string, testNumShards)
+	for shardID := 1; shardID <= testNumShards; shardID++ {
+		owner, err := resolver.OwnerForShard(shardID)
+		s.NoError(err)
+		s.Contains(hosts, owner.GetAddress())
+		owners[shardID] = owner.GetAddress()
+	}
+
+	seen := make(map[int]struct{}, testNumShards)
+	for _, host := range hosts {
+		for _, shardID := range resolver.ShardsForHost(NewHostInfo(host, nil)) {
+			_, duplicate := seen[shardID]
+			s.False(duplicate, "shard %v owned by more than one host", shardID)
+			seen[shardID] = struct{}{}
+			s.Equal(host, owners[shardID])
+		}
+	}
+	s.Len(seen, testNumShards)
+}
+
+func (s *shardResolverSuite) TestOwnershipStableAcrossIdenticalRings() {
+	hosts := []string{"10.0.0.1:7234", "10.0.0.2:7234", "10.0.0.3:7234"}
+	resolver1 := NewShardResolver(s.newMonitor(hosts...), testNumShards)
+	// same members, added in a different order
+	resolver2 := NewShardResolver(s.newMonitor(hosts[2], hosts[0], hosts[1]), testNumShards)
+
+	for shardID := 1; shardID <= testNumShards; shardID++ {
+		owner1, err := resolver1.OwnerForShard(shardID)
+		s.NoError(err)
+		owner2, err := resolver2.OwnerForShard(shardID)
+		s.NoError(err)
+		s.Equal(owner1.GetAddress(), owner2.GetAddress())
+	}
+}
+
+func (s *shardResolverSuite) TestOwnerForShard_OutOfRange() {
+	resolver := NewShardResolver(NewMockMonitor(s.controller), testNumShards)
+
+	for _, shardID := range []int{0, testNumShards + 1} {
+		_, err := resolver.OwnerForShard(shardID)
+		s.IsType(&serviceerror.InvalidArgument{}, err)
+	}
+}
+
+func (s *shardResolverSuite) TestWorkflowShard() {
+	testCases := []struct {
+		namespaceID string
+		workflowID  string
+		numShards   int
+		shardID     int
+	}{
+		{"f9d4a1c2-6f3e-4b1a-9f0e-1d2c3b4a5e6f", "order-12345", 1, 1},
+		{"f9d4a1c2-6f3e-4b1a-9f0e-1d2c3b4a5e6f", "order-12345", 4, 2},
+		{"f9d4a1c2-6f3e-4b1a-9f0e-1d2c3b4a5e6f", "order-12345", 512, 458},
+		{"f9d4a1c2-6f3e-4b1a-9f0e-1d2c3b4a5e6f", "order-12346", 512, 10},
+		{"default", "hello-world", 4, 3},
+		{"default", "hello-world", 512, 427},
+		{"", "", 512, 30},
+	}
+
+	for _, tc := range testCases {
+		shardID := WorkflowShard(tc.namespaceID, tc.workflowID, tc.numShards)
+		s.Equal(tc.shardID, shardID, "%v/%v with %v shards", tc.namespaceID, tc.workflowID, tc.numShards)
+		s.Equal(int32(shardID), common.WorkflowIDToHistoryShard(tc.namespaceID, tc.workflowID, int32(tc.numShards)))
+	}
+}
+
+func (s *shardResolverSuite) TestOwnerForWorkflow() {
+	hosts := []string{"10.0.0.1:7234", "10.0.0.2:7234", "10.0.0.3:7234"}
+	resolver := NewShardResolver(s.newMonitor(hosts...), testNumShards)
+
+	for _, workflowID := range []string{"wid-1", "wid-2", "wid-3"} {
+		owner, err := resolver.OwnerForWorkflow("namespace-id", workflowID)
+		s.NoError(err)
+		shardOwner, err := resolver.OwnerForShard(WorkflowShard("namespace-id", workflowID, testNumShards))
+		s.NoError(err)
+		s.Equal(shardOwner.GetAddress(), owner.GetAddress())
+	}
+}
+
+func (s *shardResolverSuite) TestShardsForHost_UnknownHost() {
+	resolver := NewShardResolver(s.newMonitor("10.0.0.1:7234"), testNumShards)
+
+	s.Empty(resolver.ShardsForHost(NewHostInfo("10.0.0.9:7234", nil)))
+}
+
+func (s *shardResolverSuite) TestSubscribeShardOwnership_HostJoins() {
+	hosts := []string{"10.0.0.1:7234", "10.0.0.2:7234"}
+	joiningHost := "10.0.0.3:7234"
+	ring := newTestHistoryRing(hosts...)
+	resolver := NewShardResolver(ring.newMonitor(s.controller), testNumShards)
+	host := NewHostInfo(hosts[0], nil)
+
+	ownedBefore := resolver.ShardsForHost(host)
+	type ownershipChange struct{ gained, lost []int }
+	changes := make(chan ownershipChange, 1)
+	s.NoError(resolver.SubscribeShardOwnership(host, func(gained []int, lost []int) {
+		changes <- ownershipChange{gained: gained, lost: lost}
+	}))
+
+	ring.addHost(joiningHost)
+	ownedAfter := resolver.ShardsForHost(host)
+	var expectedLost []int
+	for _, shardID := range ownedBefore {
+		owner, err := resolver.OwnerForShard(shardID)
+		s.NoError(err)
+		if owner.GetAddress() == joiningHost {
+			expectedLost = append(expectedLost, shardID)
+		}
+	}
+	s.NotEmpty(expectedLost)
+	s.Len(ownedAfter, len(ownedBefore)-len(expectedLost))
+
+	select {
+	case change := <-changes:
+		s.Empty(change.gained)
+		s.Equal(expectedLost, change.lost)
+	case <-time.After(5 * time.Second):
+		s.Fail("timed out waiting for shard ownership change")
+	}
+
+	s.NoError(resolver.UnsubscribeShardOwnership(host))
+}
+
+func (s *shardResolverSuite) TestSubscribeShardOwnership_Duplicate() {
+	ring := newTestHistoryRing("10.0.0.1:7234")
+	resolver := NewShardResolver(ring.newMonitor(s.controller), testNumShards)
+	host := NewHostInfo("10.0.0.1:7234", nil)
+
+	s.NoError(resolver.SubscribeShardOwnership(host, func([]int, []int) {}))
+	s.Equal(ErrListenerAlreadyExist, resolver.SubscribeShardOwnership(host, func([]int, []int) {}))
+	s.NoError(resolver.UnsubscribeShardOwnership(host))
+	s.NoError(resolver.SubscribeShardOwnership(host, func([]int, []int) {}))
+	s.NoError(resolver.UnsubscribeShardOwnership(host))
+}
+
+// newMonitor returns a monitor whose history ring contains the given hosts
+func (s *shardResolverSuite) newMonitor(addrs ...string) Monitor {
+	return newTestHistoryRing(addrs...).newMonitor(s.controller)
+}
+
+// testHistoryRing is a history ring whose membership can be changed by the test
+type testHistoryRing struct {
+	sync.Mutex
+	addrs     []string
+	ring      HashRing
+	listeners map[string]chan<- *ChangedEvent
+}
+
+func newTestHistoryRing(addrs ...string) *testHistoryRing {
+	r := &testHistoryRing{listeners: make(map[string]chan<- *ChangedEvent)}
+	r.setHosts(addrs)
+	return r
+}
+
+func (r *testHistoryRing) setHosts(addrs []string) {
+	r.addrs = addrs
+	r.ring = NewRingpopHashFunc().NewRing(addrs)
+}
+
+func (r *testHistoryRing) addHost(addr string) {
+	r.Lock()
+	r.setHosts(append(r.addrs, addr))
+	var listeners []chan<- *ChangedEvent
+	for _, listener := range r.listeners {
+		listeners = append(listeners, listener)
+	}
+	r.Unlock()
+
+	// notify without holding the lock, listeners look up the ring on notification
+	for _, listener := range listeners {
+		listener <- &ChangedEvent{HostsAdded: []*HostInfo{NewHostInfo(addr, nil)}}
+	}
+}
+
+func (r *testHistoryRing) newMonitor(controller *gomock.Controller) Monitor {
+	serviceResolver := NewMockServiceResolver(controller)
+	serviceResolver.EXPECT().Lookup(gomock.Any()).DoAndReturn(func(key string) (*HostInfo, error) {
+		r.Lock()
+		defer r.Unlock()
+		addr, found := r.ring.Lookup(key)
+		if !found {
+			return nil, ErrInsufficientHosts
+		}
+		return NewHostInfo(addr, nil), nil
+	}).AnyTimes()
+	serviceResolver.EXPECT().AddListener(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(name string, notifyChannel chan<- *ChangedEvent) error {
+			r.Lock()
+			defer r.Unlock()
+			r.listeners[name] = notifyChannel
+			return nil
+		}).AnyTimes()
+	serviceResolver.EXPECT().RemoveListener(gomock.Any()).DoAndReturn(func(name string) error {
+		r.Lock()
+		defer r.Unlock()
+		delete(r.listeners, name)
+		return nil
+	}).AnyTimes()
+
+	monitor := NewMockMonitor(controller)
+	monitor.EXPECT().GetResolver(common.HistoryServiceName).Return(serviceResolver, nil).AnyTimes()
+	return monitor
+}
@@ -0,0 +1,202 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+type staticMonitor struct {
+	status          int32
+	hostInfo        *HostInfo
+	resolvers       map[string]*staticServiceResolver
+	refreshInterval time.Duration
+	shutdownCh      chan struct{}
+	shutdownWG      sync.WaitGroup
+	logger          log.Logger
+}
+
+var _ Monitor = (*staticMonitor)(nil)
+
+// NewStaticMonitor returns a Monitor serving fixed members per service instead of gossiping with ringpop.
+// hosts maps each service to host:port addresses, host names are periodically re-resolved through DNS
+// so a name resolving to several addresses (like a kubernetes headless service) yields one member per
+// address. The first address listed for serviceName is the address of this host.
+func NewStaticMonitor(
+	serviceName string,
+	hosts map[string][]string,
+	logger log.Logger,
+) (Monitor, error) {
+	return newStaticMonitor(serviceName, hosts, net.LookupHost, defaultRefreshInterval, logger)
+}
+
+func newStaticMonitor(
+	serviceName string,
+	hosts map[string][]string,
+	lookupHost lookupHostFunc,
+	refreshInterval time.Duration,
+	logger log.Logger,
+) (*staticMonitor, error) {
+	if len(hosts[serviceName]) == 0 {
+		return nil, ErrUnknownService
+	}
+
+	resolvers := make(map[string]*staticServiceResolver, len(hosts))
+	for service, serviceHosts := range hosts {
+		resolvers[service] = newStaticServiceResolver(service, serviceHosts, lookupHost, NewRingpopHashFunc(), logger)
+	}
+
+	return &staticMonitor{
+		status:          common.DaemonStatusInitialized,
+		hostInfo:        NewHostInfo(hosts[serviceName][0], map[string]string{RoleKey: serviceName}),
+		resolvers:       resolvers,
+		refreshInterval: refreshInterval,
+		shutdownCh:      make(chan struct{}),
+		logger:          logger,
+	}, nil
+}
+
+func (m *staticMonitor) Start() {
+	if !atomic.CompareAndSwapInt32(
+		&m.status,
+		common.DaemonStatusInitialized,
+		common.DaemonStatusStarted,
+	) {
+		return
+	}
+
+	m.refresh()
+
+	m.shutdownWG.Add(1)
+	go m.refreshWorker()
+}
+
+func (m *staticMonitor) Stop() {
+	if !atomic.CompareAndSwapInt32(
+		&m.status,
+		common.DaemonStatusStarted,
+		common.DaemonStatusStopped,
+	) {
+		return
+	}
+
+	close(m.shutdownCh)
+	if success := common.AwaitWaitGroup(&m.shutdownWG, time.Minute); !success {
+		m.logger.Warn("static monitor timed out on shutdown.")
+	}
+}
+
+func (m *staticMonitor) WhoAmI() (*HostInfo, error) {
+	return m.hostInfo, nil
+}
+
+// EvictSelf is a no-op as members of a static ring are fixed by configuration
+func (m *staticMonitor) EvictSelf() error {
+	if atomic.LoadInt32(&m.status) == common.DaemonStatusInitialized {
+		return ErrMonitorNotStarted
+	}
+	return nil
+}
+
+func (m *staticMonitor) Lookup(service string, key string) (*HostInfo, error) {
+	resolver, err := m.GetResolver(service)
+	if err != nil {
+		return nil, err
+	}
+	return resolver.Lookup(key)
+}
+
+func (m *staticMonitor) GetResolver(service string) (ServiceResolver, error) {
+	resolver, ok := m.resolvers[service]
+	if !ok {
+		return nil, ErrUnknownService
+	}
+	return resolver, nil
+}
+
+func (m *staticMonitor) AddListener(service string, name string, notifyChannel chan<- *ChangedEvent) error {
+	resolver, err := m.GetResolver(service)
+	if err != nil {
+		return err
+	}
+	return resolver.AddListener(name, notifyChannel)
+}
+
+func (m *staticMonitor) RemoveListener(service string, name string) error {
+	resolver, err := m.GetResolver(service)
+	if err != nil {
+		return err
+	}
+	return resolver.RemoveListener(name)
+}
+
+func (m *staticMonitor) GetReachableMembers() ([]string, error) {
+	var members []string
+	for _, resolver := range m.resolvers {
+		for _, host := range resolver.Members() {
+			members = append(members, host.GetAddress())
+		}
+	}
+	return members, nil
+}
+
+func (m *staticMonitor) GetMemberCount(role string) (int, error) {
+	resolver, err := m.GetResolver(role)
+	if err != nil {
+		return 0, err
+	}
+	return resolver.MemberCount(), nil
+}
+
+func (m *staticMonitor) refresh() {
+	for service, resolver := range m.resolvers {
+		if err := resolver.refresh(); err != nil {
+			m.logger.Error("error resolving static members", tag.Service(service), tag.Error(err))
+		}
+	}
+}
+
+func (m *staticMonitor) refreshWorker() {
+	defer m.shutdownWG.Done()
+
+	refreshTicker := time.NewTicker(m.refreshInterval)
+	defer refreshTicker.Stop()
+
+	for {
+		select {
+		case <-m.shutdownCh:
+			return
+		case <-refreshTicker.C:
+			m.refresh()
+		}
+	}
+}
@@ -0,0 +1,156 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/primitives"
+)
+
+type (
+	staticMonitorSuite struct {
+		*require.Assertions
+		suite.Suite
+	}
+
+	fakeDNS struct {
+		sync.Mutex
+		records map[string][]string
+	}
+)
+
+func TestStaticMonitorSuite(t *testing.T) {
+	suite.Run(t, new(staticMonitorSuite))
+}
+
+func (s *staticMonitorSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (d *fakeDNS) lookupHost(host string) ([]string, error) {
+	d.Lock()
+	defer d.Unlock()
+	addrs, ok := d.records[host]
+	if !ok {
+		return nil, errors.New("no such host")
+	}
+	return addrs, nil
+}
+
+func (d *fakeDNS) set(host string, addrs ...string) {
+	d.Lock()
+	defer d.Unlock()
+	d.records[host] = addrs
+}
+
+func (s *staticMonitorSuite) TestStaticMonitor() {
+	monitor, err := NewStaticMonitor(primitives.FrontendService, map[string][]string{
+		primitives.FrontendService: {"127.0.0.1:7233"},
+		primitives.HistoryService:  {"127.0.0.1:7234", "127.0.0.2:7234"},
+	}, log.NewNoopLogger())
+	s.NoError(err)
+
+	s.Equal(ErrMonitorNotStarted, monitor.EvictSelf())
+	monitor.Start()
+	defer monitor.Stop()
+
+	self, err := monitor.WhoAmI()
+	s.NoError(err)
+	s.Equal("127.0.0.1:7233", self.GetAddress())
+
+	resolver, err := monitor.GetResolver(primitives.HistoryService)
+	s.NoError(err)
+	s.Equal(2, resolver.MemberCount())
+
+	host, err := monitor.Lookup(primitives.HistoryService, "key")
+	s.NoError(err)
+	s.Contains([]string{"127.0.0.1:7234", "127.0.0.2:7234"}, host.GetAddress())
+	sameHost, err := resolver.Lookup("key")
+	s.NoError(err)
+	s.Equal(host.GetAddress(), sameHost.GetAddress())
+
+	count, err := monitor.GetMemberCount(primitives.FrontendService)
+	s.NoError(err)
+	s.Equal(1, count)
+	members, err := monitor.GetReachableMembers()
+	s.NoError(err)
+	s.Len(members, 3)
+
+	_, err = monitor.GetResolver(primitives.MatchingService)
+	s.Equal(ErrUnknownService, err)
+	_, err = monitor.Lookup(primitives.MatchingService, "key")
+	s.Equal(ErrUnknownService, err)
+	s.NoError(monitor.EvictSelf())
+}
+
+func (s *staticMonitorSuite) TestStaticMonitor_UnknownSelf() {
+	_, err := NewStaticMonitor(primitives.FrontendService, map[string][]string{
+		primitives.HistoryService: {"127.0.0.1:7234"},
+	}, log.NewNoopLogger())
+	s.Equal(ErrUnknownService, err)
+}
+
+func (s *staticMonitorSuite) TestStaticMonitor_ReResolvesDNS() {
+	dns := &fakeDNS{records: map[string][]string{
+		"frontend":         {"10.0.0.1"},
+		"history-headless": {"10.0.1.1", "10.0.1.2"},
+	}}
+	monitor, err := newStaticMonitor(primitives.FrontendService, map[string][]string{
+		primitives.FrontendService: {"frontend:7233"},
+		primitives.HistoryService:  {"history-headless:7234"},
+	}, dns.lookupHost, 10*time.Millisecond, log.NewNoopLogger())
+	s.NoError(err)
+
+	listenerCh := make(chan *ChangedEvent, 1)
+	s.NoError(monitor.AddListener(primitives.HistoryService, "test-listener", listenerCh))
+	monitor.Start()
+	defer monitor.Stop()
+
+	event := <-listenerCh
+	s.Len(event.HostsAdded, 2)
+	resolver, err := monitor.GetResolver(primitives.HistoryService)
+	s.NoError(err)
+	s.Equal(2, resolver.MemberCount())
+
+	dns.set("history-headless", "10.0.1.2", "10.0.1.3")
+	select {
+	case event = <-listenerCh:
+	case <-time.After(time.Second):
+		s.Fail("members should be re-resolved")
+	}
+	s.Len(event.HostsAdded, 1)
+	s.Equal("10.0.1.3:7234", event.HostsAdded[0].GetAddress())
+	s.Len(event.HostsRemoved, 1)
+	s.Equal("10.0.1.1:7234", event.HostsRemoved[0].GetAddress())
+	s.Equal(2, resolver.MemberCount())
+}
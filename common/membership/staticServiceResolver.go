@@ -0,0 +1,254 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"context"
+	"net"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+type (
+	// lookupHostFunc resolves a host name into addresses, see net.LookupHost
+	lookupHostFunc func(host string) ([]string, error)
+
+	staticServiceResolver struct {
+		service    string
+		hosts      []string
+		lookupHost lookupHostFunc
+		hashFunc   HashFunc
+		logger     log.Logger
+
+		ringValue atomic.Value // this stores the current hashring
+		readyOnce sync.Once
+		readyCh   chan struct{} // closed once the hashring first has members
+
+		refreshLock sync.Mutex
+		membersMap  map[string]struct{}
+
+		listenerLock sync.RWMutex
+		listeners    map[string]chan<- *ChangedEvent
+	}
+)
+
+var _ ServiceResolver = (*staticServiceResolver)(nil)
+
+func newStaticServiceResolver(
+	service string,
+	hosts []string,
+	lookupHost lookupHostFunc,
+	hashFunc HashFunc,
+	logger log.Logger,
+) *staticServiceResolver {
+
+	resolver := &staticServiceResolver{
+		service:    service,
+		hosts:      hosts,
+		lookupHost: lookupHost,
+		hashFunc:   hashFunc,
+		logger:     log.With(logger, tag.ComponentServiceResolver, tag.Service(service)),
+		readyCh:    make(chan struct{}),
+		membersMap: make(map[string]struct{}),
+		listeners:  make(map[string]chan<- *ChangedEvent),
+	}
+	resolver.ringValue.Store(hashFunc.NewRing(nil))
+	return resolver
+}
+
+// Lookup finds the host in the ring responsible for serving the given key
+func (r *staticServiceResolver) Lookup(
+	key string,
+) (*HostInfo, error) {
+
+	addr, found := r.ring().Lookup(key)
+	if !found {
+		return nil, ErrInsufficientHosts
+	}
+
+	return NewHostInfo(addr, r.getLabelsMap()), nil
+}
+
+func (r *staticServiceResolver) LookupWithContext(
+	ctx context.Context,
+	key string,
+) (*HostInfo, error) {
+
+	host, err := r.Lookup(key)
+	if err != ErrInsufficientHosts {
+		return host, err
+	}
+
+	select {
+	case <-r.readyCh:
+		return r.Lookup(key)
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (r *staticServiceResolver) AddListener(
+	name string,
+	notifyChannel chan<- *ChangedEvent,
+) error {
+
+	r.listenerLock.Lock()
+	defer r.listenerLock.Unlock()
+	_, ok := r.listeners[name]
+	if ok {
+		return ErrListenerAlreadyExist
+	}
+	r.listeners[name] = notifyChannel
+	return nil
+}
+
+func (r *staticServiceResolver) RemoveListener(
+	name string,
+) error {
+
+	r.listenerLock.Lock()
+	defer r.listenerLock.Unlock()
+	delete(r.listeners, name)
+	return nil
+}
+
+func (r *staticServiceResolver) MemberCount() int {
+	return r.ring().ServerCount()
+}
+
+func (r *staticServiceResolver) Members() []*HostInfo {
+	var servers []*HostInfo
+	for _, s := range r.ring().Servers() {
+		servers = append(servers, NewHostInfo(s, r.getLabelsMap()))
+	}
+
+	return servers
+}
+
+// refresh re-resolves the configured hosts and rebuilds the ring if its members changed
+func (r *staticServiceResolver) refresh() error {
+	addrs, err := r.resolveHosts()
+	if err != nil {
+		return err
+	}
+
+	r.refreshLock.Lock()
+	defer r.refreshLock.Unlock()
+
+	event := &ChangedEvent{}
+	newMembersMap := make(map[string]struct{}, len(addrs))
+	for _, addr := range addrs {
+		newMembersMap[addr] = struct{}{}
+		if _, ok := r.membersMap[addr]; !ok {
+			event.HostsAdded = append(event.HostsAdded, NewHostInfo(addr, r.getLabelsMap()))
+		}
+	}
+	for addr := range r.membersMap {
+		if _, ok := newMembersMap[addr]; !ok {
+			event.HostsRemoved = append(event.HostsRemoved, NewHostInfo(addr, r.getLabelsMap()))
+		}
+	}
+	if len(event.HostsAdded) == 0 && len(event.HostsRemoved) == 0 {
+		return nil
+	}
+
+	ring := r.hashFunc.NewRing(addrs)
+
+	r.membersMap = newMembersMap
+	r.storeRing(ring, len(addrs))
+	r.logger.Info("Current reachable members", tag.Addresses(addrs))
+	r.emitEvent(event)
+	return nil
+}
+
+// resolveHosts resolves the host of every configured host:port, names resolving
+// to several addresses (like a headless service) contribute one member per address
+func (r *staticServiceResolver) resolveHosts() ([]string, error) {
+	addrsMap := make(map[string]struct{}, len(r.hosts))
+	for _, hostPort := range r.hosts {
+		host, port, err := net.SplitHostPort(hostPort)
+		if err != nil {
+			return nil, ErrIncorrectAddressFormat
+		}
+
+		ips, err := r.lookupHost(host)
+		if err != nil {
+			return nil, err
+		}
+		for _, ip := range ips {
+			addrsMap[net.JoinHostPort(ip, port)] = struct{}{}
+		}
+	}
+
+	addrs := make([]string, 0, len(addrsMap))
+	for addr := range addrsMap {
+		addrs = append(addrs, addr)
+	}
+	sort.Strings(addrs)
+	return addrs, nil
+}
+
+func (r *staticServiceResolver) storeRing(ring HashRing, memberCount int) {
+	r.ringValue.Store(ring)
+	if memberCount > 0 {
+		r.readyOnce.Do(func() { close(r.readyCh) })
+	}
+}
+
+func (r *staticServiceResolver) emitEvent(event *ChangedEvent) {
+	r.listenerLock.RLock()
+	defer r.listenerLock.RUnlock()
+
+	for name, ch := range r.listeners {
+		select {
+		case ch <- event:
+		default:
+			r.logger.Error("Failed to send listener notification, channel full", tag.ListenerName(name))
+		}
+	}
+}
+
+func (r *staticServiceResolver) ring() HashRing {
+	return r.ringValue.Load().(HashRing)
+}
+
+func (r *staticServiceResolver) DiffOnChange(
+	added []*HostInfo,
+	removed []*HostInfo,
+	keys []string,
+) map[string]OwnershipChange {
+
+	return diffOnChange(r.ring(), r.hashFunc, r.getLabelsMap(), added, removed, keys)
+}
+
+func (r *staticServiceResolver) getLabelsMap() map[string]string {
+	labels := make(map[string]string)
+	labels[RoleKey] = r.service
+	return labels
+}
@@ -60,6 +60,7 @@ const (
 	Counter MetricType = iota
 	Timer
 	Gauge
+	Histogram
 )
 
 // Service names for all services that emit metrics.
@@ -114,6 +115,7 @@ const (
 	MemoryStackGauge     = "memory_stack"
 	NumGCCounter         = "memory_num_gc"
 	GcPauseMsTimer       = "memory_gc_pause_ms"
+	GcPauseHistogram     = "memory_gc_pause"
 )
 
 // ServiceMetrics are types for common service base metrics
@@ -132,6 +134,7 @@ var GoRuntimeMetrics = map[MetricName]MetricType{
 	MemoryStackGauge:     Gauge,
 	NumGCCounter:         Counter,
 	GcPauseMsTimer:       Timer,
+	GcPauseHistogram:     Histogram,
 }
 
 // Scopes enum
@@ -626,6 +629,13 @@ const (
 
 	// ClusterMetadataArchivalConfigScope tracks ArchivalConfig calls to ClusterMetadata
 	ClusterMetadataArchivalConfigScope
+	// ClusterMetadataRegisterClusterScope tracks RegisterCluster calls to ClusterMetadata
+	ClusterMetadataRegisterClusterScope
+	// ClusterMetadataDeregisterClusterScope tracks DeregisterCluster calls to ClusterMetadata
+	ClusterMetadataDeregisterClusterScope
+
+	// RPCClientConnectionPoolScope is used by the internode RPC client connection pool
+	RPCClientConnectionPoolScope
 
 	// ElasticsearchRecordWorkflowExecutionStartedScope tracks RecordWorkflowExecutionStarted calls made by service to persistence layer
 	ElasticsearchRecordWorkflowExecutionStartedScope
@@ -692,6 +702,12 @@ const (
 	// BlobstoreClientDirectoryExistsScope tracks DirectoryExists calls to blobstore
 	BlobstoreClientDirectoryExistsScope
 
+	// PersistenceSerializerScope tracks calls made to the persistence serializer
+	PersistenceSerializerScope
+
+	// MembershipMonitorScope is used by the ringpop membership monitor
+	MembershipMonitorScope
+
 	NumCommonScopes
 )
 
@@ -1213,7 +1229,11 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceGetClusterMembersScope:                        {operation: "GetClusterMembership"},
 		PersistenceUpsertClusterMembershipScope:                  {operation: "UpsertClusterMembership"},
 
-		ClusterMetadataArchivalConfigScope: {operation: "ArchivalConfig"},
+		ClusterMetadataArchivalConfigScope:    {operation: "ArchivalConfig"},
+		ClusterMetadataRegisterClusterScope:   {operation: "RegisterCluster"},
+		ClusterMetadataDeregisterClusterScope: {operation: "DeregisterCluster"},
+
+		RPCClientConnectionPoolScope: {operation: "RPCClientConnectionPool"},
 
 		HistoryClientStartWorkflowExecutionScope:              {operation: "HistoryClientStartWorkflowExecution", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientRecordActivityTaskHeartbeatScope:         {operation: "HistoryClientRecordActivityTaskHeartbeat", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
@@ -1395,6 +1415,10 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		BlobstoreClientExistsScope:          {operation: "BlobstoreClientExists", tags: map[string]string{ServiceRoleTagName: BlobstoreRoleTagValue}},
 		BlobstoreClientDeleteScope:          {operation: "BlobstoreClientDelete", tags: map[string]string{ServiceRoleTagName: BlobstoreRoleTagValue}},
 		BlobstoreClientDirectoryExistsScope: {operation: "BlobstoreClientDirectoryExists", tags: map[string]string{ServiceRoleTagName: BlobstoreRoleTagValue}},
+
+		PersistenceSerializerScope: {operation: "PersistenceSerializer"},
+
+		MembershipMonitorScope: {operation: "MembershipMonitor"},
 	},
 	// Frontend Scope Names
 	Frontend: {
@@ -1636,6 +1660,8 @@ const (
 	ServiceErrNonDeterministicCounter
 	ServiceErrUnauthorizedCounter
 	ServiceErrAuthorizeFailedCounter
+	ServiceErrUnavailableCounter
+	ServiceSuccesses
 
 	PersistenceRequests
 	PersistenceFailures
@@ -1660,6 +1686,16 @@ const (
 	ClientRedirectionFailures
 	ClientRedirectionLatency
 
+	ClientConnectionPoolUtilization
+
+	WorkerPoolQueueDepth
+	WorkerPoolActiveWorkers
+
+	MembershipRingMemberCount
+	MembershipRingJoins
+	MembershipRingLeaves
+	MembershipGossipLatency
+
 	ServiceAuthorizationLatency
 
 	NamespaceCachePrepareCallbacksLatency
@@ -1677,6 +1713,8 @@ const (
 
 	ArchivalConfigFailures
 
+	ClusterMetadataChanges
+
 	ElasticsearchRequests
 	ElasticsearchFailures
 	ElasticsearchLatency
@@ -1714,12 +1752,17 @@ const (
 	HistoryArchiverDeterministicConstructionCheckFailedCount
 	HistoryArchiverRunningBlobIntegrityCheckCount
 	HistoryArchiverBlobIntegrityCheckFailedCount
+	HistoryArchiverChecksumMissingCount
 	HistoryArchiverDuplicateArchivalsCount
+	HistoryArchiverCacheHitCount
+	HistoryArchiverCacheMissCount
 
 	VisibilityArchiverArchiveNonRetryableErrorCount
 	VisibilityArchiverArchiveTransientErrorCount
 	VisibilityArchiveSuccessCount
 
+	ArchiverDryRunWriteSize
+
 	MatchingClientForwardedCounter
 	MatchingClientInvalidTaskQueueName
 
@@ -1761,6 +1804,9 @@ const (
 
 	ElasticsearchInvalidSearchAttributeCount
 
+	SerializerLatency
+	SerializerDataSize
+
 	NumCommonMetrics // Needs to be last on this list for iota numbering
 )
 
@@ -1818,6 +1864,7 @@ const (
 	AcquireShardsCounter
 	AcquireShardsLatency
 	ShardClosedCounter
+	ShardHandoffCounter
 	ShardItemCreatedCounter
 	ShardItemRemovedCounter
 	ShardItemAcquisitionLatency
@@ -1851,6 +1898,7 @@ const (
 	HistoryEventNotificationFailDeliveryCount
 	EmptyReplicationEventsCounter
 	DuplicateReplicationEventsCounter
+	UnknownEncodingReplicationEventsCounter
 	StaleReplicationEventsCounter
 	ReplicationEventsSizeTimer
 	BufferReplicationTaskTimer
@@ -1876,6 +1924,8 @@ const (
 	SignalInfoCount
 	RequestCancelInfoCount
 	BufferedEventsCount
+	VersionHistoriesBranchCount
+	VersionHistoryItemCount
 	DeleteActivityInfoCount
 	DeleteTimerInfoCount
 	DeleteChildInfoCount
@@ -1909,8 +1959,11 @@ const (
 	ReplicationTasksReturned
 	ReplicationTasksAppliedLatency
 	ReplicationDLQFailed
+	ReplicationDLQEnqueued
+	ReplicationDLQConvertFailed
 	ReplicationDLQMaxLevelGauge
 	ReplicationDLQAckLevelGauge
+	ReplicationEventsLagGauge
 	GetReplicationMessagesForShardLatency
 	GetDLQReplicationMessagesLatency
 	EventReapplySkippedCount
@@ -2063,6 +2116,8 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ServiceErrNonDeterministicCounter:                   {metricName: "service_errors_nondeterministic", metricType: Counter},
 		ServiceErrUnauthorizedCounter:                       {metricName: "service_errors_unauthorized", metricType: Counter},
 		ServiceErrAuthorizeFailedCounter:                    {metricName: "service_errors_authorize_failed", metricType: Counter},
+		ServiceErrUnavailableCounter:                        {metricName: "service_errors_unavailable", metricType: Counter},
+		ServiceSuccesses:                                    {metricName: "service_successes", metricType: Counter},
 		PersistenceRequests:                                 {metricName: "persistence_requests", metricType: Counter},
 		PersistenceFailures:                                 {metricName: "persistence_errors", metricType: Counter},
 		PersistenceLatency:                                  {metricName: "persistence_latency", metricType: Timer},
@@ -2083,6 +2138,13 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ClientRedirectionRequests:                           {metricName: "client_redirection_requests", metricType: Counter},
 		ClientRedirectionFailures:                           {metricName: "client_redirection_errors", metricType: Counter},
 		ClientRedirectionLatency:                            {metricName: "client_redirection_latency", metricType: Timer},
+		ClientConnectionPoolUtilization:                     {metricName: "client_connection_pool_utilization", metricType: Gauge},
+		WorkerPoolQueueDepth:                                {metricName: "worker_pool_queue_depth", metricType: Gauge},
+		WorkerPoolActiveWorkers:                             {metricName: "worker_pool_active_workers", metricType: Gauge},
+		MembershipRingMemberCount:                           {metricName: "membership_ring_member_count", metricType: Gauge},
+		MembershipRingJoins:                                 {metricName: "membership_ring_joins", metricType: Counter},
+		MembershipRingLeaves:                                {metricName: "membership_ring_leaves", metricType: Counter},
+		MembershipGossipLatency:                             {metricName: "membership_gossip_latency", metricType: Timer},
 		ServiceAuthorizationLatency:                         {metricName: "service_authorization_latency", metricType: Timer},
 		NamespaceCachePrepareCallbacksLatency:               {metricName: "namespace_cache_prepare_callbacks_latency", metricType: Timer},
 		NamespaceCacheCallbacksLatency:                      {metricName: "namespace_cache_callbacks_latency", metricType: Timer},
@@ -2095,6 +2157,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		LockFailures:                                        {metricName: "lock_failures", metricType: Counter},
 		LockLatency:                                         {metricName: "lock_latency", metricType: Timer},
 		ArchivalConfigFailures:                              {metricName: "archivalconfig_failures", metricType: Counter},
+		ClusterMetadataChanges:                              {metricName: "cluster_metadata_changes", metricType: Counter},
 		ElasticsearchRequests:                               {metricName: "elasticsearch_requests", metricType: Counter},
 		ElasticsearchFailures:                               {metricName: "elasticsearch_errors", metricType: Counter},
 		ElasticsearchLatency:                                {metricName: "elasticsearch_latency", metricType: Timer},
@@ -2125,10 +2188,14 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		HistoryArchiverDeterministicConstructionCheckFailedCount:  {metricName: "history_archiver_deterministic_construction_check_failed", metricType: Counter},
 		HistoryArchiverRunningBlobIntegrityCheckCount:             {metricName: "history_archiver_running_blob_integrity_check", metricType: Counter},
 		HistoryArchiverBlobIntegrityCheckFailedCount:              {metricName: "history_archiver_blob_integrity_check_failed", metricType: Counter},
+		HistoryArchiverChecksumMissingCount:                       {metricName: "history_archiver_checksum_missing", metricType: Counter},
 		HistoryArchiverDuplicateArchivalsCount:                    {metricName: "history_archiver_duplicate_archivals", metricType: Counter},
+		HistoryArchiverCacheHitCount:                              {metricName: "history_archiver_cache_hit", metricType: Counter},
+		HistoryArchiverCacheMissCount:                             {metricName: "history_archiver_cache_miss", metricType: Counter},
 		VisibilityArchiverArchiveNonRetryableErrorCount:           {metricName: "visibility_archiver_archive_non_retryable_error", metricType: Counter},
 		VisibilityArchiverArchiveTransientErrorCount:              {metricName: "visibility_archiver_archive_transient_error", metricType: Counter},
 		VisibilityArchiveSuccessCount:                             {metricName: "visibility_archiver_archive_success", metricType: Counter},
+		ArchiverDryRunWriteSize:                                   {metricName: "archiver_dry_run_write_size", metricType: Timer},
 		VersionCheckSuccessCount:                                  {metricName: "version_check_success", metricType: Counter},
 		VersionCheckFailedCount:                                   {metricName: "version_check_failed", metricType: Counter},
 		VersionCheckRequestFailedCount:                            {metricName: "version_check_request_failed", metricType: Counter},
@@ -2207,6 +2274,8 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 			metricName: "service_errors_authorize_failed_per_tl", metricRollupName: "service_errors_authorize_failed", metricType: Counter,
 		},
 		ElasticsearchInvalidSearchAttributeCount: {metricName: "elasticsearch_invalid_search_attribute_counter", metricType: Counter},
+		SerializerLatency:                        {metricName: "serializer_latency", metricType: Timer},
+		SerializerDataSize:                       {metricName: "serializer_data_size", metricType: Timer},
 	},
 	History: {
 		TaskRequests:                                      {metricName: "task_requests", metricType: Counter},
@@ -2258,6 +2327,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		AcquireShardsCounter:                              {metricName: "acquire_shards_count", metricType: Counter},
 		AcquireShardsLatency:                              {metricName: "acquire_shards_latency", metricType: Timer},
 		ShardClosedCounter:                                {metricName: "shard_closed_count", metricType: Counter},
+		ShardHandoffCounter:                               {metricName: "shard_handoff_count", metricType: Counter},
 		ShardItemCreatedCounter:                           {metricName: "sharditem_created_count", metricType: Counter},
 		ShardItemRemovedCounter:                           {metricName: "sharditem_removed_count", metricType: Counter},
 		ShardItemAcquisitionLatency:                       {metricName: "sharditem_acquisition_latency", metricType: Timer},
@@ -2291,6 +2361,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		HistoryEventNotificationFailDeliveryCount:         {metricName: "history_event_notification_fail_delivery_count", metricType: Counter},
 		EmptyReplicationEventsCounter:                     {metricName: "empty_replication_events", metricType: Counter},
 		DuplicateReplicationEventsCounter:                 {metricName: "duplicate_replication_events", metricType: Counter},
+		UnknownEncodingReplicationEventsCounter:           {metricName: "unknown_encoding_replication_events", metricType: Counter},
 		StaleReplicationEventsCounter:                     {metricName: "stale_replication_events", metricType: Counter},
 		ReplicationEventsSizeTimer:                        {metricName: "replication_events_size", metricType: Timer},
 		BufferReplicationTaskTimer:                        {metricName: "buffer_replication_tasks", metricType: Timer},
@@ -2316,6 +2387,8 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		SignalInfoCount:                                   {metricName: "signal_info_count", metricType: Timer},
 		RequestCancelInfoCount:                            {metricName: "request_cancel_info_count", metricType: Timer},
 		BufferedEventsCount:                               {metricName: "buffered_events_count", metricType: Timer},
+		VersionHistoriesBranchCount:                       {metricName: "version_histories_branch_count", metricType: Timer},
+		VersionHistoryItemCount:                           {metricName: "version_history_item_count", metricType: Timer},
 		DeleteActivityInfoCount:                           {metricName: "delete_activity_info", metricType: Timer},
 		DeleteTimerInfoCount:                              {metricName: "delete_timer_info", metricType: Timer},
 		DeleteChildInfoCount:                              {metricName: "delete_child_info", metricType: Timer},
@@ -2349,8 +2422,11 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ReplicationTasksReturned:                          {metricName: "replication_tasks_returned", metricType: Timer},
 		ReplicationTasksAppliedLatency:                    {metricName: "replication_tasks_applied_latency", metricType: Timer},
 		ReplicationDLQFailed:                              {metricName: "replication_dlq_enqueue_failed", metricType: Counter},
+		ReplicationDLQEnqueued:                            {metricName: "replication_dlq_enqueued", metricType: Counter},
+		ReplicationDLQConvertFailed:                       {metricName: "replication_dlq_convert_failed", metricType: Counter},
 		ReplicationDLQMaxLevelGauge:                       {metricName: "replication_dlq_max_level", metricType: Gauge},
 		ReplicationDLQAckLevelGauge:                       {metricName: "replication_dlq_ack_level", metricType: Gauge},
+		ReplicationEventsLagGauge:                         {metricName: "replication_events_lag", metricType: Gauge},
 		GetReplicationMessagesForShardLatency:             {metricName: "get_replication_messages_for_shard", metricType: Timer},
 		GetDLQReplicationMessagesLatency:                  {metricName: "get_dlq_replication_messages", metricType: Timer},
 		EventReapplySkippedCount:                          {metricName: "event_reapply_skipped_count", metricType: Counter},
@@ -61,9 +61,14 @@ type (
 		RecordDistribution(scope int, timer int, d int)
 		// UpdateGauge reports Gauge type absolute value metric
 		UpdateGauge(scope int, gauge int, value float64)
+		// RecordOutcome increments the success counter when err is nil, otherwise
+		// the failure counter and the counter for the class of err, if known
+		RecordOutcome(scope int, err error)
 		// Scope returns an internal scope that can be used to add additional
 		// information to metrics
 		Scope(scope int, tags ...Tag) Scope
+		// ShardScope returns an internal scope tagged with the given history shard
+		ShardScope(scope int, shardID int32) Scope
 		// UserScope returns a new metrics scope that can be used to add additional
 		// information to the metrics emitted by user code
 		UserScope() UserScope
@@ -142,6 +142,18 @@ func (mr *MockClientMockRecorder) RecordDistribution(scope, timer, d interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordDistribution", reflect.TypeOf((*MockClient)(nil).RecordDistribution), scope, timer, d)
 }
 
+// RecordOutcome mocks base method.
+func (m *MockClient) RecordOutcome(scope int, err error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordOutcome", scope, err)
+}
+
+// RecordOutcome indicates an expected call of RecordOutcome.
+func (mr *MockClientMockRecorder) RecordOutcome(scope, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordOutcome", reflect.TypeOf((*MockClient)(nil).RecordOutcome), scope, err)
+}
+
 // RecordTimer mocks base method.
 func (m *MockClient) RecordTimer(scope, timer int, d time.Duration) {
 	m.ctrl.T.Helper()
@@ -173,6 +185,20 @@ func (mr *MockClientMockRecorder) Scope(scope interface{}, tags ...interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scope", reflect.TypeOf((*MockClient)(nil).Scope), varargs...)
 }
 
+// ShardScope mocks base method.
+func (m *MockClient) ShardScope(scope int, shardID int32) Scope {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShardScope", scope, shardID)
+	ret0, _ := ret[0].(Scope)
+	return ret0
+}
+
+// ShardScope indicates an expected call of ShardScope.
+func (mr *MockClientMockRecorder) ShardScope(scope, shardID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShardScope", reflect.TypeOf((*MockClient)(nil).ShardScope), scope, shardID)
+}
+
 // StartTimer mocks base method.
 func (m *MockClient) StartTimer(scope, timer int) Stopwatch {
 	m.ctrl.T.Helper()
@@ -0,0 +1,227 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metrics
+
+import (
+	"time"
+)
+
+type (
+	multiClient struct {
+		clients []Client
+	}
+
+	multiScope struct {
+		scopes []Scope
+	}
+
+	multiUserScope struct {
+		scopes []UserScope
+	}
+
+	multiStopwatch struct {
+		stopwatches []Stopwatch
+	}
+)
+
+// NewMultiClient returns a Client which emits every metric to all of the given clients
+func NewMultiClient(clients ...Client) Client {
+	if len(clients) == 1 {
+		return clients[0]
+	}
+	return &multiClient{clients: clients}
+}
+
+func (m *multiClient) IncCounter(scope int, counter int) {
+	for _, client := range m.clients {
+		client.IncCounter(scope, counter)
+	}
+}
+
+func (m *multiClient) AddCounter(scope int, counter int, delta int64) {
+	for _, client := range m.clients {
+		client.AddCounter(scope, counter, delta)
+	}
+}
+
+func (m *multiClient) StartTimer(scope int, timer int) Stopwatch {
+	stopwatches := make([]Stopwatch, len(m.clients))
+	for i, client := range m.clients {
+		stopwatches[i] = client.StartTimer(scope, timer)
+	}
+	return &multiStopwatch{stopwatches: stopwatches}
+}
+
+func (m *multiClient) RecordTimer(scope int, timer int, d time.Duration) {
+	for _, client := range m.clients {
+		client.RecordTimer(scope, timer, d)
+	}
+}
+
+func (m *multiClient) RecordDistribution(scope int, timer int, d int) {
+	for _, client := range m.clients {
+		client.RecordDistribution(scope, timer, d)
+	}
+}
+
+func (m *multiClient) UpdateGauge(scope int, gauge int, value float64) {
+	for _, client := range m.clients {
+		client.UpdateGauge(scope, gauge, value)
+	}
+}
+
+func (m *multiClient) RecordOutcome(scope int, err error) {
+	for _, client := range m.clients {
+		client.RecordOutcome(scope, err)
+	}
+}
+
+func (m *multiClient) Scope(scope int, tags ...Tag) Scope {
+	scopes := make([]Scope, len(m.clients))
+	for i, client := range m.clients {
+		scopes[i] = client.Scope(scope, tags...)
+	}
+	return &multiScope{scopes: scopes}
+}
+
+func (m *multiClient) ShardScope(scope int, shardID int32) Scope {
+	scopes := make([]Scope, len(m.clients))
+	for i, client := range m.clients {
+		scopes[i] = client.ShardScope(scope, shardID)
+	}
+	return &multiScope{scopes: scopes}
+}
+
+func (m *multiClient) UserScope() UserScope {
+	scopes := make([]UserScope, len(m.clients))
+	for i, client := range m.clients {
+		scopes[i] = client.UserScope()
+	}
+	return &multiUserScope{scopes: scopes}
+}
+
+func (m *multiScope) IncCounter(counter int) {
+	for _, scope := range m.scopes {
+		scope.IncCounter(counter)
+	}
+}
+
+func (m *multiScope) AddCounter(counter int, delta int64) {
+	for _, scope := range m.scopes {
+		scope.AddCounter(counter, delta)
+	}
+}
+
+func (m *multiScope) StartTimer(timer int) Stopwatch {
+	stopwatches := make([]Stopwatch, len(m.scopes))
+	for i, scope := range m.scopes {
+		stopwatches[i] = scope.StartTimer(timer)
+	}
+	return &multiStopwatch{stopwatches: stopwatches}
+}
+
+func (m *multiScope) RecordTimer(timer int, d time.Duration) {
+	for _, scope := range m.scopes {
+		scope.RecordTimer(timer, d)
+	}
+}
+
+func (m *multiScope) RecordDistribution(id int, d int) {
+	for _, scope := range m.scopes {
+		scope.RecordDistribution(id, d)
+	}
+}
+
+func (m *multiScope) UpdateGauge(gauge int, value float64) {
+	for _, scope := range m.scopes {
+		scope.UpdateGauge(gauge, value)
+	}
+}
+
+func (m *multiScope) Tagged(tags ...Tag) Scope {
+	scopes := make([]Scope, len(m.scopes))
+	for i, scope := range m.scopes {
+		scopes[i] = scope.Tagged(tags...)
+	}
+	return &multiScope{scopes: scopes}
+}
+
+func (m *multiUserScope) IncCounter(counter string) {
+	for _, scope := range m.scopes {
+		scope.IncCounter(counter)
+	}
+}
+
+func (m *multiUserScope) AddCounter(counter string, delta int64) {
+	for _, scope := range m.scopes {
+		scope.AddCounter(counter, delta)
+	}
+}
+
+func (m *multiUserScope) StartTimer(timer string) Stopwatch {
+	stopwatches := make([]Stopwatch, len(m.scopes))
+	for i, scope := range m.scopes {
+		stopwatches[i] = scope.StartTimer(timer)
+	}
+	return &multiStopwatch{stopwatches: stopwatches}
+}
+
+func (m *multiUserScope) RecordTimer(timer string, d time.Duration) {
+	for _, scope := range m.scopes {
+		scope.RecordTimer(timer, d)
+	}
+}
+
+func (m *multiUserScope) RecordDistribution(id string, d int) {
+	for _, scope := range m.scopes {
+		scope.RecordDistribution(id, d)
+	}
+}
+
+func (m *multiUserScope) UpdateGauge(gauge string, value float64) {
+	for _, scope := range m.scopes {
+		scope.UpdateGauge(gauge, value)
+	}
+}
+
+func (m *multiUserScope) Tagged(tags map[string]string) UserScope {
+	scopes := make([]UserScope, len(m.scopes))
+	for i, scope := range m.scopes {
+		scopes[i] = scope.Tagged(tags)
+	}
+	return &multiUserScope{scopes: scopes}
+}
+
+func (m *multiStopwatch) Stop() {
+	for _, stopwatch := range m.stopwatches {
+		stopwatch.Stop()
+	}
+}
+
+func (m *multiStopwatch) Subtract(d time.Duration) {
+	for _, stopwatch := range m.stopwatches {
+		stopwatch.Subtract(d)
+	}
+}
@@ -0,0 +1,83 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+)
+
+func TestMultiScope(t *testing.T) {
+	scope1 := tally.NewTestScope("", nil)
+	scope2 := tally.NewTestScope("", nil)
+	multi := NewMultiScope(scope1, scope2).Tagged(map[string]string{"key": "value"})
+
+	multi.Counter("counter").Inc(2)
+	multi.Gauge("gauge").Update(3)
+	multi.Timer("timer").Record(time.Second)
+	multi.Histogram("histogram", tally.ValueBuckets{0, 10}).RecordValue(1)
+
+	for _, scope := range []tally.TestScope{scope1, scope2} {
+		snapshot := scope.Snapshot()
+		require.Equal(t, int64(2), snapshot.Counters()["counter+key=value"].Value())
+		require.Equal(t, float64(3), snapshot.Gauges()["gauge+key=value"].Value())
+		require.Equal(t, []time.Duration{time.Second}, snapshot.Timers()["timer+key=value"].Values())
+		var histogramValues int64
+		for _, count := range snapshot.Histograms()["histogram+key=value"].Values() {
+			histogramValues += count
+		}
+		require.Equal(t, int64(1), histogramValues)
+	}
+}
+
+func TestMultiClient(t *testing.T) {
+	scope1 := tally.NewTestScope("", nil)
+	scope2 := tally.NewTestScope("", nil)
+	client := NewMultiClient(NewClient(scope1, History), NewClient(scope2, History))
+
+	client.IncCounter(PersistenceGetShardScope, PersistenceRequests)
+	client.Scope(PersistenceGetShardScope, NamespaceTag("test-namespace")).IncCounter(PersistenceRequests)
+	client.StartTimer(PersistenceGetShardScope, PersistenceLatency).Stop()
+
+	for _, scope := range []tally.TestScope{scope1, scope2} {
+		var requests int64
+		var latencies int
+		for _, counter := range scope.Snapshot().Counters() {
+			if counter.Name() == "persistence_requests" {
+				requests += counter.Value()
+			}
+		}
+		for _, timer := range scope.Snapshot().Timers() {
+			if timer.Name() == "persistence_latency" {
+				latencies += len(timer.Values())
+			}
+		}
+		require.Equal(t, int64(2), requests)
+		require.Equal(t, 1, latencies)
+	}
+}
@@ -96,10 +96,16 @@ func (m NoopMetricsClient) RecordDistribution(scope int, timer int, d int) {}
 
 func (m NoopMetricsClient) UpdateGauge(scope int, gauge int, value float64) {}
 
+func (m NoopMetricsClient) RecordOutcome(scope int, err error) {}
+
 func (m NoopMetricsClient) Scope(scope int, tags ...Tag) Scope {
 	return NewNoopMetricsScope()
 }
 
+func (m NoopMetricsClient) ShardScope(scope int, shardID int32) Scope {
+	return NewNoopMetricsScope()
+}
+
 func (m NoopMetricsClient) UserScope() UserScope {
 	return NewNoopMetricsUserScope()
 }
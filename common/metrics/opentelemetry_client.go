@@ -109,12 +109,24 @@ func (m *opentelemetryClient) UpdateGauge(scopeIdx int, gaugeIdx int, value floa
 	m.childScopes[scopeIdx].UpdateGauge(gaugeIdx, value)
 }
 
+// RecordOutcome increments the success or failure counters for the given error
+func (m *opentelemetryClient) RecordOutcome(scopeIdx int, err error) {
+	for _, counterIdx := range outcomeCounters(err) {
+		m.IncCounter(scopeIdx, counterIdx)
+	}
+}
+
 // Scope returns a new internal metrics scope that can be used to add additional
 // information to the metrics emitted
 func (m *opentelemetryClient) Scope(scopeIdx int, tags ...Tag) Scope {
 	return m.childScopes[scopeIdx].Tagged(tags...)
 }
 
+// ShardScope returns a new internal metrics scope tagged with the given shard
+func (m *opentelemetryClient) ShardScope(scopeIdx int, shardID int32) Scope {
+	return m.Scope(scopeIdx, ShardTag(shardID))
+}
+
 // UserScope returns a new metrics scope that can be used to add additional
 // information to the metrics emitted by user code
 func (m *opentelemetryClient) UserScope() UserScope {
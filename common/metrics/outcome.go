@@ -0,0 +1,62 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metrics
+
+import (
+	"context"
+	"errors"
+
+	"go.temporal.io/api/serviceerror"
+)
+
+// outcomeCounters returns the counters RecordOutcome increments for err.
+func outcomeCounters(err error) []int {
+	if err == nil {
+		return []int{ServiceSuccesses}
+	}
+
+	var deadlineExceededErr *serviceerror.DeadlineExceeded
+	var canceledErr *serviceerror.Canceled
+	var unavailableErr *serviceerror.Unavailable
+	var notFoundErr *serviceerror.NotFound
+	var resourceExhaustedErr *serviceerror.ResourceExhausted
+	var invalidArgumentErr *serviceerror.InvalidArgument
+	switch {
+	case errors.Is(err, context.DeadlineExceeded) || errors.As(err, &deadlineExceededErr):
+		return []int{ServiceFailures, ServiceErrContextTimeoutCounter}
+	case errors.Is(err, context.Canceled) || errors.As(err, &canceledErr):
+		return []int{ServiceFailures, ServiceErrContextCancelledCounter}
+	case errors.As(err, &unavailableErr):
+		return []int{ServiceFailures, ServiceErrUnavailableCounter}
+	case errors.As(err, &notFoundErr):
+		return []int{ServiceFailures, ServiceErrNotFoundCounter}
+	case errors.As(err, &resourceExhaustedErr):
+		return []int{ServiceFailures, ServiceErrResourceExhaustedCounter}
+	case errors.As(err, &invalidArgumentErr):
+		return []int{ServiceFailures, ServiceErrInvalidArgumentCounter}
+	default:
+		return []int{ServiceFailures}
+	}
+}
@@ -32,6 +32,7 @@ import (
 
 	"github.com/uber-go/tally"
 
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/ldflags"
@@ -43,24 +44,32 @@ const (
 
 	// buildAgeMetricName is the emitted build age metric's name.
 	buildAgeMetricName = "build_age"
+
+	// minRuntimeMetricsReportInterval protects against misconfigured intervals flooding the metrics backend.
+	minRuntimeMetricsReportInterval = 5 * time.Second
 )
 
+// gcPauseBuckets covers GC pauses from 10us up to ~330ms.
+var gcPauseBuckets = tally.MustMakeExponentialDurationBuckets(10*time.Microsecond, 2, 16)
+
 // RuntimeMetricsReporter A struct containing the state of the RuntimeMetricsReporter.
 type RuntimeMetricsReporter struct {
-	scope          tally.Scope
-	buildInfoScope tally.Scope
-	reportInterval time.Duration
-	started        int32
-	quit           chan struct{}
-	logger         log.Logger
-	lastNumGC      uint32
-	buildTime      time.Time
+	scope             tally.Scope
+	buildInfoScope    tally.Scope
+	reportInterval    dynamicconfig.DurationPropertyFn
+	minReportInterval time.Duration
+	started           int32
+	quit              chan struct{}
+	logger            log.Logger
+	lastNumGC         uint32
+	buildTime         time.Time
 }
 
 // NewRuntimeMetricsReporter Creates a new RuntimeMetricsReporter.
+// reportInterval is re-evaluated after every report, so changes take effect from the next tick.
 func NewRuntimeMetricsReporter(
 	scope tally.Scope,
-	reportInterval time.Duration,
+	reportInterval dynamicconfig.DurationPropertyFn,
 	logger log.Logger,
 	instanceID string,
 ) *RuntimeMetricsReporter {
@@ -74,11 +83,12 @@ func NewRuntimeMetricsReporter(
 	var memstats runtime.MemStats
 	runtime.ReadMemStats(&memstats)
 	rReporter := &RuntimeMetricsReporter{
-		scope:          scope,
-		reportInterval: reportInterval,
-		logger:         logger,
-		lastNumGC:      memstats.NumGC,
-		quit:           make(chan struct{}),
+		scope:             scope,
+		reportInterval:    reportInterval,
+		minReportInterval: minRuntimeMetricsReportInterval,
+		logger:            logger,
+		lastNumGC:         memstats.NumGC,
+		quit:              make(chan struct{}),
 	}
 	rReporter.buildInfoScope = scope.Tagged(
 		map[string]string{
@@ -122,8 +132,9 @@ func (r *RuntimeMetricsReporter) report() {
 			lastNum = num - 256
 		}
 		for i := lastNum; i != num; i++ {
-			pause := memStats.PauseNs[i%256]
-			r.scope.Timer(GcPauseMsTimer).Record(time.Duration(pause))
+			pause := time.Duration(memStats.PauseNs[i%256])
+			r.scope.Timer(GcPauseMsTimer).Record(pause)
+			r.scope.Histogram(GcPauseHistogram, gcPauseBuckets).RecordDuration(pause)
 		}
 	}
 
@@ -140,13 +151,14 @@ func (r *RuntimeMetricsReporter) Start() {
 		return
 	}
 	go func() {
-		ticker := time.NewTicker(r.reportInterval)
+		timer := time.NewTimer(r.getReportInterval())
 		for {
 			select {
-			case <-ticker.C:
+			case <-timer.C:
 				r.report()
+				timer.Reset(r.getReportInterval())
 			case <-r.quit:
-				ticker.Stop()
+				timer.Stop()
 				return
 			}
 		}
@@ -154,6 +166,14 @@ func (r *RuntimeMetricsReporter) Start() {
 	r.logger.Info("RuntimeMetricsReporter started")
 }
 
+func (r *RuntimeMetricsReporter) getReportInterval() time.Duration {
+	interval := r.reportInterval()
+	if interval < r.minReportInterval {
+		return r.minReportInterval
+	}
+	return interval
+}
+
 // Stop Stops reporting of runtime metrics. The reporter cannot be started again after it's been stopped.
 func (r *RuntimeMetricsReporter) Stop() {
 	close(r.quit)
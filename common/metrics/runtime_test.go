@@ -0,0 +1,129 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metrics
+
+import (
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+)
+
+type (
+	runtimeMetricsReporterSuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+)
+
+func TestRuntimeMetricsReporterSuite(t *testing.T) {
+	s := new(runtimeMetricsReporterSuite)
+	suite.Run(t, s)
+}
+
+func (s *runtimeMetricsReporterSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *runtimeMetricsReporterSuite) TestReportEmitsGCPauseHistogram() {
+	scope := tally.NewTestScope("test", nil)
+	reporter := NewRuntimeMetricsReporter(
+		scope,
+		dynamicconfig.GetDurationPropertyFn(time.Minute),
+		log.NewNoopLogger(),
+		"",
+	)
+
+	runtime.GC()
+	runtime.GC()
+	reporter.report()
+
+	snapshot := scope.Snapshot()
+	var pauses int64
+	for _, histogram := range snapshot.Histograms() {
+		if histogram.Name() != "test."+GcPauseHistogram {
+			continue
+		}
+		for _, count := range histogram.Durations() {
+			pauses += count
+		}
+	}
+	s.GreaterOrEqual(pauses, int64(2))
+
+	gauges := make(map[string]bool)
+	for _, gauge := range snapshot.Gauges() {
+		gauges[gauge.Name()] = true
+	}
+	s.True(gauges["test."+NumGoRoutinesGauge])
+	s.True(gauges["test."+MemoryHeapGauge])
+}
+
+func (s *runtimeMetricsReporterSuite) TestReportIntervalIsClamped() {
+	reporter := NewRuntimeMetricsReporter(
+		tally.NoopScope,
+		dynamicconfig.GetDurationPropertyFn(time.Millisecond),
+		log.NewNoopLogger(),
+		"",
+	)
+	s.Equal(minRuntimeMetricsReportInterval, reporter.getReportInterval())
+
+	reporter.reportInterval = dynamicconfig.GetDurationPropertyFn(time.Hour)
+	s.Equal(time.Hour, reporter.getReportInterval())
+}
+
+func (s *runtimeMetricsReporterSuite) TestReportIntervalChangesCadence() {
+	interval := int64(10 * time.Millisecond)
+	var reads int32
+	reporter := NewRuntimeMetricsReporter(
+		tally.NoopScope,
+		func(...dynamicconfig.FilterOption) time.Duration {
+			atomic.AddInt32(&reads, 1)
+			return time.Duration(atomic.LoadInt64(&interval))
+		},
+		log.NewNoopLogger(),
+		"",
+	)
+	reporter.minReportInterval = time.Millisecond
+	reporter.Start()
+	defer reporter.Stop()
+
+	s.Eventually(func() bool {
+		return atomic.LoadInt32(&reads) >= 5
+	}, 5*time.Second, time.Millisecond)
+
+	atomic.StoreInt64(&interval, int64(time.Hour))
+	// the tick in flight may still fire with the old interval, but no more after that
+	time.Sleep(50 * time.Millisecond)
+	readsAfterChange := atomic.LoadInt32(&reads)
+	time.Sleep(100 * time.Millisecond)
+	s.Equal(readsAfterChange, atomic.LoadInt32(&reads))
+}
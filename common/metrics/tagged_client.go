@@ -0,0 +1,109 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metrics
+
+import (
+	"time"
+)
+
+type (
+	taggedClient struct {
+		client Client
+		tags   []Tag
+		// scopes holds the tagged scope of each common scope index, other scopes are tagged on use
+		scopes    []Scope
+		userScope UserScope
+	}
+)
+
+// NewTaggedClient returns a Client which adds the given tags to every metric emitted through client
+func NewTaggedClient(client Client, tags ...Tag) Client {
+	if len(tags) == 0 {
+		return client
+	}
+	userTags := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		userTags[tag.Key()] = tag.Value()
+	}
+	scopes := make([]Scope, NumCommonScopes)
+	for idx := range ScopeDefs[Common] {
+		scopes[idx] = client.Scope(idx, tags...)
+	}
+	return &taggedClient{
+		client:    client,
+		tags:      tags,
+		scopes:    scopes,
+		userScope: client.UserScope().Tagged(userTags),
+	}
+}
+
+func (c *taggedClient) IncCounter(scope int, counter int) {
+	c.Scope(scope).IncCounter(counter)
+}
+
+func (c *taggedClient) AddCounter(scope int, counter int, delta int64) {
+	c.Scope(scope).AddCounter(counter, delta)
+}
+
+func (c *taggedClient) StartTimer(scope int, timer int) Stopwatch {
+	return c.Scope(scope).StartTimer(timer)
+}
+
+func (c *taggedClient) RecordTimer(scope int, timer int, d time.Duration) {
+	c.Scope(scope).RecordTimer(timer, d)
+}
+
+func (c *taggedClient) RecordDistribution(scope int, timer int, d int) {
+	c.Scope(scope).RecordDistribution(timer, d)
+}
+
+func (c *taggedClient) UpdateGauge(scope int, gauge int, value float64) {
+	c.Scope(scope).UpdateGauge(gauge, value)
+}
+
+func (c *taggedClient) RecordOutcome(scope int, err error) {
+	metricsScope := c.Scope(scope)
+	for _, counter := range outcomeCounters(err) {
+		metricsScope.IncCounter(counter)
+	}
+}
+
+func (c *taggedClient) Scope(scope int, tags ...Tag) Scope {
+	if scope >= 0 && scope < len(c.scopes) && c.scopes[scope] != nil {
+		if len(tags) == 0 {
+			return c.scopes[scope]
+		}
+		return c.scopes[scope].Tagged(tags...)
+	}
+	return c.client.Scope(scope, append(append(make([]Tag, 0, len(c.tags)+len(tags)), c.tags...), tags...)...)
+}
+
+func (c *taggedClient) ShardScope(scope int, shardID int32) Scope {
+	return c.Scope(scope, ShardTag(shardID))
+}
+
+func (c *taggedClient) UserScope() UserScope {
+	return c.userScope
+}
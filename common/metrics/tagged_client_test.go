@@ -0,0 +1,67 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metrics
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+)
+
+func TestTaggedClient(t *testing.T) {
+	scope := tally.NewTestScope("", nil)
+	client := NewTaggedClient(NewClient(scope, History), StoreTypeTag("cassandra"))
+
+	client.IncCounter(PersistenceGetShardScope, PersistenceRequests)
+	client.RecordTimer(PersistenceGetShardScope, PersistenceLatency, time.Second)
+	client.RecordOutcome(PersistenceGetShardScope, errors.New("failed"))
+	client.Scope(PersistenceGetShardScope, NamespaceTag("test-namespace")).IncCounter(PersistenceFailures)
+
+	snapshot := scope.Snapshot()
+	require.Equal(t, int64(1), snapshot.Counters()["persistence_requests+namespace=all,operation=GetShard,store_type=cassandra"].Value())
+	require.Equal(t, []time.Duration{time.Second}, snapshot.Timers()["persistence_latency+namespace=all,operation=GetShard,store_type=cassandra"].Values())
+	require.Equal(t, int64(1), snapshot.Counters()["service_errors+namespace=all,operation=GetShard,store_type=cassandra"].Value())
+	require.Equal(t, int64(1), snapshot.Counters()["persistence_errors+namespace=test-namespace,operation=GetShard,store_type=cassandra"].Value())
+}
+
+func TestTaggedClient_NoTags(t *testing.T) {
+	client := NewClient(tally.NewTestScope("", nil), History)
+	require.Equal(t, client, NewTaggedClient(client))
+}
+
+func TestTaggedClient_PrecomputedScopes(t *testing.T) {
+	scope := tally.NewTestScope("", nil)
+	client := NewTaggedClient(NewClient(scope, History), StoreTypeTag("cassandra"))
+
+	require.Same(t, client.Scope(PersistenceGetShardScope), client.Scope(PersistenceGetShardScope))
+
+	// service scopes are tagged on use
+	client.IncCounter(HistoryStartWorkflowExecutionScope, ServiceRequests)
+	snapshot := scope.Snapshot()
+	require.Equal(t, int64(1), snapshot.Counters()["service_requests+namespace=all,operation=StartWorkflowExecution,store_type=cassandra"].Value())
+}
@@ -24,6 +24,11 @@
 
 package metrics
 
+import (
+	"strconv"
+	"strings"
+)
+
 const (
 	gitRevisionTag   = "git_revision"
 	gitBranchTag     = "git_branch"
@@ -40,10 +45,23 @@ const (
 	workflowType  = "workflowType"
 	activityType  = "activityType"
 	commandType   = "commandType"
+	encodingType  = "encoding_type"
+	serializerOp  = "serializer_operation"
+	shardID       = "shard_id"
+	storeType     = "store_type"
+	ring          = "ring"
 
 	namespaceAllValue = "all"
 	unknownValue      = "_unknown_"
 	totalMetricSuffix = "_total"
+
+	// maxTagValueLength caps the length of tag values created with NewTag, so that
+	// high cardinality values like workflow IDs don't bloat the metrics backend
+	maxTagValueLength = 64
+
+	// maxShardTagValue bounds the values of the shard tag, a cluster has a fixed number of
+	// history shards well below it so shard tags cannot grow the cardinality of a metric unbounded
+	maxShardTagValue = 1 << 16
 )
 
 // Tag is an interface to define metrics tags
@@ -65,6 +83,10 @@ type (
 		value string
 	}
 
+	shardTag struct {
+		value string
+	}
+
 	targetClusterTag struct {
 		value string
 	}
@@ -96,8 +118,55 @@ type (
 	failureTag struct {
 		value string
 	}
+
+	encodingTypeTag struct {
+		value string
+	}
+
+	serializerOperationTag struct {
+		value string
+	}
+
+	storeTypeTag struct {
+		value string
+	}
+
+	ringTag struct {
+		value string
+	}
+
+	genericTag struct {
+		key   string
+		value string
+	}
 )
 
+// NewTag returns a new tag with the given key and value. The key is lowercased, characters
+// which are not valid for the metrics backends are replaced in both key and value, and the
+// value is truncated to maxTagValueLength characters. A blank value is converted to unknown.
+func NewTag(key string, value string) Tag {
+	if len(value) == 0 {
+		value = unknownValue
+	}
+	if len(value) > maxTagValueLength {
+		value = value[:maxTagValueLength]
+	}
+	return genericTag{
+		key:   sanitizer.Key(strings.ToLower(key)),
+		value: sanitizer.Value(value),
+	}
+}
+
+// Key returns the key of the tag
+func (t genericTag) Key() string {
+	return t.key
+}
+
+// Value returns the value of the tag
+func (t genericTag) Value() string {
+	return t.value
+}
+
 // NamespaceTag returns a new namespace tag. For timers, this also ensures that we
 // dual emit the metric with the all tag. If a blank namespace is provided then
 // this converts that to an unknown namespace.
@@ -153,6 +222,24 @@ func InstanceTag(value string) Tag {
 	return instanceTag{value}
 }
 
+// ShardTag returns a new shard tag, shard IDs outside of the valid range are reported as unknown
+func ShardTag(id int32) Tag {
+	if id < 1 || id > maxShardTagValue {
+		return shardTag{unknownValue}
+	}
+	return shardTag{strconv.Itoa(int(id))}
+}
+
+// Key returns the key of the shard tag
+func (s shardTag) Key() string {
+	return shardID
+}
+
+// Value returns the value of the shard tag
+func (s shardTag) Value() string {
+	return s.value
+}
+
 // Key returns the key of the instance tag
 func (i instanceTag) Key() string {
 	return instance
@@ -306,3 +393,75 @@ func (d failureTag) Key() string {
 func (d failureTag) Value() string {
 	return d.value
 }
+
+// EncodingTypeTag returns a new encoding type tag.
+func EncodingTypeTag(value string) Tag {
+	if len(value) == 0 {
+		value = unknownValue
+	}
+	return encodingTypeTag{value}
+}
+
+// Key returns the key of the encoding type tag
+func (d encodingTypeTag) Key() string {
+	return encodingType
+}
+
+// Value returns the value of the encoding type tag
+func (d encodingTypeTag) Value() string {
+	return d.value
+}
+
+// SerializerOperationTag returns a new serializer operation tag.
+func SerializerOperationTag(value string) Tag {
+	if len(value) == 0 {
+		value = unknownValue
+	}
+	return serializerOperationTag{value}
+}
+
+// Key returns the key of the serializer operation tag
+func (d serializerOperationTag) Key() string {
+	return serializerOp
+}
+
+// Value returns the value of the serializer operation tag
+func (d serializerOperationTag) Value() string {
+	return d.value
+}
+
+// StoreTypeTag returns a new persistence store type tag
+func StoreTypeTag(value string) Tag {
+	if len(value) == 0 {
+		value = unknownValue
+	}
+	return storeTypeTag{value}
+}
+
+// Key returns the key of the store type tag
+func (d storeTypeTag) Key() string {
+	return storeType
+}
+
+// Value returns the value of the store type tag
+func (d storeTypeTag) Value() string {
+	return d.value
+}
+
+// RingTag returns a new membership ring tag
+func RingTag(value string) Tag {
+	if len(value) == 0 {
+		value = unknownValue
+	}
+	return ringTag{value}
+}
+
+// Key returns the key of the ring tag
+func (d ringTag) Key() string {
+	return ring
+}
+
+// Value returns the value of the ring tag
+func (d ringTag) Value() string {
+	return d.value
+}
@@ -0,0 +1,60 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTag_Normalization(t *testing.T) {
+	tests := []struct {
+		key           string
+		value         string
+		expectedKey   string
+		expectedValue string
+	}{
+		{key: "workflow_type", value: "MyWorkflow", expectedKey: "workflow_type", expectedValue: "MyWorkflow"},
+		{key: "WorkflowType", value: "my-workflow", expectedKey: "workflowtype", expectedValue: "my_workflow"},
+		{key: "task.queue", value: "queue/with spaces", expectedKey: "task_queue", expectedValue: "queue_with_spaces"},
+		{key: "namespace", value: "", expectedKey: "namespace", expectedValue: unknownValue},
+	}
+
+	for _, tt := range tests {
+		tag := NewTag(tt.key, tt.value)
+		assert.Equal(t, tt.expectedKey, tag.Key())
+		assert.Equal(t, tt.expectedValue, tag.Value())
+	}
+}
+
+func TestNewTag_Truncation(t *testing.T) {
+	tag := NewTag("workflow_id", strings.Repeat("a", maxTagValueLength))
+	assert.Equal(t, strings.Repeat("a", maxTagValueLength), tag.Value())
+
+	tag = NewTag("workflow_id", strings.Repeat("a", maxTagValueLength)+"bcd")
+	assert.Equal(t, strings.Repeat("a", maxTagValueLength), tag.Value())
+}
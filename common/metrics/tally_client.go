@@ -116,6 +116,13 @@ func (m *TallyClient) UpdateGauge(scopeIdx int, gaugeIdx int, value float64) {
 	m.childScopes[scopeIdx].Gauge(name).Update(value)
 }
 
+// RecordOutcome increments the success or failure counters for the given error
+func (m *TallyClient) RecordOutcome(scopeIdx int, err error) {
+	for _, counterIdx := range outcomeCounters(err) {
+		m.IncCounter(scopeIdx, counterIdx)
+	}
+}
+
 // Scope return a new internal metrics scope that can be used to add additional
 // information to the metrics emitted
 func (m *TallyClient) Scope(scopeIdx int, tags ...Tag) Scope {
@@ -123,6 +130,11 @@ func (m *TallyClient) Scope(scopeIdx int, tags ...Tag) Scope {
 	return newTallyScope(scope, scope, m.metricDefs, false).Tagged(tags...)
 }
 
+// ShardScope returns a new internal metrics scope tagged with the given shard
+func (m *TallyClient) ShardScope(scopeIdx int, shardID int32) Scope {
+	return m.Scope(scopeIdx, ShardTag(shardID))
+}
+
 // UserScope returns a new metrics scope that can be used to add additional
 // information to the metrics emitted by user code
 func (m *TallyClient) UserScope() UserScope {
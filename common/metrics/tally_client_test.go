@@ -0,0 +1,181 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"go.temporal.io/api/serviceerror"
+)
+
+type (
+	tallyClientSuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+)
+
+func TestTallyClientSuite(t *testing.T) {
+	s := new(tallyClientSuite)
+	suite.Run(t, s)
+}
+
+func (s *tallyClientSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *tallyClientSuite) TestStartTimerRecordsElapsedTime() {
+	scope := tally.NewTestScope("test", nil)
+	client := NewClient(scope, History)
+
+	sleep := 50 * time.Millisecond
+	sw := client.StartTimer(PersistenceGetShardScope, PersistenceLatency)
+	time.Sleep(sleep)
+	sw.Stop()
+
+	var recorded []time.Duration
+	for _, timer := range scope.Snapshot().Timers() {
+		if timer.Name() == "test."+string(MetricDefs[Common][PersistenceLatency].metricName) {
+			recorded = append(recorded, timer.Values()...)
+		}
+	}
+	s.Len(recorded, 1)
+	s.GreaterOrEqual(int64(recorded[0]), int64(sleep))
+	s.Less(int64(recorded[0]), int64(sleep+time.Second))
+}
+
+func (s *tallyClientSuite) TestStartTimerRecordsNothingUntilStopped() {
+	scope := tally.NewTestScope("test", nil)
+	client := NewClient(scope, History)
+
+	client.StartTimer(PersistenceGetShardScope, PersistenceLatency)
+	for _, timer := range scope.Snapshot().Timers() {
+		s.Empty(timer.Values())
+	}
+}
+
+func (s *tallyClientSuite) TestScopeTagsFlowToTallyScope() {
+	scope := tally.NewTestScope("test", nil)
+	client := NewClient(scope, History)
+
+	taggedScope := client.Scope(
+		PersistenceGetShardScope,
+		NamespaceTag("test-namespace"),
+		TaskQueueTag("testTaskQueue"),
+	)
+	taggedScope.IncCounter(PersistenceRequests)
+	taggedScope.RecordTimer(PersistenceLatency, time.Millisecond)
+
+	counters := scope.Snapshot().Counters()
+	s.Len(counters, 1)
+	for _, counter := range counters {
+		s.Equal("test."+string(MetricDefs[Common][PersistenceRequests].metricName), counter.Name())
+		s.Equal(int64(1), counter.Value())
+		s.Equal(map[string]string{
+			OperationTagName: "GetShard",
+			namespace:        "test-namespace",
+			taskQueue:        "testTaskQueue",
+		}, counter.Tags())
+	}
+
+	var tagged []tally.TimerSnapshot
+	for _, timer := range scope.Snapshot().Timers() {
+		if timer.Tags()[namespace] == "test-namespace" {
+			tagged = append(tagged, timer)
+		}
+	}
+	s.Len(tagged, 1)
+	s.Equal("testTaskQueue", tagged[0].Tags()[taskQueue])
+	s.Equal([]time.Duration{time.Millisecond}, tagged[0].Values())
+}
+
+func (s *tallyClientSuite) TestScopeTagsDoNotLeakToClient() {
+	scope := tally.NewTestScope("test", nil)
+	client := NewClient(scope, History)
+
+	client.Scope(PersistenceGetShardScope, NamespaceTag("test-namespace"))
+	client.IncCounter(PersistenceGetShardScope, PersistenceRequests)
+
+	counters := scope.Snapshot().Counters()
+	s.Len(counters, 1)
+	for _, counter := range counters {
+		s.Equal(namespaceAllValue, counter.Tags()[namespace])
+	}
+}
+
+func (s *tallyClientSuite) TestShardScopeTagsShard() {
+	scope := tally.NewTestScope("test", nil)
+	client := NewClient(scope, History)
+
+	client.ShardScope(PersistenceGetShardScope, 7).IncCounter(PersistenceRequests)
+	client.ShardScope(PersistenceGetShardScope, 0).IncCounter(PersistenceRequests)
+	client.ShardScope(PersistenceGetShardScope, maxShardTagValue+1).IncCounter(PersistenceRequests)
+
+	shardCounts := make(map[string]int64)
+	for _, counter := range scope.Snapshot().Counters() {
+		s.Equal("GetShard", counter.Tags()[OperationTagName])
+		shardCounts[counter.Tags()[shardID]] += counter.Value()
+	}
+	s.Equal(map[string]int64{"7": 1, unknownValue: 2}, shardCounts)
+}
+
+func (s *tallyClientSuite) TestRecordOutcome() {
+	testCases := []struct {
+		err      error
+		counters []int
+	}{
+		{nil, []int{ServiceSuccesses}},
+		{context.DeadlineExceeded, []int{ServiceFailures, ServiceErrContextTimeoutCounter}},
+		{serviceerror.NewDeadlineExceeded("timeout"), []int{ServiceFailures, ServiceErrContextTimeoutCounter}},
+		{fmt.Errorf("wrapped: %w", context.Canceled), []int{ServiceFailures, ServiceErrContextCancelledCounter}},
+		{serviceerror.NewUnavailable("unavailable"), []int{ServiceFailures, ServiceErrUnavailableCounter}},
+		{serviceerror.NewNotFound("not found"), []int{ServiceFailures, ServiceErrNotFoundCounter}},
+		{serviceerror.NewResourceExhausted("busy"), []int{ServiceFailures, ServiceErrResourceExhaustedCounter}},
+		{errors.New("unknown"), []int{ServiceFailures}},
+	}
+
+	for _, tc := range testCases {
+		scope := tally.NewTestScope("test", nil)
+		client := NewClient(scope, History)
+		client.RecordOutcome(PersistenceGetShardScope, tc.err)
+
+		expected := make(map[string]int64, len(tc.counters))
+		for _, counterIdx := range tc.counters {
+			expected["test."+string(MetricDefs[Common][counterIdx].metricName)] = 1
+		}
+		actual := make(map[string]int64)
+		for _, counter := range scope.Snapshot().Counters() {
+			actual[counter.Name()] += counter.Value()
+		}
+		s.Equal(expected, actual, "error: %v", tc.err)
+	}
+}
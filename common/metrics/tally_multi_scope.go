@@ -0,0 +1,178 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metrics
+
+import (
+	"time"
+
+	"github.com/uber-go/tally"
+)
+
+type (
+	multiTallyScope struct {
+		scopes []tally.Scope
+	}
+
+	multiTallyCounter struct {
+		counters []tally.Counter
+	}
+
+	multiTallyGauge struct {
+		gauges []tally.Gauge
+	}
+
+	multiTallyTimer struct {
+		timers []tally.Timer
+	}
+
+	multiTallyHistogram struct {
+		histograms []tally.Histogram
+	}
+
+	multiTallyCapabilities struct {
+		reporting bool
+		tagging   bool
+	}
+)
+
+// NewMultiScope returns a tally scope which emits every metric to all of the given scopes
+func NewMultiScope(scopes ...tally.Scope) tally.Scope {
+	if len(scopes) == 1 {
+		return scopes[0]
+	}
+	return &multiTallyScope{scopes: scopes}
+}
+
+func (s *multiTallyScope) Counter(name string) tally.Counter {
+	counters := make([]tally.Counter, len(s.scopes))
+	for i, scope := range s.scopes {
+		counters[i] = scope.Counter(name)
+	}
+	return &multiTallyCounter{counters: counters}
+}
+
+func (s *multiTallyScope) Gauge(name string) tally.Gauge {
+	gauges := make([]tally.Gauge, len(s.scopes))
+	for i, scope := range s.scopes {
+		gauges[i] = scope.Gauge(name)
+	}
+	return &multiTallyGauge{gauges: gauges}
+}
+
+func (s *multiTallyScope) Timer(name string) tally.Timer {
+	timers := make([]tally.Timer, len(s.scopes))
+	for i, scope := range s.scopes {
+		timers[i] = scope.Timer(name)
+	}
+	return &multiTallyTimer{timers: timers}
+}
+
+func (s *multiTallyScope) Histogram(name string, buckets tally.Buckets) tally.Histogram {
+	histograms := make([]tally.Histogram, len(s.scopes))
+	for i, scope := range s.scopes {
+		histograms[i] = scope.Histogram(name, buckets)
+	}
+	return &multiTallyHistogram{histograms: histograms}
+}
+
+func (s *multiTallyScope) Tagged(tags map[string]string) tally.Scope {
+	scopes := make([]tally.Scope, len(s.scopes))
+	for i, scope := range s.scopes {
+		scopes[i] = scope.Tagged(tags)
+	}
+	return &multiTallyScope{scopes: scopes}
+}
+
+func (s *multiTallyScope) SubScope(name string) tally.Scope {
+	scopes := make([]tally.Scope, len(s.scopes))
+	for i, scope := range s.scopes {
+		scopes[i] = scope.SubScope(name)
+	}
+	return &multiTallyScope{scopes: scopes}
+}
+
+// Capabilities reports the union of the capabilities of the underlying scopes
+func (s *multiTallyScope) Capabilities() tally.Capabilities {
+	var capabilities multiTallyCapabilities
+	for _, scope := range s.scopes {
+		capabilities.reporting = capabilities.reporting || scope.Capabilities().Reporting()
+		capabilities.tagging = capabilities.tagging || scope.Capabilities().Tagging()
+	}
+	return capabilities
+}
+
+func (c *multiTallyCounter) Inc(delta int64) {
+	for _, counter := range c.counters {
+		counter.Inc(delta)
+	}
+}
+
+func (g *multiTallyGauge) Update(value float64) {
+	for _, gauge := range g.gauges {
+		gauge.Update(value)
+	}
+}
+
+func (t *multiTallyTimer) Record(value time.Duration) {
+	for _, timer := range t.timers {
+		timer.Record(value)
+	}
+}
+
+func (t *multiTallyTimer) Start() tally.Stopwatch {
+	return tally.NewStopwatch(time.Now().UTC(), t)
+}
+
+func (t *multiTallyTimer) RecordStopwatch(stopwatchStart time.Time) {
+	t.Record(time.Since(stopwatchStart))
+}
+
+func (h *multiTallyHistogram) RecordValue(value float64) {
+	for _, histogram := range h.histograms {
+		histogram.RecordValue(value)
+	}
+}
+
+func (h *multiTallyHistogram) RecordDuration(value time.Duration) {
+	for _, histogram := range h.histograms {
+		histogram.RecordDuration(value)
+	}
+}
+
+func (h *multiTallyHistogram) Start() tally.Stopwatch {
+	return tally.NewStopwatch(time.Now().UTC(), h)
+}
+
+func (h *multiTallyHistogram) RecordStopwatch(stopwatchStart time.Time) {
+	h.RecordDuration(time.Since(stopwatchStart))
+}
+
+func (c multiTallyCapabilities) Reporting() bool {
+	return c.reporting
+}
+
+func (c multiTallyCapabilities) Tagging() bool {
+	return c.tagging
+}
@@ -25,17 +25,25 @@
 package metrics
 
 import (
+	"io"
+	"time"
+
 	"github.com/uber-go/tally"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
 )
 
+// tallyFlushTimeout bounds how long Stop waits for the final flush of buffered metrics.
+const tallyFlushTimeout = 5 * time.Second
+
 // TallyReporter is a base class for reporting metrics to Tally.
 type TallyReporter struct {
-	scope tally.Scope
+	scope        tally.Scope
+	flushTimeout time.Duration
 }
 
 func newTallyReporter(scope tally.Scope) *TallyReporter {
-	return &TallyReporter{scope: scope}
+	return &TallyReporter{scope: scope, flushTimeout: tallyFlushTimeout}
 }
 
 func (tr *TallyReporter) NewClient(logger log.Logger, serviceIdx ServiceIdx) (Client, error) {
@@ -46,6 +54,27 @@ func (tr *TallyReporter) GetScope() tally.Scope {
 	return tr.scope
 }
 
+// Stop closes the root scope, which reports and flushes any buffered metrics one last time.
+// Stop gives up waiting once the flush timeout elapses so a stuck backend cannot block shutdown.
 func (tr *TallyReporter) Stop(logger log.Logger) {
-	// noop
+	closer, ok := tr.scope.(io.Closer)
+	if !ok {
+		return
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- closer.Close()
+	}()
+
+	timer := time.NewTimer(tr.flushTimeout)
+	defer timer.Stop()
+	select {
+	case err := <-errCh:
+		if err != nil {
+			logger.Error("Failed to flush metrics on shutdown.", tag.Error(err))
+		}
+	case <-timer.C:
+		logger.Warn("Timed out flushing metrics on shutdown.")
+	}
 }
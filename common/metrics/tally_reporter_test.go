@@ -0,0 +1,122 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+
+	"go.temporal.io/server/common/log"
+)
+
+type (
+	tallyReporterSuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+
+	closableScope struct {
+		tally.Scope
+		closed  chan struct{}
+		blockCh chan struct{}
+	}
+
+	countingStatsReporter struct {
+		tally.StatsReporter
+		counters map[string]int64
+		flushes  int
+	}
+)
+
+func TestTallyReporterSuite(t *testing.T) {
+	s := new(tallyReporterSuite)
+	suite.Run(t, s)
+}
+
+func (s *tallyReporterSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *tallyReporterSuite) TestStopClosesScope() {
+	scope := &closableScope{Scope: tally.NoopScope, closed: make(chan struct{})}
+	reporter := newTallyReporter(scope)
+
+	reporter.Stop(log.NewNoopLogger())
+	s.True(isClosed(scope.closed))
+}
+
+func (s *tallyReporterSuite) TestStopGivesUpAfterFlushTimeout() {
+	scope := &closableScope{Scope: tally.NoopScope, closed: make(chan struct{}), blockCh: make(chan struct{})}
+	defer close(scope.blockCh)
+	reporter := newTallyReporter(scope)
+	reporter.flushTimeout = 10 * time.Millisecond
+
+	start := time.Now()
+	reporter.Stop(log.NewNoopLogger())
+	s.Less(int64(time.Since(start)), int64(time.Second))
+	s.False(isClosed(scope.closed))
+}
+
+func (s *tallyReporterSuite) TestStopFlushesBufferedCounters() {
+	statsReporter := &countingStatsReporter{StatsReporter: tally.NullStatsReporter, counters: make(map[string]int64)}
+	// a report interval long enough that only the final flush on Stop can report the counter
+	scope, _ := tally.NewRootScope(tally.ScopeOptions{Reporter: statsReporter}, time.Hour)
+	reporter := newTallyReporter(scope)
+
+	scope.Counter(RestartCount).Inc(1)
+	reporter.Stop(log.NewNoopLogger())
+
+	s.Equal(int64(1), statsReporter.counters[RestartCount])
+	s.Equal(1, statsReporter.flushes)
+}
+
+func (s *closableScope) Close() error {
+	if s.blockCh != nil {
+		<-s.blockCh
+	}
+	close(s.closed)
+	return nil
+}
+
+func (r *countingStatsReporter) ReportCounter(name string, _ map[string]string, value int64) {
+	r.counters[name] += value
+}
+
+func (r *countingStatsReporter) Flush() {
+	r.flushes++
+}
+
+func isClosed(ch chan struct{}) bool {
+	select {
+	case <-ch:
+		return true
+	default:
+		return false
+	}
+}
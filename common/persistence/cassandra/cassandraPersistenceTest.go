@@ -91,7 +91,8 @@ func (s *TestCluster) Config() config.Persistence {
 		DataStores: map[string]config.DataStore{
 			"test": {Cassandra: &cfg},
 		},
-		TransactionSizeLimit: dynamicconfig.GetIntPropertyFn(common.DefaultTransactionSizeLimit),
+		TransactionSizeLimit:  dynamicconfig.GetIntPropertyFn(common.DefaultTransactionSizeLimit),
+		MutableStateSizeLimit: dynamicconfig.GetIntPropertyFn(common.DefaultMutableStateSizeLimit),
 		VisibilityConfig: &config.VisibilityConfig{
 			EnableSampling: dynamicconfig.GetBoolPropertyFn(false),
 		},
@@ -25,6 +25,7 @@
 package cassandra
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -254,6 +255,7 @@ func (v *cassandraVisibilityPersistence) UpsertWorkflowExecution(request *p.Inte
 }
 
 func (v *cassandraVisibilityPersistence) ListOpenWorkflowExecutions(
+	ctx context.Context,
 	request *p.ListWorkflowExecutionsRequest,
 ) (*p.InternalListWorkflowExecutionsResponse, error) {
 	query := v.session.
@@ -262,7 +264,8 @@ func (v *cassandraVisibilityPersistence) ListOpenWorkflowExecutions(
 			namespacePartition,
 			p.UnixMilliseconds(request.EarliestStartTime),
 			p.UnixMilliseconds(request.LatestStartTime)).
-		Consistency(v.lowConslevel)
+		Consistency(v.lowConslevel).
+		WithContext(ctx)
 	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
 
 	response := &p.InternalListWorkflowExecutionsResponse{}
@@ -283,6 +286,7 @@ func (v *cassandraVisibilityPersistence) ListOpenWorkflowExecutions(
 }
 
 func (v *cassandraVisibilityPersistence) ListOpenWorkflowExecutionsByType(
+	ctx context.Context,
 	request *p.ListWorkflowExecutionsByTypeRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
 	query := v.session.
 		Query(templateGetOpenWorkflowExecutionsByType,
@@ -291,7 +295,8 @@ func (v *cassandraVisibilityPersistence) ListOpenWorkflowExecutionsByType(
 			p.UnixMilliseconds(request.EarliestStartTime),
 			p.UnixMilliseconds(request.LatestStartTime),
 			request.WorkflowTypeName).
-		Consistency(v.lowConslevel)
+		Consistency(v.lowConslevel).
+		WithContext(ctx)
 	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
 
 	response := &p.InternalListWorkflowExecutionsResponse{}
@@ -312,6 +317,7 @@ func (v *cassandraVisibilityPersistence) ListOpenWorkflowExecutionsByType(
 }
 
 func (v *cassandraVisibilityPersistence) ListOpenWorkflowExecutionsByWorkflowID(
+	ctx context.Context,
 	request *p.ListWorkflowExecutionsByWorkflowIDRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
 	query := v.session.
 		Query(templateGetOpenWorkflowExecutionsByID,
@@ -320,7 +326,8 @@ func (v *cassandraVisibilityPersistence) ListOpenWorkflowExecutionsByWorkflowID(
 			p.UnixMilliseconds(request.EarliestStartTime),
 			p.UnixMilliseconds(request.LatestStartTime),
 			request.WorkflowID).
-		Consistency(v.lowConslevel)
+		Consistency(v.lowConslevel).
+		WithContext(ctx)
 	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
 
 	response := &p.InternalListWorkflowExecutionsResponse{}
@@ -341,6 +348,7 @@ func (v *cassandraVisibilityPersistence) ListOpenWorkflowExecutionsByWorkflowID(
 }
 
 func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutions(
+	ctx context.Context,
 	request *p.ListWorkflowExecutionsRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
 	query := v.session.
 		Query(templateGetClosedWorkflowExecutions,
@@ -348,7 +356,8 @@ func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutions(
 			namespacePartition,
 			p.UnixMilliseconds(request.EarliestStartTime),
 			p.UnixMilliseconds(request.LatestStartTime)).
-		Consistency(v.lowConslevel)
+		Consistency(v.lowConslevel).
+		WithContext(ctx)
 	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
 
 	response := &p.InternalListWorkflowExecutionsResponse{}
@@ -369,6 +378,7 @@ func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutions(
 }
 
 func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutionsByType(
+	ctx context.Context,
 	request *p.ListWorkflowExecutionsByTypeRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
 	query := v.session.
 		Query(templateGetClosedWorkflowExecutionsByType,
@@ -377,7 +387,8 @@ func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutionsByType(
 			p.UnixMilliseconds(request.EarliestStartTime),
 			p.UnixMilliseconds(request.LatestStartTime),
 			request.WorkflowTypeName).
-		Consistency(v.lowConslevel)
+		Consistency(v.lowConslevel).
+		WithContext(ctx)
 	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
 
 	response := &p.InternalListWorkflowExecutionsResponse{}
@@ -398,6 +409,7 @@ func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutionsByType(
 }
 
 func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutionsByWorkflowID(
+	ctx context.Context,
 	request *p.ListWorkflowExecutionsByWorkflowIDRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
 	query := v.session.
 		Query(templateGetClosedWorkflowExecutionsByID,
@@ -406,7 +418,8 @@ func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutionsByWorkflowI
 			p.UnixMilliseconds(request.EarliestStartTime),
 			p.UnixMilliseconds(request.LatestStartTime),
 			request.WorkflowID).
-		Consistency(v.lowConslevel)
+		Consistency(v.lowConslevel).
+		WithContext(ctx)
 	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
 
 	response := &p.InternalListWorkflowExecutionsResponse{}
@@ -427,6 +440,7 @@ func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutionsByWorkflowI
 }
 
 func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutionsByStatus(
+	ctx context.Context,
 	request *p.ListClosedWorkflowExecutionsByStatusRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
 	query := v.session.
 		Query(templateGetClosedWorkflowExecutionsByStatus,
@@ -435,7 +449,8 @@ func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutionsByStatus(
 			p.UnixMilliseconds(request.EarliestStartTime),
 			p.UnixMilliseconds(request.LatestStartTime),
 			request.Status).
-		Consistency(v.lowConslevel)
+		Consistency(v.lowConslevel).
+		WithContext(ctx)
 	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
 
 	response := &p.InternalListWorkflowExecutionsResponse{}
@@ -456,6 +471,7 @@ func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutionsByStatus(
 }
 
 func (v *cassandraVisibilityPersistence) GetClosedWorkflowExecution(
+	ctx context.Context,
 	request *p.GetClosedWorkflowExecutionRequest) (*p.InternalGetClosedWorkflowExecutionResponse, error) {
 	execution := request.Execution
 	query := v.session.
@@ -464,7 +480,8 @@ func (v *cassandraVisibilityPersistence) GetClosedWorkflowExecution(
 			namespacePartition,
 			execution.GetWorkflowId(),
 			execution.GetRunId(),
-		)
+		).
+		WithContext(ctx)
 	iter := query.Iter()
 
 	wfexecution, has := readClosedWorkflowExecutionRecord(iter)
@@ -486,15 +503,15 @@ func (v *cassandraVisibilityPersistence) DeleteWorkflowExecution(request *p.Visi
 	return nil
 }
 
-func (v *cassandraVisibilityPersistence) ListWorkflowExecutions(request *p.ListWorkflowExecutionsRequestV2) (*p.InternalListWorkflowExecutionsResponse, error) {
+func (v *cassandraVisibilityPersistence) ListWorkflowExecutions(ctx context.Context, request *p.ListWorkflowExecutionsRequestV2) (*p.InternalListWorkflowExecutionsResponse, error) {
 	return nil, p.NewOperationNotSupportErrorForVis()
 }
 
-func (v *cassandraVisibilityPersistence) ScanWorkflowExecutions(request *p.ListWorkflowExecutionsRequestV2) (*p.InternalListWorkflowExecutionsResponse, error) {
+func (v *cassandraVisibilityPersistence) ScanWorkflowExecutions(ctx context.Context, request *p.ListWorkflowExecutionsRequestV2) (*p.InternalListWorkflowExecutionsResponse, error) {
 	return nil, p.NewOperationNotSupportErrorForVis()
 }
 
-func (v *cassandraVisibilityPersistence) CountWorkflowExecutions(request *p.CountWorkflowExecutionsRequest) (*p.CountWorkflowExecutionsResponse, error) {
+func (v *cassandraVisibilityPersistence) CountWorkflowExecutions(ctx context.Context, request *p.CountWorkflowExecutionsRequest) (*p.CountWorkflowExecutionsResponse, error) {
 	return nil, p.NewOperationNotSupportErrorForVis()
 }
 
@@ -27,8 +27,11 @@
 package client
 
 import (
+	"context"
 	"sync"
 
+	"go.temporal.io/api/serviceerror"
+
 	"go.temporal.io/server/common/persistence"
 )
 
@@ -36,6 +39,8 @@ type (
 	// Bean in an collection of persistence manager
 	Bean interface {
 		Close()
+		// Ping performs a cheap round-trip to the datastore, returning an error if it cannot be reached
+		Ping(ctx context.Context) error
 
 		GetClusterMetadataManager() persistence.ClusterMetadataManager
 		SetClusterMetadataManager(persistence.ClusterMetadataManager)
@@ -80,6 +85,9 @@ type (
 
 var _ Bean = (*BeanImpl)(nil)
 
+// pingShardID is the shard read by Ping, shard IDs start from 1
+const pingShardID = 1
+
 // NewBeanFromFactory crate a new store bean using factory
 func NewBeanFromFactory(
 	factory Factory,
@@ -337,6 +345,28 @@ func (s *BeanImpl) SetExecutionManager(
 	s.shardIDToExecutionManager[shardID] = executionManager
 }
 
+// Ping reads a shard to verify the datastore can be reached. The shard not existing still
+// counts as a successful round-trip.
+func (s *BeanImpl) Ping(ctx context.Context) error {
+	shardManager := s.GetShardManager()
+
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := shardManager.GetShard(&persistence.GetShardRequest{ShardID: pingShardID})
+		if _, ok := err.(*serviceerror.NotFound); ok {
+			err = nil
+		}
+		errCh <- err
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // Close cleanup connections
 func (s *BeanImpl) Close() {
 
@@ -27,6 +27,8 @@ package client
 import (
 	"sync"
 
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
@@ -94,6 +96,8 @@ type (
 	Datastore struct {
 		factory   DataStoreFactory
 		ratelimit quotas.RateLimiter
+		// storeTypeName is the kind of the datastore, i.e. cassandra or the SQL plugin name, used to tag metrics
+		storeTypeName string
 	}
 	factoryImpl struct {
 		sync.RWMutex
@@ -108,6 +112,10 @@ type (
 	storeType int
 )
 
+const (
+	cassandraStoreTypeName = "cassandra"
+)
+
 const (
 	storeTypeHistory storeType = iota + 1
 	storeTypeTask
@@ -169,8 +177,11 @@ func (f *factoryImpl) NewTaskManager() (p.TaskManager, error) {
 	if ds.ratelimit != nil {
 		result = p.NewTaskPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
+	if policy := f.retryPolicy(); policy != nil {
+		result = p.NewTaskPersistenceRetryableClient(result, policy)
+	}
 	if f.metricsClient != nil {
-		result = p.NewTaskPersistenceMetricsClient(result, f.metricsClient, f.logger)
+		result = p.NewTaskPersistenceMetricsClient(result, f.datastoreMetricsClient(ds), f.logger)
 	}
 	return result, nil
 }
@@ -186,8 +197,11 @@ func (f *factoryImpl) NewShardManager() (p.ShardManager, error) {
 	if ds.ratelimit != nil {
 		result = p.NewShardPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
+	if policy := f.retryPolicy(); policy != nil {
+		result = p.NewShardPersistenceRetryableClient(result, policy)
+	}
 	if f.metricsClient != nil {
-		result = p.NewShardPersistenceMetricsClient(result, f.metricsClient, f.logger)
+		result = p.NewShardPersistenceMetricsClient(result, f.datastoreMetricsClient(ds), f.logger)
 	}
 	return result, nil
 }
@@ -203,8 +217,11 @@ func (f *factoryImpl) NewHistoryManager() (p.HistoryManager, error) {
 	if ds.ratelimit != nil {
 		result = p.NewHistoryV2PersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
+	if policy := f.retryPolicy(); policy != nil {
+		result = p.NewHistoryV2PersistenceRetryableClient(result, policy)
+	}
 	if f.metricsClient != nil {
-		result = p.NewHistoryV2PersistenceMetricsClient(result, f.metricsClient, f.logger)
+		result = p.NewHistoryV2PersistenceMetricsClient(result, f.datastoreMetricsClient(ds), f.logger)
 	}
 	return result, nil
 }
@@ -224,7 +241,7 @@ func (f *factoryImpl) NewMetadataManager() (p.MetadataManager, error) {
 		result = p.NewMetadataPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
 	if f.metricsClient != nil {
-		result = p.NewMetadataPersistenceMetricsClient(result, f.metricsClient, f.logger)
+		result = p.NewMetadataPersistenceMetricsClient(result, f.datastoreMetricsClient(ds), f.logger)
 	}
 	return result, nil
 }
@@ -244,7 +261,7 @@ func (f *factoryImpl) NewClusterMetadataManager() (p.ClusterMetadataManager, err
 		result = p.NewClusterMetadataPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
 	if f.metricsClient != nil {
-		result = p.NewClusterMetadataPersistenceMetricsClient(result, f.metricsClient, f.logger)
+		result = p.NewClusterMetadataPersistenceMetricsClient(result, f.datastoreMetricsClient(ds), f.logger)
 	}
 	return result, nil
 }
@@ -259,12 +276,15 @@ func (f *factoryImpl) NewExecutionManager(
 	if err != nil {
 		return nil, err
 	}
-	result := p.NewExecutionManager(store, f.logger)
+	result := p.NewExecutionManager(store, f.logger, f.config.MutableStateSizeLimit)
 	if ds.ratelimit != nil {
 		result = p.NewWorkflowExecutionPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
+	if policy := f.retryPolicy(); policy != nil {
+		result = p.NewWorkflowExecutionPersistenceRetryableClient(result, policy)
+	}
 	if f.metricsClient != nil {
-		result = p.NewWorkflowExecutionPersistenceMetricsClient(result, f.metricsClient, f.logger)
+		result = p.NewWorkflowExecutionPersistenceMetricsClient(result, f.datastoreMetricsClient(ds), f.logger)
 	}
 	return result, nil
 }
@@ -296,7 +316,7 @@ func (f *factoryImpl) NewVisibilityManager() (p.VisibilityManager, error) {
 		result = p.NewVisibilitySamplingClient(result, visConfig, f.metricsClient, f.logger)
 	}
 	if f.metricsClient != nil {
-		result = p.NewVisibilityPersistenceMetricsClient(result, f.metricsClient, f.logger)
+		result = p.NewVisibilityPersistenceMetricsClient(result, f.datastoreMetricsClient(ds), f.logger)
 	}
 
 	return result, nil
@@ -312,7 +332,7 @@ func (f *factoryImpl) NewNamespaceReplicationQueue() (p.NamespaceReplicationQueu
 		result = p.NewQueuePersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
 	if f.metricsClient != nil {
-		result = p.NewQueuePersistenceMetricsClient(result, f.metricsClient, f.logger)
+		result = p.NewQueuePersistenceMetricsClient(result, f.datastoreMetricsClient(ds), f.logger)
 	}
 
 	return p.NewNamespaceReplicationQueue(result, f.clusterName, f.metricsClient, f.logger)
@@ -351,10 +371,13 @@ func (f *factoryImpl) init(
 	switch {
 	case defaultCfg.Cassandra != nil:
 		defaultDataStore.factory = cassandra.NewFactory(*defaultCfg.Cassandra, r, clusterName, f.logger)
+		defaultDataStore.storeTypeName = cassandraStoreTypeName
 	case defaultCfg.SQL != nil:
 		defaultDataStore.factory = sql.NewFactory(*defaultCfg.SQL, r, clusterName, f.logger)
+		defaultDataStore.storeTypeName = defaultCfg.SQL.PluginName
 	case defaultCfg.CustomDataStoreConfig != nil:
 		defaultDataStore.factory = f.abstractDataStoreFactory.NewFactory(*defaultCfg.CustomDataStoreConfig, r, clusterName, f.logger)
+		defaultDataStore.storeTypeName = defaultCfg.CustomDataStoreConfig.Name
 	default:
 		f.logger.Fatal("invalid config: one of cassandra or sql params must be specified for default data store")
 	}
@@ -370,8 +393,10 @@ func (f *factoryImpl) init(
 		switch {
 		case visibilityCfg.Cassandra != nil:
 			visibilityDataStore.factory = cassandra.NewFactory(*visibilityCfg.Cassandra, r, clusterName, f.logger)
+			visibilityDataStore.storeTypeName = cassandraStoreTypeName
 		case visibilityCfg.SQL != nil:
 			visibilityDataStore.factory = sql.NewFactory(*visibilityCfg.SQL, r, clusterName, f.logger)
+			visibilityDataStore.storeTypeName = visibilityCfg.SQL.PluginName
 		default:
 			f.logger.Fatal("invalid config: one of cassandra or sql params must be specified for visibility store")
 		}
@@ -379,6 +404,24 @@ func (f *factoryImpl) init(
 	}
 }
 
+// retryPolicy returns the policy of the retryable persistence clients, or nil when retries are disabled
+func (f *factoryImpl) retryPolicy() backoff.RetryPolicy {
+	if f.config.RetryMaxAttempts == nil {
+		return nil
+	}
+	maxAttempts := f.config.RetryMaxAttempts()
+	if maxAttempts <= 0 {
+		return nil
+	}
+	return common.CreatePersistenceClientRetryPolicy(maxAttempts)
+}
+
+// datastoreMetricsClient returns the metrics client for persistence objects of the given datastore,
+// metrics are tagged with the store type so latencies of different datastores can be told apart
+func (f *factoryImpl) datastoreMetricsClient(ds Datastore) metrics.Client {
+	return metrics.NewTaggedClient(f.metricsClient, metrics.StoreTypeTag(ds.storeTypeName))
+}
+
 func buildRateLimiters(
 	cfg *config.Persistence,
 	maxQPS dynamicconfig.IntPropertyFn,
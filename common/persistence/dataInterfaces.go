@@ -162,6 +162,11 @@ type (
 		Msg string
 	}
 
+	// EventRangeOutOfBoundsError is returned when reading events outside of the range of a version history
+	EventRangeOutOfBoundsError struct {
+		Msg string
+	}
+
 	// ShardInfoWithFailover describes a shard
 	ShardInfoWithFailover struct {
 		*persistencespb.ShardInfo
@@ -565,6 +570,10 @@ type (
 		Condition       int64
 		DBRecordVersion int64
 		Checksum        *persistencespb.Checksum
+
+		// MutableStateSize is the estimated size of the whole mutable state once the mutation is applied,
+		// see ComputeMutableStateSize. It is validated against the mutable state size limit.
+		MutableStateSize int
 	}
 
 	// WorkflowSnapshot is used as generic workflow execution state snapshot
@@ -1384,6 +1393,10 @@ func (e *TransactionSizeLimitError) Error() string {
 	return e.Msg
 }
 
+func (e *EventRangeOutOfBoundsError) Error() string {
+	return e.Msg
+}
+
 // IsTimeoutError check whether error is TimeoutError
 func IsTimeoutError(err error) bool {
 	_, ok := err.(*TimeoutError)
@@ -27,6 +27,7 @@
 package client
 
 import (
+	"context"
 	"time"
 
 	"github.com/olivere/elastic/v7"
@@ -43,6 +44,8 @@ type (
 	BulkProcessor interface {
 		Stop() error
 		Add(request *BulkableRequest)
+		// Flush commits all buffered requests and blocks until they are processed or ctx is done.
+		Flush(ctx context.Context) error
 	}
 
 	// BulkProcessorParameters holds all required and optional parameters for executing bulk service
@@ -64,4 +67,47 @@ type (
 		Version     int64
 		Doc         map[string]interface{}
 	}
+
+	// BulkItemFailure describes a single document which failed within a committed bulk request.
+	BulkItemFailure struct {
+		Index  string
+		ID     string
+		Status int
+		Reason string
+	}
 )
+
+// BulkItemFailures returns details for every failed document in the bulk response.
+func BulkItemFailures(response *elastic.BulkResponse) []BulkItemFailure {
+	if response == nil {
+		return nil
+	}
+
+	var failures []BulkItemFailure
+	for _, item := range response.Failed() {
+		failure := BulkItemFailure{
+			Index:  item.Index,
+			ID:     item.Id,
+			Status: item.Status,
+		}
+		if item.Error != nil {
+			failure.Reason = item.Error.Reason
+		}
+		failures = append(failures, failure)
+	}
+	return failures
+}
+
+func flushWithContext(ctx context.Context, flush func() error) error {
+	doneC := make(chan error, 1)
+	go func() {
+		doneC <- flush()
+	}()
+
+	select {
+	case err := <-doneC:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
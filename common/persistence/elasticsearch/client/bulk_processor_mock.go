@@ -29,6 +29,7 @@
 package client
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -69,6 +70,20 @@ func (mr *MockBulkProcessorMockRecorder) Add(request interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockBulkProcessor)(nil).Add), request)
 }
 
+// Flush mocks base method.
+func (m *MockBulkProcessor) Flush(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Flush", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Flush indicates an expected call of Flush.
+func (mr *MockBulkProcessorMockRecorder) Flush(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Flush", reflect.TypeOf((*MockBulkProcessor)(nil).Flush), ctx)
+}
+
 // Stop mocks base method.
 func (m *MockBulkProcessor) Stop() error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,195 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/olivere/elastic/v7"
+	"github.com/stretchr/testify/require"
+)
+
+// newBulkTestServer returns a fake Elasticsearch which records the number of documents per bulk request
+// and rejects documents whose ID is present in conflictIDs with a version conflict.
+func newBulkTestServer(t *testing.T, conflictIDs map[string]bool) (*httptest.Server, func() []int) {
+	var lock sync.Mutex
+	var batchSizes []int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/_bulk") {
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte("{}"))
+			return
+		}
+
+		var items []map[string]*elastic.BulkResponseItem
+		hasErrors := false
+		scanner := bufio.NewScanner(r.Body)
+		for scanner.Scan() {
+			var action map[string]struct {
+				Index string `json:"_index"`
+				ID    string `json:"_id"`
+			}
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &action))
+			for op, meta := range action {
+				item := &elastic.BulkResponseItem{Index: meta.Index, Id: meta.ID, Status: http.StatusCreated}
+				if conflictIDs[meta.ID] {
+					hasErrors = true
+					item.Status = http.StatusConflict
+					item.Error = &elastic.ErrorDetails{Type: "version_conflict_engine_exception", Reason: "version conflict"}
+				}
+				items = append(items, map[string]*elastic.BulkResponseItem{op: item})
+				if op == "index" {
+					// skip document source line
+					scanner.Scan()
+				}
+			}
+		}
+
+		lock.Lock()
+		batchSizes = append(batchSizes, len(items))
+		lock.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(&elastic.BulkResponse{Errors: hasErrors, Items: items}))
+	}))
+
+	return server, func() []int {
+		lock.Lock()
+		defer lock.Unlock()
+		return append([]int(nil), batchSizes...)
+	}
+}
+
+func newBulkTestClient(t *testing.T, url string) *clientV7 {
+	esClient, err := elastic.NewClient(
+		elastic.SetURL(url),
+		elastic.SetSniff(false),
+		elastic.SetHealthcheck(false),
+	)
+	require.NoError(t, err)
+	return &clientV7{esClient: esClient}
+}
+
+func newBulkTestIndexRequest(id string) *BulkableRequest {
+	return &BulkableRequest{
+		RequestType: BulkableRequestTypeIndex,
+		Index:       "test-index",
+		ID:          id,
+		Version:     1,
+		Doc:         map[string]interface{}{"WorkflowId": id},
+	}
+}
+
+func TestBulkProcessor_FlushesAtBatchSizeAndDrainsOnFlush(t *testing.T) {
+	server, batchSizes := newBulkTestServer(t, nil)
+	defer server.Close()
+	client := newBulkTestClient(t, server.URL)
+
+	processor, err := client.RunBulkProcessor(context.Background(), &BulkProcessorParameters{
+		Name:          "test-bulk-processor",
+		NumOfWorkers:  1,
+		BulkActions:   3,
+		BulkSize:      -1,
+		FlushInterval: time.Hour,
+	})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, processor.Stop()) }()
+
+	for i := 0; i < 7; i++ {
+		processor.Add(newBulkTestIndexRequest(string(rune('a' + i))))
+	}
+	require.Eventually(t, func() bool { return len(batchSizes()) == 2 }, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, []int{3, 3}, batchSizes())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, processor.Flush(ctx))
+	require.Equal(t, []int{3, 3, 1}, batchSizes())
+
+	// nothing buffered, so flushing again must not send another request
+	require.NoError(t, processor.Flush(ctx))
+	require.Equal(t, []int{3, 3, 1}, batchSizes())
+}
+
+func TestBulkProcessor_ReportsPerDocumentFailures(t *testing.T) {
+	server, _ := newBulkTestServer(t, map[string]bool{"bad": true})
+	defer server.Close()
+	client := newBulkTestClient(t, server.URL)
+
+	var lock sync.Mutex
+	var failures []BulkItemFailure
+	processor, err := client.RunBulkProcessor(context.Background(), &BulkProcessorParameters{
+		Name:          "test-bulk-processor",
+		NumOfWorkers:  1,
+		BulkActions:   100,
+		BulkSize:      -1,
+		FlushInterval: time.Hour,
+		AfterFunc: func(_ int64, _ []elastic.BulkableRequest, response *elastic.BulkResponse, _ error) {
+			lock.Lock()
+			defer lock.Unlock()
+			failures = append(failures, BulkItemFailures(response)...)
+		},
+	})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, processor.Stop()) }()
+
+	processor.Add(newBulkTestIndexRequest("good"))
+	processor.Add(newBulkTestIndexRequest("bad"))
+	require.NoError(t, processor.Flush(context.Background()))
+
+	lock.Lock()
+	defer lock.Unlock()
+	require.Equal(t, []BulkItemFailure{{
+		Index:  "test-index",
+		ID:     "bad",
+		Status: http.StatusConflict,
+		Reason: "version conflict",
+	}}, failures)
+}
+
+func TestBulkProcessor_FlushHonoursContext(t *testing.T) {
+	blockC := make(chan struct{})
+	defer close(blockC)
+	err := flushWithContext(canceledContext(), func() error {
+		<-blockC
+		return nil
+	})
+	require.Equal(t, context.Canceled, err)
+}
+
+func canceledContext() context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	return ctx
+}
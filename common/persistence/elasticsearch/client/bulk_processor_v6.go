@@ -25,6 +25,8 @@
 package client
 
 import (
+	"context"
+
 	elastic6 "github.com/olivere/elastic"
 	"github.com/olivere/elastic/v7"
 )
@@ -53,6 +55,12 @@ func (p *bulkProcessorV6) Stop() error {
 	return errS
 }
 
+func (p *bulkProcessorV6) Flush(ctx context.Context) error {
+	return flushWithContext(ctx, func() error {
+		return convertV6ErrorToV7(p.esBulkProcessor.Flush())
+	})
+}
+
 func (p *bulkProcessorV6) Add(request *BulkableRequest) {
 	switch request.RequestType {
 	case BulkableRequestTypeIndex:
@@ -25,6 +25,8 @@
 package client
 
 import (
+	"context"
+
 	"github.com/olivere/elastic/v7"
 )
 
@@ -52,6 +54,12 @@ func (p *bulkProcessorV7) Stop() error {
 	return errS
 }
 
+func (p *bulkProcessorV7) Flush(ctx context.Context) error {
+	return flushWithContext(ctx, func() error {
+		return p.esBulkProcessor.Flush()
+	})
+}
+
 func (p *bulkProcessorV7) Add(request *BulkableRequest) {
 	switch request.RequestType {
 	case BulkableRequestTypeIndex:
@@ -0,0 +1,220 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+
+	"github.com/olivere/elastic/v7"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+)
+
+type (
+	// ReloadableClient is a Client whose underlying client can be rebuilt from a new config at runtime,
+	// e.g. to rotate credentials. Bulk processors are recreated on the new client by a reload,
+	// scrolls keep using the client they were created with.
+	ReloadableClient struct {
+		newClient func(cfg *config.Elasticsearch) (Client, error)
+		client    atomic.Value // clientRef
+
+		// the mutex serializes reloads with starting and stopping bulk processors
+		sync.Mutex
+		bulkProcessors map[*reloadableBulkProcessor]struct{}
+	}
+
+	// reloadableBulkProcessor is a BulkProcessor which is recreated on the current client by a reload
+	reloadableBulkProcessor struct {
+		client *ReloadableClient
+		ctx    context.Context
+		params *BulkProcessorParameters
+
+		sync.RWMutex
+		processor BulkProcessor
+	}
+
+	// clientRef wraps a Client so that implementations of different types can be stored in the same atomic.Value
+	clientRef struct {
+		Client
+	}
+
+	stoppableClient interface {
+		Stop()
+	}
+)
+
+var _ Client = (*ReloadableClient)(nil)
+var _ BulkProcessor = (*reloadableBulkProcessor)(nil)
+
+// NewReloadableClient creates a ReloadableClient from the given config
+func NewReloadableClient(cfg *config.Elasticsearch, httpClient *http.Client, logger log.Logger) (*ReloadableClient, error) {
+	return newReloadableClient(cfg, func(cfg *config.Elasticsearch) (Client, error) {
+		return NewClient(cfg, httpClient, logger)
+	})
+}
+
+func newReloadableClient(cfg *config.Elasticsearch, newClient func(cfg *config.Elasticsearch) (Client, error)) (*ReloadableClient, error) {
+	client, err := newClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	c := &ReloadableClient{
+		newClient:      newClient,
+		bulkProcessors: make(map[*reloadableBulkProcessor]struct{}),
+	}
+	c.client.Store(clientRef{Client: client})
+	return c, nil
+}
+
+// Reload builds a client from cfg, recreates all running bulk processors on it and swaps it in.
+// Calls in flight complete on the current client, which is then stopped together with its bulk processors.
+// The current client is kept if the new client or any of its bulk processors can't be built.
+func (c *ReloadableClient) Reload(cfg *config.Elasticsearch) error {
+	client, err := c.newClient(cfg)
+	if err != nil {
+		return err
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	processors := make(map[*reloadableBulkProcessor]BulkProcessor, len(c.bulkProcessors))
+	for p := range c.bulkProcessors {
+		processor, err := client.RunBulkProcessor(p.ctx, p.params)
+		if err != nil {
+			for _, processor := range processors {
+				_ = processor.Stop()
+			}
+			stopClient(client)
+			return err
+		}
+		processors[p] = processor
+	}
+
+	oldClient := c.getClient()
+	c.client.Store(clientRef{Client: client})
+	for p, processor := range processors {
+		// stopping the old processor flushes the requests buffered by it
+		_ = p.swap(processor).Stop()
+	}
+	stopClient(oldClient)
+	return nil
+}
+
+func (c *ReloadableClient) getClient() Client {
+	return c.client.Load().(clientRef).Client
+}
+
+func (c *ReloadableClient) Search(ctx context.Context, p *SearchParameters) (*elastic.SearchResult, error) {
+	return c.getClient().Search(ctx, p)
+}
+
+func (c *ReloadableClient) SearchWithDSL(ctx context.Context, index, query string) (*elastic.SearchResult, error) {
+	return c.getClient().SearchWithDSL(ctx, index, query)
+}
+
+func (c *ReloadableClient) Scroll(ctx context.Context, scrollID string) (*elastic.SearchResult, ScrollService, error) {
+	return c.getClient().Scroll(ctx, scrollID)
+}
+
+func (c *ReloadableClient) ScrollFirstPage(ctx context.Context, index, query string) (*elastic.SearchResult, ScrollService, error) {
+	return c.getClient().ScrollFirstPage(ctx, index, query)
+}
+
+func (c *ReloadableClient) Count(ctx context.Context, index, query string) (int64, error) {
+	return c.getClient().Count(ctx, index, query)
+}
+
+func (c *ReloadableClient) RunBulkProcessor(ctx context.Context, p *BulkProcessorParameters) (BulkProcessor, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	processor, err := c.getClient().RunBulkProcessor(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+	reloadable := &reloadableBulkProcessor{
+		client:    c,
+		ctx:       ctx,
+		params:    p,
+		processor: processor,
+	}
+	c.bulkProcessors[reloadable] = struct{}{}
+	return reloadable, nil
+}
+
+func (c *ReloadableClient) PutMapping(ctx context.Context, index string, mapping map[string]enumspb.IndexedValueType) (bool, error) {
+	return c.getClient().PutMapping(ctx, index, mapping)
+}
+
+func (c *ReloadableClient) WaitForYellowStatus(ctx context.Context, index string) (string, error) {
+	return c.getClient().WaitForYellowStatus(ctx, index)
+}
+
+func (c *ReloadableClient) GetMapping(ctx context.Context, index string) (map[string]string, error) {
+	return c.getClient().GetMapping(ctx, index)
+}
+
+func (p *reloadableBulkProcessor) Stop() error {
+	p.client.Lock()
+	delete(p.client.bulkProcessors, p)
+	p.client.Unlock()
+
+	p.RLock()
+	defer p.RUnlock()
+	return p.processor.Stop()
+}
+
+func (p *reloadableBulkProcessor) Add(request *BulkableRequest) {
+	p.RLock()
+	defer p.RUnlock()
+	p.processor.Add(request)
+}
+
+func (p *reloadableBulkProcessor) Flush(ctx context.Context) error {
+	p.RLock()
+	defer p.RUnlock()
+	return p.processor.Flush(ctx)
+}
+
+// swap replaces the processor once requests being added to it are enqueued and returns the replaced processor
+func (p *reloadableBulkProcessor) swap(processor BulkProcessor) BulkProcessor {
+	p.Lock()
+	defer p.Unlock()
+	oldProcessor := p.processor
+	p.processor = processor
+	return oldProcessor
+}
+
+func stopClient(client Client) {
+	if stoppable, ok := client.(stoppableClient); ok {
+		stoppable.Stop()
+	}
+}
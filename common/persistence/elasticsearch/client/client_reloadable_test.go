@@ -0,0 +1,129 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/common/config"
+)
+
+type stoppableMockClient struct {
+	*MockClient
+	stopped bool
+}
+
+func (c *stoppableMockClient) Stop() {
+	c.stopped = true
+}
+
+func TestReloadableClient_Reload(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	oldClient := &stoppableMockClient{MockClient: NewMockClient(controller)}
+	newClient := &stoppableMockClient{MockClient: NewMockClient(controller)}
+	clients := map[string]Client{
+		"old-user": oldClient,
+		"new-user": newClient,
+	}
+	client, err := newReloadableClient(&config.Elasticsearch{Username: "old-user"}, func(cfg *config.Elasticsearch) (Client, error) {
+		if client, ok := clients[cfg.Username]; ok {
+			return client, nil
+		}
+		return nil, errors.New("invalid config")
+	})
+	require.NoError(t, err)
+
+	oldClient.EXPECT().Count(gomock.Any(), "index", "query").Return(int64(1), nil)
+	count, err := client.Count(context.Background(), "index", "query")
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+
+	require.Error(t, client.Reload(&config.Elasticsearch{Username: "unknown-user"}))
+	require.False(t, oldClient.stopped)
+
+	require.NoError(t, client.Reload(&config.Elasticsearch{Username: "new-user"}))
+	require.True(t, oldClient.stopped)
+
+	newClient.EXPECT().Count(gomock.Any(), "index", "query").Return(int64(2), nil)
+	count, err = client.Count(context.Background(), "index", "query")
+	require.NoError(t, err)
+	require.Equal(t, int64(2), count)
+}
+
+func TestReloadableClient_ReloadBulkProcessor(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	oldClient := &stoppableMockClient{MockClient: NewMockClient(controller)}
+	newClient := &stoppableMockClient{MockClient: NewMockClient(controller)}
+	failingClient := &stoppableMockClient{MockClient: NewMockClient(controller)}
+	clients := map[string]Client{
+		"old-user":     oldClient,
+		"new-user":     newClient,
+		"failing-user": failingClient,
+	}
+	client, err := newReloadableClient(&config.Elasticsearch{Username: "old-user"}, func(cfg *config.Elasticsearch) (Client, error) {
+		return clients[cfg.Username], nil
+	})
+	require.NoError(t, err)
+
+	params := &BulkProcessorParameters{Name: "processor"}
+	oldProcessor := NewMockBulkProcessor(controller)
+	oldClient.EXPECT().RunBulkProcessor(gomock.Any(), params).Return(oldProcessor, nil)
+	processor, err := client.RunBulkProcessor(context.Background(), params)
+	require.NoError(t, err)
+
+	request := &BulkableRequest{ID: "1"}
+	oldProcessor.EXPECT().Add(request)
+	processor.Add(request)
+
+	// the current client and processor are kept if the processor can't be recreated
+	failingClient.EXPECT().RunBulkProcessor(gomock.Any(), params).Return(nil, errors.New("processor not started"))
+	require.Error(t, client.Reload(&config.Elasticsearch{Username: "failing-user"}))
+	require.True(t, failingClient.stopped)
+	require.False(t, oldClient.stopped)
+
+	newProcessor := NewMockBulkProcessor(controller)
+	newClient.EXPECT().RunBulkProcessor(gomock.Any(), params).Return(newProcessor, nil)
+	oldProcessor.EXPECT().Stop().Return(nil)
+	require.NoError(t, client.Reload(&config.Elasticsearch{Username: "new-user"}))
+	require.True(t, oldClient.stopped)
+
+	newProcessor.EXPECT().Add(request)
+	processor.Add(request)
+
+	newProcessor.EXPECT().Stop().Return(nil)
+	require.NoError(t, processor.Stop())
+
+	// stopped processors are not recreated
+	require.NoError(t, client.Reload(&config.Elasticsearch{Username: "old-user"}))
+}
@@ -25,8 +25,13 @@
 package client
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/olivere/elastic/v7"
 	"github.com/stretchr/testify/assert"
@@ -94,3 +99,32 @@ func TestIsResponseRetryable(t *testing.T) {
 		require.True(t, IsRetryableStatus(code))
 	}
 }
+
+func TestClientV7_CanceledContext(t *testing.T) {
+	blockC := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-blockC:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(blockC)
+
+	esClient, err := elastic.NewClient(
+		elastic.SetURL(server.URL),
+		elastic.SetSniff(false),
+		elastic.SetHealthcheck(false),
+	)
+	require.NoError(t, err)
+	client := &clientV7{esClient: esClient}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	start := time.Now()
+	_, err = client.Count(ctx, "test-index", `{"query":{"match_all":{}}}`)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, context.Canceled))
+	require.Less(t, int64(time.Since(start)), int64(5*time.Second))
+}
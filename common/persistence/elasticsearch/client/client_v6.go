@@ -629,3 +629,8 @@ func buildMappingBodyV6(mapping map[string]enumspb.IndexedValueType) map[string]
 	}
 	return body
 }
+
+// Stop stops the background goroutines of the client, like sniffing and healthchecks
+func (c *clientV6) Stop() {
+	c.esClient.Stop()
+}
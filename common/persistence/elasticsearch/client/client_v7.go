@@ -342,3 +342,8 @@ func convertMappingBody(esMapping map[string]interface{}, indexName string) map[
 
 	return result
 }
+
+// Stop stops the background goroutines of the client, like sniffing and healthchecks
+func (c *clientV7) Stop() {
+	c.esClient.Stop()
+}
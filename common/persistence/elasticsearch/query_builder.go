@@ -0,0 +1,100 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package elasticsearch
+
+import (
+	"encoding/json"
+)
+
+type (
+	// queryClause is a typed Elasticsearch query clause. Values are never concatenated into the DSL,
+	// they are always JSON encoded, which takes care of quoting and escaping.
+	queryClause interface {
+		source() map[string]interface{}
+	}
+
+	matchPhraseQuery struct {
+		field string
+		value interface{}
+	}
+
+	boolQuery struct {
+		must []queryClause
+	}
+
+	// rawQuery wraps an already valid query DSL fragment, i.e. one produced by the SQL converter.
+	rawQuery struct {
+		name string
+		body json.RawMessage
+	}
+)
+
+func newMatchPhraseQuery(field string, value interface{}) *matchPhraseQuery {
+	return &matchPhraseQuery{field: field, value: value}
+}
+
+func (q *matchPhraseQuery) source() map[string]interface{} {
+	return map[string]interface{}{
+		"match_phrase": map[string]interface{}{
+			q.field: map[string]interface{}{"query": q.value},
+		},
+	}
+}
+
+func newBoolQuery() *boolQuery {
+	return &boolQuery{}
+}
+
+func (q *boolQuery) Must(queries ...queryClause) *boolQuery {
+	q.must = append(q.must, queries...)
+	return q
+}
+
+func (q *boolQuery) source() map[string]interface{} {
+	must := make([]interface{}, len(q.must))
+	for i, query := range q.must {
+		must[i] = query.source()
+	}
+	return map[string]interface{}{
+		"bool": map[string]interface{}{"must": must},
+	}
+}
+
+func newRawQuery(name string, body string) *rawQuery {
+	return &rawQuery{name: name, body: json.RawMessage(body)}
+}
+
+func (q *rawQuery) source() map[string]interface{} {
+	return map[string]interface{}{q.name: q.body}
+}
+
+// buildQueryDSL renders query clause to Elasticsearch query DSL.
+func buildQueryDSL(query queryClause) (string, error) {
+	dsl, err := json.Marshal(query.source())
+	if err != nil {
+		return "", err
+	}
+	return string(dsl), nil
+}
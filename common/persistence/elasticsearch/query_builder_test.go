@@ -0,0 +1,60 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package elasticsearch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fastjson"
+)
+
+func TestBuildQueryDSL_Bool(t *testing.T) {
+	query := newBoolQuery().
+		Must(newMatchPhraseQuery("NamespaceId", "nid")).
+		Must(newRawQuery("bool", `{"must":[{"match_all":{}}]}`))
+	dsl, err := buildQueryDSL(query)
+	require.NoError(t, err)
+	require.Equal(t, `{"bool":{"must":[{"match_phrase":{"NamespaceId":{"query":"nid"}}},{"bool":{"must":[{"match_all":{}}]}}]}}`, dsl)
+}
+
+func TestBuildQueryDSL_EscapesValues(t *testing.T) {
+	value := `wid"}},{"match_all":{}}]}},"x":"\` + "\n"
+	dsl, err := buildQueryDSL(newBoolQuery().Must(newMatchPhraseQuery("WorkflowId", value)))
+	require.NoError(t, err)
+	require.Equal(t, `{"bool":{"must":[{"match_phrase":{"WorkflowId":{"query":"wid\"}},{\"match_all\":{}}]}},\"x\":\"\\\n"}}}]}}`, dsl)
+
+	// value must round trip unchanged and must not introduce new clauses
+	parsed, err := fastjson.Parse(dsl)
+	require.NoError(t, err)
+	must := parsed.GetArray("bool", "must")
+	require.Len(t, must, 1)
+	require.Equal(t, value, string(must[0].GetStringBytes("match_phrase", "WorkflowId", "query")))
+}
+
+func TestBuildQueryDSL_InvalidRawQuery(t *testing.T) {
+	_, err := buildQueryDSL(newBoolQuery().Must(newRawQuery("bool", `{"must":`)))
+	require.Error(t, err)
+}
@@ -25,6 +25,8 @@
 package elasticsearch
 
 import (
+	"context"
+
 	"go.temporal.io/api/serviceerror"
 
 	"go.temporal.io/server/common/log"
@@ -96,11 +98,11 @@ func (m *visibilityManagerMetrics) UpsertWorkflowExecution(request *persistence.
 	return err
 }
 
-func (m *visibilityManagerMetrics) ListOpenWorkflowExecutions(request *persistence.ListWorkflowExecutionsRequest) (*persistence.ListWorkflowExecutionsResponse, error) {
+func (m *visibilityManagerMetrics) ListOpenWorkflowExecutions(ctx context.Context, request *persistence.ListWorkflowExecutionsRequest) (*persistence.ListWorkflowExecutionsResponse, error) {
 	m.metricClient.IncCounter(metrics.ElasticsearchListOpenWorkflowExecutionsScope, metrics.ElasticsearchRequests)
 
 	sw := m.metricClient.StartTimer(metrics.ElasticsearchListOpenWorkflowExecutionsScope, metrics.ElasticsearchLatency)
-	response, err := m.persistence.ListOpenWorkflowExecutions(request)
+	response, err := m.persistence.ListOpenWorkflowExecutions(ctx, request)
 	sw.Stop()
 
 	if err != nil {
@@ -110,11 +112,11 @@ func (m *visibilityManagerMetrics) ListOpenWorkflowExecutions(request *persisten
 	return response, err
 }
 
-func (m *visibilityManagerMetrics) ListClosedWorkflowExecutions(request *persistence.ListWorkflowExecutionsRequest) (*persistence.ListWorkflowExecutionsResponse, error) {
+func (m *visibilityManagerMetrics) ListClosedWorkflowExecutions(ctx context.Context, request *persistence.ListWorkflowExecutionsRequest) (*persistence.ListWorkflowExecutionsResponse, error) {
 	m.metricClient.IncCounter(metrics.ElasticsearchListClosedWorkflowExecutionsScope, metrics.ElasticsearchRequests)
 
 	sw := m.metricClient.StartTimer(metrics.ElasticsearchListClosedWorkflowExecutionsScope, metrics.ElasticsearchLatency)
-	response, err := m.persistence.ListClosedWorkflowExecutions(request)
+	response, err := m.persistence.ListClosedWorkflowExecutions(ctx, request)
 	sw.Stop()
 
 	if err != nil {
@@ -124,11 +126,11 @@ func (m *visibilityManagerMetrics) ListClosedWorkflowExecutions(request *persist
 	return response, err
 }
 
-func (m *visibilityManagerMetrics) ListOpenWorkflowExecutionsByType(request *persistence.ListWorkflowExecutionsByTypeRequest) (*persistence.ListWorkflowExecutionsResponse, error) {
+func (m *visibilityManagerMetrics) ListOpenWorkflowExecutionsByType(ctx context.Context, request *persistence.ListWorkflowExecutionsByTypeRequest) (*persistence.ListWorkflowExecutionsResponse, error) {
 	m.metricClient.IncCounter(metrics.ElasticsearchListOpenWorkflowExecutionsByTypeScope, metrics.ElasticsearchRequests)
 
 	sw := m.metricClient.StartTimer(metrics.ElasticsearchListOpenWorkflowExecutionsByTypeScope, metrics.ElasticsearchLatency)
-	response, err := m.persistence.ListOpenWorkflowExecutionsByType(request)
+	response, err := m.persistence.ListOpenWorkflowExecutionsByType(ctx, request)
 	sw.Stop()
 
 	if err != nil {
@@ -138,11 +140,11 @@ func (m *visibilityManagerMetrics) ListOpenWorkflowExecutionsByType(request *per
 	return response, err
 }
 
-func (m *visibilityManagerMetrics) ListClosedWorkflowExecutionsByType(request *persistence.ListWorkflowExecutionsByTypeRequest) (*persistence.ListWorkflowExecutionsResponse, error) {
+func (m *visibilityManagerMetrics) ListClosedWorkflowExecutionsByType(ctx context.Context, request *persistence.ListWorkflowExecutionsByTypeRequest) (*persistence.ListWorkflowExecutionsResponse, error) {
 	m.metricClient.IncCounter(metrics.ElasticsearchListClosedWorkflowExecutionsByTypeScope, metrics.ElasticsearchRequests)
 
 	sw := m.metricClient.StartTimer(metrics.ElasticsearchListClosedWorkflowExecutionsByTypeScope, metrics.ElasticsearchLatency)
-	response, err := m.persistence.ListClosedWorkflowExecutionsByType(request)
+	response, err := m.persistence.ListClosedWorkflowExecutionsByType(ctx, request)
 	sw.Stop()
 
 	if err != nil {
@@ -152,11 +154,11 @@ func (m *visibilityManagerMetrics) ListClosedWorkflowExecutionsByType(request *p
 	return response, err
 }
 
-func (m *visibilityManagerMetrics) ListOpenWorkflowExecutionsByWorkflowID(request *persistence.ListWorkflowExecutionsByWorkflowIDRequest) (*persistence.ListWorkflowExecutionsResponse, error) {
+func (m *visibilityManagerMetrics) ListOpenWorkflowExecutionsByWorkflowID(ctx context.Context, request *persistence.ListWorkflowExecutionsByWorkflowIDRequest) (*persistence.ListWorkflowExecutionsResponse, error) {
 	m.metricClient.IncCounter(metrics.ElasticsearchListOpenWorkflowExecutionsByWorkflowIDScope, metrics.ElasticsearchRequests)
 
 	sw := m.metricClient.StartTimer(metrics.ElasticsearchListOpenWorkflowExecutionsByWorkflowIDScope, metrics.ElasticsearchLatency)
-	response, err := m.persistence.ListOpenWorkflowExecutionsByWorkflowID(request)
+	response, err := m.persistence.ListOpenWorkflowExecutionsByWorkflowID(ctx, request)
 	sw.Stop()
 
 	if err != nil {
@@ -166,11 +168,11 @@ func (m *visibilityManagerMetrics) ListOpenWorkflowExecutionsByWorkflowID(reques
 	return response, err
 }
 
-func (m *visibilityManagerMetrics) ListClosedWorkflowExecutionsByWorkflowID(request *persistence.ListWorkflowExecutionsByWorkflowIDRequest) (*persistence.ListWorkflowExecutionsResponse, error) {
+func (m *visibilityManagerMetrics) ListClosedWorkflowExecutionsByWorkflowID(ctx context.Context, request *persistence.ListWorkflowExecutionsByWorkflowIDRequest) (*persistence.ListWorkflowExecutionsResponse, error) {
 	m.metricClient.IncCounter(metrics.ElasticsearchListClosedWorkflowExecutionsByWorkflowIDScope, metrics.ElasticsearchRequests)
 
 	sw := m.metricClient.StartTimer(metrics.ElasticsearchListClosedWorkflowExecutionsByWorkflowIDScope, metrics.ElasticsearchLatency)
-	response, err := m.persistence.ListClosedWorkflowExecutionsByWorkflowID(request)
+	response, err := m.persistence.ListClosedWorkflowExecutionsByWorkflowID(ctx, request)
 	sw.Stop()
 
 	if err != nil {
@@ -180,11 +182,11 @@ func (m *visibilityManagerMetrics) ListClosedWorkflowExecutionsByWorkflowID(requ
 	return response, err
 }
 
-func (m *visibilityManagerMetrics) ListClosedWorkflowExecutionsByStatus(request *persistence.ListClosedWorkflowExecutionsByStatusRequest) (*persistence.ListWorkflowExecutionsResponse, error) {
+func (m *visibilityManagerMetrics) ListClosedWorkflowExecutionsByStatus(ctx context.Context, request *persistence.ListClosedWorkflowExecutionsByStatusRequest) (*persistence.ListWorkflowExecutionsResponse, error) {
 	m.metricClient.IncCounter(metrics.ElasticsearchListClosedWorkflowExecutionsByStatusScope, metrics.ElasticsearchRequests)
 
 	sw := m.metricClient.StartTimer(metrics.ElasticsearchListClosedWorkflowExecutionsByStatusScope, metrics.ElasticsearchLatency)
-	response, err := m.persistence.ListClosedWorkflowExecutionsByStatus(request)
+	response, err := m.persistence.ListClosedWorkflowExecutionsByStatus(ctx, request)
 	sw.Stop()
 
 	if err != nil {
@@ -194,11 +196,11 @@ func (m *visibilityManagerMetrics) ListClosedWorkflowExecutionsByStatus(request
 	return response, err
 }
 
-func (m *visibilityManagerMetrics) GetClosedWorkflowExecution(request *persistence.GetClosedWorkflowExecutionRequest) (*persistence.GetClosedWorkflowExecutionResponse, error) {
+func (m *visibilityManagerMetrics) GetClosedWorkflowExecution(ctx context.Context, request *persistence.GetClosedWorkflowExecutionRequest) (*persistence.GetClosedWorkflowExecutionResponse, error) {
 	m.metricClient.IncCounter(metrics.ElasticsearchGetClosedWorkflowExecutionScope, metrics.ElasticsearchRequests)
 
 	sw := m.metricClient.StartTimer(metrics.ElasticsearchGetClosedWorkflowExecutionScope, metrics.ElasticsearchLatency)
-	response, err := m.persistence.GetClosedWorkflowExecution(request)
+	response, err := m.persistence.GetClosedWorkflowExecution(ctx, request)
 	sw.Stop()
 
 	if err != nil {
@@ -208,11 +210,11 @@ func (m *visibilityManagerMetrics) GetClosedWorkflowExecution(request *persisten
 	return response, err
 }
 
-func (m *visibilityManagerMetrics) ListWorkflowExecutions(request *persistence.ListWorkflowExecutionsRequestV2) (*persistence.ListWorkflowExecutionsResponse, error) {
+func (m *visibilityManagerMetrics) ListWorkflowExecutions(ctx context.Context, request *persistence.ListWorkflowExecutionsRequestV2) (*persistence.ListWorkflowExecutionsResponse, error) {
 	m.metricClient.IncCounter(metrics.ElasticsearchListWorkflowExecutionsScope, metrics.ElasticsearchRequests)
 
 	sw := m.metricClient.StartTimer(metrics.ElasticsearchListWorkflowExecutionsScope, metrics.ElasticsearchLatency)
-	response, err := m.persistence.ListWorkflowExecutions(request)
+	response, err := m.persistence.ListWorkflowExecutions(ctx, request)
 	sw.Stop()
 
 	if err != nil {
@@ -222,11 +224,11 @@ func (m *visibilityManagerMetrics) ListWorkflowExecutions(request *persistence.L
 	return response, err
 }
 
-func (m *visibilityManagerMetrics) ScanWorkflowExecutions(request *persistence.ListWorkflowExecutionsRequestV2) (*persistence.ListWorkflowExecutionsResponse, error) {
+func (m *visibilityManagerMetrics) ScanWorkflowExecutions(ctx context.Context, request *persistence.ListWorkflowExecutionsRequestV2) (*persistence.ListWorkflowExecutionsResponse, error) {
 	m.metricClient.IncCounter(metrics.ElasticsearchScanWorkflowExecutionsScope, metrics.ElasticsearchRequests)
 
 	sw := m.metricClient.StartTimer(metrics.ElasticsearchScanWorkflowExecutionsScope, metrics.ElasticsearchLatency)
-	response, err := m.persistence.ScanWorkflowExecutions(request)
+	response, err := m.persistence.ScanWorkflowExecutions(ctx, request)
 	sw.Stop()
 
 	if err != nil {
@@ -236,11 +238,11 @@ func (m *visibilityManagerMetrics) ScanWorkflowExecutions(request *persistence.L
 	return response, err
 }
 
-func (m *visibilityManagerMetrics) CountWorkflowExecutions(request *persistence.CountWorkflowExecutionsRequest) (*persistence.CountWorkflowExecutionsResponse, error) {
+func (m *visibilityManagerMetrics) CountWorkflowExecutions(ctx context.Context, request *persistence.CountWorkflowExecutionsRequest) (*persistence.CountWorkflowExecutionsResponse, error) {
 	m.metricClient.IncCounter(metrics.ElasticsearchCountWorkflowExecutionsScope, metrics.ElasticsearchRequests)
 
 	sw := m.metricClient.StartTimer(metrics.ElasticsearchCountWorkflowExecutionsScope, metrics.ElasticsearchLatency)
-	response, err := m.persistence.CountWorkflowExecutions(request)
+	response, err := m.persistence.CountWorkflowExecutions(ctx, request)
 	sw.Stop()
 
 	if err != nil {
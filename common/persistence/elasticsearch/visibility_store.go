@@ -204,6 +204,7 @@ func (s *visibilityStore) addBulkRequestAndWait(bulkRequest *client.BulkableRequ
 }
 
 func (s *visibilityStore) ListOpenWorkflowExecutions(
+	ctx context.Context,
 	request *persistence.ListWorkflowExecutionsRequest) (*persistence.InternalListWorkflowExecutionsResponse, error) {
 	token, err := s.getNextPageToken(request.NextPageToken)
 	if err != nil {
@@ -211,7 +212,7 @@ func (s *visibilityStore) ListOpenWorkflowExecutions(
 	}
 
 	query := elastic.NewBoolQuery().Must(elastic.NewMatchQuery(searchattribute.ExecutionStatus, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING.String()))
-	searchResult, err := s.getSearchResult(request, token, query, true)
+	searchResult, err := s.getSearchResult(ctx, request, token, query, true)
 	if err != nil {
 		return nil, serviceerror.NewInternal(fmt.Sprintf("ListOpenWorkflowExecutions failed. Error: %v", err))
 	}
@@ -224,6 +225,7 @@ func (s *visibilityStore) ListOpenWorkflowExecutions(
 }
 
 func (s *visibilityStore) ListClosedWorkflowExecutions(
+	ctx context.Context,
 	request *persistence.ListWorkflowExecutionsRequest) (*persistence.InternalListWorkflowExecutionsResponse, error) {
 
 	token, err := s.getNextPageToken(request.NextPageToken)
@@ -232,7 +234,7 @@ func (s *visibilityStore) ListClosedWorkflowExecutions(
 	}
 
 	executionStatusQuery := elastic.NewBoolQuery().MustNot(elastic.NewMatchQuery(searchattribute.ExecutionStatus, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING.String()))
-	searchResult, err := s.getSearchResult(request, token, executionStatusQuery, false)
+	searchResult, err := s.getSearchResult(ctx, request, token, executionStatusQuery, false)
 	if err != nil {
 		return nil, serviceerror.NewInternal(fmt.Sprintf("ListClosedWorkflowExecutions failed. Error: %v", err))
 	}
@@ -245,6 +247,7 @@ func (s *visibilityStore) ListClosedWorkflowExecutions(
 }
 
 func (s *visibilityStore) ListOpenWorkflowExecutionsByType(
+	ctx context.Context,
 	request *persistence.ListWorkflowExecutionsByTypeRequest) (*persistence.InternalListWorkflowExecutionsResponse, error) {
 
 	token, err := s.getNextPageToken(request.NextPageToken)
@@ -254,7 +257,7 @@ func (s *visibilityStore) ListOpenWorkflowExecutionsByType(
 
 	query := elastic.NewBoolQuery().Must(elastic.NewMatchQuery(searchattribute.WorkflowType, request.WorkflowTypeName)).
 		Must(elastic.NewMatchQuery(searchattribute.ExecutionStatus, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING.String()))
-	searchResult, err := s.getSearchResult(&request.ListWorkflowExecutionsRequest, token, query, true)
+	searchResult, err := s.getSearchResult(ctx, &request.ListWorkflowExecutionsRequest, token, query, true)
 	if err != nil {
 		return nil, serviceerror.NewInternal(fmt.Sprintf("ListOpenWorkflowExecutionsByType failed. Error: %v", err))
 	}
@@ -267,6 +270,7 @@ func (s *visibilityStore) ListOpenWorkflowExecutionsByType(
 }
 
 func (s *visibilityStore) ListClosedWorkflowExecutionsByType(
+	ctx context.Context,
 	request *persistence.ListWorkflowExecutionsByTypeRequest) (*persistence.InternalListWorkflowExecutionsResponse, error) {
 
 	token, err := s.getNextPageToken(request.NextPageToken)
@@ -276,7 +280,7 @@ func (s *visibilityStore) ListClosedWorkflowExecutionsByType(
 
 	query := elastic.NewBoolQuery().Must(elastic.NewMatchQuery(searchattribute.WorkflowType, request.WorkflowTypeName)).
 		MustNot(elastic.NewMatchQuery(searchattribute.ExecutionStatus, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING.String()))
-	searchResult, err := s.getSearchResult(&request.ListWorkflowExecutionsRequest, token, query, false)
+	searchResult, err := s.getSearchResult(ctx, &request.ListWorkflowExecutionsRequest, token, query, false)
 	if err != nil {
 		return nil, serviceerror.NewInternal(fmt.Sprintf("ListClosedWorkflowExecutionsByType failed. Error: %v", err))
 	}
@@ -289,6 +293,7 @@ func (s *visibilityStore) ListClosedWorkflowExecutionsByType(
 }
 
 func (s *visibilityStore) ListOpenWorkflowExecutionsByWorkflowID(
+	ctx context.Context,
 	request *persistence.ListWorkflowExecutionsByWorkflowIDRequest) (*persistence.InternalListWorkflowExecutionsResponse, error) {
 
 	token, err := s.getNextPageToken(request.NextPageToken)
@@ -298,7 +303,7 @@ func (s *visibilityStore) ListOpenWorkflowExecutionsByWorkflowID(
 
 	query := elastic.NewBoolQuery().Must(elastic.NewMatchQuery(searchattribute.WorkflowID, request.WorkflowID)).
 		Must(elastic.NewMatchQuery(searchattribute.ExecutionStatus, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING.String()))
-	searchResult, err := s.getSearchResult(&request.ListWorkflowExecutionsRequest, token, query, true)
+	searchResult, err := s.getSearchResult(ctx, &request.ListWorkflowExecutionsRequest, token, query, true)
 	if err != nil {
 		return nil, serviceerror.NewInternal(fmt.Sprintf("ListOpenWorkflowExecutionsByWorkflowID failed. Error: %v", err))
 	}
@@ -311,6 +316,7 @@ func (s *visibilityStore) ListOpenWorkflowExecutionsByWorkflowID(
 }
 
 func (s *visibilityStore) ListClosedWorkflowExecutionsByWorkflowID(
+	ctx context.Context,
 	request *persistence.ListWorkflowExecutionsByWorkflowIDRequest) (*persistence.InternalListWorkflowExecutionsResponse, error) {
 
 	token, err := s.getNextPageToken(request.NextPageToken)
@@ -320,7 +326,7 @@ func (s *visibilityStore) ListClosedWorkflowExecutionsByWorkflowID(
 
 	query := elastic.NewBoolQuery().Must(elastic.NewMatchQuery(searchattribute.WorkflowID, request.WorkflowID)).
 		MustNot(elastic.NewMatchQuery(searchattribute.ExecutionStatus, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING.String()))
-	searchResult, err := s.getSearchResult(&request.ListWorkflowExecutionsRequest, token, query, false)
+	searchResult, err := s.getSearchResult(ctx, &request.ListWorkflowExecutionsRequest, token, query, false)
 	if err != nil {
 		return nil, serviceerror.NewInternal(fmt.Sprintf("ListClosedWorkflowExecutionsByWorkflowID failed. Error: %v", err))
 	}
@@ -333,6 +339,7 @@ func (s *visibilityStore) ListClosedWorkflowExecutionsByWorkflowID(
 }
 
 func (s *visibilityStore) ListClosedWorkflowExecutionsByStatus(
+	ctx context.Context,
 	request *persistence.ListClosedWorkflowExecutionsByStatusRequest) (*persistence.InternalListWorkflowExecutionsResponse, error) {
 
 	token, err := s.getNextPageToken(request.NextPageToken)
@@ -341,7 +348,7 @@ func (s *visibilityStore) ListClosedWorkflowExecutionsByStatus(
 	}
 
 	query := elastic.NewBoolQuery().Must(elastic.NewMatchQuery(searchattribute.ExecutionStatus, request.Status.String()))
-	searchResult, err := s.getSearchResult(&request.ListWorkflowExecutionsRequest, token, query, false)
+	searchResult, err := s.getSearchResult(ctx, &request.ListWorkflowExecutionsRequest, token, query, false)
 	if err != nil {
 		return nil, serviceerror.NewInternal(fmt.Sprintf("ListClosedWorkflowExecutionsByStatus failed. Error: %v", err))
 	}
@@ -354,6 +361,7 @@ func (s *visibilityStore) ListClosedWorkflowExecutionsByStatus(
 }
 
 func (s *visibilityStore) GetClosedWorkflowExecution(
+	ctx context.Context,
 	request *persistence.GetClosedWorkflowExecutionRequest) (*persistence.InternalGetClosedWorkflowExecutionResponse, error) {
 
 	matchNamespaceQuery := elastic.NewMatchQuery(searchattribute.NamespaceID, request.NamespaceID)
@@ -366,7 +374,8 @@ func (s *visibilityStore) GetClosedWorkflowExecution(
 		boolQuery = boolQuery.Must(matchRunIDQuery)
 	}
 
-	ctx := context.Background()
+	ctx, cancel := s.newRequestContext(ctx)
+	defer cancel()
 	params := &client.SearchParameters{
 		Index: s.index,
 		Query: boolQuery,
@@ -392,6 +401,7 @@ func (s *visibilityStore) GetClosedWorkflowExecution(
 }
 
 func (s *visibilityStore) ListWorkflowExecutions(
+	ctx context.Context,
 	request *persistence.ListWorkflowExecutionsRequestV2) (*persistence.InternalListWorkflowExecutionsResponse, error) {
 
 	checkPageSize(request)
@@ -406,7 +416,8 @@ func (s *visibilityStore) ListWorkflowExecutions(
 		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Error when parse query: %v", err))
 	}
 
-	ctx := context.Background()
+	ctx, cancel := s.newRequestContext(ctx)
+	defer cancel()
 	searchResult, err := s.esClient.SearchWithDSL(ctx, s.index, queryDSL)
 	if err != nil {
 		return nil, serviceerror.NewInternal(fmt.Sprintf("ListWorkflowExecutions failed. Error: %v", err))
@@ -416,6 +427,7 @@ func (s *visibilityStore) ListWorkflowExecutions(
 }
 
 func (s *visibilityStore) ScanWorkflowExecutions(
+	ctx context.Context,
 	request *persistence.ListWorkflowExecutionsRequestV2) (*persistence.InternalListWorkflowExecutionsResponse, error) {
 
 	checkPageSize(request)
@@ -425,7 +437,8 @@ func (s *visibilityStore) ScanWorkflowExecutions(
 		return nil, err
 	}
 
-	ctx := context.Background()
+	ctx, cancel := s.newRequestContext(ctx)
+	defer cancel()
 	var searchResult *elastic.SearchResult
 	var scrollService client.ScrollService
 	if len(token.ScrollID) == 0 { // first call
@@ -442,7 +455,7 @@ func (s *visibilityStore) ScanWorkflowExecutions(
 	isLastPage := false
 	if err == io.EOF { // no more result
 		isLastPage = true
-		_ = scrollService.Clear(context.Background())
+		_ = scrollService.Clear(ctx)
 	} else if err != nil {
 		return nil, serviceerror.NewInternal(fmt.Sprintf("ScanWorkflowExecutions failed. Error: %v", err))
 	}
@@ -450,7 +463,7 @@ func (s *visibilityStore) ScanWorkflowExecutions(
 	return s.getScanWorkflowExecutionsResponse(searchResult.Hits, token, request.PageSize, searchResult.ScrollId, isLastPage)
 }
 
-func (s *visibilityStore) CountWorkflowExecutions(request *persistence.CountWorkflowExecutionsRequest) (
+func (s *visibilityStore) CountWorkflowExecutions(ctx context.Context, request *persistence.CountWorkflowExecutionsRequest) (
 	*persistence.CountWorkflowExecutionsResponse, error) {
 
 	queryDSL, err := getESQueryDSLForCount(request)
@@ -458,7 +471,8 @@ func (s *visibilityStore) CountWorkflowExecutions(request *persistence.CountWork
 		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Error when parse query: %v", err))
 	}
 
-	ctx := context.Background()
+	ctx, cancel := s.newRequestContext(ctx)
+	defer cancel()
 	count, err := s.esClient.Count(ctx, s.index, queryDSL)
 	if err != nil {
 		return nil, serviceerror.NewInternal(fmt.Sprintf("CountWorkflowExecutions failed. Error: %v", err))
@@ -592,7 +606,9 @@ func getCustomizedDSLFromSQL(sql string, namespaceID string) (*fastjson.Value, e
 	if strings.Contains(dslStr, jsonMissingCloseTime) { // isOpen
 		dsl = replaceQueryForOpen(dsl)
 	}
-	addNamespaceToQuery(dsl, namespaceID)
+	if err := addNamespaceToQuery(dsl, namespaceID); err != nil {
+		return nil, err
+	}
 	if err := processAllValuesForKey(dsl, timeKeyFilter, timeProcessFunc); err != nil {
 		return nil, err
 	}
@@ -615,22 +631,28 @@ func replaceQueryForOpen(dsl *fastjson.Value) *fastjson.Value {
 	return dsl
 }
 
-func addNamespaceToQuery(dsl *fastjson.Value, namespaceID string) {
+func addNamespaceToQuery(dsl *fastjson.Value, namespaceID string) error {
 	if len(namespaceID) == 0 {
-		return
+		return nil
 	}
 
-	namespaceQueryString := fmt.Sprintf(`{"match_phrase":{"NamespaceId":{"query":"%s"}}}`, namespaceID)
-	addMustQuery(dsl, namespaceQueryString)
+	return addMustQuery(dsl, newMatchPhraseQuery(searchattribute.NamespaceID, namespaceID))
 }
 
 // addMustQuery is wrapping bool query with new bool query with must,
 // reason not making a flat bool query is to ensure "should (or)" query works correctly in query context.
-func addMustQuery(dsl *fastjson.Value, queryString string) {
-	valOfTopQuery := dsl.Get("query")
+func addMustQuery(dsl *fastjson.Value, query queryClause) error {
 	valOfBool := dsl.Get("query", "bool")
-	newValOfBool := fmt.Sprintf(`{"must":[%s,{"bool":%s}]}`, queryString, valOfBool.String())
-	valOfTopQuery.Set("bool", fastjson.MustParse(newValOfBool))
+	newQueryStr, err := buildQueryDSL(newBoolQuery().Must(query, newRawQuery("bool", valOfBool.String())))
+	if err != nil {
+		return fmt.Errorf("unable to build query DSL: %v", err)
+	}
+	newQuery, err := fastjson.Parse(newQueryStr)
+	if err != nil {
+		return err
+	}
+	dsl.Set("query", newQuery)
+	return nil
 }
 
 func (s *visibilityStore) processSortField(dsl *fastjson.Value) (string, error) {
@@ -724,6 +746,18 @@ func (s *visibilityStore) checkProcessor() {
 	}
 }
 
+// newRequestContext returns context for a single ES call derived from ctx and bounded by ESVisibilityRequestTimeout if configured.
+func (s *visibilityStore) newRequestContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if s.config.ESVisibilityRequestTimeout == nil {
+		return context.WithCancel(ctx)
+	}
+	timeout := s.config.ESVisibilityRequestTimeout()
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
 func (s *visibilityStore) getNextPageToken(token []byte) (*visibilityPageToken, error) {
 	var result *visibilityPageToken
 	var err error
@@ -738,7 +772,7 @@ func (s *visibilityStore) getNextPageToken(token []byte) (*visibilityPageToken,
 	return result, nil
 }
 
-func (s *visibilityStore) getSearchResult(request *persistence.ListWorkflowExecutionsRequest, token *visibilityPageToken,
+func (s *visibilityStore) getSearchResult(ctx context.Context, request *persistence.ListWorkflowExecutionsRequest, token *visibilityPageToken,
 	boolQuery *elastic.BoolQuery, overStartTime bool) (*elastic.SearchResult, error) {
 
 	query := elastic.NewBoolQuery()
@@ -767,7 +801,8 @@ func (s *visibilityStore) getSearchResult(request *persistence.ListWorkflowExecu
 		query = query.Filter(rangeQuery)
 	}
 
-	ctx := context.Background()
+	ctx, cancel := s.newRequestContext(ctx)
+	defer cancel()
 	params := &client.SearchParameters{
 		Index:    s.index,
 		Query:    query,
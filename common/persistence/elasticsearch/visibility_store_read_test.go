@@ -131,11 +131,11 @@ func (s *ESVisibilitySuite) TestListOpenWorkflowExecutions() {
 			s.True(strings.Contains(fmt.Sprintf("%v", source), filterOpen))
 			return testSearchResult, nil
 		})
-	_, err := s.visibilityStore.ListOpenWorkflowExecutions(createTestRequest())
+	_, err := s.visibilityStore.ListOpenWorkflowExecutions(context.Background(), createTestRequest())
 	s.NoError(err)
 
 	s.mockESClient.EXPECT().Search(gomock.Any(), gomock.Any()).Return(nil, errTestESSearch)
-	_, err = s.visibilityStore.ListOpenWorkflowExecutions(createTestRequest())
+	_, err = s.visibilityStore.ListOpenWorkflowExecutions(context.Background(), createTestRequest())
 	s.Error(err)
 	_, ok := err.(*serviceerror.Internal)
 	s.True(ok)
@@ -149,11 +149,11 @@ func (s *ESVisibilitySuite) TestListClosedWorkflowExecutions() {
 			s.True(strings.Contains(fmt.Sprintf("%v", source), filterClose))
 			return testSearchResult, nil
 		})
-	_, err := s.visibilityStore.ListClosedWorkflowExecutions(createTestRequest())
+	_, err := s.visibilityStore.ListClosedWorkflowExecutions(context.Background(), createTestRequest())
 	s.NoError(err)
 
 	s.mockESClient.EXPECT().Search(gomock.Any(), gomock.Any()).Return(nil, errTestESSearch)
-	_, err = s.visibilityStore.ListClosedWorkflowExecutions(createTestRequest())
+	_, err = s.visibilityStore.ListClosedWorkflowExecutions(context.Background(), createTestRequest())
 	s.Error(err)
 	_, ok := err.(*serviceerror.Internal)
 	s.True(ok)
@@ -174,11 +174,11 @@ func (s *ESVisibilitySuite) TestListOpenWorkflowExecutionsByType() {
 		ListWorkflowExecutionsRequest: *testRequest,
 		WorkflowTypeName:              testWorkflowType,
 	}
-	_, err := s.visibilityStore.ListOpenWorkflowExecutionsByType(request)
+	_, err := s.visibilityStore.ListOpenWorkflowExecutionsByType(context.Background(), request)
 	s.NoError(err)
 
 	s.mockESClient.EXPECT().Search(gomock.Any(), gomock.Any()).Return(nil, errTestESSearch)
-	_, err = s.visibilityStore.ListOpenWorkflowExecutionsByType(request)
+	_, err = s.visibilityStore.ListOpenWorkflowExecutionsByType(context.Background(), request)
 	s.Error(err)
 	_, ok := err.(*serviceerror.Internal)
 	s.True(ok)
@@ -199,11 +199,11 @@ func (s *ESVisibilitySuite) TestListClosedWorkflowExecutionsByType() {
 		ListWorkflowExecutionsRequest: *testRequest,
 		WorkflowTypeName:              testWorkflowType,
 	}
-	_, err := s.visibilityStore.ListClosedWorkflowExecutionsByType(request)
+	_, err := s.visibilityStore.ListClosedWorkflowExecutionsByType(context.Background(), request)
 	s.NoError(err)
 
 	s.mockESClient.EXPECT().Search(gomock.Any(), gomock.Any()).Return(nil, errTestESSearch)
-	_, err = s.visibilityStore.ListClosedWorkflowExecutionsByType(request)
+	_, err = s.visibilityStore.ListClosedWorkflowExecutionsByType(context.Background(), request)
 	s.Error(err)
 	_, ok := err.(*serviceerror.Internal)
 	s.True(ok)
@@ -224,11 +224,11 @@ func (s *ESVisibilitySuite) TestListOpenWorkflowExecutionsByWorkflowID() {
 		ListWorkflowExecutionsRequest: *testRequest,
 		WorkflowID:                    testWorkflowID,
 	}
-	_, err := s.visibilityStore.ListOpenWorkflowExecutionsByWorkflowID(request)
+	_, err := s.visibilityStore.ListOpenWorkflowExecutionsByWorkflowID(context.Background(), request)
 	s.NoError(err)
 
 	s.mockESClient.EXPECT().Search(gomock.Any(), gomock.Any()).Return(nil, errTestESSearch)
-	_, err = s.visibilityStore.ListOpenWorkflowExecutionsByWorkflowID(request)
+	_, err = s.visibilityStore.ListOpenWorkflowExecutionsByWorkflowID(context.Background(), request)
 	s.Error(err)
 	_, ok := err.(*serviceerror.Internal)
 	s.True(ok)
@@ -249,11 +249,11 @@ func (s *ESVisibilitySuite) TestListClosedWorkflowExecutionsByWorkflowID() {
 		ListWorkflowExecutionsRequest: *testRequest,
 		WorkflowID:                    testWorkflowID,
 	}
-	_, err := s.visibilityStore.ListClosedWorkflowExecutionsByWorkflowID(request)
+	_, err := s.visibilityStore.ListClosedWorkflowExecutionsByWorkflowID(context.Background(), request)
 	s.NoError(err)
 
 	s.mockESClient.EXPECT().Search(gomock.Any(), gomock.Any()).Return(nil, errTestESSearch)
-	_, err = s.visibilityStore.ListClosedWorkflowExecutionsByWorkflowID(request)
+	_, err = s.visibilityStore.ListClosedWorkflowExecutionsByWorkflowID(context.Background(), request)
 	s.Error(err)
 	_, ok := err.(*serviceerror.Internal)
 	s.True(ok)
@@ -273,11 +273,11 @@ func (s *ESVisibilitySuite) TestListClosedWorkflowExecutionsByStatus() {
 		ListWorkflowExecutionsRequest: *testRequest,
 		Status:                        testStatus,
 	}
-	_, err := s.visibilityStore.ListClosedWorkflowExecutionsByStatus(request)
+	_, err := s.visibilityStore.ListClosedWorkflowExecutionsByStatus(context.Background(), request)
 	s.NoError(err)
 
 	s.mockESClient.EXPECT().Search(gomock.Any(), gomock.Any()).Return(nil, errTestESSearch)
-	_, err = s.visibilityStore.ListClosedWorkflowExecutionsByStatus(request)
+	_, err = s.visibilityStore.ListClosedWorkflowExecutionsByStatus(context.Background(), request)
 	s.Error(err)
 	_, ok := err.(*serviceerror.Internal)
 	s.True(ok)
@@ -300,11 +300,11 @@ func (s *ESVisibilitySuite) TestGetClosedWorkflowExecution() {
 			RunId:      testRunID,
 		},
 	}
-	_, err := s.visibilityStore.GetClosedWorkflowExecution(request)
+	_, err := s.visibilityStore.GetClosedWorkflowExecution(context.Background(), request)
 	s.NoError(err)
 
 	s.mockESClient.EXPECT().Search(gomock.Any(), gomock.Any()).Return(nil, errTestESSearch)
-	_, err = s.visibilityStore.GetClosedWorkflowExecution(request)
+	_, err = s.visibilityStore.GetClosedWorkflowExecution(context.Background(), request)
 	s.Error(err)
 	_, ok := err.(*serviceerror.Internal)
 	s.True(ok)
@@ -326,7 +326,7 @@ func (s *ESVisibilitySuite) TestGetClosedWorkflowExecution_NoRunID() {
 			WorkflowId: testWorkflowID,
 		},
 	}
-	_, err := s.visibilityStore.GetClosedWorkflowExecution(request)
+	_, err := s.visibilityStore.GetClosedWorkflowExecution(context.Background(), request)
 	s.NoError(err)
 }
 
@@ -368,7 +368,7 @@ func (s *ESVisibilitySuite) TestGetSearchResult() {
 		Sorter:   []elastic.Sorter{elastic.NewFieldSort(searchattribute.StartTime).Desc(), tieBreakerSorter},
 	}
 	s.mockESClient.EXPECT().Search(gomock.Any(), params).Return(nil, nil)
-	_, err := s.visibilityStore.getSearchResult(request, token, elastic.NewBoolQuery().Must(elastic.NewMatchQuery(searchattribute.ExecutionStatus, int(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING))), true)
+	_, err := s.visibilityStore.getSearchResult(context.Background(), request, token, elastic.NewBoolQuery().Must(elastic.NewMatchQuery(searchattribute.ExecutionStatus, int(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING))), true)
 	s.NoError(err)
 
 	// test request latestTime overflow
@@ -383,7 +383,7 @@ func (s *ESVisibilitySuite) TestGetSearchResult() {
 		Sorter:   []elastic.Sorter{elastic.NewFieldSort(searchattribute.StartTime).Desc(), tieBreakerSorter},
 	}
 	s.mockESClient.EXPECT().Search(gomock.Any(), param1).Return(nil, nil)
-	_, err = s.visibilityStore.getSearchResult(request, token, elastic.NewBoolQuery().Must(elastic.NewMatchQuery(searchattribute.ExecutionStatus, int(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING))), true)
+	_, err = s.visibilityStore.getSearchResult(context.Background(), request, token, elastic.NewBoolQuery().Must(elastic.NewMatchQuery(searchattribute.ExecutionStatus, int(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING))), true)
 	s.NoError(err)
 	request = createTestRequest() // revert
 
@@ -393,7 +393,7 @@ func (s *ESVisibilitySuite) TestGetSearchResult() {
 	params.Query = boolQuery
 	params.Sorter = []elastic.Sorter{elastic.NewFieldSort(searchattribute.CloseTime).Desc(), tieBreakerSorter}
 	s.mockESClient.EXPECT().Search(gomock.Any(), params).Return(nil, nil)
-	_, err = s.visibilityStore.getSearchResult(request, token, elastic.NewBoolQuery().MustNot(elastic.NewMatchQuery(searchattribute.ExecutionStatus, int(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING))), false)
+	_, err = s.visibilityStore.getSearchResult(context.Background(), request, token, elastic.NewBoolQuery().MustNot(elastic.NewMatchQuery(searchattribute.ExecutionStatus, int(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING))), false)
 	s.NoError(err)
 
 	// test for additional boolQuery
@@ -401,7 +401,7 @@ func (s *ESVisibilitySuite) TestGetSearchResult() {
 	boolQuery = elastic.NewBoolQuery().Must(matchQuery).Must(matchNamespaceQuery).Filter(rangeQuery)
 	params.Query = boolQuery
 	s.mockESClient.EXPECT().Search(gomock.Any(), params).Return(nil, nil)
-	_, err = s.visibilityStore.getSearchResult(request, token, elastic.NewBoolQuery().Must(matchQuery), false)
+	_, err = s.visibilityStore.getSearchResult(context.Background(), request, token, elastic.NewBoolQuery().Must(matchQuery), false)
 	s.NoError(err)
 
 	// test for search after
@@ -413,7 +413,7 @@ func (s *ESVisibilitySuite) TestGetSearchResult() {
 	params.From = 0
 	params.SearchAfter = []interface{}{token.SortValue, token.TieBreaker}
 	s.mockESClient.EXPECT().Search(gomock.Any(), params).Return(nil, nil)
-	_, err = s.visibilityStore.getSearchResult(request, token, elastic.NewBoolQuery().Must(matchQuery), false)
+	_, err = s.visibilityStore.getSearchResult(context.Background(), request, token, elastic.NewBoolQuery().Must(matchQuery), false)
 	s.NoError(err)
 }
 
@@ -809,12 +809,16 @@ func (s *ESVisibilitySuite) TestGetESQueryDSLForCount() {
 func (s *ESVisibilitySuite) TestAddNamespaceToQuery() {
 	dsl := fastjson.MustParse(`{}`)
 	dslStr := dsl.String()
-	addNamespaceToQuery(dsl, "")
+	s.NoError(addNamespaceToQuery(dsl, ""))
 	s.Equal(dslStr, dsl.String())
 
 	dsl = fastjson.MustParse(`{"query":{"bool":{"must":[{"match_all":{}}]}}}`)
-	addNamespaceToQuery(dsl, testNamespaceID)
+	s.NoError(addNamespaceToQuery(dsl, testNamespaceID))
 	s.Equal(`{"query":{"bool":{"must":[{"match_phrase":{"NamespaceId":{"query":"bfd5c907-f899-4baf-a7b2-2ab85e623ebd"}}},{"bool":{"must":[{"match_all":{}}]}}]}}}`, dsl.String())
+
+	dsl = fastjson.MustParse(`{"query":{"bool":{"must":[{"match_all":{}}]}}}`)
+	s.NoError(addNamespaceToQuery(dsl, `nid"}}},{"match_all":{}`))
+	s.Equal(`{"query":{"bool":{"must":[{"match_phrase":{"NamespaceId":{"query":"nid\"}}},{\"match_all\":{}"}}},{"bool":{"must":[{"match_all":{}}]}}]}}}`, dsl.String())
 }
 
 func (s *ESVisibilitySuite) TestListWorkflowExecutions() {
@@ -830,18 +834,18 @@ func (s *ESVisibilitySuite) TestListWorkflowExecutions() {
 		PageSize:    10,
 		Query:       `ExecutionStatus = "Terminated"`,
 	}
-	_, err := s.visibilityStore.ListWorkflowExecutions(request)
+	_, err := s.visibilityStore.ListWorkflowExecutions(context.Background(), request)
 	s.NoError(err)
 
 	s.mockESClient.EXPECT().SearchWithDSL(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errTestESSearch)
-	_, err = s.visibilityStore.ListWorkflowExecutions(request)
+	_, err = s.visibilityStore.ListWorkflowExecutions(context.Background(), request)
 	s.Error(err)
 	_, ok := err.(*serviceerror.Internal)
 	s.True(ok)
 	s.True(strings.Contains(err.Error(), "ListWorkflowExecutions failed"))
 
 	request.Query = `invalid query`
-	_, err = s.visibilityStore.ListWorkflowExecutions(request)
+	_, err = s.visibilityStore.ListWorkflowExecutions(context.Background(), request)
 	s.Error(err)
 	_, ok = err.(*serviceerror.InvalidArgument)
 	s.True(ok)
@@ -862,12 +866,12 @@ func (s *ESVisibilitySuite) TestScanWorkflowExecutions() {
 		PageSize:    10,
 		Query:       `ExecutionStatus = "Terminated"`,
 	}
-	_, err := s.visibilityStore.ScanWorkflowExecutions(request)
+	_, err := s.visibilityStore.ScanWorkflowExecutions(context.Background(), request)
 	s.NoError(err)
 
 	// test bad request
 	request.Query = `invalid query`
-	_, err = s.visibilityStore.ScanWorkflowExecutions(request)
+	_, err = s.visibilityStore.ScanWorkflowExecutions(context.Background(), request)
 	s.Error(err)
 	_, ok := err.(*serviceerror.InvalidArgument)
 	s.True(ok)
@@ -881,25 +885,65 @@ func (s *ESVisibilitySuite) TestScanWorkflowExecutions() {
 	tokenBytes, err := s.visibilityStore.serializePageToken(token)
 	s.NoError(err)
 	request.NextPageToken = tokenBytes
-	_, err = s.visibilityStore.ScanWorkflowExecutions(request)
+	_, err = s.visibilityStore.ScanWorkflowExecutions(context.Background(), request)
 	s.NoError(err)
 
 	// test last page
 	mockScroll := client.NewMockScrollService(s.controller)
 	s.mockESClient.EXPECT().Scroll(gomock.Any(), scrollID).Return(testSearchResult, mockScroll, io.EOF)
 	mockScroll.EXPECT().Clear(gomock.Any()).Return(nil)
-	_, err = s.visibilityStore.ScanWorkflowExecutions(request)
+	_, err = s.visibilityStore.ScanWorkflowExecutions(context.Background(), request)
 	s.NoError(err)
 
 	// test internal error
 	s.mockESClient.EXPECT().Scroll(gomock.Any(), scrollID).Return(nil, nil, errTestESSearch)
-	_, err = s.visibilityStore.ScanWorkflowExecutions(request)
+	_, err = s.visibilityStore.ScanWorkflowExecutions(context.Background(), request)
 	s.Error(err)
 	_, ok = err.(*serviceerror.Internal)
 	s.True(ok)
 	s.True(strings.Contains(err.Error(), "ScanWorkflowExecutions failed"))
 }
 
+func (s *ESVisibilitySuite) TestCountWorkflowExecutions_RequestTimeout() {
+	s.visibilityStore.config.ESVisibilityRequestTimeout = dynamicconfig.GetDurationPropertyFn(100 * time.Millisecond)
+	s.mockESClient.EXPECT().Count(gomock.Any(), testIndex, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, index, input string) (int64, error) {
+			deadline, ok := ctx.Deadline()
+			s.True(ok)
+			s.True(time.Until(deadline) <= 100*time.Millisecond)
+			<-ctx.Done()
+			return int64(0), ctx.Err()
+		})
+
+	request := &persistence.CountWorkflowExecutionsRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		Query:       `ExecutionStatus = "Terminated"`,
+	}
+	_, err := s.visibilityStore.CountWorkflowExecutions(context.Background(), request)
+	s.Error(err)
+	s.True(strings.Contains(err.Error(), context.DeadlineExceeded.Error()))
+}
+
+func (s *ESVisibilitySuite) TestCountWorkflowExecutions_CallerContextCanceled() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.mockESClient.EXPECT().Count(gomock.Any(), testIndex, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, index, input string) (int64, error) {
+			cancel()
+			<-ctx.Done()
+			return int64(0), ctx.Err()
+		})
+
+	request := &persistence.CountWorkflowExecutionsRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		Query:       `ExecutionStatus = "Terminated"`,
+	}
+	_, err := s.visibilityStore.CountWorkflowExecutions(ctx, request)
+	s.Error(err)
+	s.True(strings.Contains(err.Error(), context.Canceled.Error()))
+}
+
 func (s *ESVisibilitySuite) TestCountWorkflowExecutions() {
 	s.mockESClient.EXPECT().Count(gomock.Any(), testIndex, gomock.Any()).DoAndReturn(
 		func(ctx context.Context, index, input string) (int64, error) {
@@ -912,14 +956,14 @@ func (s *ESVisibilitySuite) TestCountWorkflowExecutions() {
 		Namespace:   testNamespace,
 		Query:       `ExecutionStatus = "Terminated"`,
 	}
-	resp, err := s.visibilityStore.CountWorkflowExecutions(request)
+	resp, err := s.visibilityStore.CountWorkflowExecutions(context.Background(), request)
 	s.NoError(err)
 	s.Equal(int64(1), resp.Count)
 
 	// test internal error
 	s.mockESClient.EXPECT().Count(gomock.Any(), testIndex, gomock.Any()).Return(int64(0), errTestESSearch)
 
-	_, err = s.visibilityStore.CountWorkflowExecutions(request)
+	_, err = s.visibilityStore.CountWorkflowExecutions(context.Background(), request)
 	s.Error(err)
 	_, ok := err.(*serviceerror.Internal)
 	s.True(ok)
@@ -927,7 +971,7 @@ func (s *ESVisibilitySuite) TestCountWorkflowExecutions() {
 
 	// test bad request
 	request.Query = `invalid query`
-	_, err = s.visibilityStore.CountWorkflowExecutions(request)
+	_, err = s.visibilityStore.CountWorkflowExecutions(context.Background(), request)
 	s.Error(err)
 	_, ok = err.(*serviceerror.InvalidArgument)
 	s.True(ok)
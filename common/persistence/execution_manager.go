@@ -28,12 +28,15 @@ import (
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
 	workflowpb "go.temporal.io/api/workflow/v1"
 
 	historyspb "go.temporal.io/server/api/history/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -46,15 +49,23 @@ type (
 		persistence   ExecutionStore
 		statsComputer statsComputer
 		logger        log.Logger
+
+		mutableStateSizeLimit dynamicconfig.IntPropertyFn
 	}
 )
 
 var _ ExecutionManager = (*executionManagerImpl)(nil)
 
+var (
+	// ErrMutableStateTooLarge is returned when a write would grow the mutable state beyond the size limit
+	ErrMutableStateTooLarge = serviceerror.NewInvalidArgument("Mutable state size exceeds limit.")
+)
+
 // NewExecutionManager returns new ExecutionManager
 func NewExecutionManager(
 	persistence ExecutionStore,
 	logger log.Logger,
+	mutableStateSizeLimit dynamicconfig.IntPropertyFn,
 ) ExecutionManager {
 
 	return &executionManagerImpl{
@@ -62,6 +73,8 @@ func NewExecutionManager(
 		persistence:   persistence,
 		statsComputer: statsComputer{},
 		logger:        logger,
+
+		mutableStateSizeLimit: mutableStateSizeLimit,
 	}
 }
 
@@ -135,6 +148,24 @@ func (m *executionManagerImpl) UpdateWorkflowExecution(
 		return nil, err
 	}
 
+	msuss := m.statsComputer.computeMutableStateUpdateStats(request)
+	// the upserted infos are part of the whole mutable state, their size is the lower bound if the caller didn't size it
+	mutableStateSize := mutation.MutableStateSize
+	if mutableStateSize < msuss.MutableStateSize {
+		mutableStateSize = msuss.MutableStateSize
+	}
+	if err := m.validateMutableStateSize(mutation.ExecutionInfo, mutableStateSize); err != nil {
+		return nil, err
+	}
+	if newSnapshot != nil {
+		if err := m.validateMutableStateSize(
+			newSnapshot.ExecutionInfo,
+			m.statsComputer.computeWorkflowSnapshotSize(newSnapshot),
+		); err != nil {
+			return nil, err
+		}
+	}
+
 	serializedWorkflowMutation, err := m.SerializeWorkflowMutation(&mutation)
 	if err != nil {
 		return nil, err
@@ -156,7 +187,6 @@ func (m *executionManagerImpl) UpdateWorkflowExecution(
 		NewWorkflowSnapshot:    serializedNewWorkflowSnapshot,
 	}
 
-	msuss := m.statsComputer.computeMutableStateUpdateStats(request)
 	err1 := m.persistence.UpdateWorkflowExecution(newRequest)
 	return &UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: msuss}, err1
 }
@@ -174,6 +204,13 @@ func (m *executionManagerImpl) ConflictResolveWorkflowExecution(
 		return err
 	}
 
+	if err := m.validateMutableStateSize(
+		request.ResetWorkflowSnapshot.ExecutionInfo,
+		m.statsComputer.computeWorkflowSnapshotSize(&request.ResetWorkflowSnapshot),
+	); err != nil {
+		return err
+	}
+
 	serializedResetWorkflowSnapshot, err := m.SerializeWorkflowSnapshot(&request.ResetWorkflowSnapshot)
 	if err != nil {
 		return err
@@ -223,6 +260,12 @@ func (m *executionManagerImpl) CreateWorkflowExecution(
 	); err != nil {
 		return nil, err
 	}
+	if err := m.validateMutableStateSize(
+		snapshot.ExecutionInfo,
+		m.statsComputer.computeWorkflowSnapshotSize(&snapshot),
+	); err != nil {
+		return nil, err
+	}
 
 	snapshot.ExecutionInfo.LastUpdateTime = timestamp.TimeNowPtrUtc()
 	serializedNewWorkflowSnapshot, err := m.SerializeWorkflowSnapshot(&snapshot)
@@ -241,6 +284,25 @@ func (m *executionManagerImpl) CreateWorkflowExecution(
 	return m.persistence.CreateWorkflowExecution(newRequest)
 }
 
+// validateMutableStateSize rejects writes whose whole mutable state, including version histories, exceeds the size limit
+func (m *executionManagerImpl) validateMutableStateSize(
+	executionInfo *persistencespb.WorkflowExecutionInfo,
+	mutableStateSize int,
+) error {
+
+	size := mutableStateSize + versionhistory.EstimatedByteSize(executionInfo.GetVersionHistories())
+	if size <= m.mutableStateSizeLimit() {
+		return nil
+	}
+
+	m.logger.Error("Mutable state size exceeds limit.",
+		tag.WorkflowNamespaceID(executionInfo.GetNamespaceId()),
+		tag.WorkflowID(executionInfo.GetWorkflowId()),
+		tag.WorkflowSize(int64(size)),
+	)
+	return ErrMutableStateTooLarge
+}
+
 func (m *executionManagerImpl) SerializeWorkflowMutation(
 	input *WorkflowMutation,
 ) (*InternalWorkflowMutation, error) {
@@ -0,0 +1,121 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence/versionhistory"
+)
+
+type (
+	executionManagerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		executionManager *executionManagerImpl
+	}
+)
+
+const testMutableStateSizeLimit = 1024
+
+func TestExecutionManagerSuite(t *testing.T) {
+	s := new(executionManagerSuite)
+	suite.Run(t, s)
+}
+
+func (s *executionManagerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.executionManager = NewExecutionManager(
+		nil,
+		log.NewNoopLogger(),
+		dynamicconfig.GetIntPropertyFn(testMutableStateSizeLimit),
+	).(*executionManagerImpl)
+}
+
+func (s *executionManagerSuite) TestValidateMutableStateSize_WithinLimit() {
+	executionInfo := s.newExecutionInfo(1)
+	s.NoError(s.executionManager.validateMutableStateSize(executionInfo, 0))
+}
+
+func (s *executionManagerSuite) TestCreateWorkflowExecution_MutableStateTooLarge() {
+	executionInfo := s.newExecutionInfo(100)
+	s.Greater(versionhistory.EstimatedByteSize(executionInfo.GetVersionHistories()), testMutableStateSizeLimit)
+
+	_, err := s.executionManager.CreateWorkflowExecution(&CreateWorkflowExecutionRequest{
+		Mode: CreateWorkflowModeBrandNew,
+		NewWorkflowSnapshot: WorkflowSnapshot{
+			ExecutionInfo: executionInfo,
+			ExecutionState: &persistencespb.WorkflowExecutionState{
+				State:  enumsspb.WORKFLOW_EXECUTION_STATE_CREATED,
+				Status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+			},
+		},
+	})
+	s.Equal(ErrMutableStateTooLarge, err)
+}
+
+func (s *executionManagerSuite) TestUpdateWorkflowExecution_AccumulatedMutableStateTooLarge() {
+	executionInfo := s.newExecutionInfo(1)
+	executionState := &persistencespb.WorkflowExecutionState{
+		State:  enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+		Status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+	}
+
+	// the mutation itself is small, the mutable state it is applied to is not
+	_, err := s.executionManager.UpdateWorkflowExecution(&UpdateWorkflowExecutionRequest{
+		Mode: UpdateWorkflowModeUpdateCurrent,
+		UpdateWorkflowMutation: WorkflowMutation{
+			ExecutionInfo:    executionInfo,
+			ExecutionState:   executionState,
+			MutableStateSize: testMutableStateSizeLimit + 1,
+		},
+	})
+	s.Equal(ErrMutableStateTooLarge, err)
+}
+
+// newExecutionInfo returns an execution info whose version histories fork once per branch
+func (s *executionManagerSuite) newExecutionInfo(numBranches int) *persistencespb.WorkflowExecutionInfo {
+	builder := versionhistory.NewVersionHistoriesBuilder().AddEvent(1, 0)
+	for i := 1; i < numBranches; i++ {
+		builder.Fork(1).AddEvent(2, int64(i))
+	}
+	versionHistories, err := builder.Build()
+	s.NoError(err)
+
+	return &persistencespb.WorkflowExecutionInfo{
+		NamespaceId:      "test-namespace-id",
+		WorkflowId:       "test-workflow-id",
+		VersionHistories: versionHistories,
+	}
+}
@@ -25,10 +25,17 @@
 package persistence
 
 import (
+	"context"
+	"fmt"
 	"sort"
 
 	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+
+	historyspb "go.temporal.io/server/api/history/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/persistence/versionhistory"
 )
 
 // ReadFullPageV2Events reads a full page of history events from HistoryManager. Due to storage format of V2 History
@@ -75,6 +82,62 @@ func ReadFullPageV2EventsByBatch(historyV2Mgr HistoryManager, req *ReadHistoryBr
 	}
 }
 
+// GetHistoryByVersionHistory reads a page of history events in [minEventID, maxEventID) from the branch of the given
+// version history. Pass the returned next page token back in to read the following page, an empty token means all
+// events in the range have been read. An EventRangeOutOfBoundsError is returned if the range is not within the
+// version history.
+func GetHistoryByVersionHistory(
+	ctx context.Context,
+	historyV2Mgr HistoryManager,
+	shardID int32,
+	versionHistory *historyspb.VersionHistory,
+	minEventID int64,
+	maxEventID int64,
+	pageSize int,
+	nextPageToken []byte,
+) ([]*historypb.HistoryEvent, []byte, error) {
+	if len(versionHistory.GetBranchToken()) == 0 {
+		return nil, nil, serviceerror.NewInvalidArgument("version history has no branch token")
+	}
+	if err := ValidateEventRange(versionHistory, minEventID, maxEventID); err != nil {
+		return nil, nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	historyEvents, _, nextPageToken, err := ReadFullPageV2Events(historyV2Mgr, &ReadHistoryBranchRequest{
+		ShardID:       shardID,
+		BranchToken:   versionHistory.GetBranchToken(),
+		MinEventID:    minEventID,
+		MaxEventID:    maxEventID,
+		PageSize:      pageSize,
+		NextPageToken: nextPageToken,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return historyEvents, nextPageToken, nil
+}
+
+// ValidateEventRange checks the event range [minEventID, maxEventID) is not empty and within the events of the
+// given version history, returning an EventRangeOutOfBoundsError otherwise.
+func ValidateEventRange(versionHistory *historyspb.VersionHistory, minEventID int64, maxEventID int64) error {
+	lastItem, err := versionhistory.GetLastVersionHistoryItem(versionHistory)
+	if err != nil {
+		return err
+	}
+	if minEventID < common.FirstEventID || minEventID >= maxEventID || maxEventID > lastItem.GetEventId()+1 {
+		return &EventRangeOutOfBoundsError{
+			Msg: fmt.Sprintf(
+				"event range [%v, %v) is outside of version history event range [%v, %v]",
+				minEventID, maxEventID, common.FirstEventID, lastItem.GetEventId(),
+			),
+		}
+	}
+	return nil
+}
+
 // GetBeginNodeID gets node id from last ancestor
 func GetBeginNodeID(bi *persistencespb.HistoryBranch) int64 {
 	if len(bi.Ancestors) == 0 {
@@ -0,0 +1,119 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+
+	historyspb "go.temporal.io/server/api/history/v1"
+)
+
+func TestGetHistoryByVersionHistory(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	branchToken := []byte("branch-token")
+	versionHistory := &historyspb.VersionHistory{
+		BranchToken: branchToken,
+		Items:       []*historyspb.VersionHistoryItem{{EventId: 3, Version: 1}},
+	}
+	historyStore := NewMockHistoryManager(controller)
+	gomock.InOrder(
+		historyStore.EXPECT().ReadHistoryBranch(&ReadHistoryBranchRequest{
+			ShardID:     1,
+			BranchToken: branchToken,
+			MinEventID:  1,
+			MaxEventID:  4,
+			PageSize:    2,
+		}).Return(&ReadHistoryBranchResponse{
+			HistoryEvents: []*historypb.HistoryEvent{{EventId: 1}, {EventId: 2}},
+			NextPageToken: []byte("page-2"),
+		}, nil),
+		historyStore.EXPECT().ReadHistoryBranch(&ReadHistoryBranchRequest{
+			ShardID:       1,
+			BranchToken:   branchToken,
+			MinEventID:    1,
+			MaxEventID:    4,
+			PageSize:      2,
+			NextPageToken: []byte("page-2"),
+		}).Return(&ReadHistoryBranchResponse{
+			HistoryEvents: []*historypb.HistoryEvent{{EventId: 3}},
+		}, nil),
+	)
+
+	var eventIDs []int64
+	var nextPageToken []byte
+	for {
+		events, token, err := GetHistoryByVersionHistory(context.Background(), historyStore, 1, versionHistory, 1, 4, 2, nextPageToken)
+		require.NoError(t, err)
+		for _, event := range events {
+			eventIDs = append(eventIDs, event.GetEventId())
+		}
+		if len(token) == 0 {
+			break
+		}
+		nextPageToken = token
+	}
+	require.Equal(t, []int64{1, 2, 3}, eventIDs)
+}
+
+func TestGetHistoryByVersionHistory_NoBranchToken(t *testing.T) {
+	_, _, err := GetHistoryByVersionHistory(context.Background(), nil, 1, &historyspb.VersionHistory{}, 1, 4, 2, nil)
+	require.IsType(t, &serviceerror.InvalidArgument{}, err)
+}
+
+func TestGetHistoryByVersionHistory_RangePastBranchEnd(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	versionHistory := &historyspb.VersionHistory{
+		BranchToken: []byte("branch-token"),
+		Items:       []*historyspb.VersionHistoryItem{{EventId: 3, Version: 1}},
+	}
+	// no reads are expected from the store
+	historyStore := NewMockHistoryManager(controller)
+
+	_, _, err := GetHistoryByVersionHistory(context.Background(), historyStore, 1, versionHistory, 1, 5, 2, nil)
+	require.IsType(t, &EventRangeOutOfBoundsError{}, err)
+}
+
+func TestValidateEventRange(t *testing.T) {
+	versionHistory := &historyspb.VersionHistory{
+		Items: []*historyspb.VersionHistoryItem{{EventId: 3, Version: 1}, {EventId: 10, Version: 2}},
+	}
+
+	require.NoError(t, ValidateEventRange(versionHistory, 1, 11))
+	require.NoError(t, ValidateEventRange(versionHistory, 10, 11))
+	require.IsType(t, &EventRangeOutOfBoundsError{}, ValidateEventRange(versionHistory, 1, 12))
+	require.IsType(t, &EventRangeOutOfBoundsError{}, ValidateEventRange(versionHistory, 0, 5))
+	require.IsType(t, &EventRangeOutOfBoundsError{}, ValidateEventRange(versionHistory, 5, 5))
+	require.IsType(t, &serviceerror.InvalidArgument{}, ValidateEventRange(&historyspb.VersionHistory{}, 1, 2))
+}
@@ -25,6 +25,7 @@
 package persistencetests
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -149,12 +150,12 @@ func (s *VisibilitySamplingSuite) TestListOpenWorkflowExecutions() {
 		NamespaceID: testNamespaceUUID,
 		Namespace:   testNamespace,
 	}
-	s.persistence.EXPECT().ListOpenWorkflowExecutions(request).Return(nil, nil)
-	_, err := s.client.ListOpenWorkflowExecutions(request)
+	s.persistence.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), request).Return(nil, nil)
+	_, err := s.client.ListOpenWorkflowExecutions(context.Background(), request)
 	s.NoError(err)
 
 	// no remaining tokens
-	_, err = s.client.ListOpenWorkflowExecutions(request)
+	_, err = s.client.ListOpenWorkflowExecutions(context.Background(), request)
 	s.Error(err)
 	errDetail, ok := err.(*serviceerror.ResourceExhausted)
 	s.True(ok)
@@ -166,12 +167,12 @@ func (s *VisibilitySamplingSuite) TestListClosedWorkflowExecutions() {
 		NamespaceID: testNamespaceUUID,
 		Namespace:   testNamespace,
 	}
-	s.persistence.EXPECT().ListClosedWorkflowExecutions(request).Return(nil, nil)
-	_, err := s.client.ListClosedWorkflowExecutions(request)
+	s.persistence.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), request).Return(nil, nil)
+	_, err := s.client.ListClosedWorkflowExecutions(context.Background(), request)
 	s.NoError(err)
 
 	// no remaining tokens
-	_, err = s.client.ListClosedWorkflowExecutions(request)
+	_, err = s.client.ListClosedWorkflowExecutions(context.Background(), request)
 	s.Error(err)
 	errDetail, ok := err.(*serviceerror.ResourceExhausted)
 	s.True(ok)
@@ -187,12 +188,12 @@ func (s *VisibilitySamplingSuite) TestListOpenWorkflowExecutionsByType() {
 		ListWorkflowExecutionsRequest: req,
 		WorkflowTypeName:              testWorkflowTypeName,
 	}
-	s.persistence.EXPECT().ListOpenWorkflowExecutionsByType(request).Return(nil, nil)
-	_, err := s.client.ListOpenWorkflowExecutionsByType(request)
+	s.persistence.EXPECT().ListOpenWorkflowExecutionsByType(gomock.Any(), request).Return(nil, nil)
+	_, err := s.client.ListOpenWorkflowExecutionsByType(context.Background(), request)
 	s.NoError(err)
 
 	// no remaining tokens
-	_, err = s.client.ListOpenWorkflowExecutionsByType(request)
+	_, err = s.client.ListOpenWorkflowExecutionsByType(context.Background(), request)
 	s.Error(err)
 	errDetail, ok := err.(*serviceerror.ResourceExhausted)
 	s.True(ok)
@@ -208,12 +209,12 @@ func (s *VisibilitySamplingSuite) TestListClosedWorkflowExecutionsByType() {
 		ListWorkflowExecutionsRequest: req,
 		WorkflowTypeName:              testWorkflowTypeName,
 	}
-	s.persistence.EXPECT().ListClosedWorkflowExecutionsByType(request).Return(nil, nil)
-	_, err := s.client.ListClosedWorkflowExecutionsByType(request)
+	s.persistence.EXPECT().ListClosedWorkflowExecutionsByType(gomock.Any(), request).Return(nil, nil)
+	_, err := s.client.ListClosedWorkflowExecutionsByType(context.Background(), request)
 	s.NoError(err)
 
 	// no remaining tokens
-	_, err = s.client.ListClosedWorkflowExecutionsByType(request)
+	_, err = s.client.ListClosedWorkflowExecutionsByType(context.Background(), request)
 	s.Error(err)
 	errDetail, ok := err.(*serviceerror.ResourceExhausted)
 	s.True(ok)
@@ -229,12 +230,12 @@ func (s *VisibilitySamplingSuite) TestListOpenWorkflowExecutionsByWorkflowID() {
 		ListWorkflowExecutionsRequest: req,
 		WorkflowID:                    testWorkflowExecution.GetWorkflowId(),
 	}
-	s.persistence.EXPECT().ListOpenWorkflowExecutionsByWorkflowID(request).Return(nil, nil)
-	_, err := s.client.ListOpenWorkflowExecutionsByWorkflowID(request)
+	s.persistence.EXPECT().ListOpenWorkflowExecutionsByWorkflowID(gomock.Any(), request).Return(nil, nil)
+	_, err := s.client.ListOpenWorkflowExecutionsByWorkflowID(context.Background(), request)
 	s.NoError(err)
 
 	// no remaining tokens
-	_, err = s.client.ListOpenWorkflowExecutionsByWorkflowID(request)
+	_, err = s.client.ListOpenWorkflowExecutionsByWorkflowID(context.Background(), request)
 	s.Error(err)
 	errDetail, ok := err.(*serviceerror.ResourceExhausted)
 	s.True(ok)
@@ -250,12 +251,12 @@ func (s *VisibilitySamplingSuite) TestListClosedWorkflowExecutionsByWorkflowID()
 		ListWorkflowExecutionsRequest: req,
 		WorkflowID:                    testWorkflowExecution.GetWorkflowId(),
 	}
-	s.persistence.EXPECT().ListClosedWorkflowExecutionsByWorkflowID(request).Return(nil, nil)
-	_, err := s.client.ListClosedWorkflowExecutionsByWorkflowID(request)
+	s.persistence.EXPECT().ListClosedWorkflowExecutionsByWorkflowID(gomock.Any(), request).Return(nil, nil)
+	_, err := s.client.ListClosedWorkflowExecutionsByWorkflowID(context.Background(), request)
 	s.NoError(err)
 
 	// no remaining tokens
-	_, err = s.client.ListClosedWorkflowExecutionsByWorkflowID(request)
+	_, err = s.client.ListClosedWorkflowExecutionsByWorkflowID(context.Background(), request)
 	s.Error(err)
 	errDetail, ok := err.(*serviceerror.ResourceExhausted)
 	s.True(ok)
@@ -271,12 +272,12 @@ func (s *VisibilitySamplingSuite) TestListClosedWorkflowExecutionsByStatus() {
 		ListWorkflowExecutionsRequest: req,
 		Status:                        enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
 	}
-	s.persistence.EXPECT().ListClosedWorkflowExecutionsByStatus(request).Return(nil, nil)
-	_, err := s.client.ListClosedWorkflowExecutionsByStatus(request)
+	s.persistence.EXPECT().ListClosedWorkflowExecutionsByStatus(gomock.Any(), request).Return(nil, nil)
+	_, err := s.client.ListClosedWorkflowExecutionsByStatus(context.Background(), request)
 	s.NoError(err)
 
 	// no remaining tokens
-	_, err = s.client.ListClosedWorkflowExecutionsByStatus(request)
+	_, err = s.client.ListClosedWorkflowExecutionsByStatus(context.Background(), request)
 	s.Error(err)
 	errDetail, ok := err.(*serviceerror.ResourceExhausted)
 	s.True(ok)
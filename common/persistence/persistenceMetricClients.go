@@ -25,6 +25,8 @@
 package persistence
 
 import (
+	"context"
+
 	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/api/serviceerror"
 
@@ -981,11 +983,11 @@ func (p *visibilityPersistenceClient) UpsertWorkflowExecution(request *UpsertWor
 	return err
 }
 
-func (p *visibilityPersistenceClient) ListOpenWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+func (p *visibilityPersistenceClient) ListOpenWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceListOpenWorkflowExecutionsScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceListOpenWorkflowExecutionsScope, metrics.PersistenceLatency)
-	response, err := p.persistence.ListOpenWorkflowExecutions(request)
+	response, err := p.persistence.ListOpenWorkflowExecutions(ctx, request)
 	sw.Stop()
 
 	if err != nil {
@@ -995,11 +997,11 @@ func (p *visibilityPersistenceClient) ListOpenWorkflowExecutions(request *ListWo
 	return response, err
 }
 
-func (p *visibilityPersistenceClient) ListClosedWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+func (p *visibilityPersistenceClient) ListClosedWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceListClosedWorkflowExecutionsScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceListClosedWorkflowExecutionsScope, metrics.PersistenceLatency)
-	response, err := p.persistence.ListClosedWorkflowExecutions(request)
+	response, err := p.persistence.ListClosedWorkflowExecutions(ctx, request)
 	sw.Stop()
 
 	if err != nil {
@@ -1009,11 +1011,11 @@ func (p *visibilityPersistenceClient) ListClosedWorkflowExecutions(request *List
 	return response, err
 }
 
-func (p *visibilityPersistenceClient) ListOpenWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+func (p *visibilityPersistenceClient) ListOpenWorkflowExecutionsByType(ctx context.Context, request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceListOpenWorkflowExecutionsByTypeScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceListOpenWorkflowExecutionsByTypeScope, metrics.PersistenceLatency)
-	response, err := p.persistence.ListOpenWorkflowExecutionsByType(request)
+	response, err := p.persistence.ListOpenWorkflowExecutionsByType(ctx, request)
 	sw.Stop()
 
 	if err != nil {
@@ -1023,11 +1025,11 @@ func (p *visibilityPersistenceClient) ListOpenWorkflowExecutionsByType(request *
 	return response, err
 }
 
-func (p *visibilityPersistenceClient) ListClosedWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+func (p *visibilityPersistenceClient) ListClosedWorkflowExecutionsByType(ctx context.Context, request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceListClosedWorkflowExecutionsByTypeScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceListClosedWorkflowExecutionsByTypeScope, metrics.PersistenceLatency)
-	response, err := p.persistence.ListClosedWorkflowExecutionsByType(request)
+	response, err := p.persistence.ListClosedWorkflowExecutionsByType(ctx, request)
 	sw.Stop()
 
 	if err != nil {
@@ -1037,11 +1039,11 @@ func (p *visibilityPersistenceClient) ListClosedWorkflowExecutionsByType(request
 	return response, err
 }
 
-func (p *visibilityPersistenceClient) ListOpenWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+func (p *visibilityPersistenceClient) ListOpenWorkflowExecutionsByWorkflowID(ctx context.Context, request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceListOpenWorkflowExecutionsByWorkflowIDScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceListOpenWorkflowExecutionsByWorkflowIDScope, metrics.PersistenceLatency)
-	response, err := p.persistence.ListOpenWorkflowExecutionsByWorkflowID(request)
+	response, err := p.persistence.ListOpenWorkflowExecutionsByWorkflowID(ctx, request)
 	sw.Stop()
 
 	if err != nil {
@@ -1051,11 +1053,11 @@ func (p *visibilityPersistenceClient) ListOpenWorkflowExecutionsByWorkflowID(req
 	return response, err
 }
 
-func (p *visibilityPersistenceClient) ListClosedWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+func (p *visibilityPersistenceClient) ListClosedWorkflowExecutionsByWorkflowID(ctx context.Context, request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceListClosedWorkflowExecutionsByWorkflowIDScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceListClosedWorkflowExecutionsByWorkflowIDScope, metrics.PersistenceLatency)
-	response, err := p.persistence.ListClosedWorkflowExecutionsByWorkflowID(request)
+	response, err := p.persistence.ListClosedWorkflowExecutionsByWorkflowID(ctx, request)
 	sw.Stop()
 
 	if err != nil {
@@ -1065,11 +1067,11 @@ func (p *visibilityPersistenceClient) ListClosedWorkflowExecutionsByWorkflowID(r
 	return response, err
 }
 
-func (p *visibilityPersistenceClient) ListClosedWorkflowExecutionsByStatus(request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error) {
+func (p *visibilityPersistenceClient) ListClosedWorkflowExecutionsByStatus(ctx context.Context, request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceListClosedWorkflowExecutionsByStatusScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceListClosedWorkflowExecutionsByStatusScope, metrics.PersistenceLatency)
-	response, err := p.persistence.ListClosedWorkflowExecutionsByStatus(request)
+	response, err := p.persistence.ListClosedWorkflowExecutionsByStatus(ctx, request)
 	sw.Stop()
 
 	if err != nil {
@@ -1079,11 +1081,11 @@ func (p *visibilityPersistenceClient) ListClosedWorkflowExecutionsByStatus(reque
 	return response, err
 }
 
-func (p *visibilityPersistenceClient) GetClosedWorkflowExecution(request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error) {
+func (p *visibilityPersistenceClient) GetClosedWorkflowExecution(ctx context.Context, request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetClosedWorkflowExecutionScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetClosedWorkflowExecutionScope, metrics.PersistenceLatency)
-	response, err := p.persistence.GetClosedWorkflowExecution(request)
+	response, err := p.persistence.GetClosedWorkflowExecution(ctx, request)
 	sw.Stop()
 
 	if err != nil {
@@ -1107,11 +1109,11 @@ func (p *visibilityPersistenceClient) DeleteWorkflowExecution(request *Visibilit
 	return err
 }
 
-func (p *visibilityPersistenceClient) ListWorkflowExecutions(request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error) {
+func (p *visibilityPersistenceClient) ListWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceListWorkflowExecutionsScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceListWorkflowExecutionsScope, metrics.PersistenceLatency)
-	response, err := p.persistence.ListWorkflowExecutions(request)
+	response, err := p.persistence.ListWorkflowExecutions(ctx, request)
 	sw.Stop()
 
 	if err != nil {
@@ -1121,11 +1123,11 @@ func (p *visibilityPersistenceClient) ListWorkflowExecutions(request *ListWorkfl
 	return response, err
 }
 
-func (p *visibilityPersistenceClient) ScanWorkflowExecutions(request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error) {
+func (p *visibilityPersistenceClient) ScanWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceScanWorkflowExecutionsScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceScanWorkflowExecutionsScope, metrics.PersistenceLatency)
-	response, err := p.persistence.ScanWorkflowExecutions(request)
+	response, err := p.persistence.ScanWorkflowExecutions(ctx, request)
 	sw.Stop()
 
 	if err != nil {
@@ -1135,11 +1137,11 @@ func (p *visibilityPersistenceClient) ScanWorkflowExecutions(request *ListWorkfl
 	return response, err
 }
 
-func (p *visibilityPersistenceClient) CountWorkflowExecutions(request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
+func (p *visibilityPersistenceClient) CountWorkflowExecutions(ctx context.Context, request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceCountWorkflowExecutionsScope, metrics.PersistenceRequests)
 
 	sw := p.metricClient.StartTimer(metrics.PersistenceCountWorkflowExecutionsScope, metrics.PersistenceLatency)
-	response, err := p.persistence.CountWorkflowExecutions(request)
+	response, err := p.persistence.CountWorkflowExecutions(ctx, request)
 	sw.Stop()
 
 	if err != nil {
@@ -0,0 +1,69 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+)
+
+func TestPersistenceMetricsClient_StoreTypeTagged(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	scope := tally.NewTestScope("", nil)
+	metricsClient := metrics.NewTaggedClient(metrics.NewClient(scope, metrics.History), metrics.StoreTypeTag("cassandra"))
+
+	executionStore := NewMockExecutionManager(controller)
+	executionStore.EXPECT().GetWorkflowExecution(gomock.Any()).Return(&GetWorkflowExecutionResponse{}, nil).Times(2)
+	executionStore.EXPECT().GetWorkflowExecution(gomock.Any()).Return(nil, serviceerror.NewResourceExhausted("busy"))
+	historyStore := NewMockHistoryManager(controller)
+	historyStore.EXPECT().AppendHistoryNodes(gomock.Any()).Return(&AppendHistoryNodesResponse{}, nil)
+
+	executionManager := NewWorkflowExecutionPersistenceMetricsClient(executionStore, metricsClient, log.NewNoopLogger())
+	historyManager := NewHistoryV2PersistenceMetricsClient(historyStore, metricsClient, log.NewNoopLogger())
+
+	for i := 0; i < 2; i++ {
+		_, err := executionManager.GetWorkflowExecution(&GetWorkflowExecutionRequest{})
+		require.NoError(t, err)
+	}
+	_, err := executionManager.GetWorkflowExecution(&GetWorkflowExecutionRequest{})
+	require.Error(t, err)
+	_, err = historyManager.AppendHistoryNodes(&AppendHistoryNodesRequest{})
+	require.NoError(t, err)
+
+	snapshot := scope.Snapshot()
+	require.Len(t, snapshot.Timers()["persistence_latency+namespace=all,operation=GetWorkflowExecution,store_type=cassandra"].Values(), 3)
+	require.Len(t, snapshot.Timers()["persistence_latency+namespace=all,operation=AppendHistoryNodes,store_type=cassandra"].Values(), 1)
+	require.Equal(t, int64(3), snapshot.Counters()["persistence_requests+namespace=all,operation=GetWorkflowExecution,store_type=cassandra"].Value())
+	require.Equal(t, int64(1), snapshot.Counters()["persistence_errors+namespace=all,operation=GetWorkflowExecution,store_type=cassandra"].Value())
+}
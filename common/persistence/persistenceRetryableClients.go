@@ -0,0 +1,577 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/backoff"
+)
+
+// The retryable clients retry reads and idempotent writes, like deletes and task completions, on transient errors.
+// Other writes are never retried: a write failing with a timeout or an internal error may have been applied,
+// and retrying it could duplicate history nodes or turn a successful write into a ConditionFailedError.
+// Callers of those writes already handle unknown outcomes, e.g. the shard context renews its range ID.
+type (
+	shardRetryablePersistenceClient struct {
+		persistence ShardManager
+		policy      backoff.RetryPolicy
+	}
+
+	workflowExecutionRetryablePersistenceClient struct {
+		persistence ExecutionManager
+		policy      backoff.RetryPolicy
+	}
+
+	taskRetryablePersistenceClient struct {
+		persistence TaskManager
+		policy      backoff.RetryPolicy
+	}
+
+	historyV2RetryablePersistenceClient struct {
+		persistence HistoryManager
+		policy      backoff.RetryPolicy
+	}
+)
+
+var _ ShardManager = (*shardRetryablePersistenceClient)(nil)
+var _ ExecutionManager = (*workflowExecutionRetryablePersistenceClient)(nil)
+var _ TaskManager = (*taskRetryablePersistenceClient)(nil)
+var _ HistoryManager = (*historyV2RetryablePersistenceClient)(nil)
+
+// NewShardPersistenceRetryableClient creates a client to manage shards which retries retryable errors
+func NewShardPersistenceRetryableClient(persistence ShardManager, policy backoff.RetryPolicy) ShardManager {
+	return &shardRetryablePersistenceClient{
+		persistence: persistence,
+		policy:      policy,
+	}
+}
+
+// NewWorkflowExecutionPersistenceRetryableClient creates a client to manage executions which retries retryable errors
+func NewWorkflowExecutionPersistenceRetryableClient(persistence ExecutionManager, policy backoff.RetryPolicy) ExecutionManager {
+	return &workflowExecutionRetryablePersistenceClient{
+		persistence: persistence,
+		policy:      policy,
+	}
+}
+
+// NewTaskPersistenceRetryableClient creates a client to manage tasks which retries retryable errors
+func NewTaskPersistenceRetryableClient(persistence TaskManager, policy backoff.RetryPolicy) TaskManager {
+	return &taskRetryablePersistenceClient{
+		persistence: persistence,
+		policy:      policy,
+	}
+}
+
+// NewHistoryV2PersistenceRetryableClient creates a HistoryManager client to manage workflow execution history
+// which retries retryable errors
+func NewHistoryV2PersistenceRetryableClient(persistence HistoryManager, policy backoff.RetryPolicy) HistoryManager {
+	return &historyV2RetryablePersistenceClient{
+		persistence: persistence,
+		policy:      policy,
+	}
+}
+
+// IsRetryablePersistenceError checks if the error returned by persistence is transient, i.e. the same
+// request may succeed if retried. Errors describing the state of the data, like ConditionFailedError
+// or NotFound, are never retryable.
+func IsRetryablePersistenceError(err error) bool {
+	return common.IsPersistenceTransientError(err)
+}
+
+// isRetryableReadError also retries timeouts, as retrying a read whose outcome is unknown is harmless
+func isRetryableReadError(err error) bool {
+	if _, ok := err.(*TimeoutError); ok {
+		return true
+	}
+	return IsRetryablePersistenceError(err)
+}
+
+func (p *shardRetryablePersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *shardRetryablePersistenceClient) CreateShard(request *CreateShardRequest) error {
+	return p.persistence.CreateShard(request)
+}
+
+func (p *shardRetryablePersistenceClient) GetShard(request *GetShardRequest) (*GetShardResponse, error) {
+	var response *GetShardResponse
+	op := func() error {
+		var err error
+		response, err = p.persistence.GetShard(request)
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, isRetryableReadError)
+	return response, err
+}
+
+func (p *shardRetryablePersistenceClient) UpdateShard(request *UpdateShardRequest) error {
+	return p.persistence.UpdateShard(request)
+}
+
+func (p *shardRetryablePersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *workflowExecutionRetryablePersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *workflowExecutionRetryablePersistenceClient) GetShardID() int32 {
+	return p.persistence.GetShardID()
+}
+
+func (p *workflowExecutionRetryablePersistenceClient) CreateWorkflowExecution(request *CreateWorkflowExecutionRequest) (*CreateWorkflowExecutionResponse, error) {
+	return p.persistence.CreateWorkflowExecution(request)
+}
+
+func (p *workflowExecutionRetryablePersistenceClient) GetWorkflowExecution(request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error) {
+	var response *GetWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		response, err = p.persistence.GetWorkflowExecution(request)
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, isRetryableReadError)
+	return response, err
+}
+
+func (p *workflowExecutionRetryablePersistenceClient) UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error) {
+	return p.persistence.UpdateWorkflowExecution(request)
+}
+
+func (p *workflowExecutionRetryablePersistenceClient) ConflictResolveWorkflowExecution(request *ConflictResolveWorkflowExecutionRequest) error {
+	return p.persistence.ConflictResolveWorkflowExecution(request)
+}
+
+func (p *workflowExecutionRetryablePersistenceClient) DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error {
+	op := func() error {
+		return p.persistence.DeleteWorkflowExecution(request)
+	}
+
+	return backoff.Retry(op, p.policy, IsRetryablePersistenceError)
+}
+
+func (p *workflowExecutionRetryablePersistenceClient) DeleteCurrentWorkflowExecution(request *DeleteCurrentWorkflowExecutionRequest) error {
+	op := func() error {
+		return p.persistence.DeleteCurrentWorkflowExecution(request)
+	}
+
+	return backoff.Retry(op, p.policy, IsRetryablePersistenceError)
+}
+
+func (p *workflowExecutionRetryablePersistenceClient) GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error) {
+	var response *GetCurrentExecutionResponse
+	op := func() error {
+		var err error
+		response, err = p.persistence.GetCurrentExecution(request)
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, isRetryableReadError)
+	return response, err
+}
+
+func (p *workflowExecutionRetryablePersistenceClient) ListConcreteExecutions(request *ListConcreteExecutionsRequest) (*ListConcreteExecutionsResponse, error) {
+	var response *ListConcreteExecutionsResponse
+	op := func() error {
+		var err error
+		response, err = p.persistence.ListConcreteExecutions(request)
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, isRetryableReadError)
+	return response, err
+}
+
+func (p *workflowExecutionRetryablePersistenceClient) AddTasks(request *AddTasksRequest) error {
+	return p.persistence.AddTasks(request)
+}
+
+func (p *workflowExecutionRetryablePersistenceClient) GetTransferTask(request *GetTransferTaskRequest) (*GetTransferTaskResponse, error) {
+	var response *GetTransferTaskResponse
+	op := func() error {
+		var err error
+		response, err = p.persistence.GetTransferTask(request)
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, isRetryableReadError)
+	return response, err
+}
+
+func (p *workflowExecutionRetryablePersistenceClient) GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error) {
+	var response *GetTransferTasksResponse
+	op := func() error {
+		var err error
+		response, err = p.persistence.GetTransferTasks(request)
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, isRetryableReadError)
+	return response, err
+}
+
+func (p *workflowExecutionRetryablePersistenceClient) GetVisibilityTask(request *GetVisibilityTaskRequest) (*GetVisibilityTaskResponse, error) {
+	var response *GetVisibilityTaskResponse
+	op := func() error {
+		var err error
+		response, err = p.persistence.GetVisibilityTask(request)
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, isRetryableReadError)
+	return response, err
+}
+
+func (p *workflowExecutionRetryablePersistenceClient) GetVisibilityTasks(request *GetVisibilityTasksRequest) (*GetVisibilityTasksResponse, error) {
+	var response *GetVisibilityTasksResponse
+	op := func() error {
+		var err error
+		response, err = p.persistence.GetVisibilityTasks(request)
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, isRetryableReadError)
+	return response, err
+}
+
+func (p *workflowExecutionRetryablePersistenceClient) GetReplicationTask(request *GetReplicationTaskRequest) (*GetReplicationTaskResponse, error) {
+	var response *GetReplicationTaskResponse
+	op := func() error {
+		var err error
+		response, err = p.persistence.GetReplicationTask(request)
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, isRetryableReadError)
+	return response, err
+}
+
+func (p *workflowExecutionRetryablePersistenceClient) GetReplicationTasks(request *GetReplicationTasksRequest) (*GetReplicationTasksResponse, error) {
+	var response *GetReplicationTasksResponse
+	op := func() error {
+		var err error
+		response, err = p.persistence.GetReplicationTasks(request)
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, isRetryableReadError)
+	return response, err
+}
+
+func (p *workflowExecutionRetryablePersistenceClient) CompleteTransferTask(request *CompleteTransferTaskRequest) error {
+	op := func() error {
+		return p.persistence.CompleteTransferTask(request)
+	}
+
+	return backoff.Retry(op, p.policy, IsRetryablePersistenceError)
+}
+
+func (p *workflowExecutionRetryablePersistenceClient) RangeCompleteTransferTask(request *RangeCompleteTransferTaskRequest) error {
+	op := func() error {
+		return p.persistence.RangeCompleteTransferTask(request)
+	}
+
+	return backoff.Retry(op, p.policy, IsRetryablePersistenceError)
+}
+
+func (p *workflowExecutionRetryablePersistenceClient) CompleteVisibilityTask(request *CompleteVisibilityTaskRequest) error {
+	op := func() error {
+		return p.persistence.CompleteVisibilityTask(request)
+	}
+
+	return backoff.Retry(op, p.policy, IsRetryablePersistenceError)
+}
+
+func (p *workflowExecutionRetryablePersistenceClient) RangeCompleteVisibilityTask(request *RangeCompleteVisibilityTaskRequest) error {
+	op := func() error {
+		return p.persistence.RangeCompleteVisibilityTask(request)
+	}
+
+	return backoff.Retry(op, p.policy, IsRetryablePersistenceError)
+}
+
+func (p *workflowExecutionRetryablePersistenceClient) CompleteReplicationTask(request *CompleteReplicationTaskRequest) error {
+	op := func() error {
+		return p.persistence.CompleteReplicationTask(request)
+	}
+
+	return backoff.Retry(op, p.policy, IsRetryablePersistenceError)
+}
+
+func (p *workflowExecutionRetryablePersistenceClient) RangeCompleteReplicationTask(request *RangeCompleteReplicationTaskRequest) error {
+	op := func() error {
+		return p.persistence.RangeCompleteReplicationTask(request)
+	}
+
+	return backoff.Retry(op, p.policy, IsRetryablePersistenceError)
+}
+
+func (p *workflowExecutionRetryablePersistenceClient) PutReplicationTaskToDLQ(
+	request *PutReplicationTaskToDLQRequest,
+) error {
+	return p.persistence.PutReplicationTaskToDLQ(request)
+}
+
+func (p *workflowExecutionRetryablePersistenceClient) GetReplicationTasksFromDLQ(
+	request *GetReplicationTasksFromDLQRequest,
+) (*GetReplicationTasksFromDLQResponse, error) {
+	var response *GetReplicationTasksFromDLQResponse
+	op := func() error {
+		var err error
+		response, err = p.persistence.GetReplicationTasksFromDLQ(request)
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, isRetryableReadError)
+	return response, err
+}
+
+func (p *workflowExecutionRetryablePersistenceClient) DeleteReplicationTaskFromDLQ(
+	request *DeleteReplicationTaskFromDLQRequest,
+) error {
+	op := func() error {
+		return p.persistence.DeleteReplicationTaskFromDLQ(request)
+	}
+
+	return backoff.Retry(op, p.policy, IsRetryablePersistenceError)
+}
+
+func (p *workflowExecutionRetryablePersistenceClient) RangeDeleteReplicationTaskFromDLQ(
+	request *RangeDeleteReplicationTaskFromDLQRequest,
+) error {
+	op := func() error {
+		return p.persistence.RangeDeleteReplicationTaskFromDLQ(request)
+	}
+
+	return backoff.Retry(op, p.policy, IsRetryablePersistenceError)
+}
+
+func (p *workflowExecutionRetryablePersistenceClient) GetTimerTask(request *GetTimerTaskRequest) (*GetTimerTaskResponse, error) {
+	var response *GetTimerTaskResponse
+	op := func() error {
+		var err error
+		response, err = p.persistence.GetTimerTask(request)
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, isRetryableReadError)
+	return response, err
+}
+
+func (p *workflowExecutionRetryablePersistenceClient) GetTimerIndexTasks(request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse, error) {
+	var response *GetTimerIndexTasksResponse
+	op := func() error {
+		var err error
+		response, err = p.persistence.GetTimerIndexTasks(request)
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, isRetryableReadError)
+	return response, err
+}
+
+func (p *workflowExecutionRetryablePersistenceClient) CompleteTimerTask(request *CompleteTimerTaskRequest) error {
+	op := func() error {
+		return p.persistence.CompleteTimerTask(request)
+	}
+
+	return backoff.Retry(op, p.policy, IsRetryablePersistenceError)
+}
+
+func (p *workflowExecutionRetryablePersistenceClient) RangeCompleteTimerTask(request *RangeCompleteTimerTaskRequest) error {
+	op := func() error {
+		return p.persistence.RangeCompleteTimerTask(request)
+	}
+
+	return backoff.Retry(op, p.policy, IsRetryablePersistenceError)
+}
+
+func (p *workflowExecutionRetryablePersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *taskRetryablePersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *taskRetryablePersistenceClient) CreateTasks(request *CreateTasksRequest) (*CreateTasksResponse, error) {
+	return p.persistence.CreateTasks(request)
+}
+
+func (p *taskRetryablePersistenceClient) GetTasks(request *GetTasksRequest) (*GetTasksResponse, error) {
+	var response *GetTasksResponse
+	op := func() error {
+		var err error
+		response, err = p.persistence.GetTasks(request)
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, isRetryableReadError)
+	return response, err
+}
+
+func (p *taskRetryablePersistenceClient) CompleteTask(request *CompleteTaskRequest) error {
+	op := func() error {
+		return p.persistence.CompleteTask(request)
+	}
+
+	return backoff.Retry(op, p.policy, IsRetryablePersistenceError)
+}
+
+func (p *taskRetryablePersistenceClient) CompleteTasksLessThan(request *CompleteTasksLessThanRequest) (int, error) {
+	var response int
+	op := func() error {
+		var err error
+		response, err = p.persistence.CompleteTasksLessThan(request)
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, IsRetryablePersistenceError)
+	return response, err
+}
+
+func (p *taskRetryablePersistenceClient) LeaseTaskQueue(request *LeaseTaskQueueRequest) (*LeaseTaskQueueResponse, error) {
+	return p.persistence.LeaseTaskQueue(request)
+}
+
+func (p *taskRetryablePersistenceClient) UpdateTaskQueue(request *UpdateTaskQueueRequest) (*UpdateTaskQueueResponse, error) {
+	return p.persistence.UpdateTaskQueue(request)
+}
+
+func (p *taskRetryablePersistenceClient) ListTaskQueue(request *ListTaskQueueRequest) (*ListTaskQueueResponse, error) {
+	var response *ListTaskQueueResponse
+	op := func() error {
+		var err error
+		response, err = p.persistence.ListTaskQueue(request)
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, isRetryableReadError)
+	return response, err
+}
+
+func (p *taskRetryablePersistenceClient) DeleteTaskQueue(request *DeleteTaskQueueRequest) error {
+	op := func() error {
+		return p.persistence.DeleteTaskQueue(request)
+	}
+
+	return backoff.Retry(op, p.policy, IsRetryablePersistenceError)
+}
+
+func (p *taskRetryablePersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *historyV2RetryablePersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *historyV2RetryablePersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *historyV2RetryablePersistenceClient) AppendHistoryNodes(request *AppendHistoryNodesRequest) (*AppendHistoryNodesResponse, error) {
+	return p.persistence.AppendHistoryNodes(request)
+}
+
+func (p *historyV2RetryablePersistenceClient) ReadHistoryBranch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchResponse, error) {
+	var response *ReadHistoryBranchResponse
+	op := func() error {
+		var err error
+		response, err = p.persistence.ReadHistoryBranch(request)
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, isRetryableReadError)
+	return response, err
+}
+
+func (p *historyV2RetryablePersistenceClient) ReadHistoryBranchByBatch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchByBatchResponse, error) {
+	var response *ReadHistoryBranchByBatchResponse
+	op := func() error {
+		var err error
+		response, err = p.persistence.ReadHistoryBranchByBatch(request)
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, isRetryableReadError)
+	return response, err
+}
+
+func (p *historyV2RetryablePersistenceClient) ReadRawHistoryBranch(request *ReadHistoryBranchRequest) (*ReadRawHistoryBranchResponse, error) {
+	var response *ReadRawHistoryBranchResponse
+	op := func() error {
+		var err error
+		response, err = p.persistence.ReadRawHistoryBranch(request)
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, isRetryableReadError)
+	return response, err
+}
+
+func (p *historyV2RetryablePersistenceClient) ForkHistoryBranch(request *ForkHistoryBranchRequest) (*ForkHistoryBranchResponse, error) {
+	return p.persistence.ForkHistoryBranch(request)
+}
+
+func (p *historyV2RetryablePersistenceClient) DeleteHistoryBranch(request *DeleteHistoryBranchRequest) error {
+	op := func() error {
+		return p.persistence.DeleteHistoryBranch(request)
+	}
+
+	return backoff.Retry(op, p.policy, IsRetryablePersistenceError)
+}
+
+func (p *historyV2RetryablePersistenceClient) TrimHistoryBranch(request *TrimHistoryBranchRequest) (*TrimHistoryBranchResponse, error) {
+	return p.persistence.TrimHistoryBranch(request)
+}
+
+func (p *historyV2RetryablePersistenceClient) GetHistoryTree(request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error) {
+	var response *GetHistoryTreeResponse
+	op := func() error {
+		var err error
+		response, err = p.persistence.GetHistoryTree(request)
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, isRetryableReadError)
+	return response, err
+}
+
+func (p *historyV2RetryablePersistenceClient) GetAllHistoryTreeBranches(request *GetAllHistoryTreeBranchesRequest) (*GetAllHistoryTreeBranchesResponse, error) {
+	var response *GetAllHistoryTreeBranchesResponse
+	op := func() error {
+		var err error
+		response, err = p.persistence.GetAllHistoryTreeBranches(request)
+		return err
+	}
+
+	err := backoff.Retry(op, p.policy, isRetryableReadError)
+	return response, err
+}
@@ -0,0 +1,113 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/backoff"
+)
+
+func TestIsRetryablePersistenceError(t *testing.T) {
+	testCases := []struct {
+		err       error
+		retryable bool
+	}{
+		{err: serviceerror.NewResourceExhausted("busy"), retryable: true},
+		{err: ErrPersistenceLimitExceeded, retryable: true},
+		{err: serviceerror.NewInternal("internal"), retryable: true},
+		{err: &TimeoutError{Msg: "timeout"}, retryable: false},
+		{err: &ConditionFailedError{Msg: "condition failed"}, retryable: false},
+		{err: &CurrentWorkflowConditionFailedError{Msg: "condition failed"}, retryable: false},
+		{err: &ShardOwnershipLostError{ShardID: 1}, retryable: false},
+		{err: &WorkflowExecutionAlreadyStartedError{Msg: "already started"}, retryable: false},
+		{err: &TransactionSizeLimitError{Msg: "too large"}, retryable: false},
+		{err: serviceerror.NewNotFound("not found"), retryable: false},
+		{err: serviceerror.NewInvalidArgument("invalid"), retryable: false},
+		{err: errors.New("unknown"), retryable: false},
+		{err: nil, retryable: false},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.retryable, IsRetryablePersistenceError(tc.err), "%T", tc.err)
+	}
+}
+
+func TestWorkflowExecutionPersistenceRetryableClient(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	policy := backoff.NewExponentialRetryPolicy(time.Millisecond)
+	policy.SetMaximumAttempts(3)
+	executionStore := NewMockExecutionManager(controller)
+	executionManager := NewWorkflowExecutionPersistenceRetryableClient(executionStore, policy)
+
+	// retryable errors are retried until the call succeeds
+	response := &GetWorkflowExecutionResponse{}
+	gomock.InOrder(
+		executionStore.EXPECT().GetWorkflowExecution(gomock.Any()).Return(nil, &TimeoutError{Msg: "timeout"}),
+		executionStore.EXPECT().GetWorkflowExecution(gomock.Any()).Return(nil, serviceerror.NewResourceExhausted("busy")),
+		executionStore.EXPECT().GetWorkflowExecution(gomock.Any()).Return(response, nil),
+	)
+	actual, err := executionManager.GetWorkflowExecution(&GetWorkflowExecutionRequest{})
+	require.NoError(t, err)
+	require.Equal(t, response, actual)
+
+	// retryable errors are returned once attempts are exhausted
+	executionStore.EXPECT().GetCurrentExecution(gomock.Any()).Return(nil, &TimeoutError{Msg: "timeout"}).Times(4)
+	_, err = executionManager.GetCurrentExecution(&GetCurrentExecutionRequest{})
+	require.IsType(t, &TimeoutError{}, err)
+
+	// non retryable errors are passed straight through
+	executionStore.EXPECT().UpdateWorkflowExecution(gomock.Any()).Return(nil, &ConditionFailedError{Msg: "condition failed"})
+	_, err = executionManager.UpdateWorkflowExecution(&UpdateWorkflowExecutionRequest{})
+	require.IsType(t, &ConditionFailedError{}, err)
+
+	// writes with an unknown outcome are not retried
+	executionStore.EXPECT().UpdateWorkflowExecution(gomock.Any()).Return(nil, &TimeoutError{Msg: "timeout"})
+	_, err = executionManager.UpdateWorkflowExecution(&UpdateWorkflowExecutionRequest{})
+	require.IsType(t, &TimeoutError{}, err)
+
+	executionStore.EXPECT().CreateWorkflowExecution(gomock.Any()).Return(nil, serviceerror.NewInternal("internal"))
+	_, err = executionManager.CreateWorkflowExecution(&CreateWorkflowExecutionRequest{})
+	require.IsType(t, &serviceerror.Internal{}, err)
+
+	// idempotent writes are retried on transient errors
+	gomock.InOrder(
+		executionStore.EXPECT().CompleteTransferTask(gomock.Any()).Return(serviceerror.NewInternal("internal")),
+		executionStore.EXPECT().CompleteTransferTask(gomock.Any()).Return(nil),
+	)
+	require.NoError(t, executionManager.CompleteTransferTask(&CompleteTransferTaskRequest{}))
+
+	executionStore.EXPECT().DeleteWorkflowExecution(gomock.Any()).Return(serviceerror.NewNotFound("not found"))
+	err = executionManager.DeleteWorkflowExecution(&DeleteWorkflowExecutionRequest{})
+	require.IsType(t, &serviceerror.NotFound{}, err)
+}
@@ -0,0 +1,606 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package serialization
+
+import (
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	namespacepb "go.temporal.io/api/namespace/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
+
+	"go.temporal.io/server/common/metrics"
+)
+
+type (
+	metricsSerializer struct {
+		serializer    Serializer
+		metricsClient metrics.Client
+	}
+)
+
+var _ Serializer = (*metricsSerializer)(nil)
+
+// NewMetricsSerializer returns a Serializer which emits latency and data size metrics,
+// tagged by operation and encoding type, for every call made to the given serializer
+func NewMetricsSerializer(
+	serializer Serializer,
+	metricsClient metrics.Client,
+) Serializer {
+	return &metricsSerializer{
+		serializer:    serializer,
+		metricsClient: metricsClient,
+	}
+}
+
+func (s *metricsSerializer) SerializeEvents(
+	batch []*historypb.HistoryEvent,
+	encodingType enumspb.EncodingType,
+) (*commonpb.DataBlob, error) {
+	startTime := time.Now()
+	result, err := s.serializer.SerializeEvents(batch, encodingType)
+	s.recordSerialize("SerializeEvents", encodingType, startTime, result)
+	return result, err
+}
+
+func (s *metricsSerializer) DeserializeEvents(
+	data *commonpb.DataBlob,
+) ([]*historypb.HistoryEvent, error) {
+	startTime := time.Now()
+	result, err := s.serializer.DeserializeEvents(data)
+	s.recordDeserialize("DeserializeEvents", data, startTime)
+	return result, err
+}
+
+func (s *metricsSerializer) SerializeEvent(
+	event *historypb.HistoryEvent,
+	encodingType enumspb.EncodingType,
+) (*commonpb.DataBlob, error) {
+	startTime := time.Now()
+	result, err := s.serializer.SerializeEvent(event, encodingType)
+	s.recordSerialize("SerializeEvent", encodingType, startTime, result)
+	return result, err
+}
+
+func (s *metricsSerializer) DeserializeEvent(
+	data *commonpb.DataBlob,
+) (*historypb.HistoryEvent, error) {
+	startTime := time.Now()
+	result, err := s.serializer.DeserializeEvent(data)
+	s.recordDeserialize("DeserializeEvent", data, startTime)
+	return result, err
+}
+
+func (s *metricsSerializer) DeserializeEventFromBatch(
+	data *commonpb.DataBlob,
+	index int,
+) (*historypb.HistoryEvent, error) {
+	startTime := time.Now()
+	result, err := s.serializer.DeserializeEventFromBatch(data, index)
+	s.recordDeserialize("DeserializeEventFromBatch", data, startTime)
+	return result, err
+}
+
+func (s *metricsSerializer) ReserializeAndCompare(
+	data []byte,
+) (bool, []byte, error) {
+	startTime := time.Now()
+	stable, result, err := s.serializer.ReserializeAndCompare(data)
+	s.record("ReserializeAndCompare", enumspb.ENCODING_TYPE_PROTO3, startTime, len(data))
+	return stable, result, err
+}
+
+func (s *metricsSerializer) SerializeVisibilityMemo(
+	memo *commonpb.Memo,
+	encodingType enumspb.EncodingType,
+) (*commonpb.DataBlob, error) {
+	startTime := time.Now()
+	result, err := s.serializer.SerializeVisibilityMemo(memo, encodingType)
+	s.recordSerialize("SerializeVisibilityMemo", encodingType, startTime, result)
+	return result, err
+}
+
+func (s *metricsSerializer) DeserializeVisibilityMemo(
+	data *commonpb.DataBlob,
+) (*commonpb.Memo, error) {
+	startTime := time.Now()
+	result, err := s.serializer.DeserializeVisibilityMemo(data)
+	s.recordDeserialize("DeserializeVisibilityMemo", data, startTime)
+	return result, err
+}
+
+func (s *metricsSerializer) SerializeResetPoints(
+	event *workflowpb.ResetPoints,
+	encodingType enumspb.EncodingType,
+) (*commonpb.DataBlob, error) {
+	startTime := time.Now()
+	result, err := s.serializer.SerializeResetPoints(event, encodingType)
+	s.recordSerialize("SerializeResetPoints", encodingType, startTime, result)
+	return result, err
+}
+
+func (s *metricsSerializer) DeserializeResetPoints(
+	data *commonpb.DataBlob,
+) (*workflowpb.ResetPoints, error) {
+	startTime := time.Now()
+	result, err := s.serializer.DeserializeResetPoints(data)
+	s.recordDeserialize("DeserializeResetPoints", data, startTime)
+	return result, err
+}
+
+func (s *metricsSerializer) SerializeBadBinaries(
+	event *namespacepb.BadBinaries,
+	encodingType enumspb.EncodingType,
+) (*commonpb.DataBlob, error) {
+	startTime := time.Now()
+	result, err := s.serializer.SerializeBadBinaries(event, encodingType)
+	s.recordSerialize("SerializeBadBinaries", encodingType, startTime, result)
+	return result, err
+}
+
+func (s *metricsSerializer) DeserializeBadBinaries(
+	data *commonpb.DataBlob,
+) (*namespacepb.BadBinaries, error) {
+	startTime := time.Now()
+	result, err := s.serializer.DeserializeBadBinaries(data)
+	s.recordDeserialize("DeserializeBadBinaries", data, startTime)
+	return result, err
+}
+
+func (s *metricsSerializer) SerializeClusterMetadata(
+	icm *persistencespb.ClusterMetadata,
+	encodingType enumspb.EncodingType,
+) (*commonpb.DataBlob, error) {
+	startTime := time.Now()
+	result, err := s.serializer.SerializeClusterMetadata(icm, encodingType)
+	s.recordSerialize("SerializeClusterMetadata", encodingType, startTime, result)
+	return result, err
+}
+
+func (s *metricsSerializer) DeserializeClusterMetadata(
+	data *commonpb.DataBlob,
+) (*persistencespb.ClusterMetadata, error) {
+	startTime := time.Now()
+	result, err := s.serializer.DeserializeClusterMetadata(data)
+	s.recordDeserialize("DeserializeClusterMetadata", data, startTime)
+	return result, err
+}
+
+func (s *metricsSerializer) ShardInfoToBlob(
+	info *persistencespb.ShardInfo,
+	encodingType enumspb.EncodingType,
+) (*commonpb.DataBlob, error) {
+	startTime := time.Now()
+	result, err := s.serializer.ShardInfoToBlob(info, encodingType)
+	s.recordSerialize("ShardInfoToBlob", encodingType, startTime, result)
+	return result, err
+}
+
+func (s *metricsSerializer) ShardInfoFromBlob(
+	data *commonpb.DataBlob,
+	clusterName string,
+) (*persistencespb.ShardInfo, error) {
+	startTime := time.Now()
+	result, err := s.serializer.ShardInfoFromBlob(data, clusterName)
+	s.recordDeserialize("ShardInfoFromBlob", data, startTime)
+	return result, err
+}
+
+func (s *metricsSerializer) NamespaceDetailToBlob(
+	info *persistencespb.NamespaceDetail,
+	encodingType enumspb.EncodingType,
+) (*commonpb.DataBlob, error) {
+	startTime := time.Now()
+	result, err := s.serializer.NamespaceDetailToBlob(info, encodingType)
+	s.recordSerialize("NamespaceDetailToBlob", encodingType, startTime, result)
+	return result, err
+}
+
+func (s *metricsSerializer) NamespaceDetailFromBlob(
+	data *commonpb.DataBlob,
+) (*persistencespb.NamespaceDetail, error) {
+	startTime := time.Now()
+	result, err := s.serializer.NamespaceDetailFromBlob(data)
+	s.recordDeserialize("NamespaceDetailFromBlob", data, startTime)
+	return result, err
+}
+
+func (s *metricsSerializer) HistoryTreeInfoToBlob(
+	info *persistencespb.HistoryTreeInfo,
+	encodingType enumspb.EncodingType,
+) (*commonpb.DataBlob, error) {
+	startTime := time.Now()
+	result, err := s.serializer.HistoryTreeInfoToBlob(info, encodingType)
+	s.recordSerialize("HistoryTreeInfoToBlob", encodingType, startTime, result)
+	return result, err
+}
+
+func (s *metricsSerializer) HistoryTreeInfoFromBlob(
+	data *commonpb.DataBlob,
+) (*persistencespb.HistoryTreeInfo, error) {
+	startTime := time.Now()
+	result, err := s.serializer.HistoryTreeInfoFromBlob(data)
+	s.recordDeserialize("HistoryTreeInfoFromBlob", data, startTime)
+	return result, err
+}
+
+func (s *metricsSerializer) HistoryBranchToBlob(
+	info *persistencespb.HistoryBranch,
+	encodingType enumspb.EncodingType,
+) (*commonpb.DataBlob, error) {
+	startTime := time.Now()
+	result, err := s.serializer.HistoryBranchToBlob(info, encodingType)
+	s.recordSerialize("HistoryBranchToBlob", encodingType, startTime, result)
+	return result, err
+}
+
+func (s *metricsSerializer) HistoryBranchFromBlob(
+	data *commonpb.DataBlob,
+) (*persistencespb.HistoryBranch, error) {
+	startTime := time.Now()
+	result, err := s.serializer.HistoryBranchFromBlob(data)
+	s.recordDeserialize("HistoryBranchFromBlob", data, startTime)
+	return result, err
+}
+
+func (s *metricsSerializer) WorkflowExecutionInfoToBlob(
+	info *persistencespb.WorkflowExecutionInfo,
+	encodingType enumspb.EncodingType,
+) (*commonpb.DataBlob, error) {
+	startTime := time.Now()
+	result, err := s.serializer.WorkflowExecutionInfoToBlob(info, encodingType)
+	s.recordSerialize("WorkflowExecutionInfoToBlob", encodingType, startTime, result)
+	return result, err
+}
+
+func (s *metricsSerializer) WorkflowExecutionInfoFromBlob(
+	data *commonpb.DataBlob,
+) (*persistencespb.WorkflowExecutionInfo, error) {
+	startTime := time.Now()
+	result, err := s.serializer.WorkflowExecutionInfoFromBlob(data)
+	s.recordDeserialize("WorkflowExecutionInfoFromBlob", data, startTime)
+	return result, err
+}
+
+func (s *metricsSerializer) WorkflowExecutionStateToBlob(
+	info *persistencespb.WorkflowExecutionState,
+	encodingType enumspb.EncodingType,
+) (*commonpb.DataBlob, error) {
+	startTime := time.Now()
+	result, err := s.serializer.WorkflowExecutionStateToBlob(info, encodingType)
+	s.recordSerialize("WorkflowExecutionStateToBlob", encodingType, startTime, result)
+	return result, err
+}
+
+func (s *metricsSerializer) WorkflowExecutionStateFromBlob(
+	data *commonpb.DataBlob,
+) (*persistencespb.WorkflowExecutionState, error) {
+	startTime := time.Now()
+	result, err := s.serializer.WorkflowExecutionStateFromBlob(data)
+	s.recordDeserialize("WorkflowExecutionStateFromBlob", data, startTime)
+	return result, err
+}
+
+func (s *metricsSerializer) ActivityInfoToBlob(
+	info *persistencespb.ActivityInfo,
+	encodingType enumspb.EncodingType,
+) (*commonpb.DataBlob, error) {
+	startTime := time.Now()
+	result, err := s.serializer.ActivityInfoToBlob(info, encodingType)
+	s.recordSerialize("ActivityInfoToBlob", encodingType, startTime, result)
+	return result, err
+}
+
+func (s *metricsSerializer) ActivityInfoFromBlob(
+	data *commonpb.DataBlob,
+) (*persistencespb.ActivityInfo, error) {
+	startTime := time.Now()
+	result, err := s.serializer.ActivityInfoFromBlob(data)
+	s.recordDeserialize("ActivityInfoFromBlob", data, startTime)
+	return result, err
+}
+
+func (s *metricsSerializer) ChildExecutionInfoToBlob(
+	info *persistencespb.ChildExecutionInfo,
+	encodingType enumspb.EncodingType,
+) (*commonpb.DataBlob, error) {
+	startTime := time.Now()
+	result, err := s.serializer.ChildExecutionInfoToBlob(info, encodingType)
+	s.recordSerialize("ChildExecutionInfoToBlob", encodingType, startTime, result)
+	return result, err
+}
+
+func (s *metricsSerializer) ChildExecutionInfoFromBlob(
+	data *commonpb.DataBlob,
+) (*persistencespb.ChildExecutionInfo, error) {
+	startTime := time.Now()
+	result, err := s.serializer.ChildExecutionInfoFromBlob(data)
+	s.recordDeserialize("ChildExecutionInfoFromBlob", data, startTime)
+	return result, err
+}
+
+func (s *metricsSerializer) SignalInfoToBlob(
+	info *persistencespb.SignalInfo,
+	encodingType enumspb.EncodingType,
+) (*commonpb.DataBlob, error) {
+	startTime := time.Now()
+	result, err := s.serializer.SignalInfoToBlob(info, encodingType)
+	s.recordSerialize("SignalInfoToBlob", encodingType, startTime, result)
+	return result, err
+}
+
+func (s *metricsSerializer) SignalInfoFromBlob(
+	data *commonpb.DataBlob,
+) (*persistencespb.SignalInfo, error) {
+	startTime := time.Now()
+	result, err := s.serializer.SignalInfoFromBlob(data)
+	s.recordDeserialize("SignalInfoFromBlob", data, startTime)
+	return result, err
+}
+
+func (s *metricsSerializer) RequestCancelInfoToBlob(
+	info *persistencespb.RequestCancelInfo,
+	encodingType enumspb.EncodingType,
+) (*commonpb.DataBlob, error) {
+	startTime := time.Now()
+	result, err := s.serializer.RequestCancelInfoToBlob(info, encodingType)
+	s.recordSerialize("RequestCancelInfoToBlob", encodingType, startTime, result)
+	return result, err
+}
+
+func (s *metricsSerializer) RequestCancelInfoFromBlob(
+	data *commonpb.DataBlob,
+) (*persistencespb.RequestCancelInfo, error) {
+	startTime := time.Now()
+	result, err := s.serializer.RequestCancelInfoFromBlob(data)
+	s.recordDeserialize("RequestCancelInfoFromBlob", data, startTime)
+	return result, err
+}
+
+func (s *metricsSerializer) TimerInfoToBlob(
+	info *persistencespb.TimerInfo,
+	encodingType enumspb.EncodingType,
+) (*commonpb.DataBlob, error) {
+	startTime := time.Now()
+	result, err := s.serializer.TimerInfoToBlob(info, encodingType)
+	s.recordSerialize("TimerInfoToBlob", encodingType, startTime, result)
+	return result, err
+}
+
+func (s *metricsSerializer) TimerInfoFromBlob(
+	data *commonpb.DataBlob,
+) (*persistencespb.TimerInfo, error) {
+	startTime := time.Now()
+	result, err := s.serializer.TimerInfoFromBlob(data)
+	s.recordDeserialize("TimerInfoFromBlob", data, startTime)
+	return result, err
+}
+
+func (s *metricsSerializer) TaskInfoToBlob(
+	info *persistencespb.AllocatedTaskInfo,
+	encodingType enumspb.EncodingType,
+) (*commonpb.DataBlob, error) {
+	startTime := time.Now()
+	result, err := s.serializer.TaskInfoToBlob(info, encodingType)
+	s.recordSerialize("TaskInfoToBlob", encodingType, startTime, result)
+	return result, err
+}
+
+func (s *metricsSerializer) TaskInfoFromBlob(
+	data *commonpb.DataBlob,
+) (*persistencespb.AllocatedTaskInfo, error) {
+	startTime := time.Now()
+	result, err := s.serializer.TaskInfoFromBlob(data)
+	s.recordDeserialize("TaskInfoFromBlob", data, startTime)
+	return result, err
+}
+
+func (s *metricsSerializer) TaskQueueInfoToBlob(
+	info *persistencespb.TaskQueueInfo,
+	encodingType enumspb.EncodingType,
+) (*commonpb.DataBlob, error) {
+	startTime := time.Now()
+	result, err := s.serializer.TaskQueueInfoToBlob(info, encodingType)
+	s.recordSerialize("TaskQueueInfoToBlob", encodingType, startTime, result)
+	return result, err
+}
+
+func (s *metricsSerializer) TaskQueueInfoFromBlob(
+	data *commonpb.DataBlob,
+) (*persistencespb.TaskQueueInfo, error) {
+	startTime := time.Now()
+	result, err := s.serializer.TaskQueueInfoFromBlob(data)
+	s.recordDeserialize("TaskQueueInfoFromBlob", data, startTime)
+	return result, err
+}
+
+func (s *metricsSerializer) TransferTaskInfoToBlob(
+	info *persistencespb.TransferTaskInfo,
+	encodingType enumspb.EncodingType,
+) (*commonpb.DataBlob, error) {
+	startTime := time.Now()
+	result, err := s.serializer.TransferTaskInfoToBlob(info, encodingType)
+	s.recordSerialize("TransferTaskInfoToBlob", encodingType, startTime, result)
+	return result, err
+}
+
+func (s *metricsSerializer) TransferTaskInfoFromBlob(
+	data *commonpb.DataBlob,
+) (*persistencespb.TransferTaskInfo, error) {
+	startTime := time.Now()
+	result, err := s.serializer.TransferTaskInfoFromBlob(data)
+	s.recordDeserialize("TransferTaskInfoFromBlob", data, startTime)
+	return result, err
+}
+
+func (s *metricsSerializer) TimerTaskInfoToBlob(
+	info *persistencespb.TimerTaskInfo,
+	encodingType enumspb.EncodingType,
+) (*commonpb.DataBlob, error) {
+	startTime := time.Now()
+	result, err := s.serializer.TimerTaskInfoToBlob(info, encodingType)
+	s.recordSerialize("TimerTaskInfoToBlob", encodingType, startTime, result)
+	return result, err
+}
+
+func (s *metricsSerializer) TimerTaskInfoFromBlob(
+	data *commonpb.DataBlob,
+) (*persistencespb.TimerTaskInfo, error) {
+	startTime := time.Now()
+	result, err := s.serializer.TimerTaskInfoFromBlob(data)
+	s.recordDeserialize("TimerTaskInfoFromBlob", data, startTime)
+	return result, err
+}
+
+func (s *metricsSerializer) ReplicationTaskInfoToBlob(
+	info *persistencespb.ReplicationTaskInfo,
+	encodingType enumspb.EncodingType,
+) (*commonpb.DataBlob, error) {
+	startTime := time.Now()
+	result, err := s.serializer.ReplicationTaskInfoToBlob(info, encodingType)
+	s.recordSerialize("ReplicationTaskInfoToBlob", encodingType, startTime, result)
+	return result, err
+}
+
+func (s *metricsSerializer) ReplicationTaskInfoFromBlob(
+	data *commonpb.DataBlob,
+) (*persistencespb.ReplicationTaskInfo, error) {
+	startTime := time.Now()
+	result, err := s.serializer.ReplicationTaskInfoFromBlob(data)
+	s.recordDeserialize("ReplicationTaskInfoFromBlob", data, startTime)
+	return result, err
+}
+
+func (s *metricsSerializer) VisibilityTaskInfoToBlob(
+	info *persistencespb.VisibilityTaskInfo,
+	encodingType enumspb.EncodingType,
+) (*commonpb.DataBlob, error) {
+	startTime := time.Now()
+	result, err := s.serializer.VisibilityTaskInfoToBlob(info, encodingType)
+	s.recordSerialize("VisibilityTaskInfoToBlob", encodingType, startTime, result)
+	return result, err
+}
+
+func (s *metricsSerializer) VisibilityTaskInfoFromBlob(
+	data *commonpb.DataBlob,
+) (*persistencespb.VisibilityTaskInfo, error) {
+	startTime := time.Now()
+	result, err := s.serializer.VisibilityTaskInfoFromBlob(data)
+	s.recordDeserialize("VisibilityTaskInfoFromBlob", data, startTime)
+	return result, err
+}
+
+func (s *metricsSerializer) ChecksumToBlob(
+	checksum *persistencespb.Checksum,
+	encodingType enumspb.EncodingType,
+) (*commonpb.DataBlob, error) {
+	startTime := time.Now()
+	result, err := s.serializer.ChecksumToBlob(checksum, encodingType)
+	s.recordSerialize("ChecksumToBlob", encodingType, startTime, result)
+	return result, err
+}
+
+func (s *metricsSerializer) ChecksumFromBlob(
+	data *commonpb.DataBlob,
+) (*persistencespb.Checksum, error) {
+	startTime := time.Now()
+	result, err := s.serializer.ChecksumFromBlob(data)
+	s.recordDeserialize("ChecksumFromBlob", data, startTime)
+	return result, err
+}
+
+func (s *metricsSerializer) QueueMetadataToBlob(
+	metadata *persistencespb.QueueMetadata,
+	encodingType enumspb.EncodingType,
+) (*commonpb.DataBlob, error) {
+	startTime := time.Now()
+	result, err := s.serializer.QueueMetadataToBlob(metadata, encodingType)
+	s.recordSerialize("QueueMetadataToBlob", encodingType, startTime, result)
+	return result, err
+}
+
+func (s *metricsSerializer) QueueMetadataFromBlob(
+	data *commonpb.DataBlob,
+) (*persistencespb.QueueMetadata, error) {
+	startTime := time.Now()
+	result, err := s.serializer.QueueMetadataFromBlob(data)
+	s.recordDeserialize("QueueMetadataFromBlob", data, startTime)
+	return result, err
+}
+
+func (s *metricsSerializer) ReplicationTaskToBlob(
+	replicationTask *replicationspb.ReplicationTask,
+	encodingType enumspb.EncodingType,
+) (*commonpb.DataBlob, error) {
+	startTime := time.Now()
+	result, err := s.serializer.ReplicationTaskToBlob(replicationTask, encodingType)
+	s.recordSerialize("ReplicationTaskToBlob", encodingType, startTime, result)
+	return result, err
+}
+
+func (s *metricsSerializer) ReplicationTaskFromBlob(
+	data *commonpb.DataBlob,
+) (*replicationspb.ReplicationTask, error) {
+	startTime := time.Now()
+	result, err := s.serializer.ReplicationTaskFromBlob(data)
+	s.recordDeserialize("ReplicationTaskFromBlob", data, startTime)
+	return result, err
+}
+
+func (s *metricsSerializer) recordSerialize(
+	operation string,
+	encodingType enumspb.EncodingType,
+	startTime time.Time,
+	blob *commonpb.DataBlob,
+) {
+	s.record(operation, encodingType, startTime, len(blob.GetData()))
+}
+
+func (s *metricsSerializer) recordDeserialize(
+	operation string,
+	data *commonpb.DataBlob,
+	startTime time.Time,
+) {
+	s.record(operation, data.GetEncodingType(), startTime, len(data.GetData()))
+}
+
+func (s *metricsSerializer) record(
+	operation string,
+	encodingType enumspb.EncodingType,
+	startTime time.Time,
+	size int,
+) {
+	scope := s.metricsClient.Scope(
+		metrics.PersistenceSerializerScope,
+		metrics.SerializerOperationTag(operation),
+		metrics.EncodingTypeTag(encodingType.String()),
+	)
+	scope.RecordTimer(metrics.SerializerLatency, time.Since(startTime))
+	scope.RecordDistribution(metrics.SerializerDataSize, size)
+}
@@ -0,0 +1,99 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package serialization
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/common/metrics"
+)
+
+type (
+	metricsSerializerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller    *gomock.Controller
+		metricsClient *metrics.MockClient
+		metricsScope  *metrics.MockScope
+		serializer    Serializer
+	}
+)
+
+func TestMetricsSerializerSuite(t *testing.T) {
+	s := new(metricsSerializerSuite)
+	suite.Run(t, s)
+}
+
+func (s *metricsSerializerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.metricsClient = metrics.NewMockClient(s.controller)
+	s.metricsScope = metrics.NewMockScope(s.controller)
+	s.serializer = NewMetricsSerializer(NewSerializer(), s.metricsClient)
+}
+
+func (s *metricsSerializerSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *metricsSerializerSuite) TestSerializeAndDeserialize() {
+	events := testEventBatch(5)
+
+	s.expectMetrics("SerializeEvents", enumspb.ENCODING_TYPE_PROTO3, gomock.Not(0))
+	blob, err := s.serializer.SerializeEvents(events, enumspb.ENCODING_TYPE_PROTO3)
+	s.NoError(err)
+
+	s.expectMetrics("DeserializeEvents", enumspb.ENCODING_TYPE_PROTO3, len(blob.Data))
+	result, err := s.serializer.DeserializeEvents(blob)
+	s.NoError(err)
+	s.Equal(events, result)
+}
+
+func (s *metricsSerializerSuite) TestSerializeFailure() {
+	s.expectMetrics("SerializeEvent", enumspb.ENCODING_TYPE_UNSPECIFIED, 0)
+	_, err := s.serializer.SerializeEvent(testEventBatch(1)[0], enumspb.ENCODING_TYPE_UNSPECIFIED)
+	s.IsType(&UnknownEncodingTypeError{}, err)
+}
+
+func (s *metricsSerializerSuite) expectMetrics(
+	operation string,
+	encodingType enumspb.EncodingType,
+	size interface{},
+) {
+	s.metricsClient.EXPECT().Scope(
+		metrics.PersistenceSerializerScope,
+		metrics.SerializerOperationTag(operation),
+		metrics.EncodingTypeTag(encodingType.String()),
+	).Return(s.metricsScope)
+	s.metricsScope.EXPECT().RecordTimer(metrics.SerializerLatency, gomock.Any())
+	s.metricsScope.EXPECT().RecordDistribution(metrics.SerializerDataSize, size)
+}
@@ -25,6 +25,7 @@
 package serialization
 
 import (
+	"bytes"
 	"fmt"
 	"reflect"
 	"time"
@@ -39,6 +40,8 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
 	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/protoschema"
+	"go.temporal.io/server/common/wirelimits"
 )
 
 type (
@@ -52,6 +55,15 @@ type (
 		// serialize/deserialize a single history event
 		SerializeEvent(event *historypb.HistoryEvent, encodingType enumspb.EncodingType) (*commonpb.DataBlob, error)
 		DeserializeEvent(data *commonpb.DataBlob) (*historypb.HistoryEvent, error)
+		// DeserializeEventFromBatch decodes only the event at the given index of a blob
+		// produced by SerializeEvents, skipping over the other events
+		DeserializeEventFromBatch(data *commonpb.DataBlob, index int) (*historypb.HistoryEvent, error)
+		// ReserializeAndCompare decodes a proto3 encoded batch of history events and encodes it again.
+		// stable is true if the result is byte identical to data. If it is not, but data only differs
+		// in encoding, stable is false and no error is returned; a DeserializationError is returned
+		// if data can't be decoded and a ReserializationMismatchError if data has fields unknown to
+		// the schema, which are lost when re-serialized.
+		ReserializeAndCompare(data []byte) (stable bool, reserialized []byte, err error)
 
 		// serialize/deserialize visibility memo fields
 		SerializeVisibilityMemo(memo *commonpb.Memo, encodingType enumspb.EncodingType) (*commonpb.DataBlob, error)
@@ -145,6 +157,18 @@ type (
 		encodingType enumspb.EncodingType
 	}
 
+	// EventIndexOutOfRangeError is an error type for an event index outside of a serialized batch
+	EventIndexOutOfRangeError struct {
+		index int
+		count int
+	}
+
+	// ReserializationMismatchError is an error type for a batch of events which changed when re-serialized
+	ReserializationMismatchError struct {
+		originalSize     int
+		reserializedSize int
+	}
+
 	serializerImpl struct{}
 )
 
@@ -171,8 +195,10 @@ func (t *serializerImpl) DeserializeEvents(data *commonpb.DataBlob) ([]*historyp
 	case enumspb.ENCODING_TYPE_PROTO3:
 		// Client API currently specifies encodingType on requests which span multiple of these objects
 		err = events.Unmarshal(data.Data)
+	case enumspb.ENCODING_TYPE_JSON:
+		err = codec.NewJSONPBEncoder().Decode(data.Data, events)
 	default:
-		return nil, NewDeserializationError("DeserializeEvents invalid encoding")
+		return nil, NewUnknownEncodingTypeError(data.EncodingType)
 	}
 	if err != nil {
 		return nil, err
@@ -212,6 +238,70 @@ func (t *serializerImpl) DeserializeEvent(data *commonpb.DataBlob) (*historypb.H
 	return event, err
 }
 
+func (t *serializerImpl) DeserializeEventFromBatch(data *commonpb.DataBlob, index int) (*historypb.HistoryEvent, error) {
+	if index < 0 {
+		return nil, NewEventIndexOutOfRangeError(index, 0)
+	}
+	if data == nil || len(data.Data) == 0 {
+		return nil, NewEventIndexOutOfRangeError(index, 0)
+	}
+	if data.EncodingType != enumspb.ENCODING_TYPE_PROTO3 {
+		return nil, NewDeserializationError("DeserializeEventFromBatch invalid encoding")
+	}
+
+	// walk the wire format of historypb.History, only unmarshalling the requested
+	// element of its repeated events field (field 1, length delimited)
+	buf := data.Data
+	count := 0
+	for len(buf) > 0 {
+		key, n := proto.DecodeVarint(buf)
+		if n == 0 {
+			return nil, NewDeserializationError("DeserializeEventFromBatch malformed field key")
+		}
+		buf = buf[n:]
+		fieldNumber, wireType := key>>3, key&0x7
+
+		var fieldLength uint64
+		switch wireType {
+		case proto.WireVarint:
+			_, n = proto.DecodeVarint(buf)
+			if n == 0 {
+				return nil, NewDeserializationError("DeserializeEventFromBatch malformed varint")
+			}
+			buf = buf[n:]
+			continue
+		case proto.WireFixed64:
+			fieldLength = 8
+		case proto.WireFixed32:
+			fieldLength = 4
+		case proto.WireBytes:
+			fieldLength, n = proto.DecodeVarint(buf)
+			if n == 0 {
+				return nil, NewDeserializationError("DeserializeEventFromBatch malformed field length")
+			}
+			buf = buf[n:]
+		default:
+			return nil, NewDeserializationError(fmt.Sprintf("DeserializeEventFromBatch unsupported wire type %v", wireType))
+		}
+		if fieldLength > uint64(len(buf)) {
+			return nil, NewDeserializationError("DeserializeEventFromBatch truncated data")
+		}
+
+		if fieldNumber == 1 && wireType == proto.WireBytes {
+			if count == index {
+				event := &historypb.HistoryEvent{}
+				if err := event.Unmarshal(buf[:fieldLength]); err != nil {
+					return nil, err
+				}
+				return event, nil
+			}
+			count++
+		}
+		buf = buf[fieldLength:]
+	}
+	return nil, NewEventIndexOutOfRangeError(index, count)
+}
+
 func (t *serializerImpl) SerializeResetPoints(rp *workflowpb.ResetPoints, encodingType enumspb.EncodingType) (*commonpb.DataBlob, error) {
 	if rp == nil {
 		rp = &workflowpb.ResetPoints{}
@@ -278,6 +368,27 @@ func (t *serializerImpl) DeserializeBadBinaries(data *commonpb.DataBlob) (*names
 	return memo, err
 }
 
+func (t *serializerImpl) ReserializeAndCompare(data []byte) (bool, []byte, error) {
+	original := &historypb.History{}
+	if err := original.Unmarshal(data); err != nil {
+		return false, nil, NewDeserializationError(err.Error())
+	}
+	reserialized, err := original.Marshal()
+	if err != nil {
+		return false, nil, NewSerializationError(err.Error())
+	}
+	if bytes.Equal(data, reserialized) {
+		return true, reserialized, nil
+	}
+
+	// bytes differ (e.g. fields out of order or non-canonical varints), Unmarshal keeps every
+	// known field so the events only changed if data has fields unknown to the schema
+	if protoschema.HasUnknownFields(data, proto.MessageName(original)) {
+		return false, reserialized, NewReserializationMismatchError(len(data), len(reserialized))
+	}
+	return false, reserialized, nil
+}
+
 func (t *serializerImpl) SerializeVisibilityMemo(memo *commonpb.Memo, encodingType enumspb.EncodingType) (*commonpb.DataBlob, error) {
 	if memo == nil {
 		// Return nil here to be consistent with Event
@@ -386,6 +497,24 @@ func (e *UnknownEncodingTypeError) Error() string {
 	return fmt.Sprintf("unknown or unsupported encoding type %v", e.encodingType)
 }
 
+// NewEventIndexOutOfRangeError returns a new instance of an EventIndexOutOfRangeError
+func NewEventIndexOutOfRangeError(index int, count int) error {
+	return &EventIndexOutOfRangeError{index: index, count: count}
+}
+
+func (e *EventIndexOutOfRangeError) Error() string {
+	return fmt.Sprintf("event index %v out of range for batch of %v events", e.index, e.count)
+}
+
+// NewReserializationMismatchError returns a ReserializationMismatchError
+func NewReserializationMismatchError(originalSize int, reserializedSize int) error {
+	return &ReserializationMismatchError{originalSize: originalSize, reserializedSize: reserializedSize}
+}
+
+func (e *ReserializationMismatchError) Error() string {
+	return fmt.Sprintf("re-serialized events differ from original: original size %v, re-serialized size %v", e.originalSize, e.reserializedSize)
+}
+
 // NewSerializationError returns a SerializationError
 func NewSerializationError(msg string) error {
 	return &SerializationError{msg: msg}
@@ -615,6 +744,10 @@ func (t *serializerImpl) ReplicationTaskToBlob(replicationTask *replicationspb.R
 }
 
 func (t *serializerImpl) ReplicationTaskFromBlob(data *commonpb.DataBlob) (*replicationspb.ReplicationTask, error) {
+	// replication tasks may come from a remote cluster, bound the work done decoding them
+	if err := wirelimits.Check(data.GetData(), &replicationspb.ReplicationTask{}, wirelimits.Default); err != nil {
+		return nil, NewDeserializationError(err.Error())
+	}
 	result := &replicationspb.ReplicationTask{}
 	return result, proto3DecodeBlob(data, result)
 }
@@ -25,6 +25,7 @@
 package serialization
 
 import (
+	"bytes"
 	"reflect"
 	"sync"
 	"testing"
@@ -46,6 +47,7 @@ import (
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/wirelimits"
 )
 
 type (
@@ -296,3 +298,122 @@ func (s *temporalSerializerSuite) TestSerializer() {
 	succ := common.AwaitWaitGroup(&doneWG, 10*time.Second)
 	s.True(succ, "test timed out")
 }
+
+func (s *temporalSerializerSuite) TestDeserializeEventFromBatch() {
+	serializer := NewSerializer()
+	events := testEventBatch(5)
+	blob, err := serializer.SerializeEvents(events, enumspb.ENCODING_TYPE_PROTO3)
+	s.NoError(err)
+
+	for i, expected := range events {
+		event, err := serializer.DeserializeEventFromBatch(blob, i)
+		s.NoError(err)
+		s.Equal(expected, event)
+	}
+
+	_, err = serializer.DeserializeEventFromBatch(blob, 5)
+	s.IsType(&EventIndexOutOfRangeError{}, err)
+	_, err = serializer.DeserializeEventFromBatch(blob, -1)
+	s.IsType(&EventIndexOutOfRangeError{}, err)
+	_, err = serializer.DeserializeEventFromBatch(nil, 0)
+	s.IsType(&EventIndexOutOfRangeError{}, err)
+
+	_, err = serializer.DeserializeEventFromBatch(&commonpb.DataBlob{
+		EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+		Data:         blob.Data[:len(blob.Data)-1],
+	}, 4)
+	s.IsType(&DeserializationError{}, err)
+}
+
+func (s *temporalSerializerSuite) TestReserializeAndCompare() {
+	serializer := NewSerializer()
+	blob, err := serializer.SerializeEvents(testEventBatch(5), enumspb.ENCODING_TYPE_PROTO3)
+	s.NoError(err)
+
+	stable, reserialized, err := serializer.ReserializeAndCompare(blob.Data)
+	s.NoError(err)
+	s.True(stable)
+	s.Equal(blob.Data, reserialized)
+
+	// History{Events: [{EventId: 5, EventType: 1}]} with the event fields out of order
+	outOfOrder := []byte{0x0a, 0x04, 0x18, 0x01, 0x08, 0x05}
+	// History{Events: [{EventId: 1}]} with EventId encoded as a non minimal varint
+	nonMinimalVarint := []byte{0x0a, 0x03, 0x08, 0x81, 0x00}
+	for _, data := range [][]byte{outOfOrder, nonMinimalVarint} {
+		stable, reserialized, err = serializer.ReserializeAndCompare(data)
+		s.NoError(err)
+		s.False(stable)
+		s.NotEqual(data, reserialized)
+		original, err := serializer.DeserializeEvents(&commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: data})
+		s.NoError(err)
+		roundTripped, err := serializer.DeserializeEvents(&commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: reserialized})
+		s.NoError(err)
+		s.Equal(original, roundTripped)
+	}
+
+	// History{Events: [{EventId: 1}]} with an event field 1000 unknown to the schema
+	unknownEventField := []byte{0x0a, 0x05, 0x08, 0x01, 0xc0, 0x3e, 0x01}
+	// History{Events: [{EventId: 1}]} with a history field 2 unknown to the schema
+	unknownHistoryField := []byte{0x0a, 0x02, 0x08, 0x01, 0x10, 0x01}
+	for _, data := range [][]byte{unknownEventField, unknownHistoryField} {
+		stable, reserialized, err = serializer.ReserializeAndCompare(data)
+		s.IsType(&ReserializationMismatchError{}, err)
+		s.False(stable)
+		s.Equal([]byte{0x0a, 0x02, 0x08, 0x01}, reserialized)
+	}
+
+	stable, reserialized, err = serializer.ReserializeAndCompare(blob.Data[:len(blob.Data)-1])
+	s.IsType(&DeserializationError{}, err)
+	s.False(stable)
+	s.Nil(reserialized)
+}
+
+func (s *temporalSerializerSuite) TestReplicationTaskFromBlob_WireLimitExceeded() {
+	serializer := NewSerializer()
+	_, err := serializer.ReplicationTaskFromBlob(&commonpb.DataBlob{
+		EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+		// field 1 groups nested deeper than the default limit
+		Data: append(bytes.Repeat([]byte{0x0b}, wirelimits.Default.MaxDepth+1), bytes.Repeat([]byte{0x0c}, wirelimits.Default.MaxDepth+1)...),
+	})
+	s.IsType(&DeserializationError{}, err)
+}
+
+func BenchmarkDeserializeEvents(b *testing.B) {
+	serializer := NewSerializer()
+	blob, _ := serializer.SerializeEvents(testEventBatch(1000), enumspb.ENCODING_TYPE_PROTO3)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		events, _ := serializer.DeserializeEvents(blob)
+		_ = events[500]
+	}
+}
+
+func BenchmarkDeserializeEventFromBatch(b *testing.B) {
+	serializer := NewSerializer()
+	blob, _ := serializer.SerializeEvents(testEventBatch(1000), enumspb.ENCODING_TYPE_PROTO3)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = serializer.DeserializeEventFromBatch(blob, 500)
+	}
+}
+
+func testEventBatch(size int) []*historypb.HistoryEvent {
+	events := make([]*historypb.HistoryEvent, 0, size)
+	for i := 0; i < size; i++ {
+		events = append(events, &historypb.HistoryEvent{
+			EventId:   int64(i + 1),
+			EventTime: timestamp.TimePtr(time.Date(2020, 8, 22, 0, 0, 0, 0, time.UTC)),
+			EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_COMPLETED,
+			Attributes: &historypb.HistoryEvent_ActivityTaskCompletedEventAttributes{
+				ActivityTaskCompletedEventAttributes: &historypb.ActivityTaskCompletedEventAttributes{
+					Result:           payloads.EncodeString("result"),
+					ScheduledEventId: int64(i),
+					Identity:         "identity",
+				},
+			},
+		})
+	}
+	return events
+}
@@ -110,7 +110,8 @@ func (s *TestCluster) Config() config.Persistence {
 		DataStores: map[string]config.DataStore{
 			"test": {SQL: &cfg},
 		},
-		TransactionSizeLimit: dynamicconfig.GetIntPropertyFn(common.DefaultTransactionSizeLimit),
+		TransactionSizeLimit:  dynamicconfig.GetIntPropertyFn(common.DefaultTransactionSizeLimit),
+		MutableStateSizeLimit: dynamicconfig.GetIntPropertyFn(common.DefaultMutableStateSizeLimit),
 		VisibilityConfig: &config.VisibilityConfig{
 			EnableSampling: dynamicconfig.GetBoolPropertyFn(false),
 		},
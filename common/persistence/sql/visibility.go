@@ -25,6 +25,7 @@
 package sql
 
 import (
+	"context"
 	"database/sql"
 	"encoding/json"
 	"fmt"
@@ -118,9 +119,10 @@ func (s *sqlVisibilityStore) UpsertWorkflowExecution(
 }
 
 func (s *sqlVisibilityStore) ListOpenWorkflowExecutions(
+	ctx context.Context,
 	request *p.ListWorkflowExecutionsRequest,
 ) (*p.InternalListWorkflowExecutionsResponse, error) {
-	ctx, cancel := newVisibilityContext()
+	ctx, cancel := context.WithTimeout(ctx, visibilityTimeout)
 	defer cancel()
 	return s.listWorkflowExecutions(
 		"ListOpenWorkflowExecutions",
@@ -141,9 +143,10 @@ func (s *sqlVisibilityStore) ListOpenWorkflowExecutions(
 }
 
 func (s *sqlVisibilityStore) ListClosedWorkflowExecutions(
+	ctx context.Context,
 	request *p.ListWorkflowExecutionsRequest,
 ) (*p.InternalListWorkflowExecutionsResponse, error) {
-	ctx, cancel := newVisibilityContext()
+	ctx, cancel := context.WithTimeout(ctx, visibilityTimeout)
 	defer cancel()
 	return s.listWorkflowExecutions("ListClosedWorkflowExecutions",
 		request.NextPageToken,
@@ -162,9 +165,10 @@ func (s *sqlVisibilityStore) ListClosedWorkflowExecutions(
 }
 
 func (s *sqlVisibilityStore) ListOpenWorkflowExecutionsByType(
+	ctx context.Context,
 	request *p.ListWorkflowExecutionsByTypeRequest,
 ) (*p.InternalListWorkflowExecutionsResponse, error) {
-	ctx, cancel := newVisibilityContext()
+	ctx, cancel := context.WithTimeout(ctx, visibilityTimeout)
 	defer cancel()
 	return s.listWorkflowExecutions("ListOpenWorkflowExecutionsByType",
 		request.NextPageToken,
@@ -185,9 +189,10 @@ func (s *sqlVisibilityStore) ListOpenWorkflowExecutionsByType(
 }
 
 func (s *sqlVisibilityStore) ListClosedWorkflowExecutionsByType(
+	ctx context.Context,
 	request *p.ListWorkflowExecutionsByTypeRequest,
 ) (*p.InternalListWorkflowExecutionsResponse, error) {
-	ctx, cancel := newVisibilityContext()
+	ctx, cancel := context.WithTimeout(ctx, visibilityTimeout)
 	defer cancel()
 	return s.listWorkflowExecutions("ListClosedWorkflowExecutionsByType",
 		request.NextPageToken,
@@ -207,9 +212,10 @@ func (s *sqlVisibilityStore) ListClosedWorkflowExecutionsByType(
 }
 
 func (s *sqlVisibilityStore) ListOpenWorkflowExecutionsByWorkflowID(
+	ctx context.Context,
 	request *p.ListWorkflowExecutionsByWorkflowIDRequest,
 ) (*p.InternalListWorkflowExecutionsResponse, error) {
-	ctx, cancel := newVisibilityContext()
+	ctx, cancel := context.WithTimeout(ctx, visibilityTimeout)
 	defer cancel()
 	return s.listWorkflowExecutions("ListOpenWorkflowExecutionsByWorkflowID",
 		request.NextPageToken,
@@ -230,9 +236,10 @@ func (s *sqlVisibilityStore) ListOpenWorkflowExecutionsByWorkflowID(
 }
 
 func (s *sqlVisibilityStore) ListClosedWorkflowExecutionsByWorkflowID(
+	ctx context.Context,
 	request *p.ListWorkflowExecutionsByWorkflowIDRequest,
 ) (*p.InternalListWorkflowExecutionsResponse, error) {
-	ctx, cancel := newVisibilityContext()
+	ctx, cancel := context.WithTimeout(ctx, visibilityTimeout)
 	defer cancel()
 	return s.listWorkflowExecutions("ListClosedWorkflowExecutionsByWorkflowID",
 		request.NextPageToken,
@@ -252,9 +259,10 @@ func (s *sqlVisibilityStore) ListClosedWorkflowExecutionsByWorkflowID(
 }
 
 func (s *sqlVisibilityStore) ListClosedWorkflowExecutionsByStatus(
+	ctx context.Context,
 	request *p.ListClosedWorkflowExecutionsByStatusRequest,
 ) (*p.InternalListWorkflowExecutionsResponse, error) {
-	ctx, cancel := newVisibilityContext()
+	ctx, cancel := context.WithTimeout(ctx, visibilityTimeout)
 	defer cancel()
 	return s.listWorkflowExecutions("ListClosedWorkflowExecutionsByStatus",
 		request.NextPageToken,
@@ -274,9 +282,10 @@ func (s *sqlVisibilityStore) ListClosedWorkflowExecutionsByStatus(
 }
 
 func (s *sqlVisibilityStore) GetClosedWorkflowExecution(
+	ctx context.Context,
 	request *p.GetClosedWorkflowExecutionRequest,
 ) (*p.InternalGetClosedWorkflowExecutionResponse, error) {
-	ctx, cancel := newVisibilityContext()
+	ctx, cancel := context.WithTimeout(ctx, visibilityTimeout)
 	defer cancel()
 	execution := request.Execution
 	rows, err := s.db.SelectFromVisibility(ctx, sqlplugin.VisibilitySelectFilter{
@@ -312,18 +321,21 @@ func (s *sqlVisibilityStore) DeleteWorkflowExecution(
 }
 
 func (s *sqlVisibilityStore) ListWorkflowExecutions(
+	ctx context.Context,
 	request *p.ListWorkflowExecutionsRequestV2,
 ) (*p.InternalListWorkflowExecutionsResponse, error) {
 	return nil, p.NewOperationNotSupportErrorForVis()
 }
 
 func (s *sqlVisibilityStore) ScanWorkflowExecutions(
+	ctx context.Context,
 	request *p.ListWorkflowExecutionsRequestV2,
 ) (*p.InternalListWorkflowExecutionsResponse, error) {
 	return nil, p.NewOperationNotSupportErrorForVis()
 }
 
 func (s *sqlVisibilityStore) CountWorkflowExecutions(
+	ctx context.Context,
 	request *p.CountWorkflowExecutionsRequest,
 ) (*p.CountWorkflowExecutionsResponse, error) {
 	return nil, p.NewOperationNotSupportErrorForVis()
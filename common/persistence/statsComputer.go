@@ -101,6 +101,11 @@ func (sc *statsComputer) computeMutableStateStats(state *persistencespb.Workflow
 	}
 }
 
+// ComputeMutableStateSize estimates the size of the whole mutable state, version histories excluded
+func ComputeMutableStateSize(state *persistencespb.WorkflowMutableState) int {
+	return (&statsComputer{}).computeMutableStateStats(state).MutableStateSize
+}
+
 func (sc *statsComputer) computeMutableStateUpdateStats(req *UpdateWorkflowExecutionRequest) *MutableStateUpdateSessionStats {
 	executionInfoSize := computeExecutionInfoSize(req.UpdateWorkflowMutation.ExecutionInfo)
 
@@ -177,6 +182,23 @@ func (sc *statsComputer) computeMutableStateUpdateStats(req *UpdateWorkflowExecu
 	}
 }
 
+func (sc *statsComputer) computeWorkflowSnapshotSize(snapshot *WorkflowSnapshot) int {
+	size := computeExecutionInfoSize(snapshot.ExecutionInfo)
+	for _, ai := range snapshot.ActivityInfos {
+		size += computeActivityInfoSize(ai)
+	}
+	for _, ti := range snapshot.TimerInfos {
+		size += computeTimerInfoSize(ti)
+	}
+	for _, ci := range snapshot.ChildExecutionInfos {
+		size += computeChildInfoSize(ci)
+	}
+	for _, si := range snapshot.SignalInfos {
+		size += computeSignalInfoSize(si)
+	}
+	return size
+}
+
 func computeExecutionInfoSize(executionInfo *persistencespb.WorkflowExecutionInfo) int {
 	if executionInfo == nil {
 		return 0
@@ -25,6 +25,8 @@
 package versionhistory
 
 import (
+	"fmt"
+
 	"go.temporal.io/api/serviceerror"
 
 	historyspb "go.temporal.io/server/api/history/v1"
@@ -189,3 +191,80 @@ func SetCurrentVersionHistoryIndex(h *historyspb.VersionHistories, currentVersio
 func GetCurrentVersionHistory(h *historyspb.VersionHistories) (*historyspb.VersionHistory, error) {
 	return GetVersionHistory(h, h.GetCurrentVersionHistoryIndex())
 }
+
+// EstimatedByteSize estimates the size of the VersionHistories as persisted: branch tokens plus
+// a version and an event ID per item.
+func EstimatedByteSize(h *historyspb.VersionHistories) int {
+	size := 0
+	for _, history := range h.GetHistories() {
+		size += len(history.GetBranchToken())
+		size += len(history.GetItems()) * 2 * 8
+	}
+	return size
+}
+
+// ValidateVersionHistoriesAgainstEventCount checks that the last event ID of the current VersionHistory matches lastEventID.
+func ValidateVersionHistoriesAgainstEventCount(h *historyspb.VersionHistories, lastEventID int64) error {
+	currentVersionHistory, err := GetCurrentVersionHistory(h)
+	if err != nil {
+		return err
+	}
+	lastItem, err := GetLastVersionHistoryItem(currentVersionHistory)
+	if err != nil {
+		return err
+	}
+
+	if lastItem.GetEventId() != lastEventID {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("version histories last event id %v does not match last event id %v.", lastItem.GetEventId(), lastEventID))
+	}
+	return nil
+}
+
+// PruneVersionHistories removes the branches whose last item version is below olderThanVersion and returns
+// how many were removed. If keepCurrent is true the current branch is always kept, otherwise it is pruned like
+// any other branch and the remaining branch with the highest last item version becomes the current one.
+// CurrentVersionHistoryIndex is adjusted to keep pointing at the current branch.
+func PruneVersionHistories(h *historyspb.VersionHistories, keepCurrent bool, olderThanVersion int64) (int, error) {
+	if _, err := GetCurrentVersionHistory(h); err != nil {
+		return 0, err
+	}
+
+	var histories []*historyspb.VersionHistory
+	var lastVersions []int64
+	currentIndex := int32(-1)
+	for index, history := range h.Histories {
+		lastItem, err := GetLastVersionHistoryItem(history)
+		if err != nil {
+			return 0, err
+		}
+
+		isCurrent := int32(index) == h.CurrentVersionHistoryIndex
+		if lastItem.GetVersion() < olderThanVersion && !(isCurrent && keepCurrent) {
+			continue
+		}
+		if isCurrent {
+			currentIndex = int32(len(histories))
+		}
+		histories = append(histories, history)
+		lastVersions = append(lastVersions, lastItem.GetVersion())
+	}
+
+	if len(histories) == 0 {
+		return 0, serviceerror.NewInvalidArgument("version histories cannot be pruned to empty.")
+	}
+
+	if currentIndex < 0 {
+		// current branch is pruned, switch to the branch with the highest last write version
+		currentIndex = 0
+		for index, lastVersion := range lastVersions {
+			if lastVersion > lastVersions[currentIndex] {
+				currentIndex = int32(index)
+			}
+		}
+	}
+
+	removed := len(h.Histories) - len(histories)
+	h.Histories = histories
+	h.CurrentVersionHistoryIndex = currentIndex
+	return removed, nil
+}
@@ -0,0 +1,118 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package versionhistory
+
+import (
+	"fmt"
+
+	"go.temporal.io/api/serviceerror"
+
+	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/common"
+)
+
+// VersionHistoriesBuilder builds VersionHistories from a sequence of events.
+// Events are always added to the most recently created branch.
+type VersionHistoriesBuilder struct {
+	histories []*historyspb.VersionHistory
+	err       error
+}
+
+// NewVersionHistoriesBuilder create a new instance of VersionHistoriesBuilder with a single empty branch.
+func NewVersionHistoriesBuilder() *VersionHistoriesBuilder {
+	return &VersionHistoriesBuilder{
+		histories: []*historyspb.VersionHistory{NewVersionHistory(nil, nil)},
+	}
+}
+
+// AddEvent appends an event to the current branch. Event IDs must be consecutive, starting from common.FirstEventID.
+func (b *VersionHistoriesBuilder) AddEvent(eventID int64, version int64) *VersionHistoriesBuilder {
+	if b.err != nil {
+		return b
+	}
+
+	branch := b.histories[len(b.histories)-1]
+	expectedEventID := common.FirstEventID
+	if !IsEmptyVersionHistory(branch) {
+		expectedEventID = branch.Items[len(branch.Items)-1].GetEventId() + 1
+	}
+	if eventID != expectedEventID {
+		b.err = serviceerror.NewInvalidArgument(fmt.Sprintf("cannot add event %v to version history. Expected event id: %v", eventID, expectedEventID))
+		return b
+	}
+
+	b.err = AddOrUpdateVersionHistoryItem(branch, NewVersionHistoryItem(eventID, version))
+	return b
+}
+
+// Fork starts a new branch sharing all events up to and including atEventID with the current branch.
+func (b *VersionHistoriesBuilder) Fork(atEventID int64) *VersionHistoriesBuilder {
+	if b.err != nil {
+		return b
+	}
+
+	branch := b.histories[len(b.histories)-1]
+	version, err := GetVersionHistoryEventVersion(branch, atEventID)
+	if err != nil {
+		b.err = err
+		return b
+	}
+	forked, err := CopyVersionHistoryUntilLCAVersionHistoryItem(branch, NewVersionHistoryItem(atEventID, version))
+	if err != nil {
+		b.err = err
+		return b
+	}
+
+	b.histories = append(b.histories, forked)
+	return b
+}
+
+// Build returns the VersionHistories. The current branch is the one with the highest last write version,
+// with ties resolved in favour of the branch created first.
+func (b *VersionHistoriesBuilder) Build() (*historyspb.VersionHistories, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+
+	var histories []*historyspb.VersionHistory
+	var currentIndex int32
+	var currentLastItem *historyspb.VersionHistoryItem
+	for index, history := range b.histories {
+		lastItem, err := GetLastVersionHistoryItem(history)
+		if err != nil {
+			return nil, err
+		}
+		if currentLastItem == nil || lastItem.GetVersion() > currentLastItem.GetVersion() {
+			currentIndex = int32(index)
+			currentLastItem = lastItem
+		}
+		histories = append(histories, CopyVersionHistory(history))
+	}
+
+	return &historyspb.VersionHistories{
+		CurrentVersionHistoryIndex: currentIndex,
+		Histories:                  histories,
+	}, nil
+}
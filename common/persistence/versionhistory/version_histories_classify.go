@@ -0,0 +1,107 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package versionhistory
+
+import (
+	"go.temporal.io/api/serviceerror"
+
+	historyspb "go.temporal.io/server/api/history/v1"
+)
+
+type (
+	// TaskDisposition is how an incoming replication task relates to the local VersionHistories.
+	TaskDisposition int
+)
+
+const (
+	// TaskDispositionAppendable means the incoming events directly extend the current branch.
+	TaskDispositionAppendable TaskDisposition = iota
+	// TaskDispositionNeedsBackfill means the incoming history extends the current branch, but events
+	// of at least one version between the local last event and the incoming events are missing locally.
+	TaskDispositionNeedsBackfill
+	// TaskDispositionNeedsConflictResolution means the incoming history diverges from the local branch
+	// it shares the most events with, or extends a branch which is not the current one.
+	TaskDispositionNeedsConflictResolution
+	// TaskDispositionStaleDuplicate means all incoming events already exist locally.
+	TaskDispositionStaleDuplicate
+)
+
+// String returns the name of the disposition.
+func (d TaskDisposition) String() string {
+	switch d {
+	case TaskDispositionAppendable:
+		return "appendable"
+	case TaskDispositionNeedsBackfill:
+		return "needs-backfill"
+	case TaskDispositionNeedsConflictResolution:
+		return "needs-conflict-resolution"
+	case TaskDispositionStaleDuplicate:
+		return "stale-duplicate"
+	default:
+		return "unknown"
+	}
+}
+
+// ClassifyReplicationTask classifies a replication task by comparing its VersionHistory with the local VersionHistories.
+// The classification is made on version history items only: a gap in event IDs within the last incoming
+// version is not visible here and still has to be verified against the first event of the task.
+func ClassifyReplicationTask(local *historyspb.VersionHistories, incoming *historyspb.VersionHistory) (TaskDisposition, error) {
+	incomingLastItem, err := GetLastVersionHistoryItem(incoming)
+	if err != nil {
+		return 0, err
+	}
+
+	lcaItem, index, err := FindLCAVersionHistoryItemAndIndex(local, incoming)
+	if err != nil {
+		return 0, err
+	}
+	if lcaItem == nil {
+		return 0, serviceerror.NewInvalidArgument("version histories is empty.")
+	}
+	localHistory, err := GetVersionHistory(local, index)
+	if err != nil {
+		return 0, err
+	}
+
+	if IsEqualVersionHistoryItem(lcaItem, incomingLastItem) {
+		return TaskDispositionStaleDuplicate, nil
+	}
+	if index != local.GetCurrentVersionHistoryIndex() || !IsLCAVersionHistoryItemAppendable(localHistory, lcaItem) {
+		return TaskDispositionNeedsConflictResolution, nil
+	}
+
+	// incoming items after the one covering the LCA item are versions which are not present locally,
+	// only the last of them can be carried by the task itself
+	newVersionCount := 0
+	for _, item := range incoming.GetItems() {
+		if item.GetVersion() > lcaItem.GetVersion() {
+			newVersionCount++
+		}
+	}
+	if newVersionCount > 1 {
+		return TaskDispositionNeedsBackfill, nil
+	}
+	return TaskDispositionAppendable, nil
+}
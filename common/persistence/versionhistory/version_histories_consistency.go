@@ -0,0 +1,108 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package versionhistory
+
+import (
+	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/common/cluster"
+)
+
+type (
+	// ConsistencyReport is the result of comparing the VersionHistories of a workflow across two clusters.
+	ConsistencyReport struct {
+		// Divergences has one entry per remote branch which is not contained in any local branch.
+		Divergences []BranchDivergence
+	}
+
+	// BranchDivergence describes a remote branch which has events the local cluster does not have.
+	BranchDivergence struct {
+		// RemoteBranchIndex is the index of the diverging branch in the remote VersionHistories.
+		RemoteBranchIndex int
+		// LocalBranchIndex is the index of the local branch sharing the most events with the remote branch.
+		LocalBranchIndex int
+		// LCAItem is the last item shared by the local and the remote branch.
+		LCAItem *historyspb.VersionHistoryItem
+		// RemoteItems are the items of the remote branch after LCAItem.
+		RemoteItems []*historyspb.VersionHistoryItem
+		// LocallyOwned is true if any of RemoteItems has a version owned by the local cluster. The local cluster
+		// is the source of truth for the events of its versions, so such a divergence is a red flag.
+		LocallyOwned bool
+	}
+)
+
+// IsConsistent returns false if any divergence involves a version owned by the local cluster. Divergences on
+// versions owned by other clusters are expected while replication is lagging or conflicts are being resolved.
+func (r ConsistencyReport) IsConsistent() bool {
+	for _, divergence := range r.Divergences {
+		if divergence.LocallyOwned {
+			return false
+		}
+	}
+	return true
+}
+
+// CompareAcrossClusters compares the VersionHistories of the same workflow from the local and a remote cluster.
+// Branch tokens are local to each cluster, so branches are matched by their items: every remote branch is
+// compared with the local branch it shares the most events with, using clusterMetadata to know which cluster
+// owns the versions of the remote events missing locally.
+func CompareAcrossClusters(
+	clusterMetadata cluster.Metadata,
+	local *historyspb.VersionHistories,
+	remote *historyspb.VersionHistories,
+) (ConsistencyReport, error) {
+	var report ConsistencyReport
+	currentClusterName := clusterMetadata.GetCurrentClusterName()
+	for remoteIndex, remoteHistory := range remote.GetHistories() {
+		lcaItem, localIndex, err := FindLCAVersionHistoryItemAndIndex(local, remoteHistory)
+		if err != nil {
+			return ConsistencyReport{}, err
+		}
+
+		var remoteItems []*historyspb.VersionHistoryItem
+		locallyOwned := false
+		for _, item := range remoteHistory.GetItems() {
+			if item.GetEventId() <= lcaItem.GetEventId() {
+				continue
+			}
+			remoteItems = append(remoteItems, CopyVersionHistoryItem(item))
+			if clusterMetadata.IsVersionFromCluster(item.GetVersion(), currentClusterName) {
+				locallyOwned = true
+			}
+		}
+		if len(remoteItems) == 0 {
+			// the remote branch is contained in a local branch
+			continue
+		}
+
+		report.Divergences = append(report.Divergences, BranchDivergence{
+			RemoteBranchIndex: remoteIndex,
+			LocalBranchIndex:  int(localIndex),
+			LCAItem:           lcaItem,
+			RemoteItems:       remoteItems,
+			LocallyOwned:      locallyOwned,
+		})
+	}
+	return report, nil
+}
@@ -0,0 +1,169 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package versionhistory
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	historyspb "go.temporal.io/server/api/history/v1"
+)
+
+type (
+	// BranchDiffType is the kind of difference found between two branches.
+	BranchDiffType int
+
+	// BranchDiff describes how a branch, matched by branch token, differs between two VersionHistories.
+	BranchDiff struct {
+		Type        BranchDiffType
+		BranchToken []byte
+		// DivergenceEventID is the first event ID whose version differs between the two branches,
+		// only set for BranchDiffTypeDiverged.
+		DivergenceEventID int64
+		// ItemA and ItemB are the items covering DivergenceEventID in each branch,
+		// nil if the branch ends before DivergenceEventID.
+		ItemA *historyspb.VersionHistoryItem
+		ItemB *historyspb.VersionHistoryItem
+	}
+)
+
+const (
+	// BranchDiffTypeOnlyInA is a branch which only exists in the first VersionHistories.
+	BranchDiffTypeOnlyInA BranchDiffType = iota
+	// BranchDiffTypeOnlyInB is a branch which only exists in the second VersionHistories.
+	BranchDiffTypeOnlyInB
+	// BranchDiffTypeDiverged is a branch which exists in both VersionHistories with different items.
+	BranchDiffTypeDiverged
+)
+
+// DiffVersionHistories compares the branches of a and b, matched by branch token, and returns one
+// BranchDiff per branch which is missing from either side or whose items differ. Branches are
+// reported in the order they appear in a, followed by the branches only present in b.
+func DiffVersionHistories(a, b *historyspb.VersionHistories) []BranchDiff {
+	var diffs []BranchDiff
+	matched := make([]bool, len(b.GetHistories()))
+	for _, historyA := range a.GetHistories() {
+		index := findBranch(b, historyA.GetBranchToken(), matched)
+		if index < 0 {
+			diffs = append(diffs, BranchDiff{
+				Type:        BranchDiffTypeOnlyInA,
+				BranchToken: historyA.GetBranchToken(),
+			})
+			continue
+		}
+
+		matched[index] = true
+		if diff, ok := diffBranch(historyA, b.GetHistories()[index]); ok {
+			diffs = append(diffs, diff)
+		}
+	}
+
+	for index, historyB := range b.GetHistories() {
+		if !matched[index] {
+			diffs = append(diffs, BranchDiff{
+				Type:        BranchDiffTypeOnlyInB,
+				BranchToken: historyB.GetBranchToken(),
+			})
+		}
+	}
+	return diffs
+}
+
+// String returns a single line, human readable description of the difference.
+func (d BranchDiff) String() string {
+	branch := hex.EncodeToString(d.BranchToken)
+	switch d.Type {
+	case BranchDiffTypeOnlyInA:
+		return fmt.Sprintf("branch %s: only in a", branch)
+	case BranchDiffTypeOnlyInB:
+		return fmt.Sprintf("branch %s: only in b", branch)
+	default:
+		return fmt.Sprintf("branch %s: diverged at event %d: a=%s b=%s", branch, d.DivergenceEventID, itemString(d.ItemA), itemString(d.ItemB))
+	}
+}
+
+func findBranch(h *historyspb.VersionHistories, branchToken []byte, matched []bool) int {
+	for index, history := range h.GetHistories() {
+		if !matched[index] && bytes.Equal(history.GetBranchToken(), branchToken) {
+			return index
+		}
+	}
+	return -1
+}
+
+// diffBranch walks the items of both branches and returns the first event ID whose version differs.
+func diffBranch(a, b *historyspb.VersionHistory) (BranchDiff, bool) {
+	itemsA := a.GetItems()
+	itemsB := b.GetItems()
+	lastEventID := int64(0)
+	i, j := 0, 0
+	for i < len(itemsA) || j < len(itemsB) {
+		var itemA, itemB *historyspb.VersionHistoryItem
+		if i < len(itemsA) {
+			itemA = itemsA[i]
+		}
+		if j < len(itemsB) {
+			itemB = itemsB[j]
+		}
+
+		if itemA == nil || itemB == nil || itemA.GetVersion() != itemB.GetVersion() {
+			return BranchDiff{
+				Type:              BranchDiffTypeDiverged,
+				BranchToken:       a.GetBranchToken(),
+				DivergenceEventID: lastEventID + 1,
+				ItemA:             copyItemOrNil(itemA),
+				ItemB:             copyItemOrNil(itemB),
+			}, true
+		}
+
+		// both items have the same version, skip past the shorter of the two ranges
+		lastEventID = itemA.GetEventId()
+		if itemB.GetEventId() < lastEventID {
+			lastEventID = itemB.GetEventId()
+		}
+		if itemA.GetEventId() == lastEventID {
+			i++
+		}
+		if itemB.GetEventId() == lastEventID {
+			j++
+		}
+	}
+	return BranchDiff{}, false
+}
+
+func copyItemOrNil(item *historyspb.VersionHistoryItem) *historyspb.VersionHistoryItem {
+	if item == nil {
+		return nil
+	}
+	return CopyVersionHistoryItem(item)
+}
+
+func itemString(item *historyspb.VersionHistoryItem) string {
+	if item == nil {
+		return "<none>"
+	}
+	return fmt.Sprintf("%d:%d", item.GetEventId(), item.GetVersion())
+}
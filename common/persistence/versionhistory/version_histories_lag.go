@@ -0,0 +1,52 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package versionhistory
+
+import (
+	historyspb "go.temporal.io/server/api/history/v1"
+)
+
+// GetReplicationLag returns how many events the local VersionHistories are behind the incoming version history,
+// i.e. the difference between the last EventId of the incoming history and the last EventId of the local current branch.
+// Zero is returned when the local current branch is not behind.
+func GetReplicationLag(h *historyspb.VersionHistories, incomingHistory *historyspb.VersionHistory) (int64, error) {
+	currentHistory, err := GetCurrentVersionHistory(h)
+	if err != nil {
+		return 0, err
+	}
+	localLastItem, err := GetLastVersionHistoryItem(currentHistory)
+	if err != nil {
+		return 0, err
+	}
+	incomingLastItem, err := GetLastVersionHistoryItem(incomingHistory)
+	if err != nil {
+		return 0, err
+	}
+
+	if lag := incomingLastItem.GetEventId() - localLastItem.GetEventId(); lag > 0 {
+		return lag, nil
+	}
+	return 0, nil
+}
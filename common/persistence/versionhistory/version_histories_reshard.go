@@ -0,0 +1,99 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package versionhistory
+
+import (
+	"fmt"
+
+	enumspb "go.temporal.io/api/enums/v1"
+
+	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+type (
+	// ReshardWorkflow is a workflow run analyzed by AnalyzeReshard.
+	ReshardWorkflow struct {
+		NamespaceID      string
+		WorkflowID       string
+		RunID            string
+		VersionHistories *historyspb.VersionHistories
+	}
+
+	// ReshardReport describes how changing the number of history shards affects a workflow run.
+	ReshardReport struct {
+		Workflow   ReshardWorkflow
+		OldShardID int32
+		NewShardID int32
+		// Err is set if a branch token of the workflow cannot be read
+		Err error
+	}
+)
+
+// NeedsRehoming returns true if the workflow is owned by a different shard with the new number of shards.
+func (r *ReshardReport) NeedsRehoming() bool {
+	return r.OldShardID != r.NewShardID
+}
+
+// AnalyzeReshard reports, for each workflow, the shard owning it with the old and the new number of shards and
+// whether the branch tokens of its version histories can still be read. It is read only, nothing is re-homed.
+func AnalyzeReshard(workflows []ReshardWorkflow, oldNumShards int32, newNumShards int32) []*ReshardReport {
+	reports := make([]*ReshardReport, 0, len(workflows))
+	for _, workflow := range workflows {
+		reports = append(reports, &ReshardReport{
+			Workflow:   workflow,
+			OldShardID: common.WorkflowIDToHistoryShard(workflow.NamespaceID, workflow.WorkflowID, oldNumShards),
+			NewShardID: common.WorkflowIDToHistoryShard(workflow.NamespaceID, workflow.WorkflowID, newNumShards),
+			Err:        validateBranchTokens(workflow.VersionHistories),
+		})
+	}
+	return reports
+}
+
+// validateBranchTokens checks every branch token decodes to a branch of the same history tree. Branch tokens
+// reference the history tree and branch, not the shard, so readable tokens stay valid after re-homing.
+func validateBranchTokens(versionHistories *historyspb.VersionHistories) error {
+	if len(versionHistories.GetHistories()) == 0 {
+		return fmt.Errorf("version histories are empty")
+	}
+
+	treeID := ""
+	for index, versionHistory := range versionHistories.Histories {
+		branch, err := serialization.HistoryBranchFromBlob(versionHistory.GetBranchToken(), enumspb.ENCODING_TYPE_PROTO3.String())
+		if err != nil {
+			return fmt.Errorf("unable to decode branch token of version history %v: %w", index, err)
+		}
+		if branch.GetTreeId() == "" || branch.GetBranchId() == "" {
+			return fmt.Errorf("branch token of version history %v has no tree or branch ID", index)
+		}
+		if treeID == "" {
+			treeID = branch.GetTreeId()
+		} else if treeID != branch.GetTreeId() {
+			return fmt.Errorf("branch token of version history %v belongs to tree %v, expected %v", index, branch.GetTreeId(), treeID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package versionhistory
+
+import (
+	"bufio"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"go.temporal.io/api/serviceerror"
+
+	historyspb "go.temporal.io/server/api/history/v1"
+)
+
+// The text format of VersionHistories is line oriented:
+//
+//	current <current version history index>
+//	branch <version history index> <hex encoded branch token>
+//	<event ID>:<version>
+//	...
+//
+// with one branch line per VersionHistory, followed by one line per VersionHistoryItem.
+const (
+	textCurrentPrefix = "current"
+	textBranchPrefix  = "branch"
+)
+
+// ExportVersionHistoriesText writes VersionHistories to w in a human readable, diff-able text format.
+func ExportVersionHistoriesText(h *historyspb.VersionHistories, w io.Writer) error {
+	writer := bufio.NewWriter(w)
+	if _, err := fmt.Fprintf(writer, "%s %d\n", textCurrentPrefix, h.GetCurrentVersionHistoryIndex()); err != nil {
+		return err
+	}
+	for index, history := range h.GetHistories() {
+		if _, err := fmt.Fprintf(writer, "%s %d %s\n", textBranchPrefix, index, hex.EncodeToString(history.GetBranchToken())); err != nil {
+			return err
+		}
+		for _, item := range history.GetItems() {
+			if _, err := fmt.Fprintf(writer, "%d:%d\n", item.GetEventId(), item.GetVersion()); err != nil {
+				return err
+			}
+		}
+	}
+	return writer.Flush()
+}
+
+// ImportVersionHistoriesText reads VersionHistories written by ExportVersionHistoriesText from r.
+func ImportVersionHistoriesText(r io.Reader) (*historyspb.VersionHistories, error) {
+	h := &historyspb.VersionHistories{}
+	var currentIndex int32
+	var currentIndexSet bool
+	var currentHistory *historyspb.VersionHistory
+
+	scanner := bufio.NewScanner(r)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		fields := strings.Fields(line)
+
+		switch {
+		case !currentIndexSet:
+			if len(fields) != 2 || fields[0] != textCurrentPrefix {
+				return nil, newImportTextError(lineNumber, "expecting current version history index")
+			}
+			index, err := strconv.ParseInt(fields[1], 10, 32)
+			if err != nil {
+				return nil, newImportTextError(lineNumber, err.Error())
+			}
+			currentIndex = int32(index)
+			currentIndexSet = true
+
+		case fields[0] == textBranchPrefix:
+			if len(fields) < 2 || len(fields) > 3 {
+				return nil, newImportTextError(lineNumber, "malformed branch line")
+			}
+			index, err := strconv.Atoi(fields[1])
+			if err != nil {
+				return nil, newImportTextError(lineNumber, err.Error())
+			}
+			if index != len(h.Histories) {
+				return nil, newImportTextError(lineNumber, fmt.Sprintf("expecting branch %v, got %v", len(h.Histories), index))
+			}
+			var branchToken []byte
+			if len(fields) == 3 {
+				if branchToken, err = hex.DecodeString(fields[2]); err != nil {
+					return nil, newImportTextError(lineNumber, err.Error())
+				}
+			}
+			currentHistory = NewVersionHistory(branchToken, nil)
+			h.Histories = append(h.Histories, currentHistory)
+
+		default:
+			if currentHistory == nil {
+				return nil, newImportTextError(lineNumber, "version history item outside of a branch")
+			}
+			pieces := strings.Split(line, ":")
+			if len(pieces) != 2 {
+				return nil, newImportTextError(lineNumber, "malformed version history item")
+			}
+			eventID, err := strconv.ParseInt(pieces[0], 10, 64)
+			if err != nil {
+				return nil, newImportTextError(lineNumber, err.Error())
+			}
+			version, err := strconv.ParseInt(pieces[1], 10, 64)
+			if err != nil {
+				return nil, newImportTextError(lineNumber, err.Error())
+			}
+			if len(currentHistory.Items) > 0 {
+				lastItem := currentHistory.Items[len(currentHistory.Items)-1]
+				if version < lastItem.GetVersion() || eventID <= lastItem.GetEventId() {
+					return nil, newImportTextError(lineNumber, "version history items must be in increasing order")
+				}
+			}
+			currentHistory.Items = append(currentHistory.Items, NewVersionHistoryItem(eventID, version))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := SetCurrentVersionHistoryIndex(h, currentIndex); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+func newImportTextError(lineNumber int, msg string) error {
+	return serviceerror.NewInvalidArgument(fmt.Sprintf("version histories text line %v: %v", lineNumber, msg))
+}
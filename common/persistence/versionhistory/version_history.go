@@ -111,6 +111,30 @@ func AddOrUpdateVersionHistoryItem(v *historyspb.VersionHistory, item *historysp
 	return nil
 }
 
+// CompactVersionHistory merges consecutive VersionHistoryItem sharing the same version into a single item
+// carrying the highest event ID, and returns the number of items removed.
+func CompactVersionHistory(v *historyspb.VersionHistory) int {
+	if len(v.Items) == 0 {
+		return 0
+	}
+
+	items := v.Items[:1]
+	for _, item := range v.Items[1:] {
+		lastItem := items[len(items)-1]
+		if item.GetVersion() == lastItem.GetVersion() {
+			if item.GetEventId() > lastItem.GetEventId() {
+				lastItem.EventId = item.GetEventId()
+			}
+			continue
+		}
+		items = append(items, item)
+	}
+
+	removed := len(v.Items) - len(items)
+	v.Items = items
+	return removed
+}
+
 // ContainsVersionHistoryItem check whether VersionHistory has given VersionHistoryItem.
 func ContainsVersionHistoryItem(v *historyspb.VersionHistory, item *historyspb.VersionHistoryItem) bool {
 	prevEventID := common.FirstEventID - 1
@@ -164,6 +188,33 @@ func IsLCAVersionHistoryItemAppendable(v *historyspb.VersionHistory, lcaItem *hi
 	return IsEqualVersionHistoryItem(v.Items[len(v.Items)-1], lcaItem)
 }
 
+// CompareVersionHistoryLineage compares two VersionHistory on their shared lineage.
+// It returns -1 if remote extends v, 1 if v extends remote and 0 if both are identical.
+// An error is returned if the two VersionHistory have diverged from their LCA item.
+func CompareVersionHistoryLineage(v *historyspb.VersionHistory, remote *historyspb.VersionHistory) (int, error) {
+	if len(v.Items) == 0 || len(remote.Items) == 0 {
+		return 0, serviceerror.NewInvalidArgument("version history is empty.")
+	}
+
+	lcaItem, err := FindLCAVersionHistoryItem(v, remote)
+	if err != nil {
+		return 0, err
+	}
+
+	localAtLCA := IsLCAVersionHistoryItemAppendable(v, lcaItem)
+	remoteAtLCA := IsLCAVersionHistoryItemAppendable(remote, lcaItem)
+	switch {
+	case localAtLCA && remoteAtLCA:
+		return 0, nil
+	case localAtLCA:
+		return -1, nil
+	case remoteAtLCA:
+		return 1, nil
+	default:
+		return 0, serviceerror.NewInvalidArgument("version histories have diverged.")
+	}
+}
+
 // GetFirstVersionHistoryItem return the first VersionHistoryItem.
 func GetFirstVersionHistoryItem(v *historyspb.VersionHistory) (*historyspb.VersionHistoryItem, error) {
 	if len(v.Items) == 0 {
@@ -0,0 +1,124 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package versionhistory
+
+import (
+	"testing"
+
+	historyspb "go.temporal.io/server/api/history/v1"
+)
+
+func BenchmarkCopyVersionHistories(b *testing.B) {
+	histories := newBenchmarkVersionHistories()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = CopyVersionHistories(histories)
+	}
+}
+
+func BenchmarkCopyVersionHistories_MarshalUnmarshal(b *testing.B) {
+	histories := newBenchmarkVersionHistories()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		data, err := histories.Marshal()
+		if err != nil {
+			b.Fatal(err)
+		}
+		result := &historyspb.VersionHistories{}
+		if err := result.Unmarshal(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkCopyVersionHistory(b *testing.B) {
+	history := newBenchmarkVersionHistories().Histories[0]
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = CopyVersionHistory(history)
+	}
+}
+
+func BenchmarkCopyVersionHistory_MarshalUnmarshal(b *testing.B) {
+	history := newBenchmarkVersionHistories().Histories[0]
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		data, err := history.Marshal()
+		if err != nil {
+			b.Fatal(err)
+		}
+		result := &historyspb.VersionHistory{}
+		if err := result.Unmarshal(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkCopyVersionHistoryItem(b *testing.B) {
+	item := NewVersionHistoryItem(123, 456)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = CopyVersionHistoryItem(item)
+	}
+}
+
+func BenchmarkCopyVersionHistoryItem_MarshalUnmarshal(b *testing.B) {
+	item := NewVersionHistoryItem(123, 456)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		data, err := item.Marshal()
+		if err != nil {
+			b.Fatal(err)
+		}
+		result := &historyspb.VersionHistoryItem{}
+		if err := result.Unmarshal(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func newBenchmarkVersionHistories() *historyspb.VersionHistories {
+	histories := &historyspb.VersionHistories{}
+	for branch := 0; branch < 4; branch++ {
+		var items []*historyspb.VersionHistoryItem
+		for i := 1; i <= 16; i++ {
+			items = append(items, NewVersionHistoryItem(int64(i*10), int64(i)))
+		}
+		histories.Histories = append(histories.Histories, NewVersionHistory([]byte("some random branch token"), items))
+	}
+	return histories
+}
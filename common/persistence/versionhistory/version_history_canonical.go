@@ -0,0 +1,53 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package versionhistory
+
+import (
+	historyspb "go.temporal.io/server/api/history/v1"
+)
+
+// Canonical marshal produces byte-identical output for equal values, across runs and Go versions,
+// which makes it suitable as input for content-addressed storage.
+//
+// VersionHistories, VersionHistory and VersionHistoryItem contain only scalar, bytes and repeated message fields.
+// The generated marshal code writes fields in field number order, repeated fields in slice order,
+// and omits proto3 default values, so no map iteration order or reflection is involved.
+// Note that TransientWorkflowTaskInfo is not supported: the embedded HistoryEvent contains map fields
+// (e.g. memo, search attributes and header) whose wire order is not deterministic.
+
+// CanonicalMarshalVersionHistories marshals VersionHistories into its canonical wire format.
+func CanonicalMarshalVersionHistories(h *historyspb.VersionHistories) ([]byte, error) {
+	return h.Marshal()
+}
+
+// CanonicalMarshalVersionHistory marshals VersionHistory into its canonical wire format.
+func CanonicalMarshalVersionHistory(v *historyspb.VersionHistory) ([]byte, error) {
+	return v.Marshal()
+}
+
+// CanonicalMarshalVersionHistoryItem marshals VersionHistoryItem into its canonical wire format.
+func CanonicalMarshalVersionHistoryItem(item *historyspb.VersionHistoryItem) ([]byte, error) {
+	return item.Marshal()
+}
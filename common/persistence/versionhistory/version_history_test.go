@@ -25,13 +25,19 @@
 package versionhistory
 
 import (
+	"bytes"
+	"encoding/hex"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
 	"go.temporal.io/api/serviceerror"
 
 	historyspb "go.temporal.io/server/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/persistence/serialization"
 )
 
 type (
@@ -368,6 +374,75 @@ func (s *versionHistorySuite) TestFindLCAItem_Error_NoLCA() {
 	s.Error(err)
 }
 
+func (s *versionHistorySuite) TestCompareLineage_Ahead() {
+	localItems := []*historyspb.VersionHistoryItem{
+		{EventId: 3, Version: 0},
+		{EventId: 7, Version: 4},
+		{EventId: 9, Version: 6},
+	}
+	remoteItems := []*historyspb.VersionHistoryItem{
+		{EventId: 3, Version: 0},
+		{EventId: 5, Version: 4},
+	}
+	localVersionHistory := NewVersionHistory([]byte("local branch token"), localItems)
+	remoteVersionHistory := NewVersionHistory([]byte("remote branch token"), remoteItems)
+
+	result, err := CompareVersionHistoryLineage(localVersionHistory, remoteVersionHistory)
+	s.NoError(err)
+	s.Equal(1, result)
+}
+
+func (s *versionHistorySuite) TestCompareLineage_Behind() {
+	localItems := []*historyspb.VersionHistoryItem{
+		{EventId: 3, Version: 0},
+		{EventId: 5, Version: 4},
+	}
+	remoteItems := []*historyspb.VersionHistoryItem{
+		{EventId: 3, Version: 0},
+		{EventId: 8, Version: 4},
+	}
+	localVersionHistory := NewVersionHistory([]byte("local branch token"), localItems)
+	remoteVersionHistory := NewVersionHistory([]byte("remote branch token"), remoteItems)
+
+	result, err := CompareVersionHistoryLineage(localVersionHistory, remoteVersionHistory)
+	s.NoError(err)
+	s.Equal(-1, result)
+}
+
+func (s *versionHistorySuite) TestCompareLineage_Equal() {
+	items := []*historyspb.VersionHistoryItem{
+		{EventId: 3, Version: 0},
+		{EventId: 5, Version: 4},
+	}
+	localVersionHistory := NewVersionHistory([]byte("local branch token"), items)
+	remoteVersionHistory := CopyVersionHistory(localVersionHistory)
+
+	result, err := CompareVersionHistoryLineage(localVersionHistory, remoteVersionHistory)
+	s.NoError(err)
+	s.Equal(0, result)
+}
+
+func (s *versionHistorySuite) TestCompareLineage_Diverged() {
+	localItems := []*historyspb.VersionHistoryItem{
+		{EventId: 3, Version: 0},
+		{EventId: 5, Version: 4},
+		{EventId: 7, Version: 6},
+	}
+	remoteItems := []*historyspb.VersionHistoryItem{
+		{EventId: 3, Version: 0},
+		{EventId: 5, Version: 4},
+		{EventId: 8, Version: 8},
+	}
+	localVersionHistory := NewVersionHistory([]byte("local branch token"), localItems)
+	remoteVersionHistory := NewVersionHistory([]byte("remote branch token"), remoteItems)
+
+	_, err := CompareVersionHistoryLineage(localVersionHistory, remoteVersionHistory)
+	s.Error(err)
+
+	_, err = CompareVersionHistoryLineage(localVersionHistory, NewVersionHistory(nil, nil))
+	s.Error(err)
+}
+
 func (s *versionHistorySuite) TestGetFirstItem_Success() {
 	BranchToken := []byte("some random branch token")
 	item := NewVersionHistoryItem(3, 0)
@@ -667,3 +742,571 @@ func (s *versionHistoriesSuite) TestCurrentVersionHistoryIndexIsInReplay() {
 	s.NoError(err)
 	s.False(isInReplay)
 }
+
+func (s *versionHistoriesSuite) TestCanonicalMarshal() {
+	newHistories := func() *historyspb.VersionHistories {
+		histories := NewVersionHistories(NewVersionHistory([]byte("some random branch token"), []*historyspb.VersionHistoryItem{
+			{EventId: 3, Version: 0},
+			{EventId: 6, Version: 4},
+		}))
+		_, _, err := AddVersionHistory(histories, NewVersionHistory([]byte("other random branch token"), []*historyspb.VersionHistoryItem{
+			{EventId: 3, Version: 0},
+			{EventId: 7, Version: 6},
+		}))
+		s.NoError(err)
+		return histories
+	}
+
+	expected, err := CanonicalMarshalVersionHistories(newHistories())
+	s.NoError(err)
+	for i := 0; i < 100; i++ {
+		data, err := CanonicalMarshalVersionHistories(newHistories())
+		s.NoError(err)
+		s.Equal(expected, data)
+	}
+
+	expected, err = CanonicalMarshalVersionHistory(newHistories().Histories[0])
+	s.NoError(err)
+	for i := 0; i < 100; i++ {
+		data, err := CanonicalMarshalVersionHistory(CopyVersionHistory(newHistories().Histories[0]))
+		s.NoError(err)
+		s.Equal(expected, data)
+	}
+
+	expected, err = CanonicalMarshalVersionHistoryItem(NewVersionHistoryItem(6, 4))
+	s.NoError(err)
+	for i := 0; i < 100; i++ {
+		data, err := CanonicalMarshalVersionHistoryItem(NewVersionHistoryItem(6, 4))
+		s.NoError(err)
+		s.Equal(expected, data)
+	}
+}
+
+func (s *versionHistoriesSuite) TestBuilder_TwoBranches() {
+	histories, err := NewVersionHistoriesBuilder().
+		AddEvent(1, 0).
+		AddEvent(2, 0).
+		AddEvent(3, 4).
+		AddEvent(4, 4).
+		Fork(2).
+		AddEvent(3, 6).
+		Build()
+	s.NoError(err)
+
+	s.Equal(int32(1), histories.GetCurrentVersionHistoryIndex())
+	s.Len(histories.Histories, 2)
+	s.Equal([]*historyspb.VersionHistoryItem{
+		NewVersionHistoryItem(2, 0),
+		NewVersionHistoryItem(4, 4),
+	}, histories.Histories[0].Items)
+	s.Equal([]*historyspb.VersionHistoryItem{
+		NewVersionHistoryItem(2, 0),
+		NewVersionHistoryItem(3, 6),
+	}, histories.Histories[1].Items)
+
+	rebuilt, err := IsVersionHistoriesRebuilt(histories)
+	s.NoError(err)
+	s.False(rebuilt)
+}
+
+func (s *versionHistoriesSuite) TestBuilder_Error() {
+	_, err := NewVersionHistoriesBuilder().Build()
+	s.Error(err)
+
+	_, err = NewVersionHistoriesBuilder().AddEvent(2, 0).Build()
+	s.Error(err)
+
+	_, err = NewVersionHistoriesBuilder().AddEvent(1, 4).AddEvent(2, 0).Build()
+	s.Error(err)
+
+	_, err = NewVersionHistoriesBuilder().AddEvent(1, 0).Fork(2).Build()
+	s.Error(err)
+
+	_, err = NewVersionHistoriesBuilder().AddEvent(1, 0).AddEvent(2, 0).Fork(1).AddEvent(3, 4).Build()
+	s.Error(err)
+}
+
+func (s *versionHistorySuite) TestCompact() {
+	BranchToken := []byte("some random branch token")
+	Items := []*historyspb.VersionHistoryItem{
+		{EventId: 2, Version: 0},
+		{EventId: 3, Version: 0},
+		{EventId: 5, Version: 4},
+		{EventId: 6, Version: 4},
+		{EventId: 8, Version: 4},
+		{EventId: 9, Version: 6},
+	}
+	history := NewVersionHistory(BranchToken, Items)
+	original := CopyVersionHistory(history)
+
+	s.Equal(3, CompactVersionHistory(history))
+	s.Equal([]*historyspb.VersionHistoryItem{
+		NewVersionHistoryItem(3, 0),
+		NewVersionHistoryItem(8, 4),
+		NewVersionHistoryItem(9, 6),
+	}, history.Items)
+
+	for eventID := common.FirstEventID; eventID <= 10; eventID++ {
+		expectedVersion, expectedErr := GetVersionHistoryEventVersion(original, eventID)
+		version, err := GetVersionHistoryEventVersion(history, eventID)
+		s.Equal(expectedErr, err)
+		s.Equal(expectedVersion, version)
+
+		for _, version := range []int64{0, 4, 6} {
+			item := NewVersionHistoryItem(eventID, version)
+			s.Equal(ContainsVersionHistoryItem(original, item), ContainsVersionHistoryItem(history, item))
+		}
+	}
+
+	s.Equal(0, CompactVersionHistory(history))
+}
+
+func (s *versionHistoriesSuite) TestExportImportText_RoundTrip() {
+	histories, err := NewVersionHistoriesBuilder().
+		AddEvent(1, 0).
+		AddEvent(2, 0).
+		AddEvent(3, 4).
+		Fork(2).
+		AddEvent(3, 6).
+		AddEvent(4, 6).
+		Build()
+	s.NoError(err)
+	SetVersionHistoryBranchToken(histories.Histories[0], []byte("branch token 0"))
+	SetVersionHistoryBranchToken(histories.Histories[1], []byte("branch token 1"))
+
+	var buf bytes.Buffer
+	s.NoError(ExportVersionHistoriesText(histories, &buf))
+	s.Equal(
+		"current 1\n"+
+			"branch 0 "+hex.EncodeToString([]byte("branch token 0"))+"\n"+
+			"2:0\n"+
+			"3:4\n"+
+			"branch 1 "+hex.EncodeToString([]byte("branch token 1"))+"\n"+
+			"2:0\n"+
+			"4:6\n",
+		buf.String(),
+	)
+
+	imported, err := ImportVersionHistoriesText(&buf)
+	s.NoError(err)
+	s.Equal(histories, imported)
+}
+
+func (s *versionHistoriesSuite) TestImportText_Malformed() {
+	testCases := []string{
+		"",
+		"branch 0 00\n1:0\n",
+		"current 0\n1:0\n",
+		"current 0\nbranch 1 00\n1:0\n",
+		"current 0\nbranch 0 zz\n1:0\n",
+		"current 0\nbranch 0 00\n2:0\n1:0\n",
+		"current 1\nbranch 0 00\n1:0\n",
+	}
+	for _, tc := range testCases {
+		_, err := ImportVersionHistoriesText(strings.NewReader(tc))
+		s.Error(err, tc)
+	}
+}
+
+func (s *versionHistoriesSuite) TestValidateAgainstEventCount() {
+	histories, err := NewVersionHistoriesBuilder().
+		AddEvent(1, 0).
+		AddEvent(2, 0).
+		AddEvent(3, 4).
+		Build()
+	s.NoError(err)
+
+	s.NoError(ValidateVersionHistoriesAgainstEventCount(histories, 3))
+	// version histories ahead of persisted events
+	s.IsType(&serviceerror.InvalidArgument{}, ValidateVersionHistoriesAgainstEventCount(histories, 2))
+	// version histories behind persisted events
+	s.IsType(&serviceerror.InvalidArgument{}, ValidateVersionHistoriesAgainstEventCount(histories, 4))
+
+	s.Error(ValidateVersionHistoriesAgainstEventCount(NewVersionHistories(NewVersionHistory(nil, nil)), 0))
+}
+
+func (s *versionHistoriesSuite) TestEstimatedByteSize() {
+	histories := NewVersionHistories(NewVersionHistory([]byte("branch-0"), []*historyspb.VersionHistoryItem{
+		NewVersionHistoryItem(3, 0),
+		NewVersionHistoryItem(5, 1),
+	}))
+	_, _, err := AddVersionHistory(histories, NewVersionHistory([]byte("branch-1"), []*historyspb.VersionHistoryItem{
+		NewVersionHistoryItem(3, 0),
+		NewVersionHistoryItem(4, 2),
+	}))
+	s.NoError(err)
+
+	s.Equal(len("branch-0")+len("branch-1")+4*16, EstimatedByteSize(histories))
+	s.Equal(0, EstimatedByteSize(nil))
+}
+
+func (s *versionHistoriesSuite) TestPruneVersionHistories() {
+	newHistory := func(branchToken string, lastVersion int64) *historyspb.VersionHistory {
+		return NewVersionHistory([]byte(branchToken), []*historyspb.VersionHistoryItem{
+			NewVersionHistoryItem(3, 0),
+			NewVersionHistoryItem(5, lastVersion),
+		})
+	}
+	histories := &historyspb.VersionHistories{
+		CurrentVersionHistoryIndex: 3,
+		Histories: []*historyspb.VersionHistory{
+			newHistory("stale-0", 1),
+			newHistory("fresh-1", 10),
+			newHistory("stale-2", 2),
+			newHistory("current", 3),
+			newHistory("fresh-4", 12),
+		},
+	}
+
+	removed, err := PruneVersionHistories(histories, true, 5)
+	s.NoError(err)
+	s.Equal(2, removed)
+	s.Len(histories.Histories, 3)
+	s.Equal(int32(1), histories.CurrentVersionHistoryIndex)
+	current, err := GetCurrentVersionHistory(histories)
+	s.NoError(err)
+	s.Equal([]byte("current"), current.BranchToken)
+
+	// current branch is no longer protected, the branch with highest version takes over
+	removed, err = PruneVersionHistories(histories, false, 5)
+	s.NoError(err)
+	s.Equal(1, removed)
+	current, err = GetCurrentVersionHistory(histories)
+	s.NoError(err)
+	s.Equal([]byte("fresh-4"), current.BranchToken)
+
+	// pruning everything is rejected and leaves histories untouched
+	_, err = PruneVersionHistories(histories, false, 100)
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+	s.Len(histories.Histories, 2)
+}
+
+func (s *versionHistoriesSuite) TestDiffVersionHistories() {
+	a := &historyspb.VersionHistories{
+		Histories: []*historyspb.VersionHistory{
+			NewVersionHistory([]byte("shared"), []*historyspb.VersionHistoryItem{
+				NewVersionHistoryItem(3, 0),
+				NewVersionHistoryItem(7, 4),
+				NewVersionHistoryItem(9, 6),
+			}),
+			NewVersionHistory([]byte("identical"), []*historyspb.VersionHistoryItem{
+				NewVersionHistoryItem(5, 0),
+			}),
+			NewVersionHistory([]byte("only-a"), []*historyspb.VersionHistoryItem{
+				NewVersionHistoryItem(2, 0),
+			}),
+		},
+	}
+	b := &historyspb.VersionHistories{
+		Histories: []*historyspb.VersionHistory{
+			NewVersionHistory([]byte("only-b"), []*historyspb.VersionHistoryItem{
+				NewVersionHistoryItem(2, 0),
+			}),
+			NewVersionHistory([]byte("identical"), []*historyspb.VersionHistoryItem{
+				NewVersionHistoryItem(5, 0),
+			}),
+			// forks from "shared" after event 5
+			NewVersionHistory([]byte("shared"), []*historyspb.VersionHistoryItem{
+				NewVersionHistoryItem(3, 0),
+				NewVersionHistoryItem(5, 4),
+				NewVersionHistoryItem(8, 5),
+			}),
+		},
+	}
+
+	diffs := DiffVersionHistories(a, b)
+	s.Equal([]BranchDiff{
+		{
+			Type:              BranchDiffTypeDiverged,
+			BranchToken:       []byte("shared"),
+			DivergenceEventID: 6,
+			ItemA:             NewVersionHistoryItem(7, 4),
+			ItemB:             NewVersionHistoryItem(8, 5),
+		},
+		{Type: BranchDiffTypeOnlyInA, BranchToken: []byte("only-a")},
+		{Type: BranchDiffTypeOnlyInB, BranchToken: []byte("only-b")},
+	}, diffs)
+	s.Equal("branch 736861726564: diverged at event 6: a=7:4 b=8:5", diffs[0].String())
+
+	// a branch which is a prefix of the other diverges right after its last event
+	prefix := NewVersionHistories(NewVersionHistory([]byte("shared"), []*historyspb.VersionHistoryItem{
+		NewVersionHistoryItem(3, 0),
+	}))
+	diffs = DiffVersionHistories(prefix, b)
+	s.Len(diffs, 3)
+	s.Equal(int64(4), diffs[0].DivergenceEventID)
+	s.Nil(diffs[0].ItemA)
+	s.Equal(NewVersionHistoryItem(5, 4), diffs[0].ItemB)
+
+	s.Empty(DiffVersionHistories(a, CopyVersionHistories(a)))
+}
+
+func (s *versionHistoriesSuite) TestClassifyReplicationTask() {
+	local := &historyspb.VersionHistories{
+		CurrentVersionHistoryIndex: 0,
+		Histories: []*historyspb.VersionHistory{
+			NewVersionHistory([]byte("current"), []*historyspb.VersionHistoryItem{
+				NewVersionHistoryItem(3, 0),
+				NewVersionHistoryItem(6, 4),
+			}),
+			NewVersionHistory([]byte("other"), []*historyspb.VersionHistoryItem{
+				NewVersionHistoryItem(3, 0),
+				NewVersionHistoryItem(5, 2),
+			}),
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		incoming []*historyspb.VersionHistoryItem
+		expected TaskDisposition
+	}{
+		{
+			name:     "same version extends current branch",
+			incoming: []*historyspb.VersionHistoryItem{NewVersionHistoryItem(3, 0), NewVersionHistoryItem(8, 4)},
+			expected: TaskDispositionAppendable,
+		},
+		{
+			name:     "new version extends current branch",
+			incoming: []*historyspb.VersionHistoryItem{NewVersionHistoryItem(3, 0), NewVersionHistoryItem(6, 4), NewVersionHistoryItem(9, 7)},
+			expected: TaskDispositionAppendable,
+		},
+		{
+			name: "intermediate version missing locally",
+			incoming: []*historyspb.VersionHistoryItem{
+				NewVersionHistoryItem(3, 0),
+				NewVersionHistoryItem(6, 4),
+				NewVersionHistoryItem(8, 7),
+				NewVersionHistoryItem(10, 9),
+			},
+			expected: TaskDispositionNeedsBackfill,
+		},
+		{
+			name:     "diverges from current branch",
+			incoming: []*historyspb.VersionHistoryItem{NewVersionHistoryItem(3, 0), NewVersionHistoryItem(5, 4), NewVersionHistoryItem(7, 6)},
+			expected: TaskDispositionNeedsConflictResolution,
+		},
+		{
+			name:     "extends non current branch",
+			incoming: []*historyspb.VersionHistoryItem{NewVersionHistoryItem(3, 0), NewVersionHistoryItem(7, 2)},
+			expected: TaskDispositionNeedsConflictResolution,
+		},
+		{
+			name:     "already applied on current branch",
+			incoming: []*historyspb.VersionHistoryItem{NewVersionHistoryItem(3, 0), NewVersionHistoryItem(5, 4)},
+			expected: TaskDispositionStaleDuplicate,
+		},
+		{
+			name:     "already applied on non current branch",
+			incoming: []*historyspb.VersionHistoryItem{NewVersionHistoryItem(3, 0), NewVersionHistoryItem(4, 2)},
+			expected: TaskDispositionStaleDuplicate,
+		},
+	}
+
+	for _, tc := range testCases {
+		disposition, err := ClassifyReplicationTask(local, NewVersionHistory([]byte("incoming"), tc.incoming))
+		s.NoError(err, tc.name)
+		s.Equal(tc.expected, disposition, "%s: got %v", tc.name, disposition)
+	}
+}
+
+func (s *versionHistoriesSuite) TestClassifyReplicationTask_Error() {
+	local := NewVersionHistories(NewVersionHistory([]byte("current"), []*historyspb.VersionHistoryItem{
+		NewVersionHistoryItem(3, 1),
+	}))
+
+	_, err := ClassifyReplicationTask(local, NewVersionHistory([]byte("incoming"), nil))
+	s.Error(err)
+
+	// no shared version
+	_, err = ClassifyReplicationTask(local, NewVersionHistory([]byte("incoming"), []*historyspb.VersionHistoryItem{
+		NewVersionHistoryItem(3, 0),
+	}))
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (s *versionHistoriesSuite) TestAnalyzeReshard() {
+	newVersionHistories := func(treeID string, branchIDs ...string) *historyspb.VersionHistories {
+		var histories []*historyspb.VersionHistory
+		for _, branchID := range branchIDs {
+			blob, err := serialization.HistoryBranchToBlob(&persistencespb.HistoryBranch{TreeId: treeID, BranchId: branchID})
+			s.NoError(err)
+			histories = append(histories, NewVersionHistory(blob.Data, []*historyspb.VersionHistoryItem{
+				NewVersionHistoryItem(3, 1),
+			}))
+		}
+		return &historyspb.VersionHistories{Histories: histories}
+	}
+	mismatchedTrees := newVersionHistories("tree-5", "branch-5")
+	mismatchedTrees.Histories = append(mismatchedTrees.Histories, newVersionHistories("tree-6", "branch-6").Histories...)
+
+	workflows := []ReshardWorkflow{
+		{NamespaceID: "namespace-id", WorkflowID: "order-1", RunID: "run-1", VersionHistories: newVersionHistories("tree-1", "branch-1")},
+		{NamespaceID: "namespace-id", WorkflowID: "order-9", RunID: "run-2", VersionHistories: newVersionHistories("tree-2", "branch-2", "branch-3")},
+		{NamespaceID: "namespace-id", WorkflowID: "payment-1", RunID: "run-3", VersionHistories: newVersionHistories("tree-3", "branch-4")},
+		{NamespaceID: "namespace-id", WorkflowID: "order-2", RunID: "run-4", VersionHistories: &historyspb.VersionHistories{
+			Histories: []*historyspb.VersionHistory{NewVersionHistory([]byte("not a branch token"), nil)},
+		}},
+		{NamespaceID: "namespace-id", WorkflowID: "order-3", RunID: "run-5", VersionHistories: mismatchedTrees},
+	}
+
+	reports := AnalyzeReshard(workflows, 512, 1024)
+	s.Len(reports, len(workflows))
+
+	expected := []struct {
+		oldShardID     int32
+		newShardID     int32
+		needsRehoming  bool
+		readableTokens bool
+	}{
+		{oldShardID: 385, newShardID: 385, needsRehoming: false, readableTokens: true},
+		{oldShardID: 32, newShardID: 544, needsRehoming: true, readableTokens: true},
+		{oldShardID: 133, newShardID: 645, needsRehoming: true, readableTokens: true},
+		{oldShardID: 380, newShardID: 380, needsRehoming: false, readableTokens: false},
+		{oldShardID: 431, newShardID: 431, needsRehoming: false, readableTokens: false},
+	}
+	for i, report := range reports {
+		s.Equal(workflows[i], report.Workflow)
+		s.Equal(expected[i].oldShardID, report.OldShardID, workflows[i].WorkflowID)
+		s.Equal(expected[i].newShardID, report.NewShardID, workflows[i].WorkflowID)
+		s.Equal(expected[i].needsRehoming, report.NeedsRehoming(), workflows[i].WorkflowID)
+		s.Equal(expected[i].readableTokens, report.Err == nil, workflows[i].WorkflowID)
+	}
+}
+
+func (s *versionHistoriesSuite) TestCompareAcrossClusters() {
+	// versions 1, 11, 21 are owned by the current (local) cluster, versions 2, 12, 22 by the alternative cluster
+	clusterMetadata := cluster.NewTestClusterMetadata(cluster.NewTestClusterMetadataConfig(true, true))
+	newVersionHistories := func(histories ...[]*historyspb.VersionHistoryItem) *historyspb.VersionHistories {
+		versionHistories := &historyspb.VersionHistories{}
+		for _, items := range histories {
+			versionHistories.Histories = append(versionHistories.Histories, NewVersionHistory([]byte("branch"), items))
+		}
+		return versionHistories
+	}
+
+	testCases := []struct {
+		name              string
+		local             *historyspb.VersionHistories
+		remote            *historyspb.VersionHistories
+		expectDivergences int
+		expectConsistent  bool
+	}{
+		{
+			name:              "identical",
+			local:             newVersionHistories([]*historyspb.VersionHistoryItem{NewVersionHistoryItem(5, 1), NewVersionHistoryItem(10, 2)}),
+			remote:            newVersionHistories([]*historyspb.VersionHistoryItem{NewVersionHistoryItem(5, 1), NewVersionHistoryItem(10, 2)}),
+			expectDivergences: 0,
+			expectConsistent:  true,
+		},
+		{
+			name:              "remote behind",
+			local:             newVersionHistories([]*historyspb.VersionHistoryItem{NewVersionHistoryItem(5, 1), NewVersionHistoryItem(10, 11)}),
+			remote:            newVersionHistories([]*historyspb.VersionHistoryItem{NewVersionHistoryItem(5, 1), NewVersionHistoryItem(7, 11)}),
+			expectDivergences: 0,
+			expectConsistent:  true,
+		},
+		{
+			name:              "remote ahead on remote owned version",
+			local:             newVersionHistories([]*historyspb.VersionHistoryItem{NewVersionHistoryItem(5, 1)}),
+			remote:            newVersionHistories([]*historyspb.VersionHistoryItem{NewVersionHistoryItem(5, 1), NewVersionHistoryItem(8, 2)}),
+			expectDivergences: 1,
+			expectConsistent:  true,
+		},
+		{
+			name:  "remote branch diverged on remote owned version",
+			local: newVersionHistories([]*historyspb.VersionHistoryItem{NewVersionHistoryItem(5, 1), NewVersionHistoryItem(10, 11)}),
+			remote: newVersionHistories(
+				[]*historyspb.VersionHistoryItem{NewVersionHistoryItem(5, 1), NewVersionHistoryItem(10, 11)},
+				[]*historyspb.VersionHistoryItem{NewVersionHistoryItem(5, 1), NewVersionHistoryItem(9, 12)},
+			),
+			expectDivergences: 1,
+			expectConsistent:  true,
+		},
+		{
+			name:              "remote ahead on local owned version",
+			local:             newVersionHistories([]*historyspb.VersionHistoryItem{NewVersionHistoryItem(5, 1)}),
+			remote:            newVersionHistories([]*historyspb.VersionHistoryItem{NewVersionHistoryItem(8, 1)}),
+			expectDivergences: 1,
+			expectConsistent:  false,
+		},
+		{
+			name:              "remote branch diverged on local owned version",
+			local:             newVersionHistories([]*historyspb.VersionHistoryItem{NewVersionHistoryItem(5, 1), NewVersionHistoryItem(10, 2)}),
+			remote:            newVersionHistories([]*historyspb.VersionHistoryItem{NewVersionHistoryItem(5, 1), NewVersionHistoryItem(9, 11)}),
+			expectDivergences: 1,
+			expectConsistent:  false,
+		},
+	}
+
+	for _, tc := range testCases {
+		report, err := CompareAcrossClusters(clusterMetadata, tc.local, tc.remote)
+		s.NoError(err, tc.name)
+		s.Len(report.Divergences, tc.expectDivergences, tc.name)
+		s.Equal(tc.expectConsistent, report.IsConsistent(), tc.name)
+	}
+}
+
+func (s *versionHistoriesSuite) TestCompareAcrossClusters_Divergence() {
+	clusterMetadata := cluster.NewTestClusterMetadata(cluster.NewTestClusterMetadataConfig(true, true))
+	local := NewVersionHistories(NewVersionHistory([]byte("local"), []*historyspb.VersionHistoryItem{
+		NewVersionHistoryItem(5, 1),
+		NewVersionHistoryItem(10, 2),
+	}))
+	remote := NewVersionHistories(NewVersionHistory([]byte("remote"), []*historyspb.VersionHistoryItem{
+		NewVersionHistoryItem(5, 1),
+		NewVersionHistoryItem(7, 12),
+		NewVersionHistoryItem(9, 21),
+	}))
+
+	report, err := CompareAcrossClusters(clusterMetadata, local, remote)
+	s.NoError(err)
+	s.Equal([]BranchDivergence{{
+		RemoteBranchIndex: 0,
+		LocalBranchIndex:  0,
+		LCAItem:           NewVersionHistoryItem(5, 1),
+		RemoteItems:       []*historyspb.VersionHistoryItem{NewVersionHistoryItem(7, 12), NewVersionHistoryItem(9, 21)},
+		LocallyOwned:      true,
+	}}, report.Divergences)
+
+	// branches without a common ancestor cannot be compared
+	remote = NewVersionHistories(NewVersionHistory([]byte("remote"), []*historyspb.VersionHistoryItem{
+		NewVersionHistoryItem(5, 11),
+	}))
+	_, err = CompareAcrossClusters(clusterMetadata, local, remote)
+	s.Error(err)
+}
+
+func (s *versionHistoriesSuite) TestGetReplicationLag() {
+	local := &historyspb.VersionHistories{
+		CurrentVersionHistoryIndex: 1,
+		Histories: []*historyspb.VersionHistory{
+			NewVersionHistory([]byte("other"), []*historyspb.VersionHistoryItem{
+				NewVersionHistoryItem(3, 0),
+				NewVersionHistoryItem(20, 2),
+			}),
+			NewVersionHistory([]byte("current"), []*historyspb.VersionHistoryItem{
+				NewVersionHistoryItem(3, 0),
+				NewVersionHistoryItem(6, 4),
+			}),
+		},
+	}
+
+	// lag is measured against the current branch only
+	lag, err := GetReplicationLag(local, NewVersionHistory(nil, []*historyspb.VersionHistoryItem{
+		NewVersionHistoryItem(3, 0),
+		NewVersionHistoryItem(8, 4),
+		NewVersionHistoryItem(15, 14),
+	}))
+	s.NoError(err)
+	s.Equal(int64(9), lag)
+
+	lag, err = GetReplicationLag(local, NewVersionHistory(nil, []*historyspb.VersionHistoryItem{
+		NewVersionHistoryItem(5, 0),
+	}))
+	s.NoError(err)
+	s.Equal(int64(0), lag)
+
+	_, err = GetReplicationLag(local, NewVersionHistory(nil, nil))
+	s.Error(err)
+}
@@ -25,6 +25,8 @@
 package persistence
 
 import (
+	"context"
+
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -148,94 +150,94 @@ func (p *visibilitySamplingClient) UpsertWorkflowExecution(request *UpsertWorkfl
 	return nil
 }
 
-func (p *visibilitySamplingClient) ListOpenWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+func (p *visibilitySamplingClient) ListOpenWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
 	namespace := request.Namespace
 
 	if ok := p.rateLimitersForList.Allow(namespace); !ok {
 		return nil, ErrPersistenceLimitExceededForList
 	}
 
-	return p.persistence.ListOpenWorkflowExecutions(request)
+	return p.persistence.ListOpenWorkflowExecutions(ctx, request)
 }
 
-func (p *visibilitySamplingClient) ListClosedWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+func (p *visibilitySamplingClient) ListClosedWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
 	namespace := request.Namespace
 
 	if ok := p.rateLimitersForList.Allow(namespace); !ok {
 		return nil, ErrPersistenceLimitExceededForList
 	}
 
-	return p.persistence.ListClosedWorkflowExecutions(request)
+	return p.persistence.ListClosedWorkflowExecutions(ctx, request)
 }
 
-func (p *visibilitySamplingClient) ListOpenWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+func (p *visibilitySamplingClient) ListOpenWorkflowExecutionsByType(ctx context.Context, request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
 	namespace := request.Namespace
 
 	if ok := p.rateLimitersForList.Allow(namespace); !ok {
 		return nil, ErrPersistenceLimitExceededForList
 	}
 
-	return p.persistence.ListOpenWorkflowExecutionsByType(request)
+	return p.persistence.ListOpenWorkflowExecutionsByType(ctx, request)
 }
 
-func (p *visibilitySamplingClient) ListClosedWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+func (p *visibilitySamplingClient) ListClosedWorkflowExecutionsByType(ctx context.Context, request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
 	namespace := request.Namespace
 
 	if ok := p.rateLimitersForList.Allow(namespace); !ok {
 		return nil, ErrPersistenceLimitExceededForList
 	}
 
-	return p.persistence.ListClosedWorkflowExecutionsByType(request)
+	return p.persistence.ListClosedWorkflowExecutionsByType(ctx, request)
 }
 
-func (p *visibilitySamplingClient) ListOpenWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+func (p *visibilitySamplingClient) ListOpenWorkflowExecutionsByWorkflowID(ctx context.Context, request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
 	namespace := request.Namespace
 
 	if ok := p.rateLimitersForList.Allow(namespace); !ok {
 		return nil, ErrPersistenceLimitExceededForList
 	}
 
-	return p.persistence.ListOpenWorkflowExecutionsByWorkflowID(request)
+	return p.persistence.ListOpenWorkflowExecutionsByWorkflowID(ctx, request)
 }
 
-func (p *visibilitySamplingClient) ListClosedWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+func (p *visibilitySamplingClient) ListClosedWorkflowExecutionsByWorkflowID(ctx context.Context, request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
 	namespace := request.Namespace
 
 	if ok := p.rateLimitersForList.Allow(namespace); !ok {
 		return nil, ErrPersistenceLimitExceededForList
 	}
 
-	return p.persistence.ListClosedWorkflowExecutionsByWorkflowID(request)
+	return p.persistence.ListClosedWorkflowExecutionsByWorkflowID(ctx, request)
 }
 
-func (p *visibilitySamplingClient) ListClosedWorkflowExecutionsByStatus(request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error) {
+func (p *visibilitySamplingClient) ListClosedWorkflowExecutionsByStatus(ctx context.Context, request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error) {
 	namespace := request.Namespace
 
 	if ok := p.rateLimitersForList.Allow(namespace); !ok {
 		return nil, ErrPersistenceLimitExceededForList
 	}
 
-	return p.persistence.ListClosedWorkflowExecutionsByStatus(request)
+	return p.persistence.ListClosedWorkflowExecutionsByStatus(ctx, request)
 }
 
-func (p *visibilitySamplingClient) GetClosedWorkflowExecution(request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error) {
-	return p.persistence.GetClosedWorkflowExecution(request)
+func (p *visibilitySamplingClient) GetClosedWorkflowExecution(ctx context.Context, request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error) {
+	return p.persistence.GetClosedWorkflowExecution(ctx, request)
 }
 
 func (p *visibilitySamplingClient) DeleteWorkflowExecution(request *VisibilityDeleteWorkflowExecutionRequest) error {
 	return p.persistence.DeleteWorkflowExecution(request)
 }
 
-func (p *visibilitySamplingClient) ListWorkflowExecutions(request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error) {
-	return p.persistence.ListWorkflowExecutions(request)
+func (p *visibilitySamplingClient) ListWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error) {
+	return p.persistence.ListWorkflowExecutions(ctx, request)
 }
 
-func (p *visibilitySamplingClient) ScanWorkflowExecutions(request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error) {
-	return p.persistence.ScanWorkflowExecutions(request)
+func (p *visibilitySamplingClient) ScanWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error) {
+	return p.persistence.ScanWorkflowExecutions(ctx, request)
 }
 
-func (p *visibilitySamplingClient) CountWorkflowExecutions(request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
-	return p.persistence.CountWorkflowExecutions(request)
+func (p *visibilitySamplingClient) CountWorkflowExecutions(ctx context.Context, request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
+	return p.persistence.CountWorkflowExecutions(ctx, request)
 }
 
 func (p *visibilitySamplingClient) Close() {
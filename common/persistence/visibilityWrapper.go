@@ -25,6 +25,7 @@
 package persistence
 
 import (
+	"context"
 	"fmt"
 
 	"go.temporal.io/api/serviceerror"
@@ -122,44 +123,44 @@ func (v *visibilityManagerWrapper) UpsertWorkflowExecution(request *UpsertWorkfl
 	}
 }
 
-func (v *visibilityManagerWrapper) ListOpenWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+func (v *visibilityManagerWrapper) ListOpenWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
 	manager := v.chooseVisibilityManagerForNamespace(request.Namespace)
-	return manager.ListOpenWorkflowExecutions(request)
+	return manager.ListOpenWorkflowExecutions(ctx, request)
 }
 
-func (v *visibilityManagerWrapper) ListClosedWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+func (v *visibilityManagerWrapper) ListClosedWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
 	manager := v.chooseVisibilityManagerForNamespace(request.Namespace)
-	return manager.ListClosedWorkflowExecutions(request)
+	return manager.ListClosedWorkflowExecutions(ctx, request)
 }
 
-func (v *visibilityManagerWrapper) ListOpenWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+func (v *visibilityManagerWrapper) ListOpenWorkflowExecutionsByType(ctx context.Context, request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
 	manager := v.chooseVisibilityManagerForNamespace(request.Namespace)
-	return manager.ListOpenWorkflowExecutionsByType(request)
+	return manager.ListOpenWorkflowExecutionsByType(ctx, request)
 }
 
-func (v *visibilityManagerWrapper) ListClosedWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+func (v *visibilityManagerWrapper) ListClosedWorkflowExecutionsByType(ctx context.Context, request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
 	manager := v.chooseVisibilityManagerForNamespace(request.Namespace)
-	return manager.ListClosedWorkflowExecutionsByType(request)
+	return manager.ListClosedWorkflowExecutionsByType(ctx, request)
 }
 
-func (v *visibilityManagerWrapper) ListOpenWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+func (v *visibilityManagerWrapper) ListOpenWorkflowExecutionsByWorkflowID(ctx context.Context, request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
 	manager := v.chooseVisibilityManagerForNamespace(request.Namespace)
-	return manager.ListOpenWorkflowExecutionsByWorkflowID(request)
+	return manager.ListOpenWorkflowExecutionsByWorkflowID(ctx, request)
 }
 
-func (v *visibilityManagerWrapper) ListClosedWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+func (v *visibilityManagerWrapper) ListClosedWorkflowExecutionsByWorkflowID(ctx context.Context, request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
 	manager := v.chooseVisibilityManagerForNamespace(request.Namespace)
-	return manager.ListClosedWorkflowExecutionsByWorkflowID(request)
+	return manager.ListClosedWorkflowExecutionsByWorkflowID(ctx, request)
 }
 
-func (v *visibilityManagerWrapper) ListClosedWorkflowExecutionsByStatus(request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error) {
+func (v *visibilityManagerWrapper) ListClosedWorkflowExecutionsByStatus(ctx context.Context, request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error) {
 	manager := v.chooseVisibilityManagerForNamespace(request.Namespace)
-	return manager.ListClosedWorkflowExecutionsByStatus(request)
+	return manager.ListClosedWorkflowExecutionsByStatus(ctx, request)
 }
 
-func (v *visibilityManagerWrapper) GetClosedWorkflowExecution(request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error) {
+func (v *visibilityManagerWrapper) GetClosedWorkflowExecution(ctx context.Context, request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error) {
 	manager := v.chooseVisibilityManagerForNamespace(request.Namespace)
-	return manager.GetClosedWorkflowExecution(request)
+	return manager.GetClosedWorkflowExecution(ctx, request)
 }
 
 func (v *visibilityManagerWrapper) DeleteWorkflowExecution(request *VisibilityDeleteWorkflowExecutionRequest) error {
@@ -178,19 +179,19 @@ func (v *visibilityManagerWrapper) DeleteWorkflowExecution(request *VisibilityDe
 	}
 }
 
-func (v *visibilityManagerWrapper) ListWorkflowExecutions(request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error) {
+func (v *visibilityManagerWrapper) ListWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error) {
 	manager := v.chooseVisibilityManagerForNamespace(request.Namespace)
-	return manager.ListWorkflowExecutions(request)
+	return manager.ListWorkflowExecutions(ctx, request)
 }
 
-func (v *visibilityManagerWrapper) ScanWorkflowExecutions(request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error) {
+func (v *visibilityManagerWrapper) ScanWorkflowExecutions(ctx context.Context, request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error) {
 	manager := v.chooseVisibilityManagerForNamespace(request.Namespace)
-	return manager.ScanWorkflowExecutions(request)
+	return manager.ScanWorkflowExecutions(ctx, request)
 }
 
-func (v *visibilityManagerWrapper) CountWorkflowExecutions(request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
+func (v *visibilityManagerWrapper) CountWorkflowExecutions(ctx context.Context, request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
 	manager := v.chooseVisibilityManagerForNamespace(request.Namespace)
-	return manager.CountWorkflowExecutions(request)
+	return manager.CountWorkflowExecutions(ctx, request)
 }
 
 func (v *visibilityManagerWrapper) chooseVisibilityManagerForNamespace(namespace string) VisibilityManager {
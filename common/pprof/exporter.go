@@ -0,0 +1,138 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package pprof
+
+import (
+	"bytes"
+	runtimepprof "runtime/pprof"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+)
+
+type (
+	// ProfileSink receives a captured CPU profile in pprof format
+	ProfileSink func(profile []byte)
+
+	// ExporterConfig configures periodic capture of CPU profiles
+	ExporterConfig struct {
+		// Interval between the starts of consecutive captures
+		Interval time.Duration
+		// Duration of each capture, should be shorter than Interval
+		Duration time.Duration
+		// Sink receives every completed capture
+		Sink ProfileSink
+	}
+
+	// Exporter periodically captures CPU profiles and hands them to a sink
+	Exporter struct {
+		status     int32
+		config     ExporterConfig
+		logger     log.Logger
+		shutdownCh chan struct{}
+		shutdownWG sync.WaitGroup
+	}
+)
+
+var _ common.Daemon = (*Exporter)(nil)
+
+// NewExporter create a new instance of CPU profile exporter
+func NewExporter(config ExporterConfig, logger log.Logger) *Exporter {
+	return &Exporter{
+		status:     common.DaemonStatusInitialized,
+		config:     config,
+		logger:     logger,
+		shutdownCh: make(chan struct{}),
+	}
+}
+
+// Start starts capturing profiles
+func (e *Exporter) Start() {
+	if !atomic.CompareAndSwapInt32(
+		&e.status,
+		common.DaemonStatusInitialized,
+		common.DaemonStatusStarted,
+	) {
+		return
+	}
+
+	e.shutdownWG.Add(1)
+	go e.exportLoop()
+	e.logger.Info("Profile exporter started")
+}
+
+// Stop stops capturing profiles, discarding the capture in progress if any
+func (e *Exporter) Stop() {
+	if !atomic.CompareAndSwapInt32(
+		&e.status,
+		common.DaemonStatusStarted,
+		common.DaemonStatusStopped,
+	) {
+		return
+	}
+
+	close(e.shutdownCh)
+	e.shutdownWG.Wait()
+	e.logger.Info("Profile exporter stopped")
+}
+
+func (e *Exporter) exportLoop() {
+	defer e.shutdownWG.Done()
+
+	ticker := time.NewTicker(e.config.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			e.capture()
+		case <-e.shutdownCh:
+			return
+		}
+	}
+}
+
+func (e *Exporter) capture() {
+	var profile bytes.Buffer
+	// only one CPU profile can be active per process, so when several services share
+	// a process only one of them captures each round
+	if err := runtimepprof.StartCPUProfile(&profile); err != nil {
+		e.logger.Debug("Unable to start CPU profile", tag.Error(err))
+		return
+	}
+
+	timer := time.NewTimer(e.config.Duration)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		runtimepprof.StopCPUProfile()
+		e.config.Sink(profile.Bytes())
+	case <-e.shutdownCh:
+		runtimepprof.StopCPUProfile()
+	}
+}
@@ -0,0 +1,91 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package pprof
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/common/log"
+)
+
+func TestExporterDeliversProfilesAtInterval(t *testing.T) {
+	interval := 100 * time.Millisecond
+	profiles := make(chan time.Time, 10)
+	exporter := NewExporter(ExporterConfig{
+		Interval: interval,
+		Duration: 10 * time.Millisecond,
+		Sink: func(profile []byte) {
+			require.NotEmpty(t, profile)
+			profiles <- time.Now()
+		},
+	}, log.NewNoopLogger())
+
+	start := time.Now()
+	exporter.Start()
+
+	var deliveries []time.Time
+	for len(deliveries) < 3 {
+		select {
+		case delivered := <-profiles:
+			deliveries = append(deliveries, delivered)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for profiles")
+		}
+	}
+	exporter.Stop()
+
+	require.GreaterOrEqual(t, int64(deliveries[0].Sub(start)), int64(interval))
+	for i := 1; i < len(deliveries); i++ {
+		// ticks are interval apart, the capture duration only shifts every delivery equally
+		require.GreaterOrEqual(t, int64(deliveries[i].Sub(deliveries[i-1])), int64(interval/2))
+	}
+
+	// no profile is delivered once the exporter is stopped
+	select {
+	case <-profiles:
+		t.Fatal("profile delivered after stop")
+	case <-time.After(2 * interval):
+	}
+}
+
+func TestExporterStopDiscardsCaptureInProgress(t *testing.T) {
+	delivered := make(chan struct{}, 1)
+	exporter := NewExporter(ExporterConfig{
+		Interval: 10 * time.Millisecond,
+		Duration: time.Hour,
+		Sink: func(profile []byte) {
+			delivered <- struct{}{}
+		},
+	}, log.NewNoopLogger())
+
+	exporter.Start()
+	time.Sleep(50 * time.Millisecond)
+	exporter.Stop()
+
+	require.Empty(t, delivered)
+}
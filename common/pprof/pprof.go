@@ -26,8 +26,10 @@ package pprof
 
 import (
 	"fmt"
+	"net"
 	"net/http"
 	_ "net/http/pprof" // DO NOT REMOVE THE LINE
+	"sync"
 	"sync/atomic"
 
 	"go.temporal.io/server/common/config"
@@ -46,12 +48,32 @@ type (
 		PProf  *config.PProf
 		Logger log.Logger
 	}
+
+	// PProfDynamicPortInitializerImpl initialize the pprof on a port picked by the OS
+	PProfDynamicPortInitializerImpl struct {
+		Logger log.Logger
+	}
+
+	// replaceableHandler lets a pattern be registered again, http.Handle panics on duplicates
+	replaceableHandler struct {
+		sync.RWMutex
+		handler http.Handler
+	}
 )
 
 // the pprof should only be initialized once per process
 // otherwise, the caller / worker will experience weird issue
 var pprofStatus = pprofNotInitialized
 
+// pprofAddress is the address pprof listens on, set once pprof is initialized
+var pprofAddress atomic.Value
+
+// debugHandlers are the handlers served next to pprof, keyed by pattern
+var (
+	debugHandlersLock sync.Mutex
+	debugHandlers     = make(map[string]*replaceableHandler)
+)
+
 // NewInitializer create a new instance of PProf Initializer
 func NewInitializer(cfg *config.PProf, logger log.Logger) *PProfInitializerImpl {
 	return &PProfInitializerImpl{
@@ -69,6 +91,7 @@ func (initializer *PProfInitializerImpl) Start() error {
 	}
 
 	if atomic.CompareAndSwapInt32(&pprofStatus, pprofNotInitialized, pprofInitialized) {
+		pprofAddress.Store(fmt.Sprintf("localhost:%d", port))
 		go func() {
 			initializer.Logger.Info("PProf listen on ", tag.Port(port))
 			err := http.ListenAndServe(fmt.Sprintf("localhost:%d", port), nil)
@@ -79,3 +102,70 @@ func (initializer *PProfInitializerImpl) Start() error {
 	}
 	return nil
 }
+
+// NewDynamicPortInitializer create a new instance of PProf Initializer listening on a port picked by the OS
+func NewDynamicPortInitializer(logger log.Logger) *PProfDynamicPortInitializerImpl {
+	return &PProfDynamicPortInitializerImpl{
+		Logger: logger,
+	}
+}
+
+// Start the pprof on a free port and return the address it listens on.
+// If pprof is already initialized in this process, the existing address is returned.
+func (initializer *PProfDynamicPortInitializerImpl) Start() (string, error) {
+	if !atomic.CompareAndSwapInt32(&pprofStatus, pprofNotInitialized, pprofInitialized) {
+		return Address(), nil
+	}
+
+	listener, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		atomic.StoreInt32(&pprofStatus, pprofNotInitialized)
+		return "", err
+	}
+	address := listener.Addr().String()
+	pprofAddress.Store(address)
+
+	go func() {
+		initializer.Logger.Info("PProf listen on ", tag.Address(address))
+		err := http.Serve(listener, nil)
+		if err != nil {
+			initializer.Logger.Error("listen and serve err", tag.Error(err))
+		}
+	}()
+	return address, nil
+}
+
+// Address returns the address pprof listens on, or empty string if pprof is not initialized
+func Address() string {
+	address, _ := pprofAddress.Load().(string)
+	return address
+}
+
+// RegisterHandler serves the handler under the given pattern on the pprof listener. It is a no-op
+// returning false when pprof is not initialized. Registering a pattern again replaces its handler.
+func RegisterHandler(pattern string, handler http.Handler) bool {
+	if atomic.LoadInt32(&pprofStatus) != pprofInitialized {
+		return false
+	}
+
+	debugHandlersLock.Lock()
+	defer debugHandlersLock.Unlock()
+
+	if existing, ok := debugHandlers[pattern]; ok {
+		existing.Lock()
+		existing.handler = handler
+		existing.Unlock()
+		return true
+	}
+	registered := &replaceableHandler{handler: handler}
+	debugHandlers[pattern] = registered
+	http.Handle(pattern, registered)
+	return true
+}
+
+func (h *replaceableHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.RLock()
+	handler := h.handler
+	h.RUnlock()
+	handler.ServeHTTP(w, r)
+}
@@ -0,0 +1,137 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package protoschema resolves the schema of registered proto messages to inspect their encoded form.
+package protoschema
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+)
+
+var (
+	descriptors sync.Map // full message name -> *descriptor.DescriptorProto
+)
+
+// MessageDescriptor returns the descriptor of the message registered with fullName, or nil if it
+// is unknown. Descriptors of map entries, which are not registered on their own, are found in the
+// file of the enclosing message.
+func MessageDescriptor(fullName string) *descriptor.DescriptorProto {
+	if message, ok := descriptors.Load(fullName); ok {
+		return message.(*descriptor.DescriptorProto)
+	}
+
+	name := fullName
+	typ := proto.MessageType(name)
+	for typ == nil {
+		idx := strings.LastIndex(name, ".")
+		if idx < 0 {
+			return nil
+		}
+		name = name[:idx]
+		typ = proto.MessageType(name)
+	}
+	message, ok := reflect.Zero(typ).Interface().(descriptor.Message)
+	if !ok {
+		return nil
+	}
+	file, _ := descriptor.ForMessage(message)
+	for _, message := range file.GetMessageType() {
+		storeDescriptors(file.GetPackage(), message)
+	}
+
+	if message, ok := descriptors.Load(fullName); ok {
+		return message.(*descriptor.DescriptorProto)
+	}
+	return nil
+}
+
+// HasUnknownFields returns true if the encoded message in data, or any message nested in it, has a
+// field which is not part of the schema of the message registered with fullName. Unmarshal drops
+// such fields. Messages which can not be resolved are not walked into.
+func HasUnknownFields(data []byte, fullName string) bool {
+	message := MessageDescriptor(fullName)
+	if message == nil {
+		return false
+	}
+	fields := make(map[int32]*descriptor.FieldDescriptorProto, len(message.GetField()))
+	for _, field := range message.GetField() {
+		fields[field.GetNumber()] = field
+	}
+
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		wire, n := proto.DecodeVarint(data[iNdEx:])
+		if n == 0 {
+			return false
+		}
+		iNdEx += n
+		field, ok := fields[int32(wire>>3)]
+		if !ok {
+			return true
+		}
+
+		switch wire & 0x7 {
+		case 0:
+			_, n := proto.DecodeVarint(data[iNdEx:])
+			if n == 0 {
+				return false
+			}
+			iNdEx += n
+		case 1:
+			iNdEx += 8
+		case 2:
+			length, n := proto.DecodeVarint(data[iNdEx:])
+			if n == 0 || length > uint64(l-iNdEx-n) {
+				return false
+			}
+			iNdEx += n
+			end := iNdEx + int(length)
+			if field.GetType() == descriptor.FieldDescriptorProto_TYPE_MESSAGE &&
+				HasUnknownFields(data[iNdEx:end], strings.TrimPrefix(field.GetTypeName(), ".")) {
+				return true
+			}
+			iNdEx = end
+		case 5:
+			iNdEx += 4
+		default:
+			// groups are not used by the schemas of the server
+			return true
+		}
+	}
+	return false
+}
+
+func storeDescriptors(prefix string, message *descriptor.DescriptorProto) {
+	fullName := prefix + "." + message.GetName()
+	descriptors.Store(fullName, message)
+	for _, nested := range message.GetNestedType() {
+		storeDescriptors(fullName, nested)
+	}
+}
@@ -0,0 +1,58 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package protoschema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+)
+
+func TestMessageDescriptor(t *testing.T) {
+	header := MessageDescriptor("temporal.api.common.v1.Header")
+	require.NotNil(t, header)
+	require.Equal(t, "Header", header.GetName())
+
+	// map entries are not registered on their own
+	entry := MessageDescriptor("temporal.api.common.v1.Header.FieldsEntry")
+	require.NotNil(t, entry)
+	require.True(t, entry.GetOptions().GetMapEntry())
+
+	require.Nil(t, MessageDescriptor("temporal.api.common.v1.Unknown"))
+	require.Nil(t, MessageDescriptor("unknown"))
+}
+
+func TestHasUnknownFields(t *testing.T) {
+	data, err := (&commonpb.Header{
+		Fields: map[string]*commonpb.Payload{"key": {Data: []byte("value")}},
+	}).Marshal()
+	require.NoError(t, err)
+	require.False(t, HasUnknownFields(data, "temporal.api.common.v1.Header"))
+
+	// field 3 of the payload in the map entry value
+	withUnknownPayloadField := []byte{0x0a, 0x09, 0x0a, 0x01, 'k', 0x12, 0x04, 0x12, 0x00, 0x18, 0x01}
+	require.True(t, HasUnknownFields(withUnknownPayloadField, "temporal.api.common.v1.Header"))
+}
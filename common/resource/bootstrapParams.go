@@ -25,13 +25,18 @@
 package resource
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/uber-go/tally"
+	"go.opentelemetry.io/otel/trace"
 	sdkclient "go.temporal.io/sdk/client"
 	"go.temporal.io/server/client"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/provider"
 	"go.temporal.io/server/common/authorization"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
@@ -39,6 +44,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
 	esclient "go.temporal.io/server/common/persistence/elasticsearch/client"
+	"go.temporal.io/server/common/pprof"
 	"go.temporal.io/server/common/resolver"
 )
 
@@ -53,6 +59,7 @@ type (
 		NamespaceLogger log.Logger
 
 		MetricsScope                 tally.Scope
+		MetricsScopes                []tally.Scope
 		MembershipFactoryInitializer MembershipFactoryInitializerFunc
 		RPCFactory                   common.RPCFactory
 		ClientFactoryProvider        client.FactoryProvider
@@ -74,6 +81,16 @@ type (
 		ClaimMapper                  authorization.ClaimMapper
 		PersistenceServiceResolver   resolver.ServiceResolver
 		AudienceGetter               authorization.JWTAudienceMapper
+		// ProfileExporterConfig enables periodic CPU profile export when set
+		ProfileExporterConfig *pprof.ExporterConfig
+		// TimeSource is the clock of the service, real wall clock time is used when nil
+		TimeSource clock.TimeSource
+		// RandomSeed seeds the random generator returned by Resource.GetRandom, a time based seed is used when zero
+		RandomSeed int64
+		// IDGenerator generates the IDs returned by Resource.GetIDGenerator, random UUIDs are generated when nil
+		IDGenerator IDGenerator
+		// TracerProvider creates the tracers used to start a span per handled gRPC request and per applied replication task, nothing is traced when nil
+		TracerProvider trace.TracerProvider
 	}
 
 	// MembershipMonitorFactory provides a bootstrapped membership monitor
@@ -86,3 +103,57 @@ type (
 	// to allow for the PersistenceBean to be constructed further downstream.
 	MembershipFactoryInitializerFunc func(persistenceBean persistenceClient.Bean, logger log.Logger) (MembershipMonitorFactory, error)
 )
+
+// FanOutMetrics wraps MetricsScope and MetricsClient so everything emitted through them
+// also reaches each of the additional MetricsScopes. It must be called before the
+// service is constructed, since services capture MetricsClient on construction.
+func (p *BootstrapParams) FanOutMetrics(serviceName string) {
+	if len(p.MetricsScopes) == 0 {
+		return
+	}
+
+	serviceIdx := metrics.GetMetricsServiceIdx(serviceName, p.Logger)
+	var clients []metrics.Client
+	if p.MetricsClient != nil {
+		clients = append(clients, p.MetricsClient)
+	}
+	for _, scope := range p.MetricsScopes {
+		clients = append(clients, metrics.NewClient(scope, serviceIdx))
+	}
+
+	p.MetricsScope = metrics.NewMultiScope(append([]tally.Scope{p.MetricsScope}, p.MetricsScopes...)...)
+	p.MetricsClient = metrics.NewMultiClient(clients...)
+	// the additional scopes are now part of MetricsScope, do not wrap them twice
+	p.MetricsScopes = nil
+}
+
+// Validate checks that the params required to bootstrap a service are set
+func (p *BootstrapParams) Validate() error {
+	var missing []string
+	if p.Name == "" {
+		missing = append(missing, "Name")
+	}
+	if p.Logger == nil {
+		missing = append(missing, "Logger")
+	}
+	if p.MetricsScope == nil {
+		missing = append(missing, "MetricsScope")
+	}
+	if p.RPCFactory == nil {
+		missing = append(missing, "RPCFactory")
+	}
+	if p.MembershipFactoryInitializer == nil {
+		missing = append(missing, "MembershipFactoryInitializer")
+	}
+	if p.PersistenceConfig.NumHistoryShards <= 0 {
+		missing = append(missing, "PersistenceConfig.NumHistoryShards")
+	}
+	if p.ClusterMetadataConfig == nil {
+		missing = append(missing, "ClusterMetadataConfig")
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("bootstrap params: missing required fields: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package resource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	persistenceClient "go.temporal.io/server/common/persistence/client"
+	"go.temporal.io/server/common/rpc"
+)
+
+func TestBootstrapParamsValidate(t *testing.T) {
+	params := &BootstrapParams{
+		Name:         common.FrontendServiceName,
+		Logger:       log.NewNoopLogger(),
+		MetricsScope: tally.NoopScope,
+		RPCFactory:   rpc.NewFactory(&config.RPC{}, config.InternodeAuth{}, common.FrontendServiceName, log.NewNoopLogger(), nil),
+		MembershipFactoryInitializer: func(persistenceClient.Bean, log.Logger) (MembershipMonitorFactory, error) {
+			return nil, nil
+		},
+		PersistenceConfig:     config.Persistence{NumHistoryShards: 4},
+		ClusterMetadataConfig: &config.ClusterMetadata{},
+	}
+	require.NoError(t, params.Validate())
+}
+
+func TestBootstrapParamsValidate_MissingFields(t *testing.T) {
+	params := &BootstrapParams{
+		Name:   common.FrontendServiceName,
+		Logger: log.NewNoopLogger(),
+	}
+
+	err := params.Validate()
+	require.Error(t, err)
+	require.Equal(t,
+		"bootstrap params: missing required fields: MetricsScope, RPCFactory, MembershipFactoryInitializer, "+
+			"PersistenceConfig.NumHistoryShards, ClusterMetadataConfig",
+		err.Error(),
+	)
+}
+
+func TestNew_InvalidBootstrapParams(t *testing.T) {
+	_, err := New(&BootstrapParams{}, common.FrontendServiceName, nil, nil, nil, nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Name, Logger")
+}
+
+func TestBootstrapParamsFanOutMetrics(t *testing.T) {
+	primaryScope := tally.NewTestScope("", nil)
+	additionalScope := tally.NewTestScope("", nil)
+	params := &BootstrapParams{
+		Logger:        log.NewNoopLogger(),
+		MetricsScope:  primaryScope,
+		MetricsClient: metrics.NewClient(primaryScope, metrics.Frontend),
+		MetricsScopes: []tally.Scope{additionalScope},
+	}
+
+	params.FanOutMetrics(common.FrontendServiceName)
+	params.MetricsScope.Counter(metrics.RestartCount).Inc(1)
+	params.MetricsClient.IncCounter(metrics.PersistenceGetShardScope, metrics.PersistenceRequests)
+
+	for _, scope := range []tally.TestScope{primaryScope, additionalScope} {
+		counters := make(map[string]int64)
+		for _, counter := range scope.Snapshot().Counters() {
+			counters[counter.Name()] += counter.Value()
+		}
+		require.Equal(t, int64(1), counters[metrics.RestartCount])
+		require.Equal(t, int64(1), counters["persistence_requests"])
+	}
+
+	// fanning out again does not wrap the additional scopes twice
+	params.FanOutMetrics(common.FrontendServiceName)
+	params.MetricsScope.Counter(metrics.RestartCount).Inc(1)
+	require.Equal(t, int64(2), additionalScope.Snapshot().Counters()[metrics.RestartCount+"+"].Value())
+}
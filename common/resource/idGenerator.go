@@ -0,0 +1,125 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package resource
+
+import (
+	"math/rand"
+	"sync"
+
+	"github.com/pborman/uuid"
+
+	"go.temporal.io/server/common"
+)
+
+type (
+	// IDGenerator generates the IDs used by a service, so that they can be made deterministic in tests
+	IDGenerator interface {
+		// NewUUID returns a new UUID string, e.g. for run IDs and request IDs
+		NewUUID() string
+		// NextEventID returns the next value of the persisted counter of the given shard, see SetShardCounter.
+		// common.EmptyEventID is returned if the counter can not be advanced, e.g. the shard is not owned by this host
+		NextEventID(shardID int) int64
+		// SetShardCounter sets the counter backing NextEventID, it is set by the service owning the shards
+		SetShardCounter(counter ShardCounter)
+	}
+
+	// ShardCounter advances the persisted counter of a shard, which is fenced by the range ID of the shard
+	// so that it stays monotonic across restarts and changes of the shard owner
+	ShardCounter interface {
+		NextID(shardID int) (int64, error)
+	}
+
+	// shardCounterHolder backs NextEventID with the ShardCounter set by the service
+	shardCounterHolder struct {
+		lock    sync.RWMutex
+		counter ShardCounter
+	}
+
+	randomIDGenerator struct {
+		shardCounterHolder
+	}
+
+	deterministicIDGenerator struct {
+		shardCounterHolder
+
+		sync.Mutex
+		random *rand.Rand
+	}
+)
+
+var _ IDGenerator = (*randomIDGenerator)(nil)
+var _ IDGenerator = (*deterministicIDGenerator)(nil)
+
+// NewIDGenerator returns the default IDGenerator, generating random UUIDs
+func NewIDGenerator() IDGenerator {
+	return &randomIDGenerator{}
+}
+
+// NewDeterministicIDGenerator returns an IDGenerator generating the same sequence of UUIDs for the same seed
+func NewDeterministicIDGenerator(seed int64) IDGenerator {
+	return &deterministicIDGenerator{
+		random: rand.New(rand.NewSource(seed)),
+	}
+}
+
+// SetShardCounter sets the counter backing NextEventID
+func (h *shardCounterHolder) SetShardCounter(counter ShardCounter) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.counter = counter
+}
+
+// NextEventID returns the next value of the counter of the shard, or common.EmptyEventID if there is none
+func (h *shardCounterHolder) NextEventID(shardID int) int64 {
+	h.lock.RLock()
+	counter := h.counter
+	h.lock.RUnlock()
+
+	if counter == nil {
+		return common.EmptyEventID
+	}
+	id, err := counter.NextID(shardID)
+	if err != nil {
+		return common.EmptyEventID
+	}
+	return id
+}
+
+func (g *randomIDGenerator) NewUUID() string {
+	return uuid.New()
+}
+
+func (g *deterministicIDGenerator) NewUUID() string {
+	g.Lock()
+	defer g.Unlock()
+
+	id := make(uuid.UUID, 16)
+	_, _ = g.random.Read(id)
+	// set the version 4 (random) and RFC 4122 variant bits, as uuid.New does
+	id[6] = (id[6] & 0x0f) | 0x40
+	id[8] = (id[8] & 0x3f) | 0x80
+	return id.String()
+}
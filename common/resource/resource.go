@@ -25,10 +25,14 @@
 package resource
 
 import (
+	"context"
+	"math/rand"
 	"net"
+	"time"
 
 	"go.temporal.io/server/common/persistence/serialization"
 
+	"go.opentelemetry.io/otel/trace"
 	"go.temporal.io/api/workflowservice/v1"
 	sdkclient "go.temporal.io/sdk/client"
 	"go.temporal.io/server/common/searchattribute"
@@ -37,7 +41,6 @@ import (
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/client"
-	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/archiver"
 	"go.temporal.io/server/common/archiver/provider"
 	"go.temporal.io/server/common/cache"
@@ -51,9 +54,30 @@ import (
 )
 
 type (
+	// HealthCheck returns an error if a dependency of the service is unhealthy
+	HealthCheck func(ctx context.Context) error
+
 	// Resource is the interface which expose common resources
 	Resource interface {
-		common.Daemon
+		// Start starts all resources. The lifecycle only moves forward, from initialized to started
+		// to stopped: starting already started resources is a no-op, while starting stopped
+		// resources returns ErrResourcesStopped.
+		Start() error
+		// Stop stops all resources. Stopping resources which were never started is a no-op.
+		Stop()
+		// LeaveMembershipRing evicts this host from the membership ring and waits for peers to stop
+		// routing to it. Services call it before stopping their gRPC server, Stop calls it otherwise.
+		// Only the first call has an effect.
+		LeaveMembershipRing()
+		// GetStatus returns the current lifecycle state, one of common.DaemonStatusInitialized,
+		// common.DaemonStatusStarted or common.DaemonStatusStopped.
+		GetStatus() int32
+		// IsReady returns true if resources are started and all registered health checks passed
+		// on their last run.
+		IsReady() bool
+		// RegisterHealthCheck registers a health check which is run when resources start and
+		// periodically afterwards. Checks must be registered before Start.
+		RegisterHealthCheck(name string, check HealthCheck)
 
 		// static infos
 
@@ -69,8 +93,16 @@ type (
 
 		GetNamespaceCache() cache.NamespaceCache
 		GetTimeSource() clock.TimeSource
+		// NewTicker returns a ticker driven by GetTimeSource, so periodic loops can be tested with a fake clock.
+		NewTicker(d time.Duration) clock.Ticker
+		// GetRandom returns a random generator scoped to this service, safe for concurrent use.
+		GetRandom() *rand.Rand
+		// GetIDGenerator returns the generator of UUIDs and shard event IDs of this service.
+		GetIDGenerator() IDGenerator
 		GetPayloadSerializer() serialization.Serializer
 		GetMetricsClient() metrics.Client
+		// GetTracerProvider returns the provider of tracers used to start spans, it is never nil.
+		GetTracerProvider() trace.TracerProvider
 		GetArchiverProvider() provider.ArchiverProvider
 
 		// membership infos
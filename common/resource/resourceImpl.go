@@ -25,19 +25,23 @@
 package resource
 
 import (
+	"context"
+	"errors"
 	"math/rand"
 	"net"
 	"os"
+	"sync"
 	"sync/atomic"
 	"time"
 
 	"go.temporal.io/server/common/persistence/serialization"
 
+	"github.com/pborman/uuid"
 	"github.com/uber-go/tally"
 	"github.com/uber/tchannel-go"
+	"go.opentelemetry.io/otel/trace"
 	"go.temporal.io/api/workflowservice/v1"
 	sdkclient "go.temporal.io/sdk/client"
-	"go.temporal.io/server/common/searchattribute"
 
 	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/api/historyservice/v1"
@@ -59,6 +63,8 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
+	"go.temporal.io/server/common/pprof"
+	"go.temporal.io/server/common/searchattribute"
 )
 
 type (
@@ -90,8 +96,11 @@ type (
 
 		namespaceCache    cache.NamespaceCache
 		timeSource        clock.TimeSource
+		random            *rand.Rand
+		idGenerator       IDGenerator
 		payloadSerializer serialization.Serializer
 		metricsClient     metrics.Client
+		tracerProvider    trace.TracerProvider
 		archivalMetadata  archiver.ArchivalMetadata
 		archiverProvider  provider.ArchiverProvider
 
@@ -131,13 +140,49 @@ type (
 		ringpopChannel *tchannel.Channel
 
 		// internal vars
-		runtimeMetricsReporter *metrics.RuntimeMetricsReporter
-		rpcFactory             common.RPCFactory
+		runtimeMetricsReporter          *metrics.RuntimeMetricsReporter
+		membershipLeavePropagationDelay dynamicconfig.DurationPropertyFn
+		leaveMembershipRingOnce         sync.Once
+		profileExporter                 *pprof.Exporter
+		rpcFactory                      common.RPCFactory
+
+		// health checks
+		healthChecksLock  sync.Mutex
+		healthChecks      map[string]HealthCheck
+		healthy           int32
+		healthCheckStopCh chan struct{}
 	}
 )
 
+const (
+	healthCheckInterval = 10 * time.Second
+	healthCheckTimeout  = 5 * time.Second
+)
+
 var _ Resource = (*Impl)(nil)
 
+// ErrResourcesStopped is returned when starting resources which have already been stopped
+var ErrResourcesStopped = errors.New("service resources have been stopped")
+
+// osHostname looks up the host name, overridden in tests
+var osHostname = os.Hostname
+
+// resolveHostName returns the host name of this machine. The host name is only used as a metrics tag, so
+// if the lookup fails it falls back to the instance ID, or a random UUID if no instance ID is configured.
+func resolveHostName(instanceID string, logger log.Logger) string {
+	hostName, err := osHostname()
+	if err == nil {
+		return hostName
+	}
+
+	hostName = instanceID
+	if hostName == "" {
+		hostName = uuid.New()
+	}
+	logger.Warn("Unable to look up host name, using fallback.", tag.Error(err), tag.HostName(hostName))
+	return hostName
+}
+
 // New create a new resource containing common dependencies
 func New(
 	params *BootstrapParams,
@@ -148,14 +193,31 @@ func New(
 	visibilityManagerInitializer VisibilityManagerInitializer,
 ) (impl *Impl, retError error) {
 
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
 	logger := log.With(params.Logger, tag.Service(serviceName))
-	throttledLogger := log.NewThrottledLogger(logger,
-		func() float64 { return float64(throttledLoggerMaxRPS()) })
+	dynamicCollection := dynamicconfig.NewCollection(params.DynamicConfigClient, logger)
+	throttledLoggerPerKeyRPS := dynamicCollection.GetIntProperty(dynamicconfig.ThrottledLogPerKeyRPS, 0)
+	throttledLogger := log.NewSampledThrottledLogger(logger,
+		func() float64 { return float64(throttledLoggerMaxRPS()) },
+		func() float64 { return float64(throttledLoggerPerKeyRPS()) })
 
 	numShards := params.PersistenceConfig.NumHistoryShards
-	hostName, err := os.Hostname()
-	if err != nil {
-		return nil, err
+	hostName := resolveHostName(params.InstanceID, logger)
+
+	timeSource := params.TimeSource
+	if timeSource == nil {
+		timeSource = clock.NewRealTimeSource()
+	}
+	idGenerator := params.IDGenerator
+	if idGenerator == nil {
+		idGenerator = NewIDGenerator()
+	}
+	tracerProvider := params.TracerProvider
+	if tracerProvider == nil {
+		tracerProvider = trace.NewNoopTracerProvider()
 	}
 
 	grpcListener := params.RPCFactory.GetGRPCListener()
@@ -193,6 +255,8 @@ func New(
 		params.ClusterMetadataConfig.MasterClusterName,
 		params.ClusterMetadataConfig.CurrentClusterName,
 		params.ClusterMetadataConfig.ClusterInformation,
+		params.MetricsClient,
+		logger,
 	)
 
 	membershipFactory, err := params.MembershipFactoryInitializer(persistenceBean, logger)
@@ -205,7 +269,6 @@ func New(
 		return nil, err
 	}
 
-	dynamicCollection := dynamicconfig.NewCollection(params.DynamicConfigClient, logger)
 	factoryProvider := params.ClientFactoryProvider
 	if factoryProvider == nil {
 		factoryProvider = client.NewFactoryProvider()
@@ -268,8 +331,8 @@ func New(
 	frontendRawClient := clientBean.GetFrontendClient()
 	frontendClient := frontend.NewRetryableClient(
 		frontendRawClient,
-		common.CreateFrontendServiceRetryPolicy(),
-		common.IsWhitelistServiceTransientError,
+		client.NewServiceRetryPolicy(common.FrontendServiceName, dynamicCollection),
+		client.NewServiceIsRetryable(common.FrontendServiceName, dynamicCollection),
 	)
 
 	matchingRawClient, err := clientBean.GetMatchingClient(namespaceCache.GetNamespaceName)
@@ -278,15 +341,15 @@ func New(
 	}
 	matchingClient := matching.NewRetryableClient(
 		matchingRawClient,
-		common.CreateMatchingServiceRetryPolicy(),
-		common.IsWhitelistServiceTransientError,
+		client.NewServiceRetryPolicy(common.MatchingServiceName, dynamicCollection),
+		client.NewServiceIsRetryable(common.MatchingServiceName, dynamicCollection),
 	)
 
 	historyRawClient := clientBean.GetHistoryClient()
 	historyClient := history.NewRetryableClient(
 		historyRawClient,
-		common.CreateHistoryServiceRetryPolicy(),
-		common.IsWhitelistServiceTransientError,
+		client.NewServiceRetryPolicy(common.HistoryServiceName, dynamicCollection),
+		client.NewServiceIsRetryable(common.HistoryServiceName, dynamicCollection),
 	)
 
 	historyArchiverBootstrapContainer := &archiver.HistoryBootstrapContainer{
@@ -326,9 +389,12 @@ func New(
 		// other common resources
 
 		namespaceCache:    namespaceCache,
-		timeSource:        clock.NewRealTimeSource(),
+		timeSource:        timeSource,
+		random:            newRandom(params.RandomSeed),
+		idGenerator:       idGenerator,
 		payloadSerializer: serialization.NewSerializer(),
 		metricsClient:     params.MetricsClient,
+		tracerProvider:    tracerProvider,
 		archivalMetadata:  params.ArchivalMetadata,
 		archiverProvider:  params.ArchiverProvider,
 
@@ -370,28 +436,42 @@ func New(
 		// internal vars
 		runtimeMetricsReporter: metrics.NewRuntimeMetricsReporter(
 			params.MetricsScope,
-			time.Minute,
+			dynamicCollection.GetDurationProperty(dynamicconfig.RuntimeMetricsReportInterval, time.Minute),
 			logger,
 			params.InstanceID,
 		),
+		membershipLeavePropagationDelay: dynamicCollection.GetDurationProperty(
+			dynamicconfig.MembershipLeavePropagationDelay,
+			0,
+		),
 		rpcFactory: params.RPCFactory,
 	}
+	if params.ProfileExporterConfig != nil {
+		impl.profileExporter = pprof.NewExporter(*params.ProfileExporterConfig, logger)
+	}
+	impl.RegisterHealthCheck("persistence", persistenceBean.Ping)
 	return impl, nil
 }
 
 // Start start all resources
-func (h *Impl) Start() {
+func (h *Impl) Start() error {
 
 	if !atomic.CompareAndSwapInt32(
 		&h.status,
 		common.DaemonStatusInitialized,
 		common.DaemonStatusStarted,
 	) {
-		return
+		if atomic.LoadInt32(&h.status) == common.DaemonStatusStopped {
+			return ErrResourcesStopped
+		}
+		return nil
 	}
 
 	h.metricsScope.Counter(metrics.RestartCount).Inc(1)
 	h.runtimeMetricsReporter.Start()
+	if h.profileExporter != nil {
+		h.profileExporter.Start()
+	}
 
 	h.membershipMonitor.Start()
 	h.namespaceCache.Start()
@@ -401,15 +481,25 @@ func (h *Impl) Start() {
 		h.logger.Fatal("fail to get host info from membership monitor", tag.Error(err))
 	}
 	h.hostInfo = hostInfo
+	pprof.RegisterHandler("/debug/membership/"+h.serviceName, membership.NewDebugHandler(h.membershipMonitor))
+
+	// verify dependencies can be reached before the service reports ready
+	h.runHealthChecks()
+	h.healthCheckStopCh = make(chan struct{})
+	go h.healthCheckLoop(h.healthCheckStopCh)
 
 	// The service is now started up
 	h.logger.Info("Service resources started", tag.Address(hostInfo.GetAddress()))
-	// seed the random generator once for this service
-	rand.Seed(time.Now().UnixNano())
+	return nil
 }
 
 // Stop stops all resources
 func (h *Impl) Stop() {
+	h.StopWithReason("unspecified")
+}
+
+// StopWithReason stops all resources, logging the reason and how long each phase took
+func (h *Impl) StopWithReason(reason string) {
 
 	if !atomic.CompareAndSwapInt32(
 		&h.status,
@@ -419,14 +509,110 @@ func (h *Impl) Stop() {
 		return
 	}
 
-	h.namespaceCache.Stop()
-	h.membershipMonitor.Stop()
-	h.ringpopChannel.Close()
-	h.runtimeMetricsReporter.Stop()
-	h.persistenceBean.Close()
+	h.logger.Info("Service resources stopping", tag.ShutdownReason(reason))
+	startTime := time.Now()
+
+	if h.healthCheckStopCh != nil {
+		close(h.healthCheckStopCh)
+	}
+
+	// services leave the ring before stopping their gRPC server, this covers the ones without one
+	h.stopPhase("leave membership ring", h.LeaveMembershipRing)
+	h.stopPhase("namespace cache", h.namespaceCache.Stop)
+	h.stopPhase("membership", h.membershipMonitor.Stop)
+	h.stopPhase("ringpop channel", h.ringpopChannel.Close)
+	h.stopPhase("runtime metrics reporter", h.runtimeMetricsReporter.Stop)
+	if h.profileExporter != nil {
+		h.stopPhase("profile exporter", h.profileExporter.Stop)
+	}
+	h.stopPhase("client bean", h.closeClientBean)
+	h.stopPhase("persistence", h.persistenceBean.Close)
 	if h.visibilityMgr != nil {
-		h.visibilityMgr.Close()
+		h.stopPhase("visibility", h.visibilityMgr.Close)
 	}
+
+	h.logger.Info("Service resources stopped",
+		tag.ShutdownReason(reason),
+		tag.ShutdownDuration(time.Since(startTime)),
+	)
+}
+
+// IsReady returns true if resources are started and all health checks passed on their last run
+func (h *Impl) IsReady() bool {
+	return atomic.LoadInt32(&h.status) == common.DaemonStatusStarted && atomic.LoadInt32(&h.healthy) == 1
+}
+
+// RegisterHealthCheck registers a health check run at start and every healthCheckInterval afterwards
+func (h *Impl) RegisterHealthCheck(name string, check HealthCheck) {
+	h.healthChecksLock.Lock()
+	defer h.healthChecksLock.Unlock()
+
+	if h.healthChecks == nil {
+		h.healthChecks = make(map[string]HealthCheck)
+	}
+	h.healthChecks[name] = check
+}
+
+func (h *Impl) runHealthChecks() {
+	h.healthChecksLock.Lock()
+	checks := make(map[string]HealthCheck, len(h.healthChecks))
+	for name, check := range h.healthChecks {
+		checks[name] = check
+	}
+	h.healthChecksLock.Unlock()
+
+	healthy := int32(1)
+	for name, check := range checks {
+		ctx, cancel := context.WithTimeout(context.Background(), healthCheckTimeout)
+		err := check(ctx)
+		cancel()
+		if err != nil {
+			h.logger.Warn("Service health check failed", tag.Name(name), tag.Error(err))
+			healthy = 0
+		}
+	}
+	atomic.StoreInt32(&h.healthy, healthy)
+}
+
+func (h *Impl) healthCheckLoop(stopCh <-chan struct{}) {
+	ticker := h.NewTicker(healthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.Chan():
+			h.runHealthChecks()
+		case <-stopCh:
+			return
+		}
+	}
+}
+
+// LeaveMembershipRing evicts this host from the membership ring and waits for peers to stop routing to it
+func (h *Impl) LeaveMembershipRing() {
+	h.leaveMembershipRingOnce.Do(func() {
+		h.logger.Info("ShutdownHandler: Evicting self from membership ring")
+		if err := h.membershipMonitor.EvictSelf(); err != nil {
+			h.logger.Warn("Unable to evict self from membership ring", tag.Error(err))
+		}
+		h.logger.Info("ShutdownHandler: Waiting for others to discover I am unhealthy")
+		time.Sleep(h.membershipLeavePropagationDelay())
+	})
+}
+
+func (h *Impl) closeClientBean() {
+	if err := h.clientBean.Close(); err != nil {
+		h.logger.Warn("Unable to close client bean", tag.Error(err))
+	}
+}
+
+func (h *Impl) stopPhase(phase string, stop func()) {
+	startTime := time.Now()
+	stop()
+	h.logger.Info("Service resources stop phase completed",
+		tag.ShutdownPhase(phase),
+		tag.ShutdownDuration(time.Since(startTime)),
+	)
 }
 
 // GetServiceName return service name
@@ -439,6 +625,11 @@ func (h *Impl) GetHostName() string {
 	return h.hostName
 }
 
+// GetStatus return the current lifecycle state
+func (h *Impl) GetStatus() int32 {
+	return atomic.LoadInt32(&h.status)
+}
+
 // GetHostInfo return host info
 func (h *Impl) GetHostInfo() *membership.HostInfo {
 	return h.hostInfo
@@ -461,6 +652,21 @@ func (h *Impl) GetTimeSource() clock.TimeSource {
 	return h.timeSource
 }
 
+// NewTicker return a ticker driven by the time source of this service
+func (h *Impl) NewTicker(d time.Duration) clock.Ticker {
+	return clock.NewTicker(h.timeSource, d)
+}
+
+// GetRandom return the random generator of this service
+func (h *Impl) GetRandom() *rand.Rand {
+	return h.random
+}
+
+// GetIDGenerator return the ID generator of this service
+func (h *Impl) GetIDGenerator() IDGenerator {
+	return h.idGenerator
+}
+
 // GetPayloadSerializer return binary payload serializer
 func (h *Impl) GetPayloadSerializer() serialization.Serializer {
 	return h.payloadSerializer
@@ -471,6 +677,11 @@ func (h *Impl) GetMetricsClient() metrics.Client {
 	return h.metricsClient
 }
 
+// GetTracerProvider return tracer provider
+func (h *Impl) GetTracerProvider() trace.TracerProvider {
+	return h.tracerProvider
+}
+
 // GetArchivalMetadata return archival metadata
 func (h *Impl) GetArchivalMetadata() archiver.ArchivalMetadata {
 	return h.archivalMetadata
@@ -0,0 +1,397 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package resource
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"github.com/uber/tchannel-go"
+
+	"go.temporal.io/server/client"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/metrics"
+	persistenceClient "go.temporal.io/server/common/persistence/client"
+)
+
+type (
+	resourceImplSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller            *gomock.Controller
+		mockLogger            *log.MockLogger
+		mockNamespaceCache    *cache.MockNamespaceCache
+		mockMembershipMonitor *membership.MockMonitor
+		mockClientBean        *client.MockBean
+		mockPersistenceBean   *persistenceClient.MockBean
+
+		resource *Impl
+	}
+
+	loggedLine struct {
+		msg  string
+		tags map[string]interface{}
+	}
+
+	testShardCounter struct {
+		next map[int]int64
+	}
+)
+
+func TestResourceImplSuite(t *testing.T) {
+	s := new(resourceImplSuite)
+	suite.Run(t, s)
+}
+
+func (s *resourceImplSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.mockLogger = log.NewMockLogger(s.controller)
+	s.mockNamespaceCache = cache.NewMockNamespaceCache(s.controller)
+	s.mockMembershipMonitor = membership.NewMockMonitor(s.controller)
+	s.mockClientBean = client.NewMockBean(s.controller)
+	s.mockPersistenceBean = persistenceClient.NewMockBean(s.controller)
+
+	ringpopChannel, err := tchannel.NewChannel("test", nil)
+	s.NoError(err)
+
+	s.resource = &Impl{
+		status:            common.DaemonStatusStarted,
+		logger:            s.mockLogger,
+		namespaceCache:    s.mockNamespaceCache,
+		membershipMonitor: s.mockMembershipMonitor,
+		clientBean:        s.mockClientBean,
+		persistenceBean:   s.mockPersistenceBean,
+		ringpopChannel:    ringpopChannel,
+		runtimeMetricsReporter: metrics.NewRuntimeMetricsReporter(
+			tally.NoopScope,
+			dynamicconfig.GetDurationPropertyFn(time.Minute),
+			log.NewNoopLogger(),
+			"",
+		),
+		metricsScope:                    tally.NoopScope,
+		membershipLeavePropagationDelay: dynamicconfig.GetDurationPropertyFn(0),
+	}
+}
+
+func (s *resourceImplSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *resourceImplSuite) TestStopWithReason() {
+	s.mockMembershipMonitor.EXPECT().EvictSelf().Return(nil)
+	s.mockNamespaceCache.EXPECT().Stop()
+	s.mockMembershipMonitor.EXPECT().Stop()
+	s.mockClientBean.EXPECT().Close().Return(nil)
+	s.mockPersistenceBean.EXPECT().Close()
+	lines := s.captureInfoLogs()
+
+	s.resource.StopWithReason("rolling restart")
+
+	s.Equal("Service resources stopping", (*lines)[0].msg)
+	s.Equal("rolling restart", (*lines)[0].tags["shutdown-reason"])
+
+	var phases []string
+	for _, line := range (*lines)[1 : len(*lines)-1] {
+		if line.msg != "Service resources stop phase completed" {
+			continue
+		}
+		s.Contains(line.tags, "shutdown-duration")
+		phases = append(phases, line.tags["shutdown-phase"].(string))
+	}
+	s.Equal([]string{
+		"leave membership ring",
+		"namespace cache",
+		"membership",
+		"ringpop channel",
+		"runtime metrics reporter",
+		"client bean",
+		"persistence",
+	}, phases)
+
+	last := (*lines)[len(*lines)-1]
+	s.Equal("Service resources stopped", last.msg)
+	s.Equal("rolling restart", last.tags["shutdown-reason"])
+	s.Contains(last.tags, "shutdown-duration")
+}
+
+func (s *resourceImplSuite) TestStop_UnspecifiedReason() {
+	s.mockMembershipMonitor.EXPECT().EvictSelf().Return(nil)
+	s.mockNamespaceCache.EXPECT().Stop()
+	s.mockMembershipMonitor.EXPECT().Stop()
+	s.mockClientBean.EXPECT().Close().Return(nil)
+	s.mockPersistenceBean.EXPECT().Close()
+	lines := s.captureInfoLogs()
+
+	s.resource.Stop()
+	s.resource.Stop()
+
+	s.Equal("unspecified", (*lines)[0].tags["shutdown-reason"])
+	s.Equal("Service resources stopped", (*lines)[len(*lines)-1].msg)
+}
+
+func (s *resourceImplSuite) TestStop_LeavesRingBeforeTeardown() {
+	propagationDelay := 50 * time.Millisecond
+	s.resource.membershipLeavePropagationDelay = dynamicconfig.GetDurationPropertyFn(propagationDelay)
+	s.captureInfoLogs()
+
+	var evictedAt time.Time
+	gomock.InOrder(
+		s.mockMembershipMonitor.EXPECT().EvictSelf().Do(func() {
+			evictedAt = time.Now()
+		}).Return(nil),
+		s.mockNamespaceCache.EXPECT().Stop().Do(func() {
+			s.GreaterOrEqual(int64(time.Since(evictedAt)), int64(propagationDelay))
+		}),
+		s.mockMembershipMonitor.EXPECT().Stop(),
+		s.mockClientBean.EXPECT().Close().Return(nil),
+		s.mockPersistenceBean.EXPECT().Close(),
+	)
+
+	s.resource.Stop()
+}
+
+func (s *resourceImplSuite) TestStop_EvictSelfFailed() {
+	s.captureInfoLogs()
+	s.mockLogger.EXPECT().Warn("Unable to evict self from membership ring", gomock.Any())
+	s.mockMembershipMonitor.EXPECT().EvictSelf().Return(errors.New("ring unavailable"))
+	s.mockNamespaceCache.EXPECT().Stop()
+	s.mockMembershipMonitor.EXPECT().Stop()
+	s.mockClientBean.EXPECT().Close().Return(nil)
+	s.mockPersistenceBean.EXPECT().Close()
+
+	s.resource.Stop()
+}
+
+func (s *resourceImplSuite) TestStop_AfterLeavingMembershipRing() {
+	s.captureInfoLogs()
+	s.mockMembershipMonitor.EXPECT().EvictSelf().Return(nil).Times(1)
+	s.mockNamespaceCache.EXPECT().Stop()
+	s.mockMembershipMonitor.EXPECT().Stop()
+	s.mockClientBean.EXPECT().Close().Return(nil)
+	s.mockPersistenceBean.EXPECT().Close()
+
+	s.resource.LeaveMembershipRing()
+	s.resource.Stop()
+}
+
+func (s *resourceImplSuite) TestStart() {
+	s.resource.status = common.DaemonStatusInitialized
+	s.expectStart()
+	lines := s.captureInfoLogs()
+
+	s.NoError(s.resource.Start())
+	s.Equal(common.DaemonStatusStarted, s.resource.status)
+	s.Equal("Service resources started", (*lines)[0].msg)
+}
+
+func (s *resourceImplSuite) TestStart_AlreadyStarted() {
+	s.resource.status = common.DaemonStatusInitialized
+	s.expectStart()
+	s.captureInfoLogs()
+
+	s.NoError(s.resource.Start())
+	s.NoError(s.resource.Start())
+	s.Equal(common.DaemonStatusStarted, s.resource.status)
+}
+
+func (s *resourceImplSuite) TestStop_NotStarted() {
+	s.resource.status = common.DaemonStatusInitialized
+
+	s.resource.Stop()
+	s.Equal(common.DaemonStatusInitialized, s.resource.status)
+}
+
+func (s *resourceImplSuite) TestStart_AfterStop() {
+	s.resource.status = common.DaemonStatusInitialized
+	s.expectStart()
+	s.mockMembershipMonitor.EXPECT().EvictSelf().Return(nil)
+	s.mockNamespaceCache.EXPECT().Stop()
+	s.mockMembershipMonitor.EXPECT().Stop()
+	s.mockClientBean.EXPECT().Close().Return(nil)
+	s.mockPersistenceBean.EXPECT().Close()
+	s.captureInfoLogs()
+
+	s.NoError(s.resource.Start())
+	s.resource.Stop()
+	s.Equal(common.DaemonStatusStopped, s.resource.status)
+
+	s.Equal(ErrResourcesStopped, s.resource.Start())
+	s.Equal(common.DaemonStatusStopped, s.resource.status)
+}
+
+func (s *resourceImplSuite) TestGetStatus() {
+	s.resource.status = common.DaemonStatusInitialized
+	s.expectStart()
+	s.mockMembershipMonitor.EXPECT().EvictSelf().Return(nil)
+	s.mockNamespaceCache.EXPECT().Stop()
+	s.mockMembershipMonitor.EXPECT().Stop()
+	s.mockClientBean.EXPECT().Close().Return(nil)
+	s.mockPersistenceBean.EXPECT().Close()
+	s.captureInfoLogs()
+
+	s.Equal(common.DaemonStatusInitialized, s.resource.GetStatus())
+	s.NoError(s.resource.Start())
+	s.Equal(common.DaemonStatusStarted, s.resource.GetStatus())
+	s.resource.Stop()
+	s.Equal(common.DaemonStatusStopped, s.resource.GetStatus())
+}
+
+func (s *resourceImplSuite) TestIsReady_PersistenceHealthCheck() {
+	s.resource.timeSource = clock.NewEventTimeSource()
+	s.resource.status = common.DaemonStatusInitialized
+	s.resource.RegisterHealthCheck("persistence", s.mockPersistenceBean.Ping)
+	s.expectStart()
+	s.captureInfoLogs()
+	s.mockLogger.EXPECT().Warn("Service health check failed", gomock.Any(), gomock.Any())
+
+	gomock.InOrder(
+		s.mockPersistenceBean.EXPECT().Ping(gomock.Any()).Return(errors.New("datastore unreachable")),
+		s.mockPersistenceBean.EXPECT().Ping(gomock.Any()).Return(nil),
+	)
+
+	s.False(s.resource.IsReady())
+	s.NoError(s.resource.Start())
+	s.False(s.resource.IsReady())
+
+	s.resource.runHealthChecks()
+	s.True(s.resource.IsReady())
+
+	s.mockMembershipMonitor.EXPECT().EvictSelf().Return(nil)
+	s.mockNamespaceCache.EXPECT().Stop()
+	s.mockMembershipMonitor.EXPECT().Stop()
+	s.mockClientBean.EXPECT().Close().Return(nil)
+	s.mockPersistenceBean.EXPECT().Close()
+	s.resource.Stop()
+	s.False(s.resource.IsReady())
+}
+
+func (s *resourceImplSuite) expectStart() {
+	s.mockMembershipMonitor.EXPECT().Start()
+	s.mockNamespaceCache.EXPECT().Start()
+	s.mockMembershipMonitor.EXPECT().WhoAmI().Return(membership.NewHostInfo("127.0.0.1:7233", nil), nil)
+}
+
+func (s *resourceImplSuite) captureInfoLogs() *[]loggedLine {
+	var lines []loggedLine
+	s.mockLogger.EXPECT().Info(gomock.Any(), gomock.Any()).Do(func(msg string, tags ...tag.Tag) {
+		line := loggedLine{msg: msg, tags: make(map[string]interface{}, len(tags))}
+		for _, t := range tags {
+			line.tags[t.Key()] = t.Value()
+		}
+		lines = append(lines, line)
+	}).AnyTimes()
+	return &lines
+}
+
+func (s *resourceImplSuite) TestResolveHostName() {
+	defer func(original func() (string, error)) { osHostname = original }(osHostname)
+
+	osHostname = func() (string, error) { return "temporal-host", nil }
+	s.Equal("temporal-host", resolveHostName("instance-id", s.mockLogger))
+
+	osHostname = func() (string, error) { return "", errors.New("hostname lookup failed") }
+	s.mockLogger.EXPECT().Warn(gomock.Any(), gomock.Any(), gomock.Any()).Times(2)
+	s.Equal("instance-id", resolveHostName("instance-id", s.mockLogger))
+	s.NotEmpty(resolveHostName("", s.mockLogger))
+}
+
+func (s *resourceImplSuite) TestGetRandom_SameSeed() {
+	other := &Impl{random: newRandom(42)}
+	s.resource.random = newRandom(42)
+
+	for i := 0; i < 10; i++ {
+		s.Equal(other.GetRandom().Int63(), s.resource.GetRandom().Int63())
+	}
+	s.NotEqual(newRandom(43).Int63(), newRandom(42).Int63())
+}
+
+func (s *resourceImplSuite) TestGetIDGenerator_Deterministic() {
+	s.resource.idGenerator = NewDeterministicIDGenerator(42)
+	idGenerator := s.resource.GetIDGenerator()
+
+	s.Equal([]string{
+		"538c7f96-b164-4f1b-97bb-9f4bb472e89f",
+		"5b1484f2-5209-49d9-b43e-92ba09dd9d52",
+		"dfd79b4d-7642-4b61-ba0c-9f9f0d3ba55b",
+	}, []string{idGenerator.NewUUID(), idGenerator.NewUUID(), idGenerator.NewUUID()})
+	s.Equal(NewDeterministicIDGenerator(7).NewUUID(), NewDeterministicIDGenerator(7).NewUUID())
+}
+
+func (s *resourceImplSuite) TestNewIDGenerator() {
+	idGenerator := NewIDGenerator()
+
+	s.NotEqual(idGenerator.NewUUID(), idGenerator.NewUUID())
+}
+
+func (s *resourceImplSuite) TestIDGenerator_NextEventID() {
+	idGenerator := NewDeterministicIDGenerator(42)
+	s.Equal(common.EmptyEventID, idGenerator.NextEventID(1))
+
+	counter := &testShardCounter{next: map[int]int64{1: 100}}
+	idGenerator.SetShardCounter(counter)
+	s.Equal([]int64{100, 101, 102}, []int64{idGenerator.NextEventID(1), idGenerator.NextEventID(1), idGenerator.NextEventID(1)})
+	// shard 2 is not owned
+	s.Equal(common.EmptyEventID, idGenerator.NextEventID(2))
+}
+
+func (c *testShardCounter) NextID(shardID int) (int64, error) {
+	next, ok := c.next[shardID]
+	if !ok {
+		return 0, errors.New("shard not owned")
+	}
+	c.next[shardID]++
+	return next, nil
+}
+
+func (s *resourceImplSuite) TestNewTicker_DrivenByTimeSource() {
+	start := time.Unix(0, 0).UTC()
+	timeSource := clock.NewEventTimeSource().Update(start)
+	s.resource.timeSource = timeSource
+
+	ticker := s.resource.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	timeSource.Update(start.Add(time.Second))
+	select {
+	case tick := <-ticker.Chan():
+		s.Equal(start.Add(time.Second), tick)
+	default:
+		s.Fail("expected tick after advancing time source")
+	}
+}
@@ -25,12 +25,15 @@
 package resource
 
 import (
+	"math/rand"
 	"net"
+	"time"
 
 	"go.temporal.io/server/common/persistence/serialization"
 
 	"github.com/golang/mock/gomock"
 	"github.com/uber-go/tally"
+	"go.opentelemetry.io/otel/trace"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/api/workflowservicemock/v1"
 	sdkclient "go.temporal.io/sdk/client"
@@ -70,8 +73,11 @@ type (
 
 		NamespaceCache    *cache.MockNamespaceCache
 		TimeSource        clock.TimeSource
+		Random            *rand.Rand
+		IDGenerator       IDGenerator
 		PayloadSerializer serialization.Serializer
 		MetricsClient     metrics.Client
+		TracerProvider    trace.TracerProvider
 		ArchivalMetadata  *archiver.MockArchivalMetadata
 		ArchiverProvider  *provider.MockArchiverProvider
 
@@ -182,8 +188,11 @@ func NewTest(
 
 		NamespaceCache:    cache.NewMockNamespaceCache(controller),
 		TimeSource:        clock.NewRealTimeSource(),
+		Random:            newRandom(0),
+		IDGenerator:       NewIDGenerator(),
 		PayloadSerializer: serialization.NewSerializer(),
 		MetricsClient:     metrics.NewClient(scope, serviceMetricsIndex),
+		TracerProvider:    trace.NewNoopTracerProvider(),
 		ArchivalMetadata:  archiver.NewMockArchivalMetadata(controller),
 		ArchiverProvider:  provider.NewMockArchiverProvider(controller),
 
@@ -225,8 +234,8 @@ func NewTest(
 }
 
 // Start for testing
-func (s *Test) Start() {
-
+func (s *Test) Start() error {
+	return nil
 }
 
 // Stop for testing
@@ -234,6 +243,26 @@ func (s *Test) Stop() {
 
 }
 
+// LeaveMembershipRing for testing
+func (s *Test) LeaveMembershipRing() {
+
+}
+
+// GetStatus for testing
+func (s *Test) GetStatus() int32 {
+	return common.DaemonStatusStarted
+}
+
+// IsReady for testing
+func (s *Test) IsReady() bool {
+	return true
+}
+
+// RegisterHealthCheck for testing
+func (s *Test) RegisterHealthCheck(_ string, _ HealthCheck) {
+
+}
+
 // static infos
 
 // GetServiceName for testing
@@ -273,6 +302,21 @@ func (s *Test) GetTimeSource() clock.TimeSource {
 	return s.TimeSource
 }
 
+// NewTicker for testing
+func (s *Test) NewTicker(d time.Duration) clock.Ticker {
+	return clock.NewTicker(s.TimeSource, d)
+}
+
+// GetRandom for testing
+func (s *Test) GetRandom() *rand.Rand {
+	return s.Random
+}
+
+// GetIDGenerator for testing
+func (s *Test) GetIDGenerator() IDGenerator {
+	return s.IDGenerator
+}
+
 // GetPayloadSerializer for testing
 func (s *Test) GetPayloadSerializer() serialization.Serializer {
 	return s.PayloadSerializer
@@ -283,6 +327,11 @@ func (s *Test) GetMetricsClient() metrics.Client {
 	return s.MetricsClient
 }
 
+// GetTracerProvider for testing
+func (s *Test) GetTracerProvider() trace.TracerProvider {
+	return s.TracerProvider
+}
+
 // GetArchivalMetadata for testing
 func (s *Test) GetArchivalMetadata() archiver.ArchivalMetadata {
 	return s.ArchivalMetadata
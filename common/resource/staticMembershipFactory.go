@@ -0,0 +1,55 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package resource
+
+import (
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/membership"
+)
+
+type staticMembershipFactory struct {
+	serviceName string
+	hosts       map[string][]string
+	logger      log.Logger
+}
+
+// NewStaticMembershipFactory returns a MembershipMonitorFactory building monitors from a static list of
+// host:port addresses per service instead of ringpop gossip, see membership.NewStaticMonitor
+func NewStaticMembershipFactory(
+	serviceName string,
+	hosts map[string][]string,
+	logger log.Logger,
+) MembershipMonitorFactory {
+	return &staticMembershipFactory{
+		serviceName: serviceName,
+		hosts:       hosts,
+		logger:      logger,
+	}
+}
+
+// GetMembershipMonitor returns a membership monitor serving the static hosts
+func (f *staticMembershipFactory) GetMembershipMonitor() (membership.Monitor, error) {
+	return membership.NewStaticMonitor(f.serviceName, f.hosts, f.logger)
+}
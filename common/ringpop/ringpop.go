@@ -36,6 +36,7 @@ import (
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/membership"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
 )
 
@@ -49,6 +50,7 @@ type RingpopFactory struct {
 	channel        *tchannel.Channel
 	serviceName    string
 	servicePortMap map[string]int
+	metricsClient  metrics.Client
 	logger         log.Logger
 
 	sync.Mutex
@@ -64,10 +66,11 @@ func NewRingpopFactory(
 	channel *tchannel.Channel,
 	serviceName string,
 	servicePortMap map[string]int,
+	metricsClient metrics.Client,
 	logger log.Logger,
 	metadataManager persistence.ClusterMetadataManager,
 ) (*RingpopFactory, error) {
-	return newRingpopFactory(rpConfig, channel, serviceName, servicePortMap, logger, metadataManager)
+	return newRingpopFactory(rpConfig, channel, serviceName, servicePortMap, metricsClient, logger, metadataManager)
 }
 
 // ValidateRingpopConfig validates that ringpop config is parseable and valid
@@ -75,6 +78,9 @@ func ValidateRingpopConfig(rpConfig *config.Membership) error {
 	if rpConfig.BroadcastAddress != "" && net.ParseIP(rpConfig.BroadcastAddress) == nil {
 		return fmt.Errorf("ringpop config malformed `broadcastAddress` param")
 	}
+	if _, err := membership.NewHashFunc(rpConfig.HashFunc); err != nil {
+		return fmt.Errorf("ringpop config malformed `hashFunc` param: %v", err)
+	}
 	return nil
 }
 
@@ -83,6 +89,7 @@ func newRingpopFactory(
 	channel *tchannel.Channel,
 	serviceName string,
 	servicePortMap map[string]int,
+	metricsClient metrics.Client,
 	logger log.Logger,
 	metadataManager persistence.ClusterMetadataManager,
 ) (*RingpopFactory, error) {
@@ -98,6 +105,7 @@ func newRingpopFactory(
 		channel:         channel,
 		serviceName:     serviceName,
 		servicePortMap:  servicePortMap,
+		metricsClient:   metricsClient,
 		logger:          logger,
 		metadataManager: metadataManager,
 	}, nil
@@ -131,8 +139,13 @@ func (factory *RingpopFactory) createMembership() (membership.Monitor, error) {
 		return nil, fmt.Errorf("ringpop creation failed: %v", err)
 	}
 
+	hashFunc, err := membership.NewHashFunc(factory.config.HashFunc)
+	if err != nil {
+		return nil, err
+	}
+
 	membershipMonitor := membership.NewRingpopMonitor(factory.serviceName,
-		factory.servicePortMap, rp, factory.logger, factory.metadataManager, factory.broadcastAddressResolver)
+		factory.servicePortMap, rp, factory.metricsClient, factory.logger, factory.metadataManager, factory.broadcastAddressResolver, hashFunc)
 
 	return membershipMonitor, nil
 }
@@ -38,7 +38,7 @@ type (
 		GetInternodeGRPCServerOptions() ([]grpc.ServerOption, error)
 		GetGRPCListener() net.Listener
 		GetRingpopChannel() *tchannel.Channel
-		CreateFrontendGRPCConnection(hostName string) *grpc.ClientConn
-		CreateInternodeGRPCConnection(hostName string) *grpc.ClientConn
+		CreateFrontendGRPCConnection(hostName string, opts ...grpc.DialOption) *grpc.ClientConn
+		CreateInternodeGRPCConnection(hostName string, opts ...grpc.DialOption) *grpc.ClientConn
 	}
 )
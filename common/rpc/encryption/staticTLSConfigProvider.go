@@ -0,0 +1,79 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"crypto/tls"
+	"time"
+)
+
+type (
+	// StaticTLSConfigProvider is a TLSConfigProvider which serves pre-built TLS configs.
+	// A nil config disables TLS for the corresponding connections.
+	StaticTLSConfigProvider struct {
+		internodeServerConfig *tls.Config
+		internodeClientConfig *tls.Config
+		frontendServerConfig  *tls.Config
+		frontendClientConfig  *tls.Config
+	}
+)
+
+var _ TLSConfigProvider = (*StaticTLSConfigProvider)(nil)
+
+// NewStaticTLSConfigProvider creates a new TLSConfigProvider from pre-built TLS configs.
+func NewStaticTLSConfigProvider(
+	internodeServerConfig *tls.Config,
+	internodeClientConfig *tls.Config,
+	frontendServerConfig *tls.Config,
+	frontendClientConfig *tls.Config,
+) *StaticTLSConfigProvider {
+	return &StaticTLSConfigProvider{
+		internodeServerConfig: internodeServerConfig,
+		internodeClientConfig: internodeClientConfig,
+		frontendServerConfig:  frontendServerConfig,
+		frontendClientConfig:  frontendClientConfig,
+	}
+}
+
+func (s *StaticTLSConfigProvider) GetInternodeServerConfig() (*tls.Config, error) {
+	return s.internodeServerConfig, nil
+}
+
+func (s *StaticTLSConfigProvider) GetInternodeClientConfig() (*tls.Config, error) {
+	return s.internodeClientConfig, nil
+}
+
+func (s *StaticTLSConfigProvider) GetFrontendServerConfig() (*tls.Config, error) {
+	return s.frontendServerConfig, nil
+}
+
+func (s *StaticTLSConfigProvider) GetFrontendClientConfig() (*tls.Config, error) {
+	return s.frontendClientConfig, nil
+}
+
+// GetExpiringCerts returns no certificates, as static configs are managed by the caller.
+func (s *StaticTLSConfigProvider) GetExpiringCerts(timeWindow time.Duration) (expiring CertExpirationMap, expired CertExpirationMap, err error) {
+	return CertExpirationMap{}, CertExpirationMap{}, nil
+}
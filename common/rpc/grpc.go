@@ -62,7 +62,8 @@ const (
 // The hostName syntax is defined in
 // https://github.com/grpc/grpc/blob/master/doc/naming.md.
 // e.g. to use dns resolver, a "dns:///" prefix should be applied to the target.
-func Dial(hostName string, tlsConfig *tls.Config, logger log.Logger, enableKeepAlive bool) (*grpc.ClientConn, error) {
+// Additional dial options are applied after the defaults.
+func Dial(hostName string, tlsConfig *tls.Config, logger log.Logger, enableKeepAlive bool, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
 	// Default to insecure
 	grpcSecureOpt := grpc.WithInsecure()
 	if tlsConfig != nil {
@@ -101,6 +102,8 @@ func Dial(hostName string, tlsConfig *tls.Config, logger log.Logger, enableKeepA
 		))
 	}
 
+	dialOptions = append(dialOptions, opts...)
+
 	return grpc.Dial(
 		hostName,
 		dialOptions...,
@@ -0,0 +1,91 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+
+	"github.com/pborman/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// CorrelationIDHeaderName is the gRPC metadata key carrying the correlation ID between services
+	CorrelationIDHeaderName = "temporal-correlation-id"
+)
+
+type (
+	correlationIDContextKey struct{}
+)
+
+var _ grpc.UnaryServerInterceptor = CorrelationIDServerInterceptor
+var _ grpc.UnaryClientInterceptor = CorrelationIDClientInterceptor
+
+// CorrelationIDServerInterceptor puts the correlation ID of an inbound call into the context,
+// the ID is read from the request metadata or a new one is generated if the caller did not send one.
+func CorrelationIDServerInterceptor(
+	ctx context.Context,
+	req interface{},
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	var correlationID string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(CorrelationIDHeaderName); len(values) > 0 {
+			correlationID = values[0]
+		}
+	}
+	if correlationID == "" {
+		correlationID = uuid.New()
+	}
+	return handler(ContextWithCorrelationID(ctx, correlationID), req)
+}
+
+// CorrelationIDClientInterceptor attaches the correlation ID found in the context to the outbound call metadata.
+func CorrelationIDClientInterceptor(
+	ctx context.Context,
+	method string,
+	req, reply interface{},
+	cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	if correlationID := GetCorrelationID(ctx); correlationID != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, CorrelationIDHeaderName, correlationID)
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// ContextWithCorrelationID returns a copy of ctx carrying the given correlation ID
+func ContextWithCorrelationID(ctx context.Context, correlationID string) context.Context {
+	return context.WithValue(ctx, correlationIDContextKey{}, correlationID)
+}
+
+// GetCorrelationID returns the correlation ID carried by ctx, or empty string if there is none
+func GetCorrelationID(ctx context.Context) string {
+	correlationID, _ := ctx.Value(correlationIDContextKey{}).(string)
+	return correlationID
+}
@@ -0,0 +1,111 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/log"
+)
+
+type (
+	historyServiceStub struct {
+		historyservice.UnimplementedHistoryServiceServer
+		correlationIDs []string
+	}
+)
+
+func (h *historyServiceStub) DescribeHistoryHost(
+	ctx context.Context,
+	_ *historyservice.DescribeHistoryHostRequest,
+) (*historyservice.DescribeHistoryHostResponse, error) {
+	h.correlationIDs = append(h.correlationIDs, GetCorrelationID(ctx))
+	return &historyservice.DescribeHistoryHostResponse{}, nil
+}
+
+func TestCorrelationID_PropagatedDownstreamAndLogged(t *testing.T) {
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(CorrelationIDServerInterceptor))
+	stub := &historyServiceStub{}
+	historyservice.RegisterHistoryServiceServer(server, stub)
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	conn, err := grpc.Dial(
+		"bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return listener.Dial() }),
+		grpc.WithInsecure(),
+		grpc.WithChainUnaryInterceptor(CorrelationIDClientInterceptor),
+	)
+	require.NoError(t, err)
+	defer func() { _ = conn.Close() }()
+	historyClient := historyservice.NewHistoryServiceClient(conn)
+
+	core, logs := observer.New(zap.InfoLevel)
+	namespaceLogInterceptor := NewNamespaceLogInterceptor(nil, log.NewZapLogger(zap.New(core)))
+	frontendHandler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return namespaceLogInterceptor.Intercept(ctx, req, &grpc.UnaryServerInfo{FullMethod: "/temporal.api.workflowservice.v1.WorkflowService/DescribeNamespace"},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				return historyClient.DescribeHistoryHost(ctx, &historyservice.DescribeHistoryHostRequest{})
+			})
+	}
+	request := &workflowservice.DescribeNamespaceRequest{Namespace: "test-namespace"}
+
+	// the ID sent by the caller is kept
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(CorrelationIDHeaderName, "test-correlation-id"))
+	_, err = CorrelationIDServerInterceptor(ctx, request, &grpc.UnaryServerInfo{}, frontendHandler)
+	require.NoError(t, err)
+	require.Equal(t, []string{"test-correlation-id"}, stub.correlationIDs)
+	require.Equal(t, 1, logs.FilterField(zap.String("correlation-id", "test-correlation-id")).Len())
+
+	// a new ID is generated for callers which did not send one
+	_, err = CorrelationIDServerInterceptor(context.Background(), request, &grpc.UnaryServerInfo{}, frontendHandler)
+	require.NoError(t, err)
+	require.Len(t, stub.correlationIDs, 2)
+	generatedID := stub.correlationIDs[1]
+	require.NotEmpty(t, generatedID)
+	require.NotEqual(t, "test-correlation-id", generatedID)
+	require.Equal(t, 1, logs.FilterField(zap.String("correlation-id", generatedID)).Len())
+}
+
+func TestCorrelationIDClientInterceptor_NoIDInContext(t *testing.T) {
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		require.Empty(t, md.Get(CorrelationIDHeaderName))
+		return nil
+	}
+	require.NoError(t, CorrelationIDClientInterceptor(context.Background(), "/test/Method", nil, nil, nil, invoker))
+}
@@ -79,7 +79,8 @@ func (nli *NamespaceLogInterceptor) Intercept(
 			tag.WorkflowNamespace(namespace),
 			tag.Operation(methodName),
 			tag.ServerName(serverName),
-			tag.CertThumbprint(certThumbprint))
+			tag.CertThumbprint(certThumbprint),
+			tag.CorrelationID(GetCorrelationID(ctx)))
 	}
 	return handler(ctx, req)
 }
@@ -0,0 +1,120 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/semconv"
+	"go.opentelemetry.io/otel/trace"
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	tracerName = "go.temporal.io/server"
+
+	// RPCGRPCStatusCodeKey is the span attribute holding the gRPC status code of the handled request
+	RPCGRPCStatusCodeKey = label.Key("rpc.grpc.status_code")
+)
+
+type (
+	// TracingInterceptor starts a server span for every handled gRPC request
+	TracingInterceptor struct {
+		tracer     trace.Tracer
+		propagator propagation.TextMapPropagator
+	}
+
+	// metadataCarrier adapts gRPC metadata to propagation.TextMapCarrier
+	metadataCarrier metadata.MD
+)
+
+var _ grpc.UnaryServerInterceptor = (*TracingInterceptor)(nil).Intercept
+var _ propagation.TextMapCarrier = metadataCarrier(nil)
+
+// NewTracingInterceptor creates a TracingInterceptor, requests are not traced when tracerProvider is nil
+func NewTracingInterceptor(
+	tracerProvider trace.TracerProvider,
+) *TracingInterceptor {
+	ti := &TracingInterceptor{
+		propagator: propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}),
+	}
+	if tracerProvider != nil {
+		ti.tracer = tracerProvider.Tracer(tracerName)
+	}
+	return ti
+}
+
+func (ti *TracingInterceptor) Intercept(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if ti.tracer == nil {
+		return handler(ctx, req)
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		ctx = ti.propagator.Extract(ctx, metadataCarrier(md))
+	}
+	serviceName, methodName := splitMethodName(info.FullMethod)
+	ctx, span := ti.tracer.Start(
+		ctx,
+		info.FullMethod,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			semconv.RPCSystemGRPC,
+			semconv.RPCServiceKey.String(serviceName),
+			semconv.RPCMethodKey.String(methodName),
+		),
+	)
+	defer span.End()
+
+	resp, err := handler(ctx, req)
+	span.SetAttributes(RPCGRPCStatusCodeKey.Int(int(serviceerror.ToStatus(err).Code())))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	} else {
+		span.SetStatus(codes.Ok, "")
+	}
+	return resp, err
+}
+
+func (c metadataCarrier) Get(key string) string {
+	if values := metadata.MD(c).Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func (c metadataCarrier) Set(key string, value string) {
+	metadata.MD(c).Set(key, value)
+}
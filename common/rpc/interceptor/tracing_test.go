@@ -0,0 +1,104 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/oteltest"
+	"go.opentelemetry.io/otel/semconv"
+	"go.opentelemetry.io/otel/trace"
+	"go.temporal.io/api/serviceerror"
+	"google.golang.org/grpc"
+	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	testTraceID      = "4bf92f3577b34da6a3ce929d0e0e4736"
+	testParentSpanID = "00f067aa0ba902b7"
+)
+
+func TestTracingInterceptor_SpanPerRequest(t *testing.T) {
+	recorder := &oteltest.StandardSpanRecorder{}
+	tracingInterceptor := NewTracingInterceptor(oteltest.NewTracerProvider(oteltest.WithSpanRecorder(recorder)))
+	info := &grpc.UnaryServerInfo{FullMethod: "/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution"}
+
+	var handlerSpanContext trace.SpanContext
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		"traceparent", "00-"+testTraceID+"-"+testParentSpanID+"-01",
+	))
+	_, err := tracingInterceptor.Intercept(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		handlerSpanContext = trace.SpanContextFromContext(ctx)
+		return nil, nil
+	})
+	require.NoError(t, err)
+
+	_, err = tracingInterceptor.Intercept(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, serviceerror.NewNotFound("workflow not found")
+	})
+	require.Error(t, err)
+
+	spans := recorder.Completed()
+	require.Len(t, spans, 2)
+	for _, span := range spans {
+		require.Equal(t, info.FullMethod, span.Name())
+		require.Equal(t, trace.SpanKindServer, span.SpanKind())
+		require.Equal(t, semconv.RPCSystemGRPC.Value, span.Attributes()[semconv.RPCSystemKey])
+		require.Equal(t, label.StringValue("temporal.api.workflowservice.v1.WorkflowService"), span.Attributes()[semconv.RPCServiceKey])
+		require.Equal(t, label.StringValue("StartWorkflowExecution"), span.Attributes()[semconv.RPCMethodKey])
+	}
+
+	// the trace context of the caller is continued
+	succeeded := spans[0]
+	require.Equal(t, testTraceID, succeeded.SpanContext().TraceID.String())
+	require.Equal(t, testParentSpanID, succeeded.ParentSpanID().String())
+	require.Equal(t, succeeded.SpanContext(), handlerSpanContext)
+	require.Equal(t, codes.Ok, succeeded.StatusCode())
+	require.Equal(t, label.IntValue(int(grpccodes.OK)), succeeded.Attributes()[RPCGRPCStatusCodeKey])
+
+	failed := spans[1]
+	require.False(t, failed.ParentSpanID().IsValid())
+	require.Equal(t, codes.Error, failed.StatusCode())
+	require.Equal(t, "workflow not found", failed.StatusMessage())
+	require.Equal(t, label.IntValue(int(grpccodes.NotFound)), failed.Attributes()[RPCGRPCStatusCodeKey])
+	require.Len(t, failed.Events(), 1)
+}
+
+func TestTracingInterceptor_NoTracerProvider(t *testing.T) {
+	tracingInterceptor := NewTracingInterceptor(nil)
+	info := &grpc.UnaryServerInfo{FullMethod: "/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution"}
+
+	resp, err := tracingInterceptor.Intercept(context.Background(), "request", info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		require.False(t, trace.SpanContextFromContext(ctx).IsValid())
+		return req, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, "request", resp)
+}
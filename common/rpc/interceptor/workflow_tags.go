@@ -0,0 +1,92 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"google.golang.org/grpc"
+
+	"go.temporal.io/server/common/log"
+)
+
+// gRPC method requests implementing these getters have their workflow execution and shard ID
+// put into the context, so that they are tagged by log.WithWorkflowTags
+type (
+	WorkflowExecutionGetter interface {
+		GetWorkflowExecution() *commonpb.WorkflowExecution
+	}
+
+	ExecutionGetter interface {
+		GetExecution() *commonpb.WorkflowExecution
+	}
+
+	WorkflowIDGetter interface {
+		GetWorkflowId() string
+	}
+
+	RunIDGetter interface {
+		GetRunId() string
+	}
+
+	ShardIDGetter interface {
+		GetShardId() int32
+	}
+)
+
+var _ grpc.UnaryServerInterceptor = WorkflowTagsInterceptor
+
+// WorkflowTagsInterceptor puts the namespace ID, workflow execution and shard ID of the request into the context
+func WorkflowTagsInterceptor(
+	ctx context.Context,
+	req interface{},
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	var namespaceID, workflowID, runID string
+	if r, ok := req.(NamespaceIDGetter); ok {
+		namespaceID = r.GetNamespaceId()
+	}
+	switch r := req.(type) {
+	case WorkflowExecutionGetter:
+		workflowID, runID = r.GetWorkflowExecution().GetWorkflowId(), r.GetWorkflowExecution().GetRunId()
+	case ExecutionGetter:
+		workflowID, runID = r.GetExecution().GetWorkflowId(), r.GetExecution().GetRunId()
+	}
+	if r, ok := req.(WorkflowIDGetter); ok && workflowID == "" {
+		workflowID = r.GetWorkflowId()
+	}
+	if r, ok := req.(RunIDGetter); ok && runID == "" {
+		runID = r.GetRunId()
+	}
+	if namespaceID != "" || workflowID != "" || runID != "" {
+		ctx = log.ContextWithWorkflowExecution(ctx, namespaceID, workflowID, runID)
+	}
+	if r, ok := req.(ShardIDGetter); ok && r.GetShardId() > 0 {
+		ctx = log.ContextWithShardID(ctx, r.GetShardId())
+	}
+	return handler(ctx, req)
+}
@@ -0,0 +1,66 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/log"
+)
+
+func TestWorkflowTagsInterceptor(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	logger := log.NewZapLogger(zap.New(core))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		log.WithWorkflowTags(ctx, logger).Info("handled")
+		return nil, nil
+	}
+
+	_, err := WorkflowTagsInterceptor(context.Background(), &historyservice.GetMutableStateRequest{
+		NamespaceId: "namespace-id",
+		Execution:   &commonpb.WorkflowExecution{WorkflowId: "workflow-id", RunId: "run-id"},
+	}, nil, handler)
+	require.NoError(t, err)
+	_, err = WorkflowTagsInterceptor(context.Background(), &historyservice.CloseShardRequest{ShardId: 3}, nil, handler)
+	require.NoError(t, err)
+
+	entries := logs.AllUntimed()
+	require.Len(t, entries, 2)
+	fields := entries[0].ContextMap()
+	require.Equal(t, "namespace-id", fields["wf-namespace-id"])
+	require.Equal(t, "workflow-id", fields["wf-id"])
+	require.Equal(t, "run-id", fields["wf-run-id"])
+	require.NotContains(t, fields, "shard-id")
+	fields = entries[1].ContextMap()
+	require.Equal(t, int32(3), fields["shard-id"])
+	require.NotContains(t, fields, "wf-id")
+}
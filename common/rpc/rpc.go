@@ -34,6 +34,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 
+	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/common/log"
@@ -43,9 +44,10 @@ import (
 
 // RPCFactory is an implementation of service.RPCFactory interface
 type RPCFactory struct {
-	config      *config.RPC
-	serviceName string
-	logger      log.Logger
+	config        *config.RPC
+	internodeAuth config.InternodeAuth
+	serviceName   string
+	logger        log.Logger
 
 	sync.Mutex
 	grpcListener   net.Listener
@@ -55,12 +57,12 @@ type RPCFactory struct {
 
 // NewFactory builds a new RPCFactory
 // conforming to the underlying configuration
-func NewFactory(cfg *config.RPC, sName string, logger log.Logger, tlsProvider encryption.TLSConfigProvider) *RPCFactory {
-	return newFactory(cfg, sName, logger, tlsProvider)
+func NewFactory(cfg *config.RPC, internodeAuth config.InternodeAuth, sName string, logger log.Logger, tlsProvider encryption.TLSConfigProvider) *RPCFactory {
+	return newFactory(cfg, internodeAuth, sName, logger, tlsProvider)
 }
 
-func newFactory(cfg *config.RPC, sName string, logger log.Logger, tlsProvider encryption.TLSConfigProvider) *RPCFactory {
-	factory := &RPCFactory{config: cfg, serviceName: sName, logger: logger, tlsFactory: tlsProvider}
+func newFactory(cfg *config.RPC, internodeAuth config.InternodeAuth, sName string, logger log.Logger, tlsProvider encryption.TLSConfigProvider) *RPCFactory {
+	factory := &RPCFactory{config: cfg, internodeAuth: internodeAuth, serviceName: sName, logger: logger, tlsFactory: tlsProvider}
 	return factory
 }
 
@@ -92,6 +94,10 @@ func (d *RPCFactory) GetFrontendClientTlsConfig() (*tls.Config, error) {
 func (d *RPCFactory) GetInternodeGRPCServerOptions() ([]grpc.ServerOption, error) {
 	var opts []grpc.ServerOption
 
+	if d.internodeAuth.Token != "" {
+		opts = append(opts, grpc.ChainUnaryInterceptor(authorization.NewInternodeTokenServerInterceptor(d.internodeAuth)))
+	}
+
 	if d.tlsFactory != nil {
 		serverConfig, err := d.tlsFactory.GetInternodeServerConfig()
 		if err != nil {
@@ -194,7 +200,7 @@ func getListenIP(cfg *config.RPC, logger log.Logger) net.IP {
 }
 
 // CreateFrontendGRPCConnection creates connection for gRPC calls
-func (d *RPCFactory) CreateFrontendGRPCConnection(hostName string) *grpc.ClientConn {
+func (d *RPCFactory) CreateFrontendGRPCConnection(hostName string, opts ...grpc.DialOption) *grpc.ClientConn {
 	var tlsClientConfig *tls.Config
 	var err error
 	if d.tlsFactory != nil {
@@ -204,11 +210,11 @@ func (d *RPCFactory) CreateFrontendGRPCConnection(hostName string) *grpc.ClientC
 		}
 	}
 
-	return d.dial(hostName, tlsClientConfig, false)
+	return d.dial(hostName, tlsClientConfig, false, opts...)
 }
 
 // CreateInternodeGRPCConnection creates connection for gRPC calls
-func (d *RPCFactory) CreateInternodeGRPCConnection(hostName string) *grpc.ClientConn {
+func (d *RPCFactory) CreateInternodeGRPCConnection(hostName string, opts ...grpc.DialOption) *grpc.ClientConn {
 	var tlsClientConfig *tls.Config
 	var err error
 	if d.tlsFactory != nil {
@@ -218,11 +224,15 @@ func (d *RPCFactory) CreateInternodeGRPCConnection(hostName string) *grpc.Client
 		}
 	}
 
-	return d.dial(hostName, tlsClientConfig, true)
+	if d.internodeAuth.Token != "" {
+		opts = append(opts, grpc.WithChainUnaryInterceptor(authorization.NewInternodeTokenClientInterceptor(d.internodeAuth.Token)))
+	}
+
+	return d.dial(hostName, tlsClientConfig, true, opts...)
 }
 
-func (d *RPCFactory) dial(hostName string, tlsClientConfig *tls.Config, enableKeepAlive bool) *grpc.ClientConn {
-	connection, err := Dial(hostName, tlsClientConfig, d.logger, enableKeepAlive)
+func (d *RPCFactory) dial(hostName string, tlsClientConfig *tls.Config, enableKeepAlive bool, opts ...grpc.DialOption) *grpc.ClientConn {
+	connection, err := Dial(hostName, tlsClientConfig, d.logger, enableKeepAlive, opts...)
 	if err != nil {
 		d.logger.Fatal("Failed to create gRPC connection", tag.Error(err))
 	}
@@ -128,7 +128,7 @@ func (s *localStoreRPCSuite) SetupSuite() {
 
 	provider, err := encryption.NewTLSConfigProviderFromConfig(serverCfgInsecure.TLS, nil, s.logger, nil)
 	s.NoError(err)
-	insecureFactory := rpc.NewFactory(rpcTestCfgDefault, "tester", s.logger, provider)
+	insecureFactory := rpc.NewFactory(rpcTestCfgDefault, config.InternodeAuth{}, "tester", s.logger, provider)
 	s.NotNil(insecureFactory)
 	s.insecureRPCFactory = i(insecureFactory)
 
@@ -306,22 +306,22 @@ func (s *localStoreRPCSuite) setupFrontend() {
 
 	provider, err := encryption.NewTLSConfigProviderFromConfig(localStoreMutualTLS.TLS, nil, s.logger, nil)
 	s.NoError(err)
-	frontendMutualTLSFactory := rpc.NewFactory(rpcTestCfgDefault, "tester", s.logger, provider)
+	frontendMutualTLSFactory := rpc.NewFactory(rpcTestCfgDefault, config.InternodeAuth{}, "tester", s.logger, provider)
 	s.NotNil(frontendMutualTLSFactory)
 
 	provider, err = encryption.NewTLSConfigProviderFromConfig(localStoreServerTLS.TLS, nil, s.logger, nil)
 	s.NoError(err)
-	frontendServerTLSFactory := rpc.NewFactory(rpcTestCfgDefault, "tester", s.logger, provider)
+	frontendServerTLSFactory := rpc.NewFactory(rpcTestCfgDefault, config.InternodeAuth{}, "tester", s.logger, provider)
 	s.NotNil(frontendServerTLSFactory)
 
 	provider, err = encryption.NewTLSConfigProviderFromConfig(localStoreMutualTLSSystemWorker.TLS, nil, s.logger, nil)
 	s.NoError(err)
-	frontendSystemWorkerMutualTLSFactory := rpc.NewFactory(rpcTestCfgDefault, "tester", s.logger, provider)
+	frontendSystemWorkerMutualTLSFactory := rpc.NewFactory(rpcTestCfgDefault, config.InternodeAuth{}, "tester", s.logger, provider)
 	s.NotNil(frontendSystemWorkerMutualTLSFactory)
 
 	provider, err = encryption.NewTLSConfigProviderFromConfig(localStoreMutualTLSWithRefresh.TLS, nil, s.logger, nil)
 	s.NoError(err)
-	frontendMutualTLSRefreshFactory := rpc.NewFactory(rpcTestCfgDefault, "tester", s.logger, provider)
+	frontendMutualTLSRefreshFactory := rpc.NewFactory(rpcTestCfgDefault, config.InternodeAuth{}, "tester", s.logger, provider)
 	s.NotNil(frontendMutualTLSRefreshFactory)
 
 	s.frontendMutualTLSRPCFactory = f(frontendMutualTLSFactory)
@@ -335,7 +335,7 @@ func (s *localStoreRPCSuite) setupFrontend() {
 		s.frontendRollingCerts,
 		s.dynamicCACertPool,
 		s.wrongCACertPool)
-	dynamicServerTLSFactory := rpc.NewFactory(rpcTestCfgDefault, "tester", s.logger, s.dynamicConfigProvider)
+	dynamicServerTLSFactory := rpc.NewFactory(rpcTestCfgDefault, config.InternodeAuth{}, "tester", s.logger, s.dynamicConfigProvider)
 	s.frontendDynamicTLSFactory = f(dynamicServerTLSFactory)
 	s.internodeDynamicTLSFactory = i(dynamicServerTLSFactory)
 
@@ -373,22 +373,22 @@ func (s *localStoreRPCSuite) setupInternode() {
 
 	provider, err := encryption.NewTLSConfigProviderFromConfig(localStoreMutualTLS.TLS, nil, s.logger, nil)
 	s.NoError(err)
-	internodeMutualTLSFactory := rpc.NewFactory(rpcTestCfgDefault, "tester", s.logger, provider)
+	internodeMutualTLSFactory := rpc.NewFactory(rpcTestCfgDefault, config.InternodeAuth{}, "tester", s.logger, provider)
 	s.NotNil(internodeMutualTLSFactory)
 
 	provider, err = encryption.NewTLSConfigProviderFromConfig(localStoreServerTLS.TLS, nil, s.logger, nil)
 	s.NoError(err)
-	internodeServerTLSFactory := rpc.NewFactory(rpcTestCfgDefault, "tester", s.logger, provider)
+	internodeServerTLSFactory := rpc.NewFactory(rpcTestCfgDefault, config.InternodeAuth{}, "tester", s.logger, provider)
 	s.NotNil(internodeServerTLSFactory)
 
 	provider, err = encryption.NewTLSConfigProviderFromConfig(localStoreAltMutualTLS.TLS, nil, s.logger, nil)
 	s.NoError(err)
-	internodeMutualAltTLSFactory := rpc.NewFactory(rpcTestCfgDefault, "tester", s.logger, provider)
+	internodeMutualAltTLSFactory := rpc.NewFactory(rpcTestCfgDefault, config.InternodeAuth{}, "tester", s.logger, provider)
 	s.NotNil(internodeMutualAltTLSFactory)
 
 	provider, err = encryption.NewTLSConfigProviderFromConfig(localStoreMutualTLSWithRefresh.TLS, nil, s.logger, nil)
 	s.NoError(err)
-	internodeMutualTLSRefreshFactory := rpc.NewFactory(rpcTestCfgDefault, "tester", s.logger, provider)
+	internodeMutualTLSRefreshFactory := rpc.NewFactory(rpcTestCfgDefault, config.InternodeAuth{}, "tester", s.logger, provider)
 	s.NotNil(internodeMutualTLSRefreshFactory)
 
 	s.internodeMutualTLSRPCFactory = i(internodeMutualTLSFactory)
@@ -514,6 +514,34 @@ func convertFileToBase64(file string) string {
 	return base64.StdEncoding.EncodeToString(fileBytes)
 }
 
+func (s *localStoreRPCSuite) TestStaticTLSConfigMutualTLS() {
+	serverConfig := &tls.Config{
+		Certificates: []tls.Certificate{*s.frontendRollingCerts[0]},
+		ClientCAs:    s.dynamicCACertPool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	}
+	clientConfig := &tls.Config{
+		Certificates: []tls.Certificate{*s.frontendRollingCerts[1]},
+		RootCAs:      s.dynamicCACertPool,
+	}
+	newFactory := func() *TestFactory {
+		provider := encryption.NewStaticTLSConfigProvider(serverConfig, clientConfig, nil, nil)
+		return i(rpc.NewFactory(rpcTestCfgDefault, config.InternodeAuth{}, "tester", s.logger, provider))
+	}
+
+	runHelloWorldTest(s.Suite, localhostIPv4, newFactory(), newFactory(), true)
+	runHelloWorldTest(s.Suite, localhostIPv4, newFactory(), s.insecureRPCFactory, false)
+}
+
+func (s *localStoreRPCSuite) TestStaticTLSConfigInsecure() {
+	newFactory := func() *TestFactory {
+		provider := encryption.NewStaticTLSConfigProvider(nil, nil, nil, nil)
+		return i(rpc.NewFactory(rpcTestCfgDefault, config.InternodeAuth{}, "tester", s.logger, provider))
+	}
+
+	runHelloWorldTest(s.Suite, localhostIPv4, newFactory(), newFactory(), true)
+}
+
 func (s *localStoreRPCSuite) TestServerTLS() {
 	runHelloWorldTest(s.Suite, localhostIPv4, s.internodeServerTLSRPCFactory, s.internodeServerTLSRPCFactory, true)
 }
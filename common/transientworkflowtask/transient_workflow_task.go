@@ -0,0 +1,132 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package transientworkflowtask
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/gogo/protobuf/proto"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+
+	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/common/codec/wire"
+)
+
+const (
+	scheduledEventFieldNumber = 1
+	startedEventFieldNumber   = 2
+)
+
+// CopyTransientWorkflowTaskInfo deep copies TransientWorkflowTaskInfo.
+func CopyTransientWorkflowTaskInfo(info *historyspb.TransientWorkflowTaskInfo) *historyspb.TransientWorkflowTaskInfo {
+	result := &historyspb.TransientWorkflowTaskInfo{}
+	if info.GetScheduledEvent() != nil {
+		result.ScheduledEvent = proto.Clone(info.GetScheduledEvent()).(*historypb.HistoryEvent)
+	}
+	if info.GetStartedEvent() != nil {
+		result.StartedEvent = proto.Clone(info.GetStartedEvent()).(*historypb.HistoryEvent)
+	}
+	return result
+}
+
+// Validate checks that the scheduled and started events of TransientWorkflowTaskInfo are consistent with each other.
+func Validate(info *historyspb.TransientWorkflowTaskInfo) error {
+	if info == nil {
+		return serviceerror.NewInvalidArgument("transient workflow task info is null.")
+	}
+
+	scheduledEvent := info.GetScheduledEvent()
+	if scheduledEvent == nil {
+		return serviceerror.NewInvalidArgument("transient workflow task scheduled event is null.")
+	}
+	startedEvent := info.GetStartedEvent()
+	if startedEvent == nil {
+		return serviceerror.NewInvalidArgument("transient workflow task started event is null.")
+	}
+
+	if startedEvent.GetEventId() <= scheduledEvent.GetEventId() {
+		return serviceerror.NewInvalidArgument(fmt.Sprintf("transient workflow task started event ID %v is not greater than scheduled event ID %v.", startedEvent.GetEventId(), scheduledEvent.GetEventId()))
+	}
+
+	if startedEvent.GetEventType() == enumspb.EVENT_TYPE_WORKFLOW_TASK_STARTED {
+		// only workflow task started event carries a reference to the scheduled event
+		scheduledEventID := startedEvent.GetWorkflowTaskStartedEventAttributes().GetScheduledEventId()
+		if scheduledEventID != scheduledEvent.GetEventId() {
+			return serviceerror.NewInvalidArgument(fmt.Sprintf("transient workflow task started event references scheduled event ID %v, but scheduled event ID is %v.", scheduledEventID, scheduledEvent.GetEventId()))
+		}
+	}
+	return nil
+}
+
+// Materialize converts TransientWorkflowTaskInfo into a pair of history events with event IDs assigned sequentially from startEventID.
+func Materialize(info *historyspb.TransientWorkflowTaskInfo, startEventID int64) ([]*historypb.HistoryEvent, error) {
+	if info.GetScheduledEvent() == nil {
+		return nil, serviceerror.NewInvalidArgument("transient workflow task scheduled event is null.")
+	}
+	if info.GetStartedEvent() == nil {
+		return nil, serviceerror.NewInvalidArgument("transient workflow task started event is null.")
+	}
+
+	scheduledEvent := proto.Clone(info.GetScheduledEvent()).(*historypb.HistoryEvent)
+	scheduledEvent.EventId = startEventID
+	startedEvent := proto.Clone(info.GetStartedEvent()).(*historypb.HistoryEvent)
+	startedEvent.EventId = startEventID + 1
+	if attributes := startedEvent.GetWorkflowTaskStartedEventAttributes(); attributes != nil {
+		attributes.ScheduledEventId = scheduledEvent.EventId
+	}
+
+	return []*historypb.HistoryEvent{scheduledEvent, startedEvent}, nil
+}
+
+// UnmarshalShallow walks the wire format of TransientWorkflowTaskInfo and returns encoded lengths of the scheduled and started events
+// without decoding them. Length of absent event is 0.
+func UnmarshalShallow(data []byte) (scheduledLen int, startedLen int, err error) {
+	reader := wire.NewReader(data)
+	for {
+		field, err := reader.Next()
+		if err == io.EOF {
+			return scheduledLen, startedLen, nil
+		}
+		if err != nil {
+			return 0, 0, err
+		}
+
+		switch field.Number {
+		case scheduledEventFieldNumber:
+			if field.WireType != wire.WireTypeBytes {
+				return 0, 0, fmt.Errorf("proto: wrong wireType = %d for field ScheduledEvent", field.WireType)
+			}
+			scheduledLen = len(field.Value)
+		case startedEventFieldNumber:
+			if field.WireType != wire.WireTypeBytes {
+				return 0, 0, fmt.Errorf("proto: wrong wireType = %d for field StartedEvent", field.WireType)
+			}
+			startedLen = len(field.Value)
+		}
+	}
+}
@@ -0,0 +1,244 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package transientworkflowtask
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+
+	historyspb "go.temporal.io/server/api/history/v1"
+)
+
+type (
+	transientWorkflowTaskSuite struct {
+		suite.Suite
+	}
+)
+
+func TestTransientWorkflowTaskSuite(t *testing.T) {
+	s := new(transientWorkflowTaskSuite)
+	suite.Run(t, s)
+}
+
+func (s *transientWorkflowTaskSuite) TestCopy() {
+	info := newTransientWorkflowTaskInfo(5, 6, 5)
+
+	copied := CopyTransientWorkflowTaskInfo(info)
+	s.Equal(info, copied)
+	s.NotSame(info.ScheduledEvent, copied.ScheduledEvent)
+	s.NotSame(info.StartedEvent, copied.StartedEvent)
+
+	copied.StartedEvent.GetWorkflowTaskStartedEventAttributes().ScheduledEventId = 100
+	s.Equal(int64(5), info.StartedEvent.GetWorkflowTaskStartedEventAttributes().GetScheduledEventId())
+
+	s.Equal(&historyspb.TransientWorkflowTaskInfo{}, CopyTransientWorkflowTaskInfo(&historyspb.TransientWorkflowTaskInfo{}))
+}
+
+func (s *transientWorkflowTaskSuite) TestValidate_Success() {
+	s.NoError(Validate(newTransientWorkflowTaskInfo(5, 6, 5)))
+}
+
+func (s *transientWorkflowTaskSuite) TestValidate_NilInfo() {
+	s.Error(Validate(nil))
+}
+
+func (s *transientWorkflowTaskSuite) TestValidate_NilScheduledEvent() {
+	info := newTransientWorkflowTaskInfo(5, 6, 5)
+	info.ScheduledEvent = nil
+	s.Error(Validate(info))
+}
+
+func (s *transientWorkflowTaskSuite) TestValidate_NilStartedEvent() {
+	info := newTransientWorkflowTaskInfo(5, 6, 5)
+	info.StartedEvent = nil
+	s.Error(Validate(info))
+}
+
+func (s *transientWorkflowTaskSuite) TestValidate_StartedEventIDNotGreater() {
+	s.Error(Validate(newTransientWorkflowTaskInfo(5, 5, 5)))
+	s.Error(Validate(newTransientWorkflowTaskInfo(5, 4, 5)))
+}
+
+func (s *transientWorkflowTaskSuite) TestValidate_ScheduledEventIDMismatch() {
+	s.Error(Validate(newTransientWorkflowTaskInfo(5, 6, 4)))
+}
+
+func (s *transientWorkflowTaskSuite) TestMaterialize_Success() {
+	info := newTransientWorkflowTaskInfo(5, 6, 5)
+
+	events, err := Materialize(info, 10)
+	s.NoError(err)
+	s.Len(events, 2)
+	s.Equal(int64(10), events[0].GetEventId())
+	s.Equal(enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED, events[0].GetEventType())
+	s.Equal(int64(11), events[1].GetEventId())
+	s.Equal(enumspb.EVENT_TYPE_WORKFLOW_TASK_STARTED, events[1].GetEventType())
+	s.Equal(int64(10), events[1].GetWorkflowTaskStartedEventAttributes().GetScheduledEventId())
+	s.NoError(Validate(&historyspb.TransientWorkflowTaskInfo{
+		ScheduledEvent: events[0],
+		StartedEvent:   events[1],
+	}))
+
+	// original info must not be modified
+	s.Equal(newTransientWorkflowTaskInfo(5, 6, 5), info)
+}
+
+func (s *transientWorkflowTaskSuite) TestMaterialize_NilEvent() {
+	_, err := Materialize(nil, 10)
+	s.Error(err)
+
+	info := newTransientWorkflowTaskInfo(5, 6, 5)
+	info.ScheduledEvent = nil
+	_, err = Materialize(info, 10)
+	s.Error(err)
+
+	info = newTransientWorkflowTaskInfo(5, 6, 5)
+	info.StartedEvent = nil
+	_, err = Materialize(info, 10)
+	s.Error(err)
+}
+
+func (s *transientWorkflowTaskSuite) TestUnmarshalShallow() {
+	info := newTransientWorkflowTaskInfo(5, 6, 5)
+	info.StartedEvent.GetWorkflowTaskStartedEventAttributes().Identity = "some random identity"
+	data, err := info.Marshal()
+	s.NoError(err)
+
+	scheduledLen, startedLen, err := UnmarshalShallow(data)
+	s.NoError(err)
+	s.Equal(info.ScheduledEvent.Size(), scheduledLen)
+	s.Equal(info.StartedEvent.Size(), startedLen)
+
+	decoded := &historyspb.TransientWorkflowTaskInfo{}
+	s.NoError(decoded.Unmarshal(data))
+	s.Equal(decoded.ScheduledEvent.Size(), scheduledLen)
+	s.Equal(decoded.StartedEvent.Size(), startedLen)
+}
+
+func (s *transientWorkflowTaskSuite) TestUnmarshalShallow_MissingEvent() {
+	info := newTransientWorkflowTaskInfo(5, 6, 5)
+	info.StartedEvent = nil
+	data, err := info.Marshal()
+	s.NoError(err)
+
+	scheduledLen, startedLen, err := UnmarshalShallow(data)
+	s.NoError(err)
+	s.Equal(info.ScheduledEvent.Size(), scheduledLen)
+	s.Equal(0, startedLen)
+}
+
+func (s *transientWorkflowTaskSuite) TestUnmarshalShallow_Truncated() {
+	data, err := newTransientWorkflowTaskInfo(5, 6, 5).Marshal()
+	s.NoError(err)
+
+	_, _, err = UnmarshalShallow(data[:len(data)-1])
+	s.Error(err)
+}
+
+func newTransientWorkflowTaskInfo(
+	scheduledEventID int64,
+	startedEventID int64,
+	referencedScheduledEventID int64,
+) *historyspb.TransientWorkflowTaskInfo {
+	return &historyspb.TransientWorkflowTaskInfo{
+		ScheduledEvent: &historypb.HistoryEvent{
+			EventId:   scheduledEventID,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED,
+			Attributes: &historypb.HistoryEvent_WorkflowTaskScheduledEventAttributes{
+				WorkflowTaskScheduledEventAttributes: &historypb.WorkflowTaskScheduledEventAttributes{},
+			},
+		},
+		StartedEvent: &historypb.HistoryEvent{
+			EventId:   startedEventID,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_STARTED,
+			Attributes: &historypb.HistoryEvent_WorkflowTaskStartedEventAttributes{
+				WorkflowTaskStartedEventAttributes: &historypb.WorkflowTaskStartedEventAttributes{
+					ScheduledEventId: referencedScheduledEventID,
+				},
+			},
+		},
+	}
+}
+
+func BenchmarkCopyTransientWorkflowTaskInfo(b *testing.B) {
+	info := newTransientWorkflowTaskInfo(5, 6, 5)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = CopyTransientWorkflowTaskInfo(info)
+	}
+}
+
+func BenchmarkCopyTransientWorkflowTaskInfo_MarshalUnmarshal(b *testing.B) {
+	info := newTransientWorkflowTaskInfo(5, 6, 5)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		data, err := info.Marshal()
+		if err != nil {
+			b.Fatal(err)
+		}
+		result := &historyspb.TransientWorkflowTaskInfo{}
+		if err := result.Unmarshal(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkUnmarshalShallow(b *testing.B) {
+	data, err := newTransientWorkflowTaskInfo(5, 6, 5).Marshal()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, _, err := UnmarshalShallow(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkUnmarshal(b *testing.B) {
+	data, err := newTransientWorkflowTaskInfo(5, 6, 5).Marshal()
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		info := &historyspb.TransientWorkflowTaskInfo{}
+		if err := info.Unmarshal(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -150,6 +150,17 @@ func CreatePersistanceRetryPolicy() backoff.RetryPolicy {
 	return policy
 }
 
+// CreatePersistenceClientRetryPolicy creates a retry policy for the retryable persistence clients,
+// retrying a call at most maxAttempts times
+func CreatePersistenceClientRetryPolicy(maxAttempts int) backoff.RetryPolicy {
+	policy := backoff.NewExponentialRetryPolicy(retryPersistenceOperationInitialInterval)
+	policy.SetMaximumInterval(retryPersistenceOperationMaxInterval)
+	policy.SetExpirationInterval(retryPersistenceOperationExpirationInterval)
+	policy.SetMaximumAttempts(maxAttempts)
+
+	return policy
+}
+
 // CreateHistoryServiceRetryPolicy creates a retry policy for calls to history service
 func CreateHistoryServiceRetryPolicy() backoff.RetryPolicy {
 	policy := backoff.NewExponentialRetryPolicy(historyServiceOperationInitialInterval)
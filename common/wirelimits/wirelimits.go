@@ -0,0 +1,157 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package wirelimits bounds the work done decoding untrusted proto payloads.
+package wirelimits
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+
+	"go.temporal.io/server/common/protoschema"
+)
+
+type (
+	// Limits bounds the work done when decoding an encoded proto message.
+	Limits struct {
+		// MaxDepth is the maximum nesting depth of messages and (deprecated) groups.
+		MaxDepth int
+		// MaxBytes is the maximum size of the encoded message.
+		MaxBytes int
+	}
+
+	// ExceededError is returned when an encoded message exceeds its Limits.
+	ExceededError struct {
+		Msg string
+	}
+
+	// messageSchema maps the numbers of the fields of a message which hold nested messages to the full name of their type
+	messageSchema map[int32]string
+)
+
+// Default are the limits applied to payloads received from remote clusters.
+var Default = Limits{
+	MaxDepth: 100,
+	MaxBytes: 128 * 1024 * 1024,
+}
+
+var schemas sync.Map // full message name -> messageSchema
+
+func (e *ExceededError) Error() string {
+	return e.Msg
+}
+
+// Check returns an ExceededError if the encoded message in data is larger than limits.MaxBytes or
+// nests messages and groups deeper than limits.MaxDepth. msg is only used for its schema, which
+// tells nested messages apart from other length-delimited fields. Malformed input is left for
+// Unmarshal to reject.
+func Check(data []byte, msg proto.Message, limits Limits) error {
+	if len(data) > limits.MaxBytes {
+		return newExceededError("message size %d exceeds limit %d", len(data), limits.MaxBytes)
+	}
+	return checkMessage(data, schemaOf(proto.MessageName(msg)), 1, limits)
+}
+
+func checkMessage(data []byte, schema messageSchema, depth int, limits Limits) error {
+	if depth > limits.MaxDepth {
+		return newExceededError("message depth exceeds limit %d", limits.MaxDepth)
+	}
+
+	l := len(data)
+	iNdEx := 0
+	groupDepth := 0
+	for iNdEx < l {
+		wire, n := proto.DecodeVarint(data[iNdEx:])
+		if n == 0 {
+			return nil
+		}
+		iNdEx += n
+
+		switch wireType := wire & 0x7; wireType {
+		case 0:
+			_, n := proto.DecodeVarint(data[iNdEx:])
+			if n == 0 {
+				return nil
+			}
+			iNdEx += n
+		case 1:
+			iNdEx += 8
+		case 2:
+			length, n := proto.DecodeVarint(data[iNdEx:])
+			if n == 0 || length > uint64(l-iNdEx-n) {
+				return nil
+			}
+			iNdEx += n
+			end := iNdEx + int(length)
+			if typeName, ok := schema[int32(wire>>3)]; ok && groupDepth == 0 {
+				if err := checkMessage(data[iNdEx:end], schemaOf(typeName), depth+1, limits); err != nil {
+					return err
+				}
+			}
+			iNdEx = end
+		case 3:
+			groupDepth++
+			if depth+groupDepth > limits.MaxDepth {
+				return newExceededError("group depth exceeds limit %d", limits.MaxDepth)
+			}
+		case 4:
+			if groupDepth == 0 {
+				return nil
+			}
+			groupDepth--
+		case 5:
+			iNdEx += 4
+		default:
+			return nil
+		}
+	}
+	return nil
+}
+
+// schemaOf returns the schema of the message registered with fullName, messages which can not be
+// resolved have an empty schema and are not walked into
+func schemaOf(fullName string) messageSchema {
+	if schema, ok := schemas.Load(fullName); ok {
+		return schema.(messageSchema)
+	}
+
+	schema := make(messageSchema)
+	if message := protoschema.MessageDescriptor(fullName); message != nil {
+		for _, field := range message.GetField() {
+			if field.GetType() == descriptor.FieldDescriptorProto_TYPE_MESSAGE {
+				schema[field.GetNumber()] = strings.TrimPrefix(field.GetTypeName(), ".")
+			}
+		}
+	}
+	schemas.Store(fullName, schema)
+	return schema
+}
+
+func newExceededError(format string, args ...interface{}) error {
+	return &ExceededError{Msg: fmt.Sprintf(format, args...)}
+}
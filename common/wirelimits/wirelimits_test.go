@@ -0,0 +1,112 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package wirelimits_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	failurepb "go.temporal.io/api/failure/v1"
+
+	historyspb "go.temporal.io/server/api/history/v1"
+	"go.temporal.io/server/common/wirelimits"
+)
+
+type (
+	wireLimitsSuite struct {
+		suite.Suite
+	}
+)
+
+func TestWireLimitsSuite(t *testing.T) {
+	s := new(wireLimitsSuite)
+	suite.Run(t, s)
+}
+
+func (s *wireLimitsSuite) TestValidMessage() {
+	data, err := (&historyspb.VersionHistory{
+		BranchToken: []byte("branch-token"),
+		Items:       []*historyspb.VersionHistoryItem{{EventId: 10, Version: 1}},
+	}).Marshal()
+	s.NoError(err)
+	s.NoError(wirelimits.Check(data, &historyspb.VersionHistory{}, wirelimits.Default))
+}
+
+func (s *wireLimitsSuite) TestDeeplyNestedMessage() {
+	failure := &failurepb.Failure{Message: "root cause"}
+	for i := 0; i < 9; i++ {
+		failure = &failurepb.Failure{Message: "failure", Cause: failure}
+	}
+	data, err := failure.Marshal()
+	s.NoError(err)
+
+	s.NoError(wirelimits.Check(data, &failurepb.Failure{}, wirelimits.Limits{MaxDepth: 10, MaxBytes: 1024}))
+	err = wirelimits.Check(data, &failurepb.Failure{}, wirelimits.Limits{MaxDepth: 9, MaxBytes: 1024})
+	s.IsType(&wirelimits.ExceededError{}, err)
+	s.Contains(err.Error(), "message depth")
+}
+
+func (s *wireLimitsSuite) TestNestedMapValue() {
+	data, err := (&commonpb.Header{
+		Fields: map[string]*commonpb.Payload{"key": {Data: []byte("value")}},
+	}).Marshal()
+	s.NoError(err)
+
+	// header, map entry and payload
+	s.NoError(wirelimits.Check(data, &commonpb.Header{}, wirelimits.Limits{MaxDepth: 3, MaxBytes: 1024}))
+	s.IsType(&wirelimits.ExceededError{}, wirelimits.Check(data, &commonpb.Header{}, wirelimits.Limits{MaxDepth: 2, MaxBytes: 1024}))
+}
+
+func (s *wireLimitsSuite) TestDeeplyNestedGroup() {
+	limits := wirelimits.Limits{MaxDepth: 11, MaxBytes: 1024}
+	// field 1, wire type 3 (start group)
+	startGroup := []byte{0x0b}
+	// field 1, wire type 4 (end group)
+	endGroup := []byte{0x0c}
+
+	withinLimit := append(bytes.Repeat(startGroup, 10), bytes.Repeat(endGroup, 10)...)
+	s.NoError(wirelimits.Check(withinLimit, &historyspb.VersionHistory{}, limits))
+
+	tooDeep := append(bytes.Repeat(startGroup, 11), bytes.Repeat(endGroup, 11)...)
+	err := wirelimits.Check(tooDeep, &historyspb.VersionHistory{}, limits)
+	s.IsType(&wirelimits.ExceededError{}, err)
+	s.Contains(err.Error(), "group depth")
+}
+
+func (s *wireLimitsSuite) TestAbsurdLengthPrefix() {
+	// field 1, wire type 2 (length delimited) with a length of 1<<40, the length is checked by Unmarshal
+	data := []byte{0x0a, 0x80, 0x80, 0x80, 0x80, 0x80, 0x20}
+	s.NoError(wirelimits.Check(data, &historyspb.VersionHistory{}, wirelimits.Default))
+	s.Error((&historyspb.VersionHistory{}).Unmarshal(data))
+}
+
+func (s *wireLimitsSuite) TestMessageTooLarge() {
+	data := make([]byte, 32)
+	err := wirelimits.Check(data, &historyspb.VersionHistory{}, wirelimits.Limits{MaxDepth: 1, MaxBytes: 16})
+	s.IsType(&wirelimits.ExceededError{}, err)
+	s.Contains(err.Error(), "message size")
+}
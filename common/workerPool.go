@@ -0,0 +1,146 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package common
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+
+	"go.temporal.io/server/common/metrics"
+)
+
+type (
+	// WorkerPool runs submitted tasks on a fixed number of goroutines.
+	// At most size tasks wait in its queue, submitting more blocks until a worker picks one up.
+	WorkerPool struct {
+		tasks      chan func()
+		shutdownCh chan struct{}
+		once       sync.Once
+		workerWG   sync.WaitGroup
+
+		// held for read by submitters and for write by Shutdown before the task queue is closed,
+		// so that no task is sent on the closed queue
+		sync.RWMutex
+		isShutdown bool
+
+		activeWorkers int32
+	}
+)
+
+// ErrWorkerPoolShutdown is returned when a task is submitted to a WorkerPool which has been shut down
+var ErrWorkerPoolShutdown = errors.New("worker pool is shut down")
+
+// NewWorkerPool creates a WorkerPool with size workers and a queue of size tasks
+func NewWorkerPool(size int) *WorkerPool {
+	if size <= 0 {
+		size = 1
+	}
+	p := &WorkerPool{
+		tasks:      make(chan func(), size),
+		shutdownCh: make(chan struct{}),
+	}
+	p.workerWG.Add(size)
+	for i := 0; i < size; i++ {
+		go p.worker()
+	}
+	return p
+}
+
+// Submit queues task to be run by a worker, blocking while the queue is full
+func (p *WorkerPool) Submit(task func()) error {
+	return p.SubmitWithContext(context.Background(), task)
+}
+
+// SubmitWithContext queues task to be run by a worker, blocking while the queue is full.
+// ctx error is returned if ctx is done before the task is queued.
+func (p *WorkerPool) SubmitWithContext(ctx context.Context, task func()) error {
+	p.RLock()
+	defer p.RUnlock()
+
+	if p.isShutdown {
+		return ErrWorkerPoolShutdown
+	}
+	select {
+	case p.tasks <- task:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-p.shutdownCh:
+		return ErrWorkerPoolShutdown
+	}
+}
+
+// Shutdown stops accepting tasks and waits for the queued and running ones to complete.
+// ctx error is returned if ctx is done first, the remaining tasks are still run in background.
+func (p *WorkerPool) Shutdown(ctx context.Context) error {
+	p.once.Do(func() {
+		// unblock submitters waiting for room in the queue before taking the write lock
+		close(p.shutdownCh)
+		p.Lock()
+		p.isShutdown = true
+		close(p.tasks)
+		p.Unlock()
+	})
+
+	drainedCh := make(chan struct{})
+	go func() {
+		p.workerWG.Wait()
+		close(drainedCh)
+	}()
+	select {
+	case <-drainedCh:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// QueueDepth returns the number of tasks waiting for a worker
+func (p *WorkerPool) QueueDepth() int {
+	return len(p.tasks)
+}
+
+// ActiveWorkers returns the number of workers running a task
+func (p *WorkerPool) ActiveWorkers() int {
+	return int(atomic.LoadInt32(&p.activeWorkers))
+}
+
+// EmitMetrics reports the queue depth and active workers gauges to scope
+func (p *WorkerPool) EmitMetrics(scope metrics.Scope) {
+	scope.UpdateGauge(metrics.WorkerPoolQueueDepth, float64(p.QueueDepth()))
+	scope.UpdateGauge(metrics.WorkerPoolActiveWorkers, float64(p.ActiveWorkers()))
+}
+
+func (p *WorkerPool) worker() {
+	defer p.workerWG.Done()
+
+	for task := range p.tasks {
+		atomic.AddInt32(&p.activeWorkers, 1)
+		task()
+		atomic.AddInt32(&p.activeWorkers, -1)
+	}
+}
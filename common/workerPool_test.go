@@ -0,0 +1,122 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package common
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkerPool_BoundedConcurrency(t *testing.T) {
+	pool := NewWorkerPool(2)
+
+	var running, maxRunning, completed int32
+	for i := 0; i < 20; i++ {
+		require.NoError(t, pool.Submit(func() {
+			current := atomic.AddInt32(&running, 1)
+			for {
+				max := atomic.LoadInt32(&maxRunning)
+				if current <= max || atomic.CompareAndSwapInt32(&maxRunning, max, current) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			atomic.AddInt32(&running, -1)
+			atomic.AddInt32(&completed, 1)
+		}))
+	}
+	require.NoError(t, pool.Shutdown(context.Background()))
+	require.Equal(t, int32(20), completed)
+	require.LessOrEqual(t, maxRunning, int32(2))
+}
+
+func TestWorkerPool_SubmitWithContextCancelled(t *testing.T) {
+	pool := NewWorkerPool(1)
+	blockCh := make(chan struct{})
+	startedCh := make(chan struct{})
+
+	require.NoError(t, pool.Submit(func() {
+		close(startedCh)
+		<-blockCh
+	}))
+	<-startedCh
+	require.NoError(t, pool.Submit(func() {}))
+	require.Equal(t, 1, pool.ActiveWorkers())
+	require.Equal(t, 1, pool.QueueDepth())
+
+	// the worker is busy and the queue is full
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.Equal(t, context.DeadlineExceeded, pool.SubmitWithContext(ctx, func() {}))
+
+	close(blockCh)
+	require.NoError(t, pool.Shutdown(context.Background()))
+	require.Equal(t, 0, pool.ActiveWorkers())
+	require.Equal(t, 0, pool.QueueDepth())
+}
+
+func TestWorkerPool_ShutdownDrains(t *testing.T) {
+	pool := NewWorkerPool(4)
+
+	var completed int32
+	for i := 0; i < 16; i++ {
+		require.NoError(t, pool.Submit(func() {
+			time.Sleep(5 * time.Millisecond)
+			atomic.AddInt32(&completed, 1)
+		}))
+	}
+	require.NoError(t, pool.Shutdown(context.Background()))
+	require.Equal(t, int32(16), atomic.LoadInt32(&completed))
+	require.Equal(t, ErrWorkerPoolShutdown, pool.Submit(func() {}))
+	require.NoError(t, pool.Shutdown(context.Background()))
+}
+
+func TestWorkerPool_ShutdownTimeout(t *testing.T) {
+	pool := NewWorkerPool(1)
+	blockCh := make(chan struct{})
+	require.NoError(t, pool.Submit(func() { <-blockCh }))
+
+	// a submitter blocked on the full queue is released by Shutdown
+	require.NoError(t, pool.Submit(func() {}))
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		require.Equal(t, ErrWorkerPoolShutdown, pool.Submit(func() {}))
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.Equal(t, context.DeadlineExceeded, pool.Shutdown(ctx))
+	wg.Wait()
+
+	close(blockCh)
+	require.NoError(t, pool.Shutdown(context.Background()))
+}
@@ -83,7 +83,7 @@ type (
 		frontendService common.Daemon
 		matchingService common.Daemon
 		historyServices []common.Daemon
-		workerService   common.Daemon
+		workerService   resource.Resource
 
 		adminClient                      adminservice.AdminServiceClient
 		frontendClient                   workflowservice.WorkflowServiceClient
@@ -612,7 +612,9 @@ func (c *temporalImpl) startWorker(hosts map[string][]string, startWG *sync.Wait
 		params.Logger.Fatal("unable to create worker service", tag.Error(err))
 	}
 	c.workerService = service
-	service.Start()
+	if err := service.Start(); err != nil {
+		params.Logger.Fatal("unable to start worker service", tag.Error(err))
+	}
 
 	clusterMetadata := cluster.NewTestClusterMetadata(c.clusterMetadataConfig)
 	var replicatorNamespaceCache cache.NamespaceCache
@@ -763,12 +765,12 @@ func (c *rpcFactoryImpl) GetInternodeGRPCServerOptions() ([]grpc.ServerOption, e
 	return nil, nil
 }
 
-func (c *rpcFactoryImpl) CreateFrontendGRPCConnection(hostName string) *grpc.ClientConn {
-	return c.CreateGRPCConnection(hostName)
+func (c *rpcFactoryImpl) CreateFrontendGRPCConnection(hostName string, opts ...grpc.DialOption) *grpc.ClientConn {
+	return c.CreateGRPCConnection(hostName, opts...)
 }
 
-func (c *rpcFactoryImpl) CreateInternodeGRPCConnection(hostName string) *grpc.ClientConn {
-	return c.CreateGRPCConnection(hostName)
+func (c *rpcFactoryImpl) CreateInternodeGRPCConnection(hostName string, opts ...grpc.DialOption) *grpc.ClientConn {
+	return c.CreateGRPCConnection(hostName, opts...)
 }
 
 func newRPCFactoryImpl(sName, grpcHostPort, ringpopHostPort string, logger log.Logger) common.RPCFactory {
@@ -828,8 +830,8 @@ func (c *rpcFactoryImpl) GetRingpopChannel() *tchannel.Channel {
 }
 
 // CreateGRPCConnection creates connection for gRPC calls
-func (c *rpcFactoryImpl) CreateGRPCConnection(hostName string) *grpc.ClientConn {
-	connection, err := rpc.Dial(hostName, nil, c.logger, false)
+func (c *rpcFactoryImpl) CreateGRPCConnection(hostName string, opts ...grpc.DialOption) *grpc.ClientConn {
+	connection, err := rpc.Dial(hostName, nil, c.logger, false, opts...)
 	if err != nil {
 		c.logger.Fatal("Failed to create gRPC connection", tag.Error(err))
 	}
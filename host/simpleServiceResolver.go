@@ -25,6 +25,8 @@
 package host
 
 import (
+	"context"
+
 	"github.com/dgryski/go-farm"
 
 	"go.temporal.io/server/common/membership"
@@ -46,9 +48,20 @@ func newSimpleResolver(service string, hosts []string) membership.ServiceResolve
 }
 
 func (s *simpleResolver) Lookup(key string) (*membership.HostInfo, error) {
+	return s.lookup(s.hosts, key), nil
+}
+
+func (s *simpleResolver) lookup(hosts []*membership.HostInfo, key string) *membership.HostInfo {
+	if len(hosts) == 0 {
+		return nil
+	}
 	hash := int(s.hashfunc([]byte(key)))
-	idx := hash % len(s.hosts)
-	return s.hosts[idx], nil
+	idx := hash % len(hosts)
+	return hosts[idx]
+}
+
+func (s *simpleResolver) LookupWithContext(_ context.Context, key string) (*membership.HostInfo, error) {
+	return s.Lookup(key)
 }
 
 func (s *simpleResolver) AddListener(name string, notifyChannel chan<- *membership.ChangedEvent) error {
@@ -66,3 +79,27 @@ func (s *simpleResolver) MemberCount() int {
 func (s *simpleResolver) Members() []*membership.HostInfo {
 	return s.hosts
 }
+
+func (s *simpleResolver) DiffOnChange(added []*membership.HostInfo, removed []*membership.HostInfo, keys []string) map[string]membership.OwnershipChange {
+	removedMap := make(map[string]struct{}, len(removed))
+	for _, host := range removed {
+		removedMap[host.GetAddress()] = struct{}{}
+	}
+	var hosts []*membership.HostInfo
+	for _, host := range s.hosts {
+		if _, ok := removedMap[host.GetAddress()]; !ok {
+			hosts = append(hosts, host)
+		}
+	}
+	hosts = append(hosts, added...)
+
+	changes := make(map[string]membership.OwnershipChange)
+	for _, key := range keys {
+		oldOwner := s.lookup(s.hosts, key)
+		newOwner := s.lookup(hosts, key)
+		if oldOwner != newOwner {
+			changes[key] = membership.OwnershipChange{OldOwner: oldOwner, NewOwner: newOwner}
+		}
+	}
+	return changes
+}
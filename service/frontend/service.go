@@ -69,6 +69,7 @@ type Config struct {
 	EnableReadVisibilityFromES   dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	ESVisibilityListMaxQPS       dynamicconfig.IntPropertyFnWithNamespaceFilter
 	ESIndexMaxResultWindow       dynamicconfig.IntPropertyFn
+	ESVisibilityRequestTimeout   dynamicconfig.DurationPropertyFn
 	HistoryMaxPageSize           dynamicconfig.IntPropertyFnWithNamespaceFilter
 	RPS                          dynamicconfig.IntPropertyFn
 	MaxNamespaceRPSPerInstance   dynamicconfig.IntPropertyFnWithNamespaceFilter
@@ -145,6 +146,7 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int32, esIndexName
 		EnableReadVisibilityFromES:             dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableReadVisibilityFromES, enableReadFromES),
 		ESVisibilityListMaxQPS:                 dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendESVisibilityListMaxQPS, 10),
 		ESIndexMaxResultWindow:                 dc.GetIntProperty(dynamicconfig.FrontendESIndexMaxResultWindow, 10000),
+		ESVisibilityRequestTimeout:             dc.GetDurationProperty(dynamicconfig.FrontendESVisibilityRequestTimeout, 30*time.Second),
 		HistoryMaxPageSize:                     dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendHistoryMaxPageSize, common.GetHistoryMaxPageSize),
 		RPS:                                    dc.GetIntProperty(dynamicconfig.FrontendRPS, 2400),
 		MaxNamespaceRPSPerInstance:             dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendMaxNamespaceRPSPerInstance, 2400),
@@ -223,9 +225,10 @@ func NewService(
 		if params.ESConfig != nil {
 			visibilityIndexName := params.ESConfig.GetVisibilityIndex()
 			visibilityConfigForES := &config.VisibilityConfig{
-				MaxQPS:                 serviceConfig.PersistenceMaxQPS,
-				VisibilityListMaxQPS:   serviceConfig.ESVisibilityListMaxQPS,
-				ESIndexMaxResultWindow: serviceConfig.ESIndexMaxResultWindow,
+				MaxQPS:                     serviceConfig.PersistenceMaxQPS,
+				VisibilityListMaxQPS:       serviceConfig.ESVisibilityListMaxQPS,
+				ESIndexMaxResultWindow:     serviceConfig.ESIndexMaxResultWindow,
+				ESVisibilityRequestTimeout: serviceConfig.ESVisibilityRequestTimeout,
 			}
 			visibilityFromES = espersistence.NewVisibilityManager(visibilityIndexName, params.ESClient, visibilityConfigForES,
 				searchAttributesProvider, nil, params.MetricsClient, logger)
@@ -313,6 +316,9 @@ func NewService(
 		grpc.KeepaliveParams(kp),
 		grpc.KeepaliveEnforcementPolicy(kep),
 		grpc.ChainUnaryInterceptor(
+			interceptor.CorrelationIDServerInterceptor,
+			interceptor.NewTracingInterceptor(params.TracerProvider).Intercept,
+			interceptor.WorkflowTagsInterceptor,
 			namespaceLogInterceptor.Intercept,
 			rpc.ServiceErrorInterceptor,
 			metricsInterceptor.Intercept,
@@ -361,7 +367,9 @@ func (s *Service) Start() {
 	reflection.Register(s.server)
 
 	// must start resource first
-	s.Resource.Start()
+	if err := s.Resource.Start(); err != nil {
+		logger.Fatal("unable to start service resources", tag.Error(err))
+	}
 	s.adminHandler.Start()
 	s.versionChecker.Start()
 
@@ -381,7 +389,8 @@ func (s *Service) Stop() {
 	}
 
 	// initiate graceful shutdown:
-	// 1. Fail rpc health check, this will cause client side load balancer to stop forwarding requests to this node
+	// 1. Fail rpc health check and remove self from the membership ring, this will cause client side
+	//    load balancer and other services to stop forwarding requests to this node
 	// 2. wait for failure detection time
 	// 3. stop taking new requests by returning InternalServiceError
 	// 4. Wait for a second
@@ -393,7 +402,9 @@ func (s *Service) Stop() {
 	logger.Info("ShutdownHandler: Updating rpc health status to ShuttingDown")
 	s.handler.UpdateHealthStatus(HealthStatusShuttingDown)
 
-	logger.Info("ShutdownHandler: Waiting for others to discover I am unhealthy")
+	s.LeaveMembershipRing()
+
+	logger.Info("ShutdownHandler: Waiting for clients to discover I am unhealthy")
 	time.Sleep(failureDetectionTime)
 
 	s.adminHandler.Stop()
@@ -180,6 +180,8 @@ func (h *Handler) Start() {
 		h,
 		h.config,
 	)
+	// event IDs of the service are allocated from the persisted counters of the shards owned by this host
+	h.GetIDGenerator().SetShardCounter(h.controller)
 	h.eventNotifier = events.NewNotifier(h.GetTimeSource(), h.GetMetricsClient(), h.config.GetShardID)
 	// events notifier must starts before controller
 	h.eventNotifier.Start()
@@ -240,6 +242,12 @@ func (h *Handler) Check(_ context.Context, request *healthpb.HealthCheckRequest)
 		}, nil
 	}
 
+	if !h.IsReady() {
+		return &healthpb.HealthCheckResponse{
+			Status: healthpb.HealthCheckResponse_NOT_SERVING,
+		}, nil
+	}
+
 	hs := &healthpb.HealthCheckResponse{
 		Status: healthpb.HealthCheckResponse_SERVING,
 	}
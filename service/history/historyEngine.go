@@ -157,6 +157,7 @@ func NewEngineWithShardContext(
 			publicClient,
 			shard.GetConfig().NumArchiveSystemWorkflows,
 			shard.GetConfig().ArchiveRequestRPS,
+			shard.GetConfig().ArchivalDryRun,
 			shard.GetService().GetArchiverProvider(),
 		),
 		publicClient:       publicClient,
@@ -480,7 +481,7 @@ func (e *historyEngineImpl) StartWorkflowExecution(
 
 	execution := commonpb.WorkflowExecution{
 		WorkflowId: workflowID,
-		RunId:      uuid.New(),
+		RunId:      e.shard.GetService().GetIDGenerator().NewUUID(),
 	}
 	clusterMetadata := e.shard.GetService().GetClusterMetadata()
 	mutableState, err := e.createMutableState(namespaceEntry, execution.GetRunId())
@@ -1951,7 +1952,7 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 
 	execution = commonpb.WorkflowExecution{
 		WorkflowId: workflowID,
-		RunId:      uuid.New(),
+		RunId:      e.shard.GetService().GetIDGenerator().NewUUID(),
 	}
 
 	clusterMetadata := e.shard.GetService().GetClusterMetadata()
@@ -2344,7 +2345,7 @@ func (e *historyEngineImpl) ResetWorkflowExecution(
 		}, nil
 	}
 
-	resetRunID := uuid.New()
+	resetRunID := e.shard.GetService().GetIDGenerator().NewUUID()
 	baseRebuildLastEventID := request.GetWorkflowTaskFinishEventId() - 1
 	baseVersionHistories := baseMutableState.GetExecutionInfo().GetVersionHistories()
 	baseCurrentVersionHistory, err := versionhistory.GetCurrentVersionHistory(baseVersionHistories)
@@ -2906,7 +2907,7 @@ func (e *historyEngineImpl) ReapplyEvents(
 				// need to reset target workflow (which is also the current workflow)
 				// to accept events to be reapplied
 				baseRunID := mutableState.GetExecutionState().GetRunId()
-				resetRunID := uuid.New()
+				resetRunID := e.shard.GetService().GetIDGenerator().NewUUID()
 				baseRebuildLastEventID := mutableState.GetPreviousStartedEventID()
 
 				// TODO when https://github.com/uber/cadence/issues/2420 is finished, remove this block,
@@ -2945,7 +2946,7 @@ func (e *historyEngineImpl) ReapplyEvents(
 					baseRebuildLastEventVersion,
 					baseNextEventID,
 					resetRunID,
-					uuid.New(),
+					e.shard.GetService().GetIDGenerator().NewUUID(),
 					newNDCWorkflow(
 						ctx,
 						e.shard.GetNamespaceCache(),
@@ -29,7 +29,6 @@ package history
 import (
 	"context"
 
-	"github.com/pborman/uuid"
 	"go.temporal.io/api/serviceerror"
 
 	"go.temporal.io/server/common/definition"
@@ -113,7 +112,7 @@ func (r *nDCConflictResolverImpl) prepareMutableState(
 	// task.getVersion() > currentLastItem
 	// incoming replication task, after application, will become the current branch
 	// (because higher version wins), we need to rebuild the mutable state for that
-	rebuiltMutableState, err := r.rebuild(ctx, branchIndex, uuid.New())
+	rebuiltMutableState, err := r.rebuild(ctx, branchIndex, r.shard.GetService().GetIDGenerator().NewUUID())
 	if err != nil {
 		return nil, false, err
 	}
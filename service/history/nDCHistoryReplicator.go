@@ -26,11 +26,11 @@ package history
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"go.temporal.io/server/common/persistence/serialization"
 
-	"github.com/pborman/uuid"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
@@ -212,6 +212,10 @@ func (r *nDCHistoryReplicatorImpl) ApplyEvents(
 		request,
 	)
 	if err != nil {
+		var unknownEncodingErr *serialization.UnknownEncodingTypeError
+		if errors.As(err, &unknownEncodingErr) {
+			r.metricsClient.IncCounter(metrics.ReplicateHistoryEventsScope, metrics.UnknownEncodingReplicationEventsCounter)
+		}
 		return err
 	}
 
@@ -270,6 +274,7 @@ func (r *nDCHistoryReplicatorImpl) applyEvents(
 			if mutableState.GetExecutionInfo().GetVersionHistories() == nil {
 				return serviceerror.NewInternal("The mutable state does not support 3DC.")
 			}
+			r.emitReplicationLag(namespaceEntry.GetInfo().Name, mutableState, task)
 
 			doContinue, branchIndex, err := r.applyNonStartEventsPrepareBranch(ctx, context, mutableState, task)
 			if err != nil {
@@ -305,6 +310,23 @@ func (r *nDCHistoryReplicatorImpl) applyEvents(
 	}
 }
 
+func (r *nDCHistoryReplicatorImpl) emitReplicationLag(
+	namespace string,
+	mutableState workflow.MutableState,
+	task nDCReplicationTask,
+) {
+
+	lag, err := versionhistory.GetReplicationLag(mutableState.GetExecutionInfo().GetVersionHistories(), task.getVersionHistory())
+	if err != nil {
+		r.logger.Warn("Unable to compute replication lag.", tag.WorkflowID(task.getExecution().GetWorkflowId()), tag.Error(err))
+		return
+	}
+	r.metricsClient.Scope(
+		metrics.ReplicateHistoryEventsScope,
+		metrics.NamespaceTag(namespace),
+	).UpdateGauge(metrics.ReplicationEventsLagGauge, float64(lag))
+}
+
 func (r *nDCHistoryReplicatorImpl) applyStartEvents(
 	ctx context.Context,
 	context workflow.Context,
@@ -316,7 +338,7 @@ func (r *nDCHistoryReplicatorImpl) applyStartEvents(
 	if err != nil {
 		return err
 	}
-	requestID := uuid.New() // requestID used for start workflow execution request.  This is not on the history event.
+	requestID := r.shard.GetService().GetIDGenerator().NewUUID() // requestID used for start workflow execution request.  This is not on the history event.
 	mutableState := r.newMutableState(namespaceEntry, timestamp.TimeValue(task.getFirstEvent().GetEventTime()), task.getLogger())
 	stateBuilder := r.newStateBuilder(mutableState, task.getLogger())
 
@@ -423,7 +445,7 @@ func (r *nDCHistoryReplicatorImpl) applyNonStartEventsToCurrentBranch(
 	task nDCReplicationTask,
 ) error {
 
-	requestID := uuid.New() // requestID used for start workflow execution request.  This is not on the history event.
+	requestID := r.shard.GetService().GetIDGenerator().NewUUID() // requestID used for start workflow execution request.  This is not on the history event.
 	stateBuilder := r.newStateBuilder(mutableState, task.getLogger())
 	newMutableState, err := stateBuilder.ApplyEvents(
 		task.getNamespaceID(),
@@ -684,7 +706,7 @@ func (r *nDCHistoryReplicatorImpl) applyNonStartEventsResetWorkflow(
 	task nDCReplicationTask,
 ) error {
 
-	requestID := uuid.New() // requestID used for start workflow execution request.  This is not on the history event.
+	requestID := r.shard.GetService().GetIDGenerator().NewUUID() // requestID used for start workflow execution request.  This is not on the history event.
 	stateBuilder := r.newStateBuilder(mutableState, task.getLogger())
 	_, err := stateBuilder.ApplyEvents(
 		task.getNamespaceID(),
@@ -382,8 +382,16 @@ func deserializeBlob(
 		return nil, nil
 	}
 
+	// decode with the encoding tagged by the producer, so that encodings can be migrated safely,
+	// encodings the serializer does not support fail with an UnknownEncodingTypeError
+	encodingType := blob.GetEncodingType()
+	if encodingType == enumspb.ENCODING_TYPE_UNSPECIFIED {
+		// producers which predate the encoding tag always sent proto3
+		encodingType = enumspb.ENCODING_TYPE_PROTO3
+	}
+
 	events, err := historySerializer.DeserializeEvents(&commonpb.DataBlob{
-		EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+		EncodingType: encodingType,
 		Data:         blob.Data,
 	})
 
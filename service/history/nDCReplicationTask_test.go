@@ -0,0 +1,132 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/codec"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+const (
+	// unknownEncodingType is an encoding type the serializer can not decode
+	unknownEncodingType = enumspb.EncodingType(100)
+)
+
+type (
+	nDCReplicationTaskSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller *gomock.Controller
+		serializer serialization.Serializer
+		events     []*historypb.HistoryEvent
+	}
+)
+
+func TestNDCReplicationTaskSuite(t *testing.T) {
+	s := new(nDCReplicationTaskSuite)
+	suite.Run(t, s)
+}
+
+func (s *nDCReplicationTaskSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.serializer = serialization.NewSerializer()
+	s.events = []*historypb.HistoryEvent{
+		{EventId: 1, Version: 2, EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED},
+		{EventId: 2, Version: 2, EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED},
+	}
+}
+
+func (s *nDCReplicationTaskSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *nDCReplicationTaskSuite) TestDeserializeBlob_EncodingDispatch() {
+	blob, err := s.serializer.SerializeEvents(s.events, enumspb.ENCODING_TYPE_PROTO3)
+	s.NoError(err)
+
+	events, err := deserializeBlob(s.serializer, blob)
+	s.NoError(err)
+	s.Equal(s.events, events)
+
+	// producers which predate the encoding tag leave it unspecified
+	events, err = deserializeBlob(s.serializer, &commonpb.DataBlob{Data: blob.Data})
+	s.NoError(err)
+	s.Equal(s.events, events)
+
+	jsonData, err := codec.NewJSONPBEncoder().Encode(&historypb.History{Events: s.events})
+	s.NoError(err)
+	events, err = deserializeBlob(s.serializer, &commonpb.DataBlob{
+		EncodingType: enumspb.ENCODING_TYPE_JSON,
+		Data:         jsonData,
+	})
+	s.NoError(err)
+	s.Equal(s.events, events)
+
+	_, err = deserializeBlob(s.serializer, &commonpb.DataBlob{
+		EncodingType: unknownEncodingType,
+		Data:         blob.Data,
+	})
+	s.IsType(&serialization.UnknownEncodingTypeError{}, err)
+}
+
+func (s *nDCReplicationTaskSuite) TestApplyEvents_UnknownEncoding() {
+	mockMetricsClient := metrics.NewMockClient(s.controller)
+	mockMetricsClient.EXPECT().IncCounter(metrics.ReplicateHistoryEventsScope, metrics.UnknownEncodingReplicationEventsCounter)
+	replicator := &nDCHistoryReplicatorImpl{
+		historySerializer: s.serializer,
+		metricsClient:     mockMetricsClient,
+		logger:            log.NewNoopLogger(),
+	}
+
+	err := replicator.ApplyEvents(context.Background(), &historyservice.ReplicateEventsV2Request{
+		NamespaceId: uuid.New(),
+		WorkflowExecution: &commonpb.WorkflowExecution{
+			WorkflowId: "workflow-id",
+			RunId:      uuid.New(),
+		},
+		Events: &commonpb.DataBlob{
+			EncodingType: unknownEncodingType,
+			Data:         []byte("{}"),
+		},
+	})
+	s.IsType(&serialization.UnknownEncodingTypeError{}, err)
+}
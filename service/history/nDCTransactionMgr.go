@@ -32,7 +32,6 @@ import (
 
 	"go.temporal.io/server/common/persistence/serialization"
 
-	"github.com/pborman/uuid"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
@@ -320,7 +319,7 @@ func (r *nDCTransactionMgrImpl) backfillWorkflowEventsReapply(
 		namespaceID := baseMutableState.GetExecutionInfo().NamespaceId
 		workflowID := baseMutableState.GetExecutionInfo().WorkflowId
 		baseRunID := baseMutableState.GetExecutionState().GetRunId()
-		resetRunID := uuid.New()
+		resetRunID := r.shard.GetService().GetIDGenerator().NewUUID()
 		baseRebuildLastEventID := baseMutableState.GetPreviousStartedEventID()
 
 		// TODO when https://github.com/uber/cadence/issues/2420 is finished, remove this block,
@@ -356,7 +355,7 @@ func (r *nDCTransactionMgrImpl) backfillWorkflowEventsReapply(
 			baseRebuildLastEventVersion,
 			baseNextEventID,
 			resetRunID,
-			uuid.New(),
+			r.shard.GetService().GetIDGenerator().NewUUID(),
 			targetWorkflow,
 			eventsReapplicationResetWorkflowReason,
 			targetWorkflowEvents.Events,
@@ -30,8 +30,6 @@ import (
 	"context"
 	"time"
 
-	"github.com/pborman/uuid"
-
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/log"
@@ -125,7 +123,7 @@ func (r *nDCWorkflowResetterImpl) resetWorkflow(
 
 	resetBranchToken, err := r.getResetBranchToken(ctx, baseBranchToken, baseLastEventID)
 
-	requestID := uuid.New()
+	requestID := r.shard.GetService().GetIDGenerator().NewUUID()
 	rebuildMutableState, rebuiltHistorySize, err := r.stateRebuilder.rebuild(
 		ctx,
 		now,
@@ -29,6 +29,7 @@ package history
 import (
 	"context"
 
+	"go.opentelemetry.io/otel/trace"
 	commonpb "go.temporal.io/api/common/v1"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
@@ -55,6 +56,7 @@ type (
 		namespaceCache     cache.NamespaceCache
 		nDCHistoryResender xdc.NDCHistoryResender
 		historyEngine      shard.Engine
+		tracer             trace.Tracer
 
 		metricsClient metrics.Client
 		logger        log.Logger
@@ -79,6 +81,7 @@ func newReplicationTaskExecutor(
 		namespaceCache:     namespaceCache,
 		nDCHistoryResender: nDCHistoryResender,
 		historyEngine:      historyEngine,
+		tracer:             shard.GetService().GetTracerProvider().Tracer(replicationTracerName),
 		metricsClient:      metricsClient,
 		logger:             logger,
 	}
@@ -89,7 +92,10 @@ func (e *replicationTaskExecutorImpl) execute(
 	forceApply bool,
 ) (int, error) {
 
+	ctx, span := startReplicationTaskSpan(context.Background(), e.tracer, replicationTask)
 	var err error
+	defer func() { endReplicationTaskSpan(span, err) }()
+
 	var scope int
 	switch replicationTask.GetTaskType() {
 	case enumsspb.REPLICATION_TASK_TYPE_SYNC_SHARD_STATUS_TASK:
@@ -97,13 +103,13 @@ func (e *replicationTaskExecutorImpl) execute(
 		scope = metrics.SyncShardTaskScope
 	case enumsspb.REPLICATION_TASK_TYPE_SYNC_ACTIVITY_TASK:
 		scope = metrics.SyncActivityTaskScope
-		err = e.handleActivityTask(replicationTask, forceApply)
+		err = e.handleActivityTask(ctx, replicationTask, forceApply)
 	case enumsspb.REPLICATION_TASK_TYPE_HISTORY_METADATA_TASK:
 		// Without kafka we should not have size limits so we don't necessary need this in the new replication scheme.
 		scope = metrics.HistoryMetadataReplicationTaskScope
 	case enumsspb.REPLICATION_TASK_TYPE_HISTORY_V2_TASK:
 		scope = metrics.HistoryReplicationTaskScope
-		err = e.handleHistoryReplicationTask(replicationTask, forceApply)
+		err = e.handleHistoryReplicationTask(ctx, replicationTask, forceApply)
 	default:
 		e.logger.Error("Unknown task type.")
 		scope = metrics.ReplicatorScope
@@ -114,6 +120,7 @@ func (e *replicationTaskExecutorImpl) execute(
 }
 
 func (e *replicationTaskExecutorImpl) handleActivityTask(
+	ctx context.Context,
 	task *replicationspb.ReplicationTask,
 	forceApply bool,
 ) error {
@@ -143,7 +150,7 @@ func (e *replicationTaskExecutorImpl) handleActivityTask(
 		LastWorkerIdentity: attr.LastWorkerIdentity,
 		VersionHistory:     attr.GetVersionHistory(),
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), replicationTimeout)
+	ctx, cancel := context.WithTimeout(ctx, replicationTimeout)
 	defer cancel()
 
 	err = e.historyEngine.SyncActivity(ctx, request)
@@ -177,6 +184,7 @@ func (e *replicationTaskExecutorImpl) handleActivityTask(
 }
 
 func (e *replicationTaskExecutorImpl) handleHistoryReplicationTask(
+	ctx context.Context,
 	task *replicationspb.ReplicationTask,
 	forceApply bool,
 ) error {
@@ -201,7 +209,7 @@ func (e *replicationTaskExecutorImpl) handleHistoryReplicationTask(
 		// new run events does not need version history since there is no prior events
 		NewRunEvents: attr.NewRunEvents,
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), replicationTimeout)
+	ctx, cancel := context.WithTimeout(ctx, replicationTimeout)
 	defer cancel()
 
 	err = e.historyEngine.ReplicateEventsV2(ctx, request)
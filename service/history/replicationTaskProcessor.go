@@ -288,7 +288,9 @@ func (p *ReplicationTaskProcessorImpl) applyReplicationTask(
 	)
 	request, err := p.convertTaskToDLQTask(replicationTask)
 	if err != nil {
-		p.logger.Error("failed to generate DLQ replication task", tag.Error(err))
+		// the task cannot even be parsed into a DLQ entry, skip it so it does not block the shard
+		p.logger.Error("failed to generate DLQ replication task", tag.TaskID(replicationTask.GetSourceTaskId()), tag.Error(err))
+		p.metricsClient.IncCounter(metrics.ReplicationTaskFetcherScope, metrics.ReplicationDLQConvertFailed)
 		return nil
 	}
 	if err := p.handleReplicationDLQTask(request); err != nil {
@@ -359,8 +361,10 @@ func (p *ReplicationTaskProcessorImpl) handleReplicationDLQTask(
 		if err != nil {
 			p.logger.Error("failed to enqueue replication task to DLQ", tag.Error(err))
 			p.metricsClient.IncCounter(metrics.ReplicationTaskFetcherScope, metrics.ReplicationDLQFailed)
+			return err
 		}
-		return err
+		p.metricsClient.IncCounter(metrics.ReplicationTaskFetcherScope, metrics.ReplicationDLQEnqueued)
+		return nil
 	}, p.dlqRetryPolicy, p.isRetryableError)
 }
 
@@ -37,11 +37,13 @@ import (
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
 
 	"go.temporal.io/server/api/adminservicemock/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/service/history/configs"
@@ -354,6 +356,79 @@ func (s *replicationTaskProcessorSuite) TestConvertTaskToDLQTask_History() {
 	s.Equal(request, dlqTask)
 }
 
+func (s *replicationTaskProcessorSuite) TestApplyReplicationTask_DLQAfterRetryLimit() {
+	metricsScope := tally.NewTestScope("", nil)
+	s.replicationTaskProcessor.metricsClient = metrics.NewClient(metricsScope, metrics.History)
+	taskRetryPolicy := backoff.NewExponentialRetryPolicy(time.Millisecond)
+	// the task is attempted once and then retried twice
+	taskRetryPolicy.SetMaximumAttempts(2)
+	s.replicationTaskProcessor.taskRetryPolicy = taskRetryPolicy
+
+	namespaceID := uuid.NewRandom().String()
+	workflowID := uuid.New()
+	runID := uuid.NewRandom().String()
+	task := &replicationspb.ReplicationTask{
+		TaskType:     enumsspb.REPLICATION_TASK_TYPE_SYNC_ACTIVITY_TASK,
+		SourceTaskId: 123,
+		Attributes: &replicationspb.ReplicationTask_SyncActivityTaskAttributes{
+			SyncActivityTaskAttributes: &replicationspb.SyncActivityTaskAttributes{
+				NamespaceId: namespaceID,
+				WorkflowId:  workflowID,
+				RunId:       runID,
+			},
+		},
+	}
+
+	s.mockReplicationTaskExecutor.EXPECT().execute(task, false).Return(0, serviceerror.NewUnavailable("poison task")).Times(3)
+	s.mockExecutionManager.EXPECT().PutReplicationTaskToDLQ(&persistence.PutReplicationTaskToDLQRequest{
+		SourceClusterName: cluster.TestAlternativeClusterName,
+		TaskInfo: &persistencespb.ReplicationTaskInfo{
+			NamespaceId: namespaceID,
+			WorkflowId:  workflowID,
+			RunId:       runID,
+			TaskId:      123,
+			TaskType:    enumsspb.TASK_TYPE_REPLICATION_SYNC_ACTIVITY,
+		},
+	}).Return(nil)
+
+	s.NoError(s.replicationTaskProcessor.applyReplicationTask(task))
+	s.Equal(int64(1), s.counterValue(metricsScope, "replication_dlq_enqueued"))
+}
+
+func (s *replicationTaskProcessorSuite) TestApplyReplicationTask_UnparseableTaskSkipped() {
+	metricsScope := tally.NewTestScope("", nil)
+	s.replicationTaskProcessor.metricsClient = metrics.NewClient(metricsScope, metrics.History)
+	taskRetryPolicy := backoff.NewExponentialRetryPolicy(time.Millisecond)
+	taskRetryPolicy.SetMaximumAttempts(1)
+	s.replicationTaskProcessor.taskRetryPolicy = taskRetryPolicy
+
+	task := &replicationspb.ReplicationTask{
+		TaskType: enumsspb.REPLICATION_TASK_TYPE_HISTORY_V2_TASK,
+		Attributes: &replicationspb.ReplicationTask_HistoryTaskV2Attributes{
+			HistoryTaskV2Attributes: &replicationspb.HistoryTaskV2Attributes{
+				Events: &commonpb.DataBlob{
+					EncodingType: enumspb.ENCODING_TYPE_PROTO3,
+					Data:         []byte("not a history batch"),
+				},
+			},
+		},
+	}
+
+	s.mockReplicationTaskExecutor.EXPECT().execute(task, false).Return(0, serviceerror.NewUnavailable("poison task")).Times(2)
+	s.NoError(s.replicationTaskProcessor.applyReplicationTask(task))
+	s.Equal(int64(1), s.counterValue(metricsScope, "replication_dlq_convert_failed"))
+}
+
+func (s *replicationTaskProcessorSuite) counterValue(scope tally.TestScope, name string) int64 {
+	var value int64
+	for _, counter := range scope.Snapshot().Counters() {
+		if counter.Name() == name {
+			value += counter.Value()
+		}
+	}
+	return value
+}
+
 func (s *replicationTaskProcessorSuite) TestCleanupReplicationTask_Noop() {
 	ackedTaskID := int64(12345)
 	s.mockResource.ShardMgr.EXPECT().UpdateShard(gomock.Any()).Return(nil)
@@ -0,0 +1,105 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	replicationspb "go.temporal.io/server/api/replication/v1"
+)
+
+const (
+	replicationTracerName = "go.temporal.io/server/service/history"
+
+	// replicationTaskTypeKey is the span attribute holding the type of the applied replication task
+	replicationTaskTypeKey = label.Key("temporal.replication_task_type")
+)
+
+type (
+	// traceContextCarrier adapts the trace context of a replication task to propagation.TextMapCarrier
+	traceContextCarrier map[string]string
+)
+
+var _ propagation.TextMapCarrier = traceContextCarrier(nil)
+
+var replicationTracePropagator = propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+
+// injectReplicationTraceContext stores the trace context of ctx in the replication task,
+// the task is left untouched when ctx is not traced
+func injectReplicationTraceContext(
+	ctx context.Context,
+	task *replicationspb.ReplicationTask,
+) {
+	carrier := traceContextCarrier{}
+	replicationTracePropagator.Inject(ctx, carrier)
+	if len(carrier) > 0 {
+		task.TraceContext = carrier
+	}
+}
+
+// startReplicationTaskSpan starts the span of applying the replication task, as a child of the
+// trace context carried by the task, or as a new root span when the task carries none
+func startReplicationTaskSpan(
+	ctx context.Context,
+	tracer trace.Tracer,
+	task *replicationspb.ReplicationTask,
+) (context.Context, trace.Span) {
+	opts := []trace.SpanOption{
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(replicationTaskTypeKey.String(task.GetTaskType().String())),
+	}
+	ctx = replicationTracePropagator.Extract(ctx, traceContextCarrier(task.GetTraceContext()))
+	if !trace.RemoteSpanContextFromContext(ctx).IsValid() {
+		opts = append(opts, trace.WithNewRoot())
+	}
+	return tracer.Start(ctx, "ReplicationTask/"+task.GetTaskType().String(), opts...)
+}
+
+// endReplicationTaskSpan records the outcome of applying the replication task and ends its span
+func endReplicationTaskSpan(
+	span trace.Span,
+	err error,
+) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	} else {
+		span.SetStatus(codes.Ok, "")
+	}
+	span.End()
+}
+
+func (c traceContextCarrier) Get(key string) string {
+	return c[key]
+}
+
+func (c traceContextCarrier) Set(key string, value string) {
+	c[key] = value
+}
@@ -0,0 +1,98 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/oteltest"
+	"go.opentelemetry.io/otel/trace"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
+)
+
+func TestReplicationTraceContext_RoundTrip(t *testing.T) {
+	recorder := &oteltest.StandardSpanRecorder{}
+	tracer := oteltest.NewTracerProvider(oteltest.WithSpanRecorder(recorder)).Tracer(replicationTracerName)
+
+	// the task is generated while serving the poll of the remote cluster
+	producerCtx, producerSpan := tracer.Start(context.Background(), "GetReplicationMessages")
+	task := &replicationspb.ReplicationTask{
+		TaskType:     enumsspb.REPLICATION_TASK_TYPE_HISTORY_V2_TASK,
+		SourceTaskId: 1234,
+	}
+	injectReplicationTraceContext(producerCtx, task)
+	producerSpan.End()
+	require.NotEmpty(t, task.GetTraceContext())
+
+	// the task is shipped to the remote cluster over the wire
+	blob, err := task.Marshal()
+	require.NoError(t, err)
+	receivedTask := &replicationspb.ReplicationTask{}
+	require.NoError(t, receivedTask.Unmarshal(blob))
+	require.Equal(t, task, receivedTask)
+
+	_, span := startReplicationTaskSpan(context.Background(), tracer, receivedTask)
+	endReplicationTaskSpan(span, nil)
+
+	spans := recorder.Completed()
+	require.Len(t, spans, 2)
+	consumed := spans[1]
+	require.Equal(t, "ReplicationTask/HistoryV2Task", consumed.Name())
+	require.Equal(t, trace.SpanKindConsumer, consumed.SpanKind())
+	require.Equal(t, producerSpan.SpanContext().TraceID, consumed.SpanContext().TraceID)
+	require.Equal(t, producerSpan.SpanContext().SpanID, consumed.ParentSpanID())
+	require.Equal(t, codes.Ok, consumed.StatusCode())
+}
+
+func TestReplicationTraceContext_NoTraceContext(t *testing.T) {
+	recorder := &oteltest.StandardSpanRecorder{}
+	tracer := oteltest.NewTracerProvider(oteltest.WithSpanRecorder(recorder)).Tracer(replicationTracerName)
+
+	task := &replicationspb.ReplicationTask{
+		TaskType: enumsspb.REPLICATION_TASK_TYPE_SYNC_ACTIVITY_TASK,
+	}
+	injectReplicationTraceContext(context.Background(), task)
+	require.Nil(t, task.GetTraceContext())
+
+	// tasks from clusters which do not propagate trace context start a new trace
+	ctx, parentSpan := tracer.Start(context.Background(), "parent")
+	_, span := startReplicationTaskSpan(ctx, tracer, task)
+	endReplicationTaskSpan(span, errors.New("apply failed"))
+	parentSpan.End()
+
+	spans := recorder.Completed()
+	require.Len(t, spans, 2)
+	consumed := spans[0]
+	require.False(t, consumed.ParentSpanID().IsValid())
+	require.NotEqual(t, parentSpan.SpanContext().TraceID, consumed.SpanContext().TraceID)
+	require.Equal(t, codes.Error, consumed.StatusCode())
+	require.Equal(t, "apply failed", consumed.StatusMessage())
+}
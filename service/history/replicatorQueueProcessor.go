@@ -263,6 +263,9 @@ func (p *replicatorQueueProcessorImpl) taskInfoToTask(
 	if err := backoff.Retry(op, p.retryPolicy, common.IsPersistenceTransientError); err != nil {
 		return nil, err
 	}
+	if replicationTask != nil {
+		injectReplicationTraceContext(ctx, replicationTask)
+	}
 	return replicationTask, nil
 }
 
@@ -104,8 +104,9 @@ func NewService(
 			esProcessor.Start()
 
 			visibilityConfigForES := &config.VisibilityConfig{
-				ESIndexMaxResultWindow: serviceConfig.ESIndexMaxResultWindow,
-				ESProcessorAckTimeout:  serviceConfig.ESProcessorAckTimeout,
+				ESIndexMaxResultWindow:     serviceConfig.ESIndexMaxResultWindow,
+				ESProcessorAckTimeout:      serviceConfig.ESProcessorAckTimeout,
+				ESVisibilityRequestTimeout: serviceConfig.ESVisibilityRequestTimeout,
 			}
 			visibilityFromES = espersistence.NewVisibilityManager(visibilityIndexName, params.ESClient, visibilityConfigForES, searchAttributesProvider, esProcessor, params.MetricsClient, logger)
 		}
@@ -147,6 +148,9 @@ func NewService(
 	grpcServerOptions = append(
 		grpcServerOptions,
 		grpc.ChainUnaryInterceptor(
+			interceptor.CorrelationIDServerInterceptor,
+			interceptor.NewTracingInterceptor(params.TracerProvider).Intercept,
+			interceptor.WorkflowTagsInterceptor,
 			rpc.ServiceErrorInterceptor,
 			metrics.NewServerMetricsContextInjectorInterceptor(),
 			metrics.NewServerMetricsTrailerPropagatorInterceptor(logger),
@@ -177,7 +181,9 @@ func (s *Service) Start() {
 	logger.Info("history starting")
 
 	// must start resource first
-	s.Resource.Start()
+	if err := s.Resource.Start(); err != nil {
+		logger.Fatal("unable to start service resources", tag.Error(err))
+	}
 	s.handler.Start()
 
 	historyservice.RegisterHistoryServiceServer(s.server, s.handler)
@@ -208,17 +214,12 @@ func (s *Service) Stop() {
 	// 6. wait for grace period
 	// 7. force stop the whole world and return
 
-	const gossipPropagationDelay = 400 * time.Millisecond
 	const shardOwnershipTransferDelay = 5 * time.Second
 	const gracePeriod = 2 * time.Second
 
 	remainingTime := s.config.ShutdownDrainDuration()
 
-	logger.Info("ShutdownHandler: Evicting self from membership ring")
-	_ = s.GetMembershipMonitor().EvictSelf()
-
-	logger.Info("ShutdownHandler: Waiting for others to discover I am unhealthy")
-	remainingTime = s.sleep(gossipPropagationDelay, remainingTime)
+	s.LeaveMembershipRing()
 
 	logger.Info("ShutdownHandler: Initiating shardController shutdown")
 	s.handler.controller.PrepareToStop()
@@ -90,6 +90,9 @@ var _ Context = (*ContextImpl)(nil)
 // ErrShardClosed is returned when shard is closed and a req cannot be processed
 var ErrShardClosed = errors.New("shard closed")
 
+// ErrShardHandoffInProgress is returned when the previous owner of the shard has not yet completed the handoff
+var ErrShardHandoffInProgress = serviceerror.NewUnavailable("shard handoff in progress")
+
 const (
 	logWarnTransferLevelDiff = 3000000 // 3 million
 	logWarnTimerLevelDiff    = time.Duration(30 * time.Minute)
@@ -384,6 +387,51 @@ func (s *ContextImpl) UpdateNamespaceNotificationVersion(namespaceNotificationVe
 	return s.updateShardInfoLocked()
 }
 
+// BeginHandoff persists a handoff record of the shard to the new owner, the record is fenced by the current range ID
+func (s *ContextImpl) BeginHandoff(newOwner string) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.isStopped() {
+		return ErrShardClosed
+	}
+
+	now := clock.NewRealTimeSource().Now()
+	s.shardInfo.HandoffInfo = &persistencespb.ShardHandoffInfo{
+		NewOwner:  newOwner,
+		RangeId:   s.getRangeID(),
+		StartTime: timestamp.TimePtr(now),
+	}
+	if err := s.persistShardInfoLocked(now); err != nil {
+		s.shardInfo.HandoffInfo = nil
+		return err
+	}
+	return nil
+}
+
+// CompleteHandoff persists the ack levels of the shard and marks the handoff as completed,
+// it must be called after the engine of the shard is stopped
+func (s *ContextImpl) CompleteHandoff() error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.isStopped() {
+		return ErrShardClosed
+	}
+
+	handoffInfo := s.shardInfo.GetHandoffInfo()
+	if handoffInfo == nil || handoffInfo.GetRangeId() != s.getRangeID() {
+		return serviceerror.NewInternal("shard handoff not started")
+	}
+
+	handoffInfo.Completed = true
+	if err := s.persistShardInfoLocked(clock.NewRealTimeSource().Now()); err != nil {
+		handoffInfo.Completed = false
+		return err
+	}
+	return nil
+}
+
 func (s *ContextImpl) GetTimerMaxReadLevel(cluster string) time.Time {
 	s.RLock()
 	defer s.RUnlock()
@@ -767,15 +815,18 @@ func (s *ContextImpl) updateShardInfoLocked() error {
 		return ErrShardClosed
 	}
 
-	var err error
 	now := clock.NewRealTimeSource().Now()
 	if s.lastUpdated.Add(s.config.ShardUpdateMinInterval()).After(now) {
 		return nil
 	}
+	return s.persistShardInfoLocked(now)
+}
+
+func (s *ContextImpl) persistShardInfoLocked(now time.Time) error {
 	updatedShardInfo := copyShardInfo(s.shardInfo)
 	s.emitShardInfoMetricsLogsLocked()
 
-	err = s.GetShardManager().UpdateShard(&persistence.UpdateShardRequest{
+	err := s.GetShardManager().UpdateShard(&persistence.UpdateShardRequest{
 		ShardInfo:       updatedShardInfo.ShardInfo,
 		PreviousRangeID: s.shardInfo.GetRangeId(),
 	})
@@ -1044,6 +1095,9 @@ func acquireShard(
 		if common.IsPersistenceTransientError(err) {
 			return true
 		}
+		if err == ErrShardHandoffInProgress {
+			return true
+		}
 		_, ok := err.(*persistence.ShardAlreadyExistError)
 		return ok
 
@@ -1055,6 +1109,9 @@ func acquireShard(
 		})
 		if err == nil {
 			shardInfo = &persistence.ShardInfoWithFailover{ShardInfo: resp.ShardInfo}
+			if !isReadyToAcquire(shardInfo.ShardInfo, clock.NewRealTimeSource().Now(), shardItem.config.ShardHandoffTimeout()) {
+				return ErrShardHandoffInProgress
+			}
 			return nil
 		}
 		if _, ok := err.(*serviceerror.NotFound); !ok {
@@ -1079,6 +1136,8 @@ func acquireShard(
 	updatedShardInfo := copyShardInfo(shardInfo)
 	ownershipChanged := shardInfo.Owner != shardItem.GetHostInfo().Identity()
 	updatedShardInfo.Owner = shardItem.GetHostInfo().Identity()
+	// the handoff is over once the range is renewed by the new owner
+	updatedShardInfo.HandoffInfo = nil
 
 	// initialize the cluster current time to be the same as ack level
 	remoteClusterCurrentTime := make(map[string]time.Time)
@@ -1107,6 +1166,13 @@ func acquireShard(
 	if err != nil {
 		return nil, err
 	}
+	if shardItem.config.PersistencePerShardMaxQPS != nil {
+		executionMgr = persistence.NewWorkflowExecutionPersistenceRateLimitedClient(
+			executionMgr,
+			newPersistencePerShardRateLimiter(shardItem.config.PersistencePerShardMaxQPS, persistencePerShardRateLimiterRefreshInterval),
+			shardItem.logger,
+		)
+	}
 
 	shardContext := &ContextImpl{
 		Resource: shardItem.Resource,
@@ -1187,6 +1253,7 @@ func copyShardInfo(shardInfo *persistence.ShardInfoWithFailover) *persistence.Sh
 			ReplicationDlqAckLevel:       clusterReplicationDLQLevel,
 			UpdateTime:                   shardInfo.UpdateTime,
 			VisibilityAckLevel:           shardInfo.VisibilityAckLevel,
+			HandoffInfo:                  copyShardHandoffInfo(shardInfo.HandoffInfo),
 		},
 		TransferFailoverLevels: transferFailoverLevels,
 		TimerFailoverLevels:    timerFailoverLevels,
@@ -1194,3 +1261,25 @@ func copyShardInfo(shardInfo *persistence.ShardInfoWithFailover) *persistence.Sh
 
 	return shardInfoCopy
 }
+
+func copyShardHandoffInfo(handoffInfo *persistencespb.ShardHandoffInfo) *persistencespb.ShardHandoffInfo {
+	if handoffInfo == nil {
+		return nil
+	}
+	return &persistencespb.ShardHandoffInfo{
+		NewOwner:  handoffInfo.NewOwner,
+		RangeId:   handoffInfo.RangeId,
+		StartTime: handoffInfo.StartTime,
+		Completed: handoffInfo.Completed,
+	}
+}
+
+// isReadyToAcquire returns false while the previous owner of the shard is draining it, i.e. the handoff
+// was begun in the current range and is neither completed nor timed out
+func isReadyToAcquire(shardInfo *persistencespb.ShardInfo, now time.Time, timeout time.Duration) bool {
+	handoffInfo := shardInfo.GetHandoffInfo()
+	if handoffInfo == nil || handoffInfo.GetCompleted() || handoffInfo.GetRangeId() != shardInfo.GetRangeId() {
+		return true
+	}
+	return !now.Before(timestamp.TimeValue(handoffInfo.GetStartTime()).Add(timeout))
+}
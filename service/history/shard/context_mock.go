@@ -97,6 +97,34 @@ func (mr *MockContextMockRecorder) AppendHistoryEvents(request, namespaceID, exe
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendHistoryEvents", reflect.TypeOf((*MockContext)(nil).AppendHistoryEvents), request, namespaceID, execution)
 }
 
+// BeginHandoff mocks base method.
+func (m *MockContext) BeginHandoff(newOwner string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeginHandoff", newOwner)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BeginHandoff indicates an expected call of BeginHandoff.
+func (mr *MockContextMockRecorder) BeginHandoff(newOwner interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginHandoff", reflect.TypeOf((*MockContext)(nil).BeginHandoff), newOwner)
+}
+
+// CompleteHandoff mocks base method.
+func (m *MockContext) CompleteHandoff() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteHandoff")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteHandoff indicates an expected call of CompleteHandoff.
+func (mr *MockContextMockRecorder) CompleteHandoff() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteHandoff", reflect.TypeOf((*MockContext)(nil).CompleteHandoff))
+}
+
 // ConflictResolveWorkflowExecution mocks base method.
 func (m *MockContext) ConflictResolveWorkflowExecution(request *persistence.ConflictResolveWorkflowExecutionRequest) error {
 	m.ctrl.T.Helper()
@@ -26,6 +26,7 @@ package shard
 
 import (
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -139,3 +140,27 @@ func (s *contextSuite) TestAddTasks_Success() {
 	err := s.shardContext.AddTasks(addTasksRequest)
 	s.NoError(err)
 }
+
+func (s *contextSuite) TestIsReadyToAcquire() {
+	now := time.Now().UTC()
+	timeout := 10 * time.Second
+	shardInfo := &persistencespb.ShardInfo{RangeId: 5}
+	s.True(isReadyToAcquire(shardInfo, now, timeout))
+
+	shardInfo.HandoffInfo = &persistencespb.ShardHandoffInfo{
+		NewOwner:  "new-owner",
+		RangeId:   5,
+		StartTime: timestamp.TimePtr(now),
+	}
+	s.False(isReadyToAcquire(shardInfo, now, timeout))
+	// the previous owner is assumed dead once the handoff times out
+	s.True(isReadyToAcquire(shardInfo, now.Add(timeout), timeout))
+
+	shardInfo.HandoffInfo.Completed = true
+	s.True(isReadyToAcquire(shardInfo, now, timeout))
+
+	// a handoff from an older range is stale
+	shardInfo.HandoffInfo.Completed = false
+	shardInfo.RangeId = 6
+	s.True(isReadyToAcquire(shardInfo, now, timeout))
+}
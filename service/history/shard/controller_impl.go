@@ -78,8 +78,9 @@ type (
 		engineFactory   EngineFactory
 
 		sync.RWMutex
-		status historyShardsItemStatus
-		engine Engine
+		status  historyShardsItemStatus
+		engine  Engine
+		context Context
 	}
 )
 
@@ -196,6 +197,72 @@ func (c *ControllerImpl) GetEngineForShard(shardID int32) (Engine, error) {
 	return item.getOrCreateEngine(c.shardClosedCallback)
 }
 
+// NextID returns the next transfer task ID of the shard, which is persisted and fenced by the range ID of the shard
+func (c *ControllerImpl) NextID(shardID int) (int64, error) {
+	item, err := c.getOrCreateHistoryShardItem(int32(shardID))
+	if err != nil {
+		return 0, err
+	}
+	if _, err := item.getOrCreateEngine(c.shardClosedCallback); err != nil {
+		return 0, err
+	}
+	context, err := item.getContext()
+	if err != nil {
+		return 0, err
+	}
+	return context.GenerateTransferTaskID()
+}
+
+// BeginHandoff persists a handoff record of the shard to the host owning the shard in the membership ring,
+// the new owner will not acquire the shard until the handoff is completed or timed out
+func (c *ControllerImpl) BeginHandoff(shardID int32) error {
+	info, err := c.GetHistoryServiceResolver().Lookup(convert.Int32ToString(shardID))
+	if err != nil {
+		return err
+	}
+	if info.Identity() == c.GetHostInfo().Identity() {
+		return fmt.Errorf("shard %v is still owned by host '%v'", shardID, info.Identity())
+	}
+	item, err := c.getHistoryShardItem(shardID)
+	if err != nil {
+		return err
+	}
+	context, err := item.getContext()
+	if err != nil {
+		return err
+	}
+	return context.BeginHandoff(info.Identity())
+}
+
+// CompleteHandoff stops the engine of the shard so that its queues are drained, persists the
+// ack levels with the handoff marked as completed and then releases the shard
+func (c *ControllerImpl) CompleteHandoff(shardID int32) error {
+	item, err := c.getHistoryShardItem(shardID)
+	if err != nil {
+		return err
+	}
+	context, err := item.getContext()
+	if err != nil {
+		return err
+	}
+	item.stopEngine()
+	err = context.CompleteHandoff()
+	c.RemoveEngineForShard(shardID, item)
+	return err
+}
+
+func (c *ControllerImpl) handoffShard(shardID int32) {
+	c.metricsScope.IncCounter(metrics.ShardHandoffCounter)
+	c.logger.Info("Handing off shard", tag.ShardID(shardID))
+	if err := c.BeginHandoff(shardID); err != nil {
+		c.logger.Error("Unable to begin shard handoff", tag.Error(err), tag.OperationFailed, tag.ShardID(shardID))
+		return
+	}
+	if err := c.CompleteHandoff(shardID); err != nil {
+		c.logger.Error("Unable to complete shard handoff", tag.Error(err), tag.OperationFailed, tag.ShardID(shardID))
+	}
+}
+
 func (c *ControllerImpl) RemoveEngineForShard(shardID int32, shardItem *historyShardsItem) {
 	sw := c.metricsScope.StartTimer(metrics.RemoveEngineForShardLatency)
 	defer sw.Stop()
@@ -268,6 +335,17 @@ func (c *ControllerImpl) getOrCreateHistoryShardItem(shardID int32) (*historySha
 	return nil, serviceerrors.NewShardOwnershipLost(c.GetHostInfo().Identity(), info.GetAddress())
 }
 
+func (c *ControllerImpl) getHistoryShardItem(shardID int32) (*historyShardsItem, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	item, ok := c.historyShards[shardID]
+	if !ok {
+		return nil, fmt.Errorf("No item found for shard: %v", shardID)
+	}
+	return item, nil
+}
+
 func (c *ControllerImpl) removeHistoryShardItem(shardID int32, shardItem *historyShardsItem) (*historyShardsItem, error) {
 	nShards := 0
 	c.Lock()
@@ -296,9 +374,10 @@ func (c *ControllerImpl) removeHistoryShardItem(shardID int32, shardItem *histor
 // ControllerImpl. It is responsible for acquiring /
 // releasing shards in response to any event that can
 // change the shard ownership. These events are
-//   a. Ring membership change
-//   b. Periodic ticker
-//   c. ShardOwnershipLostError and subsequent ShardClosedEvents from engine
+//
+//	a. Ring membership change
+//	b. Periodic ticker
+//	c. ShardOwnershipLostError and subsequent ShardClosedEvents from engine
 func (c *ControllerImpl) shardManagementPump() {
 
 	defer c.shutdownWG.Done()
@@ -353,6 +432,8 @@ func (c *ControllerImpl) acquireShards() {
 							c.metricsScope.IncCounter(metrics.GetEngineForShardErrorCounter)
 							c.logger.Error("Unable to create history shard engine", tag.Error(err1), tag.OperationFailed, tag.ShardID(shardID))
 						}
+					} else if c.isShardStarted(shardID) {
+						c.handoffShard(shardID)
 					}
 				}
 			}
@@ -372,6 +453,15 @@ func (c *ControllerImpl) acquireShards() {
 	c.metricsScope.UpdateGauge(metrics.NumShardsGauge, float64(c.NumShards()))
 }
 
+func (c *ControllerImpl) isShardStarted(shardID int32) bool {
+	item, err := c.getHistoryShardItem(shardID)
+	if err != nil {
+		return false
+	}
+	_, err = item.getContext()
+	return err == nil
+}
+
 func (c *ControllerImpl) doShutdown() {
 	c.logger.Info("", tag.LifeCycleStopping)
 	c.Lock()
@@ -428,6 +518,7 @@ func (i *historyShardsItem) getOrCreateEngine(
 			i.GetMetricsClient().RecordTimer(metrics.ShardInfoScope, metrics.ShardItemAcquisitionLatency,
 				context.GetCurrentTime(i.GetClusterMetadata().GetCurrentClusterName()).Sub(context.GetLastUpdatedTime()))
 		}
+		i.context = context
 		i.engine = i.engineFactory.CreateEngine(context)
 		i.engine.Start()
 		i.logger.Info("", tag.LifeCycleStarted, tag.ComponentShardEngine)
@@ -442,6 +533,16 @@ func (i *historyShardsItem) getOrCreateEngine(
 	}
 }
 
+func (i *historyShardsItem) getContext() (Context, error) {
+	i.RLock()
+	defer i.RUnlock()
+
+	if i.status != historyShardsItemStatusStarted {
+		return nil, fmt.Errorf("shard %v for host '%v' is shut down", i.shardID, i.GetHostInfo().Identity())
+	}
+	return i.context, nil
+}
+
 func (i *historyShardsItem) stopEngine() {
 	i.Lock()
 	defer i.Unlock()
@@ -453,6 +554,7 @@ func (i *historyShardsItem) stopEngine() {
 		i.logger.Info("", tag.LifeCycleStopping, tag.ComponentShardEngine)
 		i.engine.Stop()
 		i.engine = nil
+		i.context = nil
 		i.logger.Info("", tag.LifeCycleStopped, tag.ComponentShardEngine)
 		i.status = historyShardsItemStatusStopped
 	case historyShardsItemStatusStopped:
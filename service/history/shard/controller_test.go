@@ -28,9 +28,12 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"go.temporal.io/api/serviceerror"
+
 	"go.temporal.io/server/common/convert"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/tests"
@@ -41,6 +44,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
@@ -57,6 +61,28 @@ type (
 		shardID int32
 	}
 
+	// fakeHost is a history host with its own identity sharing the shard store with the other fake hosts
+	fakeHost struct {
+		*resource.Test
+
+		hostInfo     *membership.HostInfo
+		shardManager persistence.ShardManager
+		engine       *MockEngine
+		controller   *ControllerImpl
+	}
+
+	// fakeShardManager is an in-memory shard store, updates are fenced by the range ID like in the real stores
+	fakeShardManager struct {
+		sync.Mutex
+		shards map[int32]*persistencespb.ShardInfo
+	}
+
+	// fakeRing is the membership ring of the fake hosts, all shards are owned by a single host
+	fakeRing struct {
+		sync.RWMutex
+		owner *membership.HostInfo
+	}
+
 	controllerSuite struct {
 		suite.Suite
 		*require.Assertions
@@ -564,6 +590,28 @@ func (s *controllerSuite) TestShardControllerClosed() {
 	workerWG.Wait()
 }
 
+func (s *controllerSuite) TestNextEventID() {
+	shardID := int32(1)
+	s.config.NumberOfShards = 2
+	s.setupMocksForAcquireShard(shardID, s.mockHistoryEngine, 5, 6)
+	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestSingleDCClusterInfo).AnyTimes()
+	// shard 2 is owned by another host
+	otherHost := membership.NewHostInfo("other-host", nil)
+	s.mockServiceResolver.EXPECT().Lookup(convert.Int32ToString(2)).Return(otherHost, nil).Times(2)
+	s.shardController.acquireShards()
+
+	idGenerator := s.mockResource.GetIDGenerator()
+	s.Equal(common.EmptyEventID, idGenerator.NextEventID(int(shardID)))
+
+	idGenerator.SetShardCounter(s.shardController)
+	firstID := idGenerator.NextEventID(int(shardID))
+	// the IDs of a shard start from its range, so they do not collide with the ones allocated by previous owners
+	s.Equal(int64(6)<<uint(s.config.RangeSizeBits), firstID)
+	s.Equal(firstID+1, idGenerator.NextEventID(int(shardID)))
+	s.Equal(common.EmptyEventID, idGenerator.NextEventID(2))
+}
+
 func (s *controllerSuite) setupMocksForAcquireShard(shardID int32, mockEngine *MockEngine, currentRangeID,
 	newRangeID int64) {
 
@@ -622,6 +670,183 @@ func (s *controllerSuite) setupMocksForAcquireShard(shardID int32, mockEngine *M
 	}).Return(nil)
 }
 
+func (s *controllerSuite) TestHandoff_NewOwnerWaitsForCompletion() {
+	shardID := int32(1)
+	shardManager := &fakeShardManager{shards: make(map[int32]*persistencespb.ShardInfo)}
+	ring := &fakeRing{}
+	hostA := s.newFakeHost("host-a", shardManager, ring)
+	hostB := s.newFakeHost("host-b", shardManager, ring)
+
+	ring.setOwner(hostA.hostInfo)
+	hostA.engine.EXPECT().Start()
+	hostA.controller.acquireShards()
+	s.Equal(1, hostA.controller.NumShards())
+	s.Equal(int64(1), shardManager.get(shardID).GetRangeId())
+	oldContext, err := hostA.controller.historyShards[shardID].getContext()
+	s.NoError(err)
+
+	ring.setOwner(hostB.hostInfo)
+	s.NoError(hostA.controller.BeginHandoff(shardID))
+	handoffInfo := shardManager.get(shardID).GetHandoffInfo()
+	s.Equal(hostB.hostInfo.Identity(), handoffInfo.GetNewOwner())
+	s.Equal(int64(1), handoffInfo.GetRangeId())
+	s.False(handoffInfo.GetCompleted())
+
+	var drained int32
+	hostA.engine.EXPECT().Stop().Do(func() {
+		// give the new owner time to observe the handoff in progress
+		time.Sleep(100 * time.Millisecond)
+		atomic.StoreInt32(&drained, 1)
+	})
+	hostB.engine.EXPECT().Start().Do(func() {
+		s.Equal(int32(1), atomic.LoadInt32(&drained))
+	})
+
+	var acquireWG sync.WaitGroup
+	acquireWG.Add(1)
+	go func() {
+		defer acquireWG.Done()
+		engine, err := hostB.controller.GetEngineForShard(shardID)
+		s.NoError(err)
+		s.Equal(hostB.engine, engine)
+	}()
+	s.NoError(hostA.controller.CompleteHandoff(shardID))
+	acquireWG.Wait()
+
+	s.Equal(0, hostA.controller.NumShards())
+	shardInfo := shardManager.get(shardID)
+	s.Equal(hostB.hostInfo.Identity(), shardInfo.GetOwner())
+	s.Equal(int64(2), shardInfo.GetRangeId())
+	s.Nil(shardInfo.GetHandoffInfo())
+
+	// writes of the previous owner are fenced by the range ID
+	err = oldContext.CompleteHandoff()
+	s.True(IsShardOwnershipLostError(err))
+}
+
+func (s *controllerSuite) TestHandoff_MembershipChange() {
+	shardID := int32(1)
+	shardManager := &fakeShardManager{shards: make(map[int32]*persistencespb.ShardInfo)}
+	ring := &fakeRing{}
+	hostA := s.newFakeHost("host-a", shardManager, ring)
+	hostB := s.newFakeHost("host-b", shardManager, ring)
+
+	ring.setOwner(hostA.hostInfo)
+	hostA.engine.EXPECT().Start()
+	hostA.controller.acquireShards()
+	s.Equal(1, hostA.controller.NumShards())
+
+	// the previous owner hands the shard off once the ring changes
+	ring.setOwner(hostB.hostInfo)
+	hostA.engine.EXPECT().Stop()
+	hostA.controller.acquireShards()
+	s.Equal(0, hostA.controller.NumShards())
+	handoffInfo := shardManager.get(shardID).GetHandoffInfo()
+	s.Equal(hostB.hostInfo.Identity(), handoffInfo.GetNewOwner())
+	s.True(handoffInfo.GetCompleted())
+
+	hostB.engine.EXPECT().Start()
+	hostB.controller.acquireShards()
+	s.Equal(1, hostB.controller.NumShards())
+	shardInfo := shardManager.get(shardID)
+	s.Equal(hostB.hostInfo.Identity(), shardInfo.GetOwner())
+	s.Equal(int64(2), shardInfo.GetRangeId())
+	s.Nil(shardInfo.GetHandoffInfo())
+}
+
+func (s *controllerSuite) newFakeHost(identity string, shardManager persistence.ShardManager, ring *fakeRing) *fakeHost {
+	host := &fakeHost{
+		Test:         resource.NewTest(s.controller, metrics.History),
+		hostInfo:     membership.NewHostInfo(identity, nil),
+		shardManager: shardManager,
+		engine:       NewMockEngine(s.controller),
+	}
+	host.HistoryServiceResolver.EXPECT().Lookup(gomock.Any()).DoAndReturn(func(string) (*membership.HostInfo, error) {
+		return ring.getOwner(), nil
+	}).AnyTimes()
+	host.ClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	host.ClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestSingleDCClusterInfo).AnyTimes()
+
+	engineFactory := NewMockEngineFactory(s.controller)
+	engineFactory.EXPECT().CreateEngine(gomock.Any()).Return(host.engine).AnyTimes()
+	config := tests.NewDynamicConfig()
+	config.NumberOfShards = 1
+	host.controller = NewController(host, engineFactory, config)
+	return host
+}
+
+func (h *fakeHost) GetHostInfo() *membership.HostInfo {
+	return h.hostInfo
+}
+
+func (h *fakeHost) GetShardManager() persistence.ShardManager {
+	return h.shardManager
+}
+
+func (m *fakeShardManager) Close() {}
+
+func (m *fakeShardManager) GetName() string {
+	return "fake"
+}
+
+func (m *fakeShardManager) CreateShard(request *persistence.CreateShardRequest) error {
+	m.Lock()
+	defer m.Unlock()
+
+	shardID := request.ShardInfo.GetShardId()
+	if _, ok := m.shards[shardID]; ok {
+		return &persistence.ShardAlreadyExistError{Msg: fmt.Sprintf("shard %v already exists", shardID)}
+	}
+	m.shards[shardID] = copyShardInfo(&persistence.ShardInfoWithFailover{ShardInfo: request.ShardInfo}).ShardInfo
+	return nil
+}
+
+func (m *fakeShardManager) GetShard(request *persistence.GetShardRequest) (*persistence.GetShardResponse, error) {
+	shardInfo := m.get(request.ShardID)
+	if shardInfo == nil {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("shard %v not found", request.ShardID))
+	}
+	return &persistence.GetShardResponse{ShardInfo: shardInfo}, nil
+}
+
+func (m *fakeShardManager) UpdateShard(request *persistence.UpdateShardRequest) error {
+	m.Lock()
+	defer m.Unlock()
+
+	shardID := request.ShardInfo.GetShardId()
+	if m.shards[shardID].GetRangeId() != request.PreviousRangeID {
+		return &persistence.ShardOwnershipLostError{
+			ShardID: shardID,
+			Msg:     fmt.Sprintf("shard %v range ID is %v", shardID, m.shards[shardID].GetRangeId()),
+		}
+	}
+	m.shards[shardID] = copyShardInfo(&persistence.ShardInfoWithFailover{ShardInfo: request.ShardInfo}).ShardInfo
+	return nil
+}
+
+func (m *fakeShardManager) get(shardID int32) *persistencespb.ShardInfo {
+	m.Lock()
+	defer m.Unlock()
+
+	shardInfo, ok := m.shards[shardID]
+	if !ok {
+		return nil
+	}
+	return copyShardInfo(&persistence.ShardInfoWithFailover{ShardInfo: shardInfo}).ShardInfo
+}
+
+func (r *fakeRing) setOwner(owner *membership.HostInfo) {
+	r.Lock()
+	defer r.Unlock()
+	r.owner = owner
+}
+
+func (r *fakeRing) getOwner() *membership.HostInfo {
+	r.RLock()
+	defer r.RUnlock()
+	return r.owner
+}
+
 func newContextMatcher(shardID int32) *contextMatcher {
 	return &contextMatcher{shardID: shardID}
 }
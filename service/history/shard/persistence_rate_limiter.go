@@ -0,0 +1,59 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shard
+
+import (
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/quotas"
+)
+
+const persistencePerShardRateLimiterRefreshInterval = 10 * time.Second
+
+// newPersistencePerShardRateLimiter creates the rate limiter for execution persistence calls of a single shard.
+// A non positive maxQPS disables the limit, changes of maxQPS are picked up every refreshInterval.
+func newPersistencePerShardRateLimiter(
+	maxQPS dynamicconfig.IntPropertyFn,
+	refreshInterval time.Duration,
+) quotas.RateLimiter {
+
+	rateFn := func() float64 {
+		if qps := maxQPS(); qps > 0 {
+			return float64(qps)
+		}
+		return float64(rate.Inf)
+	}
+	burstFn := func() int {
+		// burst is ignored by an unlimited rate limiter
+		if qps := maxQPS(); qps > 0 {
+			return qps
+		}
+		return 1
+	}
+	return quotas.NewDynamicRateLimiter(rateFn, burstFn, refreshInterval)
+}
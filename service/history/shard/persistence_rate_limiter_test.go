@@ -0,0 +1,90 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package shard
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+)
+
+func TestPersistencePerShardRateLimiter(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	var maxQPS int32 = 5
+	dcClient := dynamicconfig.NewMockClient(controller)
+	dcClient.EXPECT().GetIntValue(dynamicconfig.HistoryPersistencePerShardMaxQPS, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ dynamicconfig.Key, _ map[dynamicconfig.Filter]interface{}, _ int) (int, error) {
+			return int(atomic.LoadInt32(&maxQPS)), nil
+		}).AnyTimes()
+	collection := dynamicconfig.NewCollection(dcClient, log.NewNoopLogger())
+
+	executionManager := persistence.NewMockExecutionManager(controller)
+	executionManager.EXPECT().GetCurrentExecution(gomock.Any()).Return(&persistence.GetCurrentExecutionResponse{}, nil).AnyTimes()
+
+	refreshInterval := 100 * time.Millisecond
+	rateLimitedManager := persistence.NewWorkflowExecutionPersistenceRateLimitedClient(
+		executionManager,
+		newPersistencePerShardRateLimiter(collection.GetIntProperty(dynamicconfig.HistoryPersistencePerShardMaxQPS, 0), refreshInterval),
+		log.NewNoopLogger(),
+	)
+
+	countAllowed := func(calls int) int {
+		allowed := 0
+		for i := 0; i < calls; i++ {
+			_, err := rateLimitedManager.GetCurrentExecution(&persistence.GetCurrentExecutionRequest{})
+			if err == nil {
+				allowed++
+			} else {
+				require.Equal(t, persistence.ErrPersistenceLimitExceeded, err)
+			}
+		}
+		return allowed
+	}
+
+	// burst equals max qps, everything above it in a tight loop is throttled
+	require.Equal(t, 5, countAllowed(20))
+
+	// raising the limit is picked up after the refresh interval, then tokens refill at the new rate
+	atomic.StoreInt32(&maxQPS, 100)
+	time.Sleep(2 * refreshInterval)
+	countAllowed(1) // triggers the refresh
+	time.Sleep(600 * time.Millisecond)
+	require.Equal(t, 50, countAllowed(50))
+
+	// non positive limit disables throttling
+	atomic.StoreInt32(&maxQPS, 0)
+	time.Sleep(2 * refreshInterval)
+	countAllowed(1) // triggers the refresh
+	require.Equal(t, 1000, countAllowed(1000))
+}
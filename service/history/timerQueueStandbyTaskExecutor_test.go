@@ -763,6 +763,7 @@ func (s *timerQueueStandbyTaskExecutorSuite) TestProcessActivityTimeout_Multiple
 					DeleteSignalRequestedIDs:  map[string]struct{}{},
 					NewBufferedEvents:         nil,
 					ClearBufferedEvents:       false,
+					MutableStateSize:          persistence.ComputeMutableStateSize(mutableState.CloneToProto()),
 				},
 				NewWorkflowSnapshot: nil,
 			}, input)
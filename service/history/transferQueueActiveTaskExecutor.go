@@ -30,7 +30,6 @@ import (
 	"time"
 
 	"github.com/gogo/protobuf/proto"
-	"github.com/pborman/uuid"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
@@ -1230,7 +1229,7 @@ func (t *transferQueueActiveTaskExecutor) resetWorkflow(
 	workflowID := task.GetWorkflowId()
 	baseRunID := baseMutableState.GetExecutionState().GetRunId()
 
-	resetRunID := uuid.New()
+	resetRunID := t.shard.GetService().GetIDGenerator().NewUUID()
 	baseRebuildLastEventID := resetPoint.GetFirstWorkflowTaskCompletedId() - 1
 	baseVersionHistories := baseMutableState.GetExecutionInfo().GetVersionHistories()
 	baseCurrentVersionHistory, err := versionhistory.GetCurrentVersionHistory(baseVersionHistories)
@@ -1254,7 +1253,7 @@ func (t *transferQueueActiveTaskExecutor) resetWorkflow(
 		baseRebuildLastEventVersion,
 		baseNextEventID,
 		resetRunID,
-		uuid.New(),
+		t.shard.GetService().GetIDGenerator().NewUUID(),
 		newNDCWorkflow(
 			ctx,
 			t.shard.GetNamespaceCache(),
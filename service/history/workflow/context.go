@@ -455,6 +455,7 @@ func (c *ContextImpl) CreateWorkflowExecution(
 		newWorkflow.VisibilityTasks,
 	)
 	emitStateTransitionCount(c.metricsClient, newMutableState)
+	emitVersionHistoriesStats(c.metricsClient, newMutableState)
 
 	return nil
 }
@@ -626,8 +627,11 @@ func (c *ContextImpl) ConflictResolveWorkflowExecution(
 	}
 
 	emitStateTransitionCount(c.metricsClient, resetMutableState)
+	emitVersionHistoriesStats(c.metricsClient, resetMutableState)
 	emitStateTransitionCount(c.metricsClient, newMutableState)
+	emitVersionHistoriesStats(c.metricsClient, newMutableState)
 	emitStateTransitionCount(c.metricsClient, currentMutableState)
+	emitVersionHistoriesStats(c.metricsClient, currentMutableState)
 
 	return nil
 }
@@ -844,7 +848,9 @@ func (c *ContextImpl) UpdateWorkflowExecutionWithNew(
 	}
 
 	emitStateTransitionCount(c.metricsClient, c.MutableState)
+	emitVersionHistoriesStats(c.metricsClient, c.MutableState)
 	emitStateTransitionCount(c.metricsClient, newMutableState)
+	emitVersionHistoriesStats(c.metricsClient, newMutableState)
 
 	// finally emit session stats
 	namespace := c.GetNamespace()
@@ -30,6 +30,7 @@ import (
 
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/versionhistory"
 )
 
 func emitWorkflowHistoryStats(
@@ -110,6 +111,31 @@ func emitSessionUpdateStats(
 	countScope.RecordDistribution(metrics.DeleteRequestCancelInfoCount, stats.DeleteRequestCancelInfoCount)
 }
 
+// emitVersionHistoriesStats records the number of branches and the number of items of the current branch of a
+// written mutable state, so workflows forking excessively can be detected
+func emitVersionHistoriesStats(
+	metricsClient metrics.Client,
+	mutableState MutableState,
+) {
+	if mutableState == nil {
+		return
+	}
+
+	versionHistories := mutableState.GetExecutionInfo().GetVersionHistories()
+	if versionHistories == nil {
+		return
+	}
+
+	countScope := metricsClient.Scope(
+		metrics.ExecutionCountStatsScope,
+		metrics.NamespaceTag(mutableState.GetNamespaceEntry().GetInfo().Name),
+	)
+	countScope.RecordDistribution(metrics.VersionHistoriesBranchCount, len(versionHistories.Histories))
+	if currentVersionHistory, err := versionhistory.GetCurrentVersionHistory(versionHistories); err == nil {
+		countScope.RecordDistribution(metrics.VersionHistoryItemCount, len(currentVersionHistory.Items))
+	}
+}
+
 func emitWorkflowCompletionStats(
 	metricsClient metrics.Client,
 	namespace string,
@@ -0,0 +1,84 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package workflow
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+
+	historyspb "go.temporal.io/server/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/metrics"
+)
+
+func TestEmitVersionHistoriesStats(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	versionHistories := &historyspb.VersionHistories{
+		CurrentVersionHistoryIndex: 1,
+		Histories: []*historyspb.VersionHistory{
+			{
+				BranchToken: []byte("branch-1"),
+				Items:       []*historyspb.VersionHistoryItem{{EventId: 5, Version: 1}},
+			},
+			{
+				BranchToken: []byte("branch-2"),
+				Items: []*historyspb.VersionHistoryItem{
+					{EventId: 3, Version: 1},
+					{EventId: 9, Version: 2},
+				},
+			},
+			{
+				BranchToken: []byte("branch-3"),
+				Items:       []*historyspb.VersionHistoryItem{{EventId: 4, Version: 1}},
+			},
+		},
+	}
+	mutableState := NewMockMutableState(controller)
+	mutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{
+		VersionHistories: versionHistories,
+	}).AnyTimes()
+	mutableState.EXPECT().GetNamespaceEntry().Return(cache.NewLocalNamespaceCacheEntryForTest(
+		&persistencespb.NamespaceInfo{Name: "test-namespace"}, nil, "", nil,
+	)).AnyTimes()
+
+	scope := tally.NewTestScope("", nil)
+	emitVersionHistoriesStats(metrics.NewClient(scope, metrics.History), mutableState)
+	emitVersionHistoriesStats(metrics.NewClient(scope, metrics.History), nil)
+
+	timers := scope.Snapshot().Timers()
+	branchCount := timers["version_histories_branch_count+namespace=test-namespace,operation=ExecutionStats,stats_type=count"]
+	require.NotNil(t, branchCount)
+	require.Equal(t, []time.Duration{3 * time.Millisecond}, branchCount.Values())
+	itemCount := timers["version_history_item_count+namespace=test-namespace,operation=ExecutionStats,stats_type=count"]
+	require.NotNil(t, itemCount)
+	require.Equal(t, []time.Duration{2 * time.Millisecond}, itemCount.Values())
+}
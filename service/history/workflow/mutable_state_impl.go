@@ -30,7 +30,6 @@ import (
 	"time"
 
 	"github.com/gogo/protobuf/proto"
-	"github.com/pborman/uuid"
 	commandpb "go.temporal.io/api/command/v1"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
@@ -1239,7 +1238,7 @@ func (e *MutableStateImpl) addWorkflowExecutionStartedEventForContinueAsNew(
 	runTimeout := command.GetWorkflowRunTimeout()
 
 	createRequest := &workflowservice.StartWorkflowExecutionRequest{
-		RequestId:                uuid.New(),
+		RequestId:                e.shard.GetService().GetIDGenerator().NewUUID(),
 		Namespace:                e.namespaceEntry.GetInfo().Name,
 		WorkflowId:               execution.WorkflowId,
 		TaskQueue:                tq,
@@ -2953,7 +2952,7 @@ func (e *MutableStateImpl) AddContinueAsNewEvent(
 	}
 
 	var err error
-	newRunID := uuid.New()
+	newRunID := e.shard.GetService().GetIDGenerator().NewUUID()
 	newExecution := commonpb.WorkflowExecution{
 		WorkflowId: e.executionInfo.WorkflowId,
 		RunId:      newRunID,
@@ -3687,6 +3686,15 @@ func (e *MutableStateImpl) CloseTransactionAsMutation(
 		Condition:       e.nextEventIDInDB,
 		DBRecordVersion: e.dbRecordVersion,
 		Checksum:        checksum,
+
+		MutableStateSize: persistence.ComputeMutableStateSize(&persistencespb.WorkflowMutableState{
+			ExecutionInfo:       e.executionInfo,
+			ActivityInfos:       e.pendingActivityInfoIDs,
+			TimerInfos:          e.pendingTimerInfoIDs,
+			ChildExecutionInfos: e.pendingChildExecutionInfoIDs,
+			SignalInfos:         e.pendingSignalInfoIDs,
+			BufferedEvents:      e.bufferEventsInDB,
+		}),
 	}
 
 	e.checksum = checksum
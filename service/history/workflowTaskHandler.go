@@ -28,7 +28,6 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/pborman/uuid"
 	commandpb "go.temporal.io/api/command/v1"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
@@ -45,6 +44,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/workflow"
 )
@@ -71,6 +71,7 @@ type (
 
 		logger         log.Logger
 		namespaceCache cache.NamespaceCache
+		idGenerator    resource.IDGenerator
 		metricsClient  metrics.Client
 		config         *configs.Config
 	}
@@ -89,6 +90,7 @@ func newWorkflowTaskHandler(
 	sizeLimitChecker *workflowSizeChecker,
 	logger log.Logger,
 	namespaceCache cache.NamespaceCache,
+	idGenerator resource.IDGenerator,
 	metricsClient metrics.Client,
 	config *configs.Config,
 ) *workflowTaskHandlerImpl {
@@ -112,6 +114,7 @@ func newWorkflowTaskHandler(
 
 		logger:         logger,
 		namespaceCache: namespaceCache,
+		idGenerator:    idGenerator,
 		metricsClient:  metricsClient,
 		config:         config,
 	}
@@ -584,7 +587,7 @@ func (handler *workflowTaskHandlerImpl) handleCommandRequestCancelExternalWorkfl
 		return err
 	}
 
-	cancelRequestID := uuid.New()
+	cancelRequestID := handler.idGenerator.NewUUID()
 	_, _, err := handler.mutableState.AddRequestCancelExternalWorkflowExecutionInitiatedEvent(
 		handler.workflowTaskCompletedID, cancelRequestID, attr,
 	)
@@ -783,7 +786,7 @@ func (handler *workflowTaskHandlerImpl) handleCommandStartChildWorkflow(
 
 	enums.SetDefaultWorkflowIdReusePolicy(&attr.WorkflowIdReusePolicy)
 
-	requestID := uuid.New()
+	requestID := handler.idGenerator.NewUUID()
 	_, _, err = handler.mutableState.AddStartChildWorkflowExecutionInitiatedEvent(
 		handler.workflowTaskCompletedID, requestID, attr,
 	)
@@ -837,7 +840,7 @@ func (handler *workflowTaskHandlerImpl) handleCommandSignalExternalWorkflow(
 		return err
 	}
 
-	signalRequestID := uuid.New() // for deduplicate
+	signalRequestID := handler.idGenerator.NewUUID() // for deduplicate
 	_, _, err = handler.mutableState.AddSignalExternalWorkflowExecutionInitiatedEvent(
 		handler.workflowTaskCompletedID, signalRequestID, attr,
 	)
@@ -439,6 +439,7 @@ Update_History_Loop:
 				workflowSizeChecker,
 				handler.logger,
 				handler.namespaceCache,
+				handler.shard.GetService().GetIDGenerator(),
 				handler.metricsClient,
 				handler.config,
 			)
@@ -82,6 +82,7 @@ func NewHandler(
 			resource.GetMetricsClient(),
 			resource.GetNamespaceCache(),
 			resource.GetMatchingServiceResolver(),
+			resource.GetIDGenerator(),
 		),
 	}
 
@@ -113,6 +114,12 @@ func (h *Handler) Check(_ context.Context, request *healthpb.HealthCheckRequest)
 		}, nil
 	}
 
+	if !h.IsReady() {
+		return &healthpb.HealthCheckResponse{
+			Status: healthpb.HealthCheckResponse_NOT_SERVING,
+		}, nil
+	}
+
 	hs := &healthpb.HealthCheckResponse{
 		Status: healthpb.HealthCheckResponse_SERVING,
 	}
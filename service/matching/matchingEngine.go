@@ -53,6 +53,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/resource"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
 )
 
@@ -86,6 +87,7 @@ type (
 		lockableQueryTaskMap lockableQueryTaskMap
 		namespaceCache       cache.NamespaceCache
 		keyResolver          membership.ServiceResolver
+		idGenerator          resource.IDGenerator
 	}
 )
 
@@ -116,6 +118,7 @@ func NewEngine(taskManager persistence.TaskManager,
 	metricsClient metrics.Client,
 	namespaceCache cache.NamespaceCache,
 	resolver membership.ServiceResolver,
+	idGenerator resource.IDGenerator,
 ) Engine {
 
 	return &matchingEngineImpl{
@@ -131,6 +134,7 @@ func NewEngine(taskManager persistence.TaskManager,
 		lockableQueryTaskMap: lockableQueryTaskMap{queryTaskMap: make(map[string]chan *queryResult)},
 		namespaceCache:       namespaceCache,
 		keyResolver:          resolver,
+		idGenerator:          idGenerator,
 	}
 }
 
@@ -858,7 +862,7 @@ func (e *matchingEngineImpl) recordWorkflowTaskStarted(
 		WorkflowExecution: task.workflowExecution(),
 		ScheduleId:        task.event.Data.GetScheduleId(),
 		TaskId:            task.event.GetTaskId(),
-		RequestId:         uuid.New(),
+		RequestId:         e.idGenerator.NewUUID(),
 		PollRequest:       pollReq,
 	}
 	var resp *historyservice.RecordWorkflowTaskStartedResponse
@@ -887,7 +891,7 @@ func (e *matchingEngineImpl) recordActivityTaskStarted(
 		WorkflowExecution: task.workflowExecution(),
 		ScheduleId:        task.event.Data.GetScheduleId(),
 		TaskId:            task.event.GetTaskId(),
-		RequestId:         uuid.New(),
+		RequestId:         e.idGenerator.NewUUID(),
 		PollRequest:       pollReq,
 	}
 	var resp *historyservice.RecordActivityTaskStartedResponse
@@ -63,6 +63,7 @@ import (
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/common/resource"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
 )
 
@@ -143,6 +144,7 @@ func newMatchingEngine(
 		tokenSerializer: common.NewProtoTaskTokenSerializer(),
 		config:          config,
 		namespaceCache:  mockNamespaceCache,
+		idGenerator:     resource.NewIDGenerator(),
 	}
 }
 
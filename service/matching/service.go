@@ -101,6 +101,8 @@ func NewService(
 	grpcServerOptions = append(
 		grpcServerOptions,
 		grpc.ChainUnaryInterceptor(
+			interceptor.CorrelationIDServerInterceptor,
+			interceptor.NewTracingInterceptor(params.TracerProvider).Intercept,
 			rpc.ServiceErrorInterceptor,
 			metrics.NewServerMetricsContextInjectorInterceptor(),
 			metrics.NewServerMetricsTrailerPropagatorInterceptor(logger),
@@ -128,7 +130,9 @@ func (s *Service) Start() {
 	logger.Info("matching starting")
 
 	// must start base service first
-	s.Resource.Start()
+	if err := s.Resource.Start(); err != nil {
+		logger.Fatal("unable to start service resources", tag.Error(err))
+	}
 	s.handler.Start()
 
 	matchingservice.RegisterMatchingServiceServer(s.server, s.handler)
@@ -148,9 +152,8 @@ func (s *Service) Stop() {
 	}
 
 	// remove self from membership ring and wait for traffic to drain
-	s.GetLogger().Info("ShutdownHandler: Evicting self from membership ring")
-	s.GetMembershipMonitor().EvictSelf()
-	s.GetLogger().Info("ShutdownHandler: Waiting for others to discover I am unhealthy")
+	s.LeaveMembershipRing()
+	s.GetLogger().Info("ShutdownHandler: Waiting for traffic to drain")
 	time.Sleep(s.config.ShutdownDrainDuration())
 
 	// TODO: Change this to GracefulStop when integration tests are refactored.
@@ -85,7 +85,7 @@ func uploadHistoryActivity(ctx context.Context, request ArchiveRequest) (err err
 		BranchToken:          request.BranchToken,
 		NextEventID:          request.NextEventID,
 		CloseFailoverVersion: request.CloseFailoverVersion,
-	}, carchiver.GetHeartbeatArchiveOption(), carchiver.GetNonRetryableErrorOption(errUploadNonRetryable))
+	}, archiveOptions(container, carchiver.GetHeartbeatArchiveOption(), carchiver.GetNonRetryableErrorOption(errUploadNonRetryable))...)
 	if err == nil {
 		return nil
 	}
@@ -171,7 +171,7 @@ func archiveVisibilityActivity(ctx context.Context, request ArchiveRequest) (err
 		Memo:               request.Memo,
 		SearchAttributes:   searchAttributes,
 		HistoryArchivalUri: request.HistoryURI,
-	}, carchiver.GetNonRetryableErrorOption(errArchiveVisibilityNonRetryable))
+	}, archiveOptions(container, carchiver.GetNonRetryableErrorOption(errArchiveVisibilityNonRetryable))...)
 	if err == nil {
 		return nil
 	}
@@ -182,3 +182,10 @@ func archiveVisibilityActivity(ctx context.Context, request ArchiveRequest) (err
 	logger.Error(carchiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason("got retryable error from visibility archiver"), tag.Error(err))
 	return err
 }
+
+func archiveOptions(container *BootstrapContainer, opts ...carchiver.ArchiveOption) []carchiver.ArchiveOption {
+	if container.Config != nil && container.Config.ArchivalDryRun() {
+		opts = append(opts, carchiver.GetDryRunArchiveOption())
+	}
+	return opts
+}
@@ -103,6 +103,7 @@ type (
 		numWorkflows     dynamicconfig.IntPropertyFn
 		rateLimiter      quotas.RateLimiter
 		archiverProvider provider.ArchiverProvider
+		dryRun           dynamicconfig.BoolPropertyFn
 	}
 
 	// ArchivalTarget is either history or visibility
@@ -129,6 +130,7 @@ func NewClient(
 	publicClient sdkclient.Client,
 	numWorkflows dynamicconfig.IntPropertyFn,
 	requestRPS dynamicconfig.IntPropertyFn,
+	dryRun dynamicconfig.BoolPropertyFn,
 	archiverProvider provider.ArchiverProvider,
 ) Client {
 	return &client{
@@ -140,6 +142,7 @@ func NewClient(
 			func() float64 { return float64(requestRPS()) },
 		),
 		archiverProvider: archiverProvider,
+		dryRun:           dryRun,
 	}
 }
 
@@ -224,7 +227,7 @@ func (c *client) archiveHistoryInline(ctx context.Context, request *ClientReques
 		BranchToken:          request.ArchiveRequest.BranchToken,
 		NextEventID:          request.ArchiveRequest.NextEventID,
 		CloseFailoverVersion: request.ArchiveRequest.CloseFailoverVersion,
-	})
+	}, c.archiveOptions()...)
 }
 
 func (c *client) archiveVisibilityInline(ctx context.Context, request *ClientRequest, logger log.Logger, errCh chan error) {
@@ -271,7 +274,14 @@ func (c *client) archiveVisibilityInline(ctx context.Context, request *ClientReq
 		Memo:               request.ArchiveRequest.Memo,
 		SearchAttributes:   searchAttributes,
 		HistoryArchivalUri: request.ArchiveRequest.HistoryURI,
-	})
+	}, c.archiveOptions()...)
+}
+
+func (c *client) archiveOptions() []carchiver.ArchiveOption {
+	if c.dryRun() {
+		return []carchiver.ArchiveOption{carchiver.GetDryRunArchiveOption()}
+	}
+	return nil
 }
 
 func (c *client) sendArchiveSignal(ctx context.Context, request *ArchiveRequest, taggedLogger log.Logger) error {
@@ -78,6 +78,7 @@ func (s *clientSuite) SetupTest() {
 		nil,
 		dynamicconfig.GetIntPropertyFn(1000),
 		dynamicconfig.GetIntPropertyFn(1000),
+		dynamicconfig.GetBoolPropertyFn(false),
 		s.archiverProvider,
 	).(*client)
 	s.client.temporalClient = s.sdkClient
@@ -71,6 +71,7 @@ type (
 		ArchiverConcurrency           dynamicconfig.IntPropertyFn
 		ArchivalsPerIteration         dynamicconfig.IntPropertyFn
 		TimeLimitPerArchivalIteration dynamicconfig.DurationPropertyFn
+		ArchivalDryRun                dynamicconfig.BoolPropertyFn
 	}
 
 	contextKey int
@@ -101,11 +101,24 @@ func (r *replayMetricsClient) UpdateGauge(scope int, gauge int, value float64) {
 	r.client.UpdateGauge(scope, gauge, value)
 }
 
+// RecordOutcome increments the success or failure counters for the given error
+func (r *replayMetricsClient) RecordOutcome(scope int, err error) {
+	if workflow.IsReplaying(r.ctx) {
+		return
+	}
+	r.client.RecordOutcome(scope, err)
+}
+
 // Scope returns a client that adds the given tags to all metrics
 func (r *replayMetricsClient) Scope(scope int, tags ...metrics.Tag) metrics.Scope {
 	return NewReplayMetricsScope(r.client.Scope(scope, tags...), r.ctx)
 }
 
+// ShardScope returns a client that adds the shard tag to all metrics
+func (r *replayMetricsClient) ShardScope(scope int, shardID int32) metrics.Scope {
+	return NewReplayMetricsScope(r.client.ShardScope(scope, shardID), r.ctx)
+}
+
 func (r *replayMetricsClient) UserScope() metrics.UserScope {
 	panic("Not supported")
 }
@@ -131,6 +131,7 @@ func NewConfig(params *resource.BootstrapParams) *Config {
 			ArchiverConcurrency:           dc.GetIntProperty(dynamicconfig.WorkerArchiverConcurrency, 50),
 			ArchivalsPerIteration:         dc.GetIntProperty(dynamicconfig.WorkerArchivalsPerIteration, 1000),
 			TimeLimitPerArchivalIteration: dc.GetDurationProperty(dynamicconfig.WorkerTimeLimitPerArchivalIteration, archiver.MaxArchivalIterationTimeout()),
+			ArchivalDryRun:                dc.GetBoolProperty(dynamicconfig.ArchivalDryRun, false),
 		},
 		ScannerCfg: &scanner.Config{
 			PersistenceMaxQPS:        dc.GetIntProperty(dynamicconfig.ScannerPersistenceMaxQPS, 100),
@@ -157,7 +158,9 @@ func (s *Service) Start() {
 	logger := s.GetLogger()
 	logger.Info("worker starting", tag.ComponentWorker)
 
-	s.Resource.Start()
+	if err := s.Resource.Start(); err != nil {
+		logger.Fatal("unable to start service resources", tag.Error(err))
+	}
 
 	s.ensureSystemNamespaceExists()
 	s.startScanner()
@@ -43,3 +43,18 @@ func InterruptCh() <-chan interface{} {
 
 	return ret
 }
+
+// ConfigReloadCh returns a channel which receives a value on every SIGHUP
+func ConfigReloadCh() <-chan interface{} {
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, syscall.SIGHUP)
+
+	ret := make(chan interface{}, 1)
+	go func() {
+		for s := range c {
+			ret <- s
+		}
+	}()
+
+	return ret
+}
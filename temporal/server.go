@@ -78,6 +78,7 @@ type (
 		namespaceLogger   log.Logger
 		serverReporter    metrics.Reporter
 		sdkReporter       metrics.Reporter
+		esClient          *esclient.ReloadableClient
 	}
 )
 
@@ -234,6 +235,10 @@ func (s *Server) Start() error {
 
 	}
 
+	if s.so.reloadCh != nil {
+		go s.reloadConfigOnSignal()
+	}
+
 	if s.so.blockingStart {
 		// If s.so.interruptCh is nil this will wait forever.
 		interruptSignal := <-s.so.interruptCh
@@ -244,6 +249,11 @@ func (s *Server) Start() error {
 	return nil
 }
 
+// GetPProfAddress returns the address pprof listens on, or empty string if pprof is not enabled.
+func (s *Server) GetPProfAddress() string {
+	return pprof.Address()
+}
+
 // Stop stops the server.
 func (s *Server) Stop() {
 	var wg sync.WaitGroup
@@ -297,7 +307,7 @@ func (s *Server) newBootstrapParams(
 	}
 
 	svcCfg := s.so.config.Services[svcName]
-	rpcFactory := rpc.NewFactory(&svcCfg.RPC, svcName, s.logger, s.so.tlsConfigProvider)
+	rpcFactory := rpc.NewFactory(&svcCfg.RPC, s.so.config.Global.Authorization.InternodeAuth, svcName, s.logger, s.so.tlsConfigProvider)
 	params.RPCFactory = rpcFactory
 
 	// Ringpop uses a different port to register handlers, this map is needed to resolve
@@ -314,6 +324,7 @@ func (s *Server) newBootstrapParams(
 				rpcFactory.GetRingpopChannel(),
 				svcName,
 				servicePortMap,
+				params.MetricsClient,
 				logger,
 				persistenceBean.GetClusterMetadataManager(),
 			)
@@ -345,6 +356,8 @@ func (s *Server) newBootstrapParams(
 	}
 
 	params.MetricsClient = metricsClient
+	params.MetricsScopes = s.so.metricsScopes
+	params.FanOutMetrics(svcName)
 
 	options, err := s.so.tlsConfigProvider.GetFrontendClientConfig()
 	if err != nil {
@@ -354,7 +367,7 @@ func (s *Server) newBootstrapParams(
 	params.SdkClient, err = sdkclient.NewClient(sdkclient.Options{
 		HostPort:     s.so.config.PublicClient.HostPort,
 		Namespace:    common.SystemLocalNamespace,
-		MetricsScope: globalTallyScope,
+		MetricsScope: params.MetricsScope,
 		Logger:       log.NewSdkLogger(s.logger),
 		ConnectionOptions: sdkclient.ConnectionOptions{
 			TLS:                options,
@@ -376,6 +389,8 @@ func (s *Server) newBootstrapParams(
 
 	params.ArchiverProvider = provider.NewArchiverProvider(s.so.config.Archival.History.Provider, s.so.config.Archival.Visibility.Provider)
 	params.PersistenceConfig.TransactionSizeLimit = dc.GetIntProperty(dynamicconfig.TransactionSizeLimit, common.DefaultTransactionSizeLimit)
+	params.PersistenceConfig.MutableStateSizeLimit = dc.GetIntProperty(dynamicconfig.MutableStateSizeLimit, common.DefaultMutableStateSizeLimit)
+	params.PersistenceConfig.RetryMaxAttempts = dc.GetIntProperty(dynamicconfig.PersistenceRetryMaxAttempts, 0)
 
 	if s.so.authorizer != nil {
 		params.Authorizer = s.so.authorizer
@@ -388,6 +403,8 @@ func (s *Server) newBootstrapParams(
 		params.ClaimMapper = authorization.NewNoopClaimMapper()
 	}
 	params.AudienceGetter = s.so.audienceGetter
+	params.ProfileExporterConfig = s.so.profileExporterConfig
+	params.TracerProvider = s.so.tracerProvider
 
 	params.PersistenceServiceResolver = s.so.persistenceServiceResolver
 
@@ -417,14 +434,62 @@ func (s *Server) getESConfigClient(advancedVisibilityWritingMode string) (*confi
 		}
 	}
 
-	esClient, err := esclient.NewClient(advancedVisibilityStore.ElasticSearch, s.so.elasticseachHttpClient, s.logger)
+	esClient, err := esclient.NewReloadableClient(advancedVisibilityStore.ElasticSearch, s.so.elasticseachHttpClient, s.logger)
 	if err != nil {
 		return nil, nil, fmt.Errorf("unable to create Elasticsearch client: %w", err)
 	}
+	s.esClient = esClient
 
 	return advancedVisibilityStore.ElasticSearch, esClient, nil
 }
 
+// ReloadESClient rebuilds the Elasticsearch client shared by all services from cfg and swaps it in,
+// so that credentials or endpoints can be rotated without a restart. Index names are not reloaded.
+func (s *Server) ReloadESClient(cfg *config.Elasticsearch) error {
+	if s.esClient == nil {
+		return errors.New("advanced visibility is not enabled")
+	}
+	if err := s.esClient.Reload(cfg); err != nil {
+		return fmt.Errorf("unable to reload Elasticsearch client: %w", err)
+	}
+	s.logger.Info("Reloaded Elasticsearch client")
+	return nil
+}
+
+// reloadConfigOnSignal reloads the config on every signal from the reload channel until the server is stopped
+func (s *Server) reloadConfigOnSignal() {
+	for {
+		select {
+		case <-s.stoppedCh:
+			return
+		case reloadSignal, ok := <-s.so.reloadCh:
+			if !ok {
+				return
+			}
+			s.logger.Info("Received reload signal, reloading config.", tag.Value(reloadSignal))
+			if err := s.reloadConfig(); err != nil {
+				s.logger.Error("Unable to reload config.", tag.Error(err))
+			}
+		}
+	}
+}
+
+func (s *Server) reloadConfig() error {
+	cfg, err := config.LoadConfig(s.so.env, s.so.configDir, s.so.zone)
+	if err != nil {
+		return err
+	}
+	if s.esClient == nil {
+		return nil
+	}
+
+	advancedVisibilityStore, ok := cfg.Persistence.DataStores[cfg.Persistence.AdvancedVisibilityStore]
+	if !ok || advancedVisibilityStore.ElasticSearch == nil {
+		return fmt.Errorf("unable to find advanced visibility store in config for %q key", cfg.Persistence.AdvancedVisibilityStore)
+	}
+	return s.ReloadESClient(advancedVisibilityStore.ElasticSearch)
+}
+
 func verifyPersistenceCompatibleVersion(config config.Persistence, persistenceServiceResolver resolver.ServiceResolver, checkVisibility bool) error {
 	// cassandra schema version validation
 	if err := cassandra.VerifyCompatibleVersion(config, persistenceServiceResolver, checkVisibility); err != nil {
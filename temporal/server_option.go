@@ -27,12 +27,16 @@ package temporal
 import (
 	"net/http"
 
+	"github.com/uber-go/tally"
+	"go.opentelemetry.io/otel/trace"
+
 	"go.temporal.io/server/client"
 	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	persistenceclient "go.temporal.io/server/common/persistence/client"
+	"go.temporal.io/server/common/pprof"
 	"go.temporal.io/server/common/resolver"
 	"go.temporal.io/server/common/rpc/encryption"
 )
@@ -69,6 +73,14 @@ func InterruptOn(interruptCh <-chan interface{}) ServerOption {
 	})
 }
 
+// ReloadConfigOn reloads the config from the config loader directory on every signal from reloadCh.
+// Only the Elasticsearch client is rebuilt from the reloaded config.
+func ReloadConfigOn(reloadCh <-chan interface{}) ServerOption {
+	return newApplyFuncContainer(func(s *serverOptions) {
+		s.reloadCh = reloadCh
+	})
+}
+
 func WithLogger(logger log.Logger) ServerOption {
 	return newApplyFuncContainer(func(s *serverOptions) {
 		s.logger = logger
@@ -121,6 +133,13 @@ func WithCustomMetricsReporter(reporter interface{}) ServerOption {
 	})
 }
 
+// WithAdditionalMetricsScopes emits all server metrics to each of the given scopes in addition to the configured reporter
+func WithAdditionalMetricsScopes(scopes ...tally.Scope) ServerOption {
+	return newApplyFuncContainer(func(s *serverOptions) {
+		s.metricsScopes = append(s.metricsScopes, scopes...)
+	})
+}
+
 // Set custom persistence service resolver which will convert service name or address value from config to another a....
 func WithPersistenceServiceResolver(r resolver.ServiceResolver) ServerOption {
 	return newApplyFuncContainer(func(s *serverOptions) {
@@ -157,3 +176,19 @@ func WithClientFactoryProvider(clientFactoryProvider client.FactoryProvider) Ser
 		s.clientFactoryProvider = clientFactoryProvider
 	})
 }
+
+// WithProfileExporter periodically captures CPU profiles and hands them to config.Sink
+// NOTE: this option is experimental and may be changed or removed in future release.
+func WithProfileExporter(config pprof.ExporterConfig) ServerOption {
+	return newApplyFuncContainer(func(s *serverOptions) {
+		s.profileExporterConfig = &config
+	})
+}
+
+// WithTracerProvider starts a span for every gRPC request handled by the server using tracers of the given provider
+// NOTE: this option is experimental and may be changed or removed in future release.
+func WithTracerProvider(tracerProvider trace.TracerProvider) ServerOption {
+	return newApplyFuncContainer(func(s *serverOptions) {
+		s.tracerProvider = tracerProvider
+	})
+}
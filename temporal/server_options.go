@@ -28,12 +28,16 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/uber-go/tally"
+	"go.opentelemetry.io/otel/trace"
+
 	"go.temporal.io/server/client"
 	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
+	"go.temporal.io/server/common/pprof"
 	"go.temporal.io/server/common/resolver"
 	"go.temporal.io/server/common/rpc/encryption"
 )
@@ -49,6 +53,7 @@ type (
 
 		interruptCh   <-chan interface{}
 		blockingStart bool
+		reloadCh      <-chan interface{}
 
 		logger                     log.Logger
 		namespaceLogger            log.Logger
@@ -62,6 +67,9 @@ type (
 		dynamicConfigClient        dynamicconfig.Client
 		customDataStoreFactory     persistenceClient.AbstractDataStoreFactory
 		clientFactoryProvider      client.FactoryProvider
+		profileExporterConfig      *pprof.ExporterConfig
+		tracerProvider             trace.TracerProvider
+		metricsScopes              []tally.Scope
 	}
 )
 
@@ -92,7 +92,8 @@ func (s *VersionTestSuite) TestVerifyCompatibleVersion() {
 			"default":    {Cassandra: &defaultCfg},
 			"visibility": {Cassandra: &visibilityCfg},
 		},
-		TransactionSizeLimit: dynamicconfig.GetIntPropertyFn(common.DefaultTransactionSizeLimit),
+		TransactionSizeLimit:  dynamicconfig.GetIntPropertyFn(common.DefaultTransactionSizeLimit),
+		MutableStateSizeLimit: dynamicconfig.GetIntPropertyFn(common.DefaultMutableStateSizeLimit),
 	}
 	s.NoError(cassandra.VerifyCompatibleVersion(cfg, resolver.NewNoopResolver(), true))
 }
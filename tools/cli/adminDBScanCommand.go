@@ -40,6 +40,7 @@ import (
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/persistence"
 	cassp "go.temporal.io/server/common/persistence/cassandra"
@@ -302,7 +303,7 @@ func scanShard(
 		}
 		return report
 	}
-	execMan := persistence.NewExecutionManager(execStore, log.NewNoopLogger())
+	execMan := persistence.NewExecutionManager(execStore, log.NewNoopLogger(), dynamicconfig.GetIntPropertyFn(common.DefaultMutableStateSizeLimit))
 
 	var token []byte
 	isFirstIteration := true
@@ -304,6 +304,8 @@ func initializeClusterMetadata(
 		clusterMetadata.MasterClusterName,
 		clusterMetadata.CurrentClusterName,
 		clusterMetadata.ClusterInformation,
+		metrics.NewNoopMetricsClient(),
+		log.NewNoopLogger(),
 	)
 }
 
@@ -132,7 +132,8 @@ func (s *VersionTestSuite) TestVerifyCompatibleVersion() {
 			"default":    {SQL: &defaultCfg},
 			"visibility": {SQL: &visibilityCfg},
 		},
-		TransactionSizeLimit: dynamicconfig.GetIntPropertyFn(common.DefaultTransactionSizeLimit),
+		TransactionSizeLimit:  dynamicconfig.GetIntPropertyFn(common.DefaultTransactionSizeLimit),
+		MutableStateSizeLimit: dynamicconfig.GetIntPropertyFn(common.DefaultMutableStateSizeLimit),
 	}
 	s.NoError(persistencesql.VerifyCompatibleVersion(cfg, resolver.NewNoopResolver(), true))
 }